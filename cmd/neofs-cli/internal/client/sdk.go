@@ -7,6 +7,8 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
@@ -58,28 +60,94 @@ func GetSDKClient(key *ecdsa.PrivateKey, addr network.Address) (*client.Client,
 	return &c, nil
 }
 
-// GetCurrentEpoch returns current epoch.
-func GetCurrentEpoch(ctx context.Context, endpoint string) (uint64, error) {
+// NetworkEpoch groups the epoch parameters of the NeoFS network.
+type NetworkEpoch struct {
+	// Epoch is the current epoch.
+	Epoch uint64
+	// Duration is the epoch duration in blocks, zero if not reported.
+	Duration uint64
+	// MsPerBlock is the time per block in milliseconds, zero if not reported.
+	MsPerBlock int64
+}
+
+// ErrUnknownEpochDuration is returned by NetworkEpoch.EpochAt if the network
+// reports zero epoch duration or time per block.
+var ErrUnknownEpochDuration = errors.New("network reports zero epoch duration, use epoch numbers instead")
+
+// EpochAt estimates the epoch which is current at the given time counting
+// from the current epoch. The time must not be in the past.
+//
+// Returns ErrUnknownEpochDuration if the duration of the epoch is unknown.
+func (x NetworkEpoch) EpochAt(t time.Time) (uint64, error) {
+	if x.Duration == 0 || x.MsPerBlock <= 0 {
+		return 0, ErrUnknownEpochDuration
+	}
+
+	d := time.Until(t)
+	if d < 0 {
+		return 0, fmt.Errorf("time %s is in the past", t.Format(time.RFC3339))
+	}
+
+	epochMs := x.Duration * uint64(x.MsPerBlock)
+
+	return x.Epoch + uint64(d.Milliseconds())/epochMs, nil
+}
+
+var (
+	networkEpochMtx   sync.Mutex
+	networkEpochCache = make(map[string]NetworkEpoch)
+)
+
+// GetNetworkEpoch returns the current epoch of the NeoFS network with its
+// duration requested with one NetworkInfo call. The result is cached for
+// the lifetime of the process, i.e. for the whole command run.
+func GetNetworkEpoch(ctx context.Context, endpoint string) (NetworkEpoch, error) {
+	networkEpochMtx.Lock()
+	defer networkEpochMtx.Unlock()
+
+	if ne, ok := networkEpochCache[endpoint]; ok {
+		return ne, nil
+	}
+
 	var addr network.Address
 
 	if err := addr.FromString(endpoint); err != nil {
-		return 0, fmt.Errorf("can't parse RPC endpoint: %w", err)
+		return NetworkEpoch{}, fmt.Errorf("can't parse RPC endpoint: %w", err)
 	}
 
 	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
-		return 0, fmt.Errorf("can't generate key to sign query: %w", err)
+		return NetworkEpoch{}, fmt.Errorf("can't generate key to sign query: %w", err)
 	}
 
 	c, err := GetSDKClient(key, addr)
 	if err != nil {
-		return 0, err
+		return NetworkEpoch{}, err
 	}
 
-	ni, err := c.NetworkInfo(ctx, client.PrmNetworkInfo{})
+	res, err := c.NetworkInfo(ctx, client.PrmNetworkInfo{})
+	if err != nil {
+		return NetworkEpoch{}, err
+	}
+
+	ni := res.Info()
+	ne := NetworkEpoch{
+		Epoch:      ni.CurrentEpoch(),
+		Duration:   ni.EpochDuration(),
+		MsPerBlock: ni.MsPerBlock(),
+	}
+
+	networkEpochCache[endpoint] = ne
+
+	return ne, nil
+}
+
+// GetCurrentEpoch returns current epoch.
+func GetCurrentEpoch(ctx context.Context, endpoint string) (uint64, error) {
+	ne, err := GetNetworkEpoch(ctx, endpoint)
 	if err != nil {
 		return 0, err
 	}
 
-	return ni.Info().CurrentEpoch(), nil
+	return ne.Epoch, nil
 }
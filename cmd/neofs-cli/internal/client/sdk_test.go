@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkEpoch_EpochAt(t *testing.T) {
+	ne := NetworkEpoch{
+		Epoch:      10,
+		Duration:   100,
+		MsPerBlock: 1000,
+	}
+
+	t.Run("unknown duration", func(t *testing.T) {
+		for _, x := range []NetworkEpoch{
+			{Epoch: 10, MsPerBlock: 1000},
+			{Epoch: 10, Duration: 100},
+		} {
+			_, err := x.EpochAt(time.Now().Add(time.Hour))
+			require.ErrorIs(t, err, ErrUnknownEpochDuration)
+		}
+	})
+
+	t.Run("past", func(t *testing.T) {
+		_, err := ne.EpochAt(time.Now().Add(-time.Minute))
+		require.Error(t, err)
+	})
+
+	t.Run("future", func(t *testing.T) {
+		epoch, err := ne.EpochAt(time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 10, epoch)
+
+		epoch, err = ne.EpochAt(time.Now().Add(250 * time.Second))
+		require.NoError(t, err)
+		require.EqualValues(t, 12, epoch)
+	})
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// ErrAwaitTimeout is returned when the awaited condition
+// has not been met in time.
+var ErrAwaitTimeout = errors.New("timeout")
+
 // ExitOnErr prints error and exits with a code that matches
-// one of the common errors from sdk library. If no errors
-// found, exits with 1 code.
+// one of the common errors from sdk library or ErrAwaitTimeout.
+// If no errors found, exits with 1 code.
 // Does nothing if passed error in nil.
 func ExitOnErr(cmd *cobra.Command, errFmt string, err error) {
 	if err == nil {
@@ -26,6 +30,7 @@ func ExitOnErr(cmd *cobra.Command, errFmt string, err error) {
 		_ = iota
 		internal
 		aclDenied
+		awaitTimeout
 	)
 
 	var (
@@ -41,6 +46,8 @@ func ExitOnErr(cmd *cobra.Command, errFmt string, err error) {
 	case errors.As(err, &accessErr):
 		code = aclDenied
 		err = fmt.Errorf("%w: %s", err, accessErr.Reason())
+	case errors.Is(err, ErrAwaitTimeout):
+		code = awaitTimeout
 	default:
 		code = internal
 	}
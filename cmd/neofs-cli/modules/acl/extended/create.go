@@ -11,6 +11,7 @@ import (
 
 	"github.com/flynn-archive/go-shlex"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/spf13/cobra"
@@ -42,10 +43,22 @@ Target is
   'others' for all other request senders, 
   'pubkey:<key1>,<key2>,...' for exact request sender, where <key> is a hex-encoded 33-byte public key.
 
-When both '--rule' and '--file' arguments are used, '--rule' records will be placed higher in resulting extended ACL table.
+Template is a canonical extended ACL table for the common access scenario:
+  'private' denies all the operations for others,
+  'read-only' allows others to read the objects, but denies PUT and DELETE,
+  'public-append' allows others to read and put the objects, but denies DELETE.
+
+Records are placed in the resulting extended ACL table in the following order:
+records of the table read with '--from-file', '--rule' records, '--file' records
+and template records.
+
+The resulting table is validated (see 'neofs-cli acl extended validate'), the
+command fails if any error is found.
 `,
-	Example: `neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -f rules.txt --out table.json
-neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -r 'allow get obj:Key=Value others' -r 'deny put others'`,
+	Example: `neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -f rules.txt --to-file table.json
+neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -r 'allow get obj:Key=Value others' -r 'deny put others'
+neofs-cli acl extended create --template read-only -r 'allow put pubkey:036410abb260bbbda89f61c0cad65a4fa15ac5cb83b3c3abf8aee403856fcf65ed' --to-file table.json
+neofs-cli acl extended create --from-file table.json -r 'deny get obj:Secret=true others' --to-file new_table.json`,
 	Run: createEACL,
 }
 
@@ -53,18 +66,32 @@ func init() {
 	createCmd.Flags().StringArrayP("rule", "r", nil, "extended ACL table record to apply")
 	createCmd.Flags().StringP("file", "f", "", "read list of extended ACL table records from from text file")
 	createCmd.Flags().StringP("out", "o", "", "save JSON formatted extended ACL table in file")
+	createCmd.Flags().String("to-file", "", "save JSON formatted extended ACL table in file")
+	createCmd.Flags().String("from-file", "", "read JSON or binary encoded extended ACL table to extend from file")
+	createCmd.Flags().String("template", "", "add records of the extended ACL template ("+strings.Join(templateNames(), ", ")+")")
 	createCmd.Flags().StringP("cid", "", "", "container ID")
 
+	_ = createCmd.Flags().MarkDeprecated("out", "use --to-file instead")
+
 	_ = cobra.MarkFlagFilename(createCmd.Flags(), "file")
 	_ = cobra.MarkFlagFilename(createCmd.Flags(), "out")
+	_ = cobra.MarkFlagFilename(createCmd.Flags(), "to-file")
+	_ = cobra.MarkFlagFilename(createCmd.Flags(), "from-file")
 }
 
 func createEACL(cmd *cobra.Command, _ []string) {
 	rules, _ := cmd.Flags().GetStringArray("rule")
 	fileArg, _ := cmd.Flags().GetString("file")
 	outArg, _ := cmd.Flags().GetString("out")
+	toFileArg, _ := cmd.Flags().GetString("to-file")
+	fromFileArg, _ := cmd.Flags().GetString("from-file")
+	templateArg, _ := cmd.Flags().GetString("template")
 	cidArg, _ := cmd.Flags().GetString("cid")
 
+	if toFileArg != "" {
+		outArg = toFileArg
+	}
+
 	var containerID cid.ID
 	if cidArg != "" {
 		if err := containerID.DecodeString(cidArg); err != nil {
@@ -80,12 +107,15 @@ func createEACL(cmd *cobra.Command, _ []string) {
 	}
 
 	rules = append(rules, rulesFile...)
-	if len(rules) == 0 {
+	if len(rules) == 0 && templateArg == "" && fromFileArg == "" {
 		cmd.PrintErrln("no extended ACL rules has been provided")
 		os.Exit(1)
 	}
 
 	tb := eacl.NewTable()
+	if fromFileArg != "" {
+		tb = common.ReadEACL(cmd, fromFileArg)
+	}
 
 	for _, ruleStr := range rules {
 		r, err := shlex.Split(ruleStr)
@@ -101,7 +131,25 @@ func createEACL(cmd *cobra.Command, _ []string) {
 		}
 	}
 
-	tb.SetCID(containerID)
+	if templateArg != "" {
+		if err := addTemplate(tb, templateArg); err != nil {
+			cmd.PrintErrln(err)
+			os.Exit(1)
+		}
+	}
+
+	if cidArg != "" || fromFileArg == "" {
+		tb.SetCID(containerID)
+	}
+
+	if problems := validateTable(tb); len(problems) > 0 {
+		printTable(cmd.ErrOrStderr(), tb, problems)
+
+		if n := countErrors(problems); n > 0 {
+			cmd.PrintErrf("extended ACL table has %d error(s)\n", n)
+			os.Exit(1)
+		}
+	}
 
 	data, err := tb.MarshalJSON()
 	if err != nil {
@@ -0,0 +1,57 @@
+package extended
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// eaclTemplates are the canonical extended ACL tables for the most common
+// container access scenarios. Every template lists the rules in the format
+// of `--rule` flag.
+var eaclTemplates = map[string][]string{
+	// only the container owner has access to the container objects
+	"private": {
+		"deny get,head,put,delete,search,getrange,getrangehash others",
+	},
+	// others can read but can not write the container objects
+	"read-only": {
+		"allow get,head,search,getrange,getrangehash others",
+		"deny put,delete others",
+	},
+	// others can read and add but can not remove the container objects
+	"public-append": {
+		"allow get,head,put,search,getrange,getrangehash others",
+		"deny delete others",
+	},
+}
+
+// templateNames returns the sorted names of the extended ACL templates.
+func templateNames() []string {
+	names := make([]string, 0, len(eaclTemplates))
+	for name := range eaclTemplates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// addTemplate adds the records of the named template to the table.
+func addTemplate(tb *eacl.Table, name string) error {
+	rules, ok := eaclTemplates[name]
+	if !ok {
+		return fmt.Errorf("unknown template '%s', expected one of: %s", name, strings.Join(templateNames(), ", "))
+	}
+
+	for _, rule := range rules {
+		if err := parseTable(tb, strings.Fields(rule)); err != nil {
+			return fmt.Errorf("invalid template rule '%s': %w", rule, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package extended
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddTemplate(t *testing.T) {
+	allOps := []eacl.Operation{
+		eacl.OperationGet,
+		eacl.OperationHead,
+		eacl.OperationPut,
+		eacl.OperationDelete,
+		eacl.OperationSearch,
+		eacl.OperationRange,
+		eacl.OperationRangeHash,
+	}
+
+	tests := [...]struct {
+		name   string                         // template name
+		access map[eacl.Operation]eacl.Action // expected access of others
+	}{
+		{
+			name: "private",
+			access: map[eacl.Operation]eacl.Action{
+				eacl.OperationGet:       eacl.ActionDeny,
+				eacl.OperationHead:      eacl.ActionDeny,
+				eacl.OperationPut:       eacl.ActionDeny,
+				eacl.OperationDelete:    eacl.ActionDeny,
+				eacl.OperationSearch:    eacl.ActionDeny,
+				eacl.OperationRange:     eacl.ActionDeny,
+				eacl.OperationRangeHash: eacl.ActionDeny,
+			},
+		},
+		{
+			name: "read-only",
+			access: map[eacl.Operation]eacl.Action{
+				eacl.OperationGet:       eacl.ActionAllow,
+				eacl.OperationHead:      eacl.ActionAllow,
+				eacl.OperationPut:       eacl.ActionDeny,
+				eacl.OperationDelete:    eacl.ActionDeny,
+				eacl.OperationSearch:    eacl.ActionAllow,
+				eacl.OperationRange:     eacl.ActionAllow,
+				eacl.OperationRangeHash: eacl.ActionAllow,
+			},
+		},
+		{
+			name: "public-append",
+			access: map[eacl.Operation]eacl.Action{
+				eacl.OperationGet:       eacl.ActionAllow,
+				eacl.OperationHead:      eacl.ActionAllow,
+				eacl.OperationPut:       eacl.ActionAllow,
+				eacl.OperationDelete:    eacl.ActionDeny,
+				eacl.OperationSearch:    eacl.ActionAllow,
+				eacl.OperationRange:     eacl.ActionAllow,
+				eacl.OperationRangeHash: eacl.ActionAllow,
+			},
+		},
+	}
+
+	require.Len(t, tests, len(eaclTemplates), "every template must be tested")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := eacl.NewTable()
+			require.NoError(t, addTemplate(tb, test.name))
+			require.Empty(t, validateTable(tb))
+
+			records := tb.Records()
+			access := make(map[eacl.Operation]eacl.Action, len(records))
+			for i := range records {
+				require.Empty(t, records[i].Filters())
+				require.Len(t, records[i].Targets(), 1)
+				require.Equal(t, eacl.RoleOthers, records[i].Targets()[0].Role())
+
+				access[records[i].Operation()] = records[i].Action()
+			}
+
+			require.Len(t, access, len(allOps))
+			require.Equal(t, test.access, access)
+
+			// JSON round-trip keeps the table
+			data, err := tb.MarshalJSON()
+			require.NoError(t, err)
+
+			restored := eacl.NewTable()
+			require.NoError(t, restored.UnmarshalJSON(data))
+			require.True(t, eacl.EqualTables(*tb, *restored))
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		require.Error(t, addTemplate(eacl.NewTable(), "public-read-write"))
+	})
+}
@@ -0,0 +1,289 @@
+package extended
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/spf13/cobra"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Explain and validate extended ACL table",
+	Long: `Explain and validate extended ACL table.
+
+Every record of the table is printed in a human-readable form followed by
+the problems found in it. Records are applied in the listed order, the first
+matching record defines the access.
+
+Errors are reported for the records which never apply or duplicate the
+previous ones and for the filters which can't be checked for the record
+operation. Warnings are reported for the records which are valid but may
+work not as expected: records overridden by the previous ones with the
+opposite action and records which may break the storage functions. Command
+fails if any error is found.`,
+	Example: `neofs-cli acl extended validate --from-file table.json`,
+	Run:     validateEACL,
+}
+
+func init() {
+	validateCmd.Flags().String("from-file", "", "read JSON or binary encoded extended ACL table from file")
+
+	_ = validateCmd.MarkFlagRequired("from-file")
+	_ = cobra.MarkFlagFilename(validateCmd.Flags(), "from-file")
+}
+
+func validateEACL(cmd *cobra.Command, _ []string) {
+	fromFile, _ := cmd.Flags().GetString("from-file")
+
+	tb := common.ReadEACL(cmd, fromFile)
+
+	problems := validateTable(tb)
+	printTable(cmd.OutOrStdout(), tb, problems)
+
+	if countErrors(problems) > 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("extended ACL table has %d error(s)", countErrors(problems)))
+	}
+}
+
+// recordProblem describes the problem of the extended ACL record.
+type recordProblem struct {
+	// index is the index of the record in the table.
+	index int
+	// warning is true if the record is valid but may work not as expected.
+	warning bool
+	msg     string
+}
+
+func (p recordProblem) String() string {
+	if p.warning {
+		return "warning: " + p.msg
+	}
+
+	return "error: " + p.msg
+}
+
+func countErrors(problems []recordProblem) int {
+	var n int
+	for i := range problems {
+		if !problems[i].warning {
+			n++
+		}
+	}
+
+	return n
+}
+
+// printTable prints every record of the table with its problems.
+func printTable(w io.Writer, tb *eacl.Table, problems []recordProblem) {
+	records := tb.Records()
+	for i := range records {
+		fmt.Fprintf(w, "#%d: %s\n", i+1, explainRecord(records[i]))
+
+		for _, p := range problems {
+			if p.index == i {
+				fmt.Fprintf(w, "  %s\n", p)
+			}
+		}
+	}
+}
+
+// explainRecord returns human-readable representation of the record.
+func explainRecord(r eacl.Record) string {
+	var sb strings.Builder
+
+	sb.WriteString(r.Action().String())
+	sb.WriteString(" ")
+	sb.WriteString(r.Operation().String())
+
+	targets := r.Targets()
+	tt := make([]string, len(targets))
+	for i := range targets {
+		tt[i] = explainTarget(targets[i])
+	}
+
+	sb.WriteString(" for ")
+	if len(tt) == 0 {
+		sb.WriteString("nobody")
+	} else {
+		sb.WriteString(strings.Join(tt, " or "))
+	}
+
+	filters := r.Filters()
+	if len(filters) > 0 {
+		ff := make([]string, len(filters))
+		for i := range filters {
+			ff[i] = explainFilter(filters[i])
+		}
+
+		sb.WriteString(" if ")
+		sb.WriteString(strings.Join(ff, " and "))
+	}
+
+	return sb.String()
+}
+
+func explainTarget(t eacl.Target) string {
+	var desc string
+
+	switch t.Role() {
+	case eacl.RoleUser:
+		desc = "container owner"
+	case eacl.RoleSystem:
+		desc = "container nodes and Inner Ring"
+	case eacl.RoleOthers:
+		desc = "others"
+	}
+
+	keys := t.BinaryKeys()
+	if len(keys) == 0 {
+		if desc == "" {
+			return "unspecified target"
+		}
+		return desc
+	}
+
+	kk := make([]string, len(keys))
+	for i := range keys {
+		kk[i] = hex.EncodeToString(keys[i])
+	}
+
+	if desc != "" {
+		desc += " with "
+	}
+
+	return desc + "key(s) " + strings.Join(kk, ", ")
+}
+
+func explainFilter(f eacl.Filter) string {
+	var from string
+
+	switch f.From() {
+	case eacl.HeaderFromObject:
+		from = "object header"
+	case eacl.HeaderFromRequest:
+		from = "request header"
+	case eacl.HeaderFromService:
+		from = "service header"
+	default:
+		from = "unknown header"
+	}
+
+	var match string
+
+	switch f.Matcher() {
+	case eacl.MatchStringEqual:
+		match = "="
+	case eacl.MatchStringNotEqual:
+		match = "!="
+	default:
+		match = "<unknown match>"
+	}
+
+	return fmt.Sprintf("%s '%s' %s '%s'", from, f.Key(), match, f.Value())
+}
+
+// objectHeadersOfOp maps the operations to the only object headers known
+// when the access is checked. Filters by the other object headers never
+// match for such operations.
+var objectHeadersOfOp = map[eacl.Operation][]string{
+	eacl.OperationSearch:    {v2acl.FilterObjectContainerID},
+	eacl.OperationDelete:    {v2acl.FilterObjectContainerID, v2acl.FilterObjectID},
+	eacl.OperationRangeHash: {v2acl.FilterObjectContainerID, v2acl.FilterObjectID},
+}
+
+// validateTable checks every record of the table and returns the problems found.
+func validateTable(tb *eacl.Table) []recordProblem {
+	var (
+		res     []recordProblem
+		records = tb.Records()
+		keys    = make([][]byte, len(records))
+	)
+
+	for i := range records {
+		r := records[i]
+
+		addProblem := func(warning bool, format string, args ...interface{}) {
+			res = append(res, recordProblem{index: i, warning: warning, msg: fmt.Sprintf(format, args...)})
+		}
+
+		if r.Action() != eacl.ActionAllow && r.Action() != eacl.ActionDeny {
+			addProblem(false, "action must be ALLOW or DENY")
+		}
+
+		if r.Operation() < eacl.OperationGet || r.Operation() > eacl.OperationRangeHash {
+			addProblem(false, "operation is not specified")
+		}
+
+		targets := r.Targets()
+		if len(targets) == 0 {
+			addProblem(false, "record has no targets and never applies")
+		}
+
+		for _, t := range targets {
+			switch t.Role() {
+			case eacl.RoleUser, eacl.RoleSystem, eacl.RoleOthers:
+			default:
+				if len(t.BinaryKeys()) == 0 {
+					addProblem(false, "target has neither role nor keys")
+				}
+			}
+
+			if t.Role() == eacl.RoleSystem && r.Action() == eacl.ActionDeny {
+				addProblem(true, "denying %s for container nodes and Inner Ring may break replication and data audit", r.Operation())
+			}
+		}
+
+		for _, f := range r.Filters() {
+			if f.From() != eacl.HeaderFromObject && f.From() != eacl.HeaderFromRequest {
+				addProblem(false, "unsupported header type of filter '%s'", f.Key())
+			}
+
+			if f.Matcher() != eacl.MatchStringEqual && f.Matcher() != eacl.MatchStringNotEqual {
+				addProblem(false, "unsupported match type of filter '%s'", f.Key())
+			}
+
+			if known, ok := objectHeadersOfOp[r.Operation()]; ok && f.From() == eacl.HeaderFromObject && !containsString(known, f.Key()) {
+				addProblem(false, "object header '%s' is unknown for %s operation, only %s can be filtered",
+					f.Key(), r.Operation(), strings.Join(known, ", "))
+			}
+		}
+
+		// records are compared regardless of the action
+		r.SetAction(eacl.ActionUnknown)
+		keys[i], _ = r.Marshal()
+
+		for j := 0; j < i; j++ {
+			if !bytes.Equal(keys[i], keys[j]) {
+				continue
+			}
+
+			if records[i].Action() == records[j].Action() {
+				addProblem(false, "duplicates record #%d", j+1)
+			} else {
+				// overriding the template records is a common case, so
+				// the shadowed record is valid, but worth to be reviewed
+				addProblem(true, "conflicts with record #%d which takes precedence, never applies", j+1)
+			}
+			break
+		}
+	}
+
+	return res
+}
+
+func containsString(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+
+	return false
+}
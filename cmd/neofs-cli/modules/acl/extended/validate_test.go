@@ -0,0 +1,102 @@
+package extended
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTable(t *testing.T) {
+	tests := [...]struct {
+		name     string   // test name
+		rules    []string // input extended ACL rules
+		errors   []int    // indices of the records with errors
+		warnings []int    // indices of the records with warnings
+	}{
+		{
+			name:  "valid table",
+			rules: []string{"allow get obj:a=b user", "deny search obj:$Object:containerID=x others", "deny put others"},
+		},
+		{
+			name:   "duplicate record",
+			rules:  []string{"deny get obj:a=b others", "allow put user", "deny get obj:a=b others"},
+			errors: []int{2},
+		},
+		{
+			name:     "conflicting record",
+			rules:    []string{"deny get obj:a=b others", "allow get obj:a=b others"},
+			warnings: []int{1},
+		},
+		{
+			name:  "same operation with other filters",
+			rules: []string{"deny get obj:a=b others", "allow get obj:a=c others"},
+		},
+		{
+			name:   "object header filter in search",
+			rules:  []string{"deny search obj:a=b others"},
+			errors: []int{0},
+		},
+		{
+			name:   "object header filter in delete",
+			rules:  []string{"deny delete obj:$Object:ownerID=x others", "deny delete obj:$Object:objectID=x others"},
+			errors: []int{0},
+		},
+		{
+			name:  "request header filter in search",
+			rules: []string{"deny search req:a=b others"},
+		},
+		{
+			name:     "system role denied",
+			rules:    []string{"deny put system"},
+			warnings: []int{0},
+		},
+		{
+			name:  "system role allowed",
+			rules: []string{"allow put system"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := eacl.NewTable()
+			for _, rule := range test.rules {
+				require.NoError(t, parseTable(tb, strings.Fields(rule)))
+			}
+
+			var errs, warns []int
+			for _, p := range validateTable(tb) {
+				if p.warning {
+					warns = append(warns, p.index)
+				} else {
+					errs = append(errs, p.index)
+				}
+			}
+
+			require.Equal(t, test.errors, errs)
+			require.Equal(t, test.warnings, warns)
+		})
+	}
+
+	t.Run("no targets", func(t *testing.T) {
+		r := eacl.NewRecord()
+		r.SetAction(eacl.ActionDeny)
+		r.SetOperation(eacl.OperationGet)
+
+		tb := eacl.NewTable()
+		tb.AddRecord(r)
+
+		problems := validateTable(tb)
+		require.Len(t, problems, 1)
+		require.False(t, problems[0].warning)
+	})
+}
+
+func TestExplainRecord(t *testing.T) {
+	tb := eacl.NewTable()
+	require.NoError(t, parseTable(tb, strings.Fields("deny get obj:a=b req:c=d others user")))
+
+	require.Equal(t, "DENY GET for others or container owner if object header 'a' = 'b' and request header 'c' = 'd'",
+		explainRecord(tb.Records()[0]))
+}
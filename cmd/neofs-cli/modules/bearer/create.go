@@ -10,14 +10,18 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const (
 	eaclFlag           = "eacl"
+	cidFlag            = "cid"
 	issuedAtFlag       = "issued-at"
 	notValidBeforeFlag = "not-valid-before"
 	ownerFlag          = "owner"
@@ -33,8 +37,22 @@ var createCmd = &cobra.Command{
 All epoch flags can be specified relative to the current epoch with the +n syntax.
 In this case --` + commonflags.RPC + ` flag should be specified and the epoch in bearer token
 is set to current epoch + n.
+
+Epoch flags also accept the time in RFC3339 format, e.g. 2022-12-31T23:59:59Z.
+It is converted to the epoch using the current epoch and the epoch duration
+reported by the network, so --` + commonflags.RPC + ` flag should be specified too.
+
+Issued-at and not-valid-before epochs default to the current epoch. Instead of
+the expiration epoch the --` + commonflags.Lifetime + ` in epochs can be specified.
+
+If --` + commonflags.WalletPath + ` flag is specified, the token is signed with the
+issuer key, so it can be passed to the --bearer flag of the object commands as is.
 `,
 	Run: createToken,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		_ = viper.BindPFlag(commonflags.WalletPath, cmd.Flags().Lookup(commonflags.WalletPath))
+		_ = viper.BindPFlag(commonflags.Account, cmd.Flags().Lookup(commonflags.Account))
+	},
 }
 
 func init() {
@@ -42,48 +60,49 @@ func init() {
 	createCmd.Flags().StringP(issuedAtFlag, "i", "", "epoch to issue token at")
 	createCmd.Flags().StringP(notValidBeforeFlag, "n", "", "not valid before epoch")
 	createCmd.Flags().StringP(commonflags.ExpireAt, "x", "", "expiration epoch")
+	createCmd.Flags().Uint64(commonflags.Lifetime, 0, "number of epochs for token to stay valid")
+	createCmd.Flags().String(cidFlag, "", "container ID the extended ACL table must be bound to")
 	createCmd.Flags().StringP(ownerFlag, "o", "", "token owner")
 	createCmd.Flags().String(outFlag, "", "file to write token to")
 	createCmd.Flags().Bool(jsonFlag, false, "output token in JSON")
 	createCmd.Flags().StringP(commonflags.RPC, commonflags.RPCShorthand, commonflags.RPCDefault, commonflags.RPCUsage)
+	createCmd.Flags().StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, commonflags.WalletPathDefault, commonflags.WalletPathUsage)
+	createCmd.Flags().StringP(commonflags.Account, commonflags.AccountShorthand, commonflags.AccountDefault, commonflags.AccountUsage)
 
 	_ = cobra.MarkFlagFilename(createCmd.Flags(), eaclFlag)
 
-	_ = cobra.MarkFlagRequired(createCmd.Flags(), issuedAtFlag)
-	_ = cobra.MarkFlagRequired(createCmd.Flags(), notValidBeforeFlag)
-	_ = cobra.MarkFlagRequired(createCmd.Flags(), commonflags.ExpireAt)
 	_ = cobra.MarkFlagRequired(createCmd.Flags(), ownerFlag)
 	_ = cobra.MarkFlagRequired(createCmd.Flags(), outFlag)
+
+	createCmd.MarkFlagsMutuallyExclusive(commonflags.ExpireAt, commonflags.Lifetime)
 }
 
 func createToken(cmd *cobra.Command, _ []string) {
-	iat, iatRelative, err := common.ParseEpoch(cmd, issuedAtFlag)
-	common.ExitOnErr(cmd, "can't parse --"+issuedAtFlag+" flag: %w", err)
-
-	exp, expRelative, err := common.ParseEpoch(cmd, commonflags.ExpireAt)
-	common.ExitOnErr(cmd, "can't parse --"+commonflags.ExpireAt+" flag: %w", err)
-
-	nvb, nvbRelative, err := common.ParseEpoch(cmd, notValidBeforeFlag)
-	common.ExitOnErr(cmd, "can't parse --"+notValidBeforeFlag+" flag: %w", err)
-
-	if iatRelative || expRelative || nvbRelative {
+	// requested once, see GetNetworkEpoch
+	networkEpoch := func() internalclient.NetworkEpoch {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
 		endpoint, _ := cmd.Flags().GetString(commonflags.RPC)
-		currEpoch, err := internalclient.GetCurrentEpoch(ctx, endpoint)
+		ne, err := internalclient.GetNetworkEpoch(ctx, endpoint)
 		common.ExitOnErr(cmd, "can't fetch current epoch: %w", err)
 
-		if iatRelative {
-			iat += currEpoch
-		}
-		if expRelative {
-			exp += currEpoch
-		}
-		if nvbRelative {
-			nvb += currEpoch
-		}
+		return ne
+	}
+
+	iat := parseEpochFlag(cmd, issuedAtFlag, networkEpoch)
+	nvb := parseEpochFlag(cmd, notValidBeforeFlag, networkEpoch)
+
+	var exp uint64
+	if lifetime, _ := cmd.Flags().GetUint64(commonflags.Lifetime); lifetime != 0 {
+		exp = networkEpoch().Epoch + lifetime
+	} else if cmd.Flags().Changed(commonflags.ExpireAt) {
+		exp = parseEpochFlag(cmd, commonflags.ExpireAt, networkEpoch)
+	} else {
+		common.ExitOnErr(cmd, "", fmt.Errorf("either --%s or --%s flag must be specified",
+			commonflags.ExpireAt, commonflags.Lifetime))
 	}
+
 	if exp < nvb {
 		common.ExitOnErr(cmd, "",
 			fmt.Errorf("expiration epoch is less than not-valid-before epoch: %d < %d", exp, nvb))
@@ -106,20 +125,83 @@ func createToken(cmd *cobra.Command, _ []string) {
 		raw, err := os.ReadFile(eaclPath)
 		common.ExitOnErr(cmd, "can't read extended ACL file: %w", err)
 		common.ExitOnErr(cmd, "can't parse extended ACL: %w", json.Unmarshal(raw, table))
+
+		if cidStr, _ := cmd.Flags().GetString(cidFlag); cidStr != "" {
+			var cnr cid.ID
+			common.ExitOnErr(cmd, "can't parse container ID: %w", cnr.DecodeString(cidStr))
+			common.ExitOnErr(cmd, "", bindEACLToContainer(table, cnr))
+		}
+
 		b.SetEACLTable(*table)
 	}
 
-	var data []byte
+	if walletPath, _ := cmd.Flags().GetString(commonflags.WalletPath); walletPath != "" {
+		pk := key.Get(cmd)
+		common.ExitOnErr(cmd, "can't sign token: %w", b.Sign(*pk))
+	}
 
 	toJSON, _ := cmd.Flags().GetBool(jsonFlag)
-	if toJSON {
-		data, err = json.Marshal(b)
-		common.ExitOnErr(cmd, "can't mashal token to JSON: %w", err)
-	} else {
-		data = b.Marshal()
-	}
+	data, err := encodeToken(b, toJSON)
+	common.ExitOnErr(cmd, "", err)
 
 	out, _ := cmd.Flags().GetString(outFlag)
 	err = os.WriteFile(out, data, 0644)
 	common.ExitOnErr(cmd, "can't write token to file: %w", err)
 }
+
+// bindEACLToContainer checks that the extended ACL table is bound to the
+// container. The table without the container is bound to it.
+func bindEACLToContainer(table *eaclSDK.Table, cnr cid.ID) error {
+	tableCnr, ok := table.CID()
+	if !ok {
+		table.SetCID(cnr)
+		return nil
+	}
+
+	if !tableCnr.Equals(cnr) {
+		return fmt.Errorf("extended ACL table is bound to container %s, not %s", tableCnr, cnr)
+	}
+
+	return nil
+}
+
+// encodeToken encodes the bearer token in the format read by the --bearer
+// flag of the object commands, see common.ReadBearerToken.
+func encodeToken(b bearer.Token, toJSON bool) ([]byte, error) {
+	if !toJSON {
+		return b.Marshal(), nil
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("can't mashal token to JSON: %w", err)
+	}
+
+	return data, nil
+}
+
+// parseEpochFlag parses the epoch flag which can be an epoch number, an epoch
+// relative to the current one with +n syntax or the time in RFC3339 format.
+// The current network epoch is requested only if needed.
+func parseEpochFlag(cmd *cobra.Command, flag string, networkEpoch func() internalclient.NetworkEpoch) uint64 {
+	s, _ := cmd.Flags().GetString(flag)
+	if s == "" {
+		return networkEpoch().Epoch
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		epoch, err := networkEpoch().EpochAt(t)
+		common.ExitOnErr(cmd, "can't convert --"+flag+" time to epoch: %w", err)
+
+		return epoch
+	}
+
+	epoch, relative, err := common.ParseEpoch(cmd, flag)
+	common.ExitOnErr(cmd, "can't parse --"+flag+" flag: %w", err)
+
+	if relative {
+		epoch += networkEpoch().Epoch
+	}
+
+	return epoch
+}
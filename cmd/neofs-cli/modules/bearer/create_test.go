@@ -0,0 +1,85 @@
+package bearer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindEACLToContainer(t *testing.T) {
+	cnr := cidtest.ID()
+
+	t.Run("unbound table", func(t *testing.T) {
+		table := eaclSDK.NewTable()
+		require.NoError(t, bindEACLToContainer(table, cnr))
+
+		tableCnr, ok := table.CID()
+		require.True(t, ok)
+		require.Equal(t, cnr, tableCnr)
+	})
+
+	t.Run("same container", func(t *testing.T) {
+		table := eaclSDK.NewTable()
+		table.SetCID(cnr)
+		require.NoError(t, bindEACLToContainer(table, cnr))
+	})
+
+	t.Run("other container", func(t *testing.T) {
+		table := eaclSDK.NewTable()
+		table.SetCID(cidtest.ID())
+		require.Error(t, bindEACLToContainer(table, cnr))
+	})
+}
+
+func TestEncodeToken(t *testing.T) {
+	issuerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	ownerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var owner user.ID
+	user.IDFromKey(&owner, ownerKey.PrivateKey.PublicKey)
+
+	table := eaclSDK.NewTable()
+	table.SetCID(cidtest.ID())
+
+	var b bearer.Token
+	b.SetIat(10)
+	b.SetNbf(10)
+	b.SetExp(110)
+	b.ForUser(owner)
+	b.SetEACLTable(*table)
+	require.NoError(t, b.Sign(issuerKey.PrivateKey))
+
+	for _, toJSON := range []bool{false, true} {
+		data, err := encodeToken(b, toJSON)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, data, 0644))
+
+		// the token is read by the object commands
+		cmd := &cobra.Command{}
+		cmd.Flags().String("bearer", path, "")
+
+		res := common.ReadBearerToken(cmd, "bearer")
+		require.NotNil(t, res)
+		require.Equal(t, b.Marshal(), res.Marshal())
+		require.True(t, res.VerifySignature())
+		require.True(t, res.AssertUser(owner))
+
+		var issuer user.ID
+		user.IDFromKey(&issuer, issuerKey.PrivateKey.PublicKey)
+		require.True(t, bearer.ResolveIssuer(*res).Equals(issuer))
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
@@ -28,6 +29,7 @@ var (
 	containerName        string
 	containerNoTimestamp bool
 	containerSubnet      string
+	containerEACLPath    string
 	force                bool
 )
 
@@ -74,10 +76,19 @@ It will be stored in sidechain when inner ring will accepts it.`,
 		var basicACL acl.Basic
 		common.ExitOnErr(cmd, "decode basic ACL string: %w", basicACL.DecodeString(containerACL))
 
+		var eaclTable *eacl.Table
+		if containerEACLPath != "" {
+			eaclTable = common.ReadEACL(cmd, containerEACLPath)
+		}
+
 		var tok *session.Container
 
 		sessionTokenPath, _ := cmd.Flags().GetString(commonflags.SessionToken)
 		if sessionTokenPath != "" {
+			if eaclTable != nil {
+				common.ExitOnErr(cmd, "", errors.New("EACL can't be set within container creation session"))
+			}
+
 			tok = new(session.Container)
 			common.ReadSessionToken(cmd, tok, sessionTokenPath)
 
@@ -115,24 +126,35 @@ It will be stored in sidechain when inner ring will accepts it.`,
 
 		cmd.Println("container ID:", id)
 
-		if containerAwait {
+		// EACL can be set only after the container is persisted
+		if containerAwait || eaclTable != nil {
 			cmd.Println("awaiting...")
 
 			var getPrm internalclient.GetContainerPrm
 			getPrm.SetClient(cli)
 			getPrm.SetContainer(id)
 
-			for i := 0; i < awaitTimeout; i++ {
-				time.Sleep(1 * time.Second)
-
+			await(cmd, errCreateTimeout, func() bool {
 				_, err := internalclient.GetContainer(getPrm)
-				if err == nil {
-					cmd.Println("container has been persisted on sidechain")
-					return
-				}
-			}
+				return err == nil
+			})
 
-			common.ExitOnErr(cmd, "", errCreateTimeout)
+			cmd.Println("container has been persisted on sidechain")
+		}
+
+		if eaclTable != nil {
+			eaclTable.SetCID(id)
+
+			var setEACLPrm internalclient.SetEACLPrm
+			setEACLPrm.SetClient(cli)
+			setEACLPrm.SetTable(*eaclTable)
+
+			_, err = internalclient.SetEACL(setEACLPrm)
+			common.ExitOnErr(cmd, "set EACL rpc error: %w", err)
+
+			if containerAwait {
+				awaitEACL(cmd, cli, id, eaclTable)
+			}
 		}
 	},
 }
@@ -148,11 +170,14 @@ func initContainerCreateCmd() {
 	flags.StringVarP(&containerPolicy, "policy", "p", "", "QL-encoded or JSON-encoded placement policy or path to file with it")
 	flags.StringSliceVarP(&containerAttributes, "attributes", "a", nil, "comma separated pairs of container attributes in form of Key1=Value1,Key2=Value2")
 	flags.BoolVar(&containerAwait, "await", false, "block execution until container is persisted")
+	flags.StringVar(&containerEACLPath, "eacl", "", "path to file with JSON or binary encoded EACL table to set after the container is persisted")
 	flags.StringVar(&containerName, "name", "", "container name attribute")
 	flags.BoolVar(&containerNoTimestamp, "disable-timestamp", false, "disable timestamp container attribute")
 	flags.StringVar(&containerSubnet, "subnet", "", "string representation of container subnetwork")
 	flags.BoolVarP(&force, commonflags.ForceFlag, commonflags.ForceFlagShorthand, false,
 		"skip placement validity check")
+
+	initAwaitFlags(createContainerCmd)
 }
 
 func parseContainerPolicy(policyString string) (*netmap.PlacementPolicy, error) {
@@ -2,7 +2,6 @@ package container
 
 import (
 	"fmt"
-	"time"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
@@ -74,17 +73,12 @@ Only owner of the container has a permission to remove container.`,
 			getPrm.SetClient(cli)
 			getPrm.SetContainer(id)
 
-			for i := 0; i < awaitTimeout; i++ {
-				time.Sleep(1 * time.Second)
-
+			await(cmd, errDeleteTimeout, func() bool {
 				_, err := internalclient.GetContainer(getPrm)
-				if err != nil {
-					cmd.Println("container has been removed:", containerID)
-					return
-				}
-			}
+				return err != nil
+			})
 
-			common.ExitOnErr(cmd, "", errDeleteTimeout)
+			cmd.Println("container has been removed:", containerID)
 		}
 	},
 }
@@ -99,4 +93,6 @@ func initContainerDeleteCmd() {
 	flags.StringVar(&containerID, "cid", "", "container ID")
 	flags.BoolVar(&containerAwait, "await", false, "block execution until container is removed")
 	flags.BoolP(commonflags.ForceFlag, commonflags.ForceFlagShorthand, false, "do not check whether container contains locks and remove immediately")
+
+	initAwaitFlags(deleteContainerCmd)
 }
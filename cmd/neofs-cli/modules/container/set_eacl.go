@@ -3,12 +3,14 @@ package container
 import (
 	"bytes"
 	"errors"
-	"time"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/spf13/cobra"
 )
@@ -66,35 +68,7 @@ Container ID in EACL table will be substituted with ID from the CLI.`,
 		common.ExitOnErr(cmd, "rpc error: %w", err)
 
 		if containerAwait {
-			exp, err := eaclTable.Marshal()
-			common.ExitOnErr(cmd, "broken EACL table: %w", err)
-
-			cmd.Println("awaiting...")
-
-			var getEACLPrm internalclient.EACLPrm
-			getEACLPrm.SetClient(cli)
-			getEACLPrm.SetContainer(id)
-
-			for i := 0; i < awaitTimeout; i++ {
-				time.Sleep(1 * time.Second)
-
-				res, err := internalclient.EACL(getEACLPrm)
-				if err == nil {
-					// compare binary values because EACL could have been set already
-					table := res.EACL()
-					got, err := table.Marshal()
-					if err != nil {
-						continue
-					}
-
-					if bytes.Equal(exp, got) {
-						cmd.Println("EACL has been persisted on sidechain")
-						return
-					}
-				}
-			}
-
-			common.ExitOnErr(cmd, "", errSetEACLTimeout)
+			awaitEACL(cmd, cli, id, eaclTable)
 		}
 	},
 }
@@ -107,4 +81,33 @@ func initContainerSetEACLCmd() {
 	flags.StringVar(&flagVarsSetEACL.srcPath, "table", "", "path to file with JSON or binary encoded EACL table")
 	flags.BoolVar(&containerAwait, "await", false, "block execution until EACL is persisted")
 	flags.BoolVar(&flagVarsSetEACL.noPreCheck, "no-precheck", false, "do not pre-check the extensibility of the container ACL")
+
+	initAwaitFlags(setExtendedACLCmd)
+}
+
+// awaitEACL waits until the container EACL matches the expected table.
+func awaitEACL(cmd *cobra.Command, cli *client.Client, id cid.ID, table *eacl.Table) {
+	exp, err := table.Marshal()
+	common.ExitOnErr(cmd, "broken EACL table: %w", err)
+
+	cmd.Println("awaiting...")
+
+	var getEACLPrm internalclient.EACLPrm
+	getEACLPrm.SetClient(cli)
+	getEACLPrm.SetContainer(id)
+
+	await(cmd, errSetEACLTimeout, func() bool {
+		res, err := internalclient.EACL(getEACLPrm)
+		if err != nil {
+			return false
+		}
+
+		// compare binary values because EACL could have been set already
+		table := res.EACL()
+		got, err := table.Marshal()
+
+		return err == nil && bytes.Equal(exp, got)
+	})
+
+	cmd.Println("EACL has been persisted on sidechain")
 }
@@ -2,6 +2,8 @@ package container
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -11,15 +13,57 @@ import (
 const (
 	attributeDelimiter = "="
 
-	awaitTimeout = 120 // in seconds
+	awaitTimeoutFlag     = "await-timeout"
+	awaitTimeoutDefault  = 2 * time.Minute
+	awaitIntervalFlag    = "await-interval"
+	awaitIntervalDefault = time.Second
 )
 
 var (
-	errCreateTimeout  = errors.New("timeout: container has not been persisted on sidechain")
-	errDeleteTimeout  = errors.New("timeout: container has not been removed from sidechain")
-	errSetEACLTimeout = errors.New("timeout: EACL has not been persisted on sidechain")
+	containerAwaitTimeout  time.Duration
+	containerAwaitInterval time.Duration
 )
 
+var (
+	errCreateTimeout  = fmt.Errorf("%w: container has not been persisted on sidechain", common.ErrAwaitTimeout)
+	errDeleteTimeout  = fmt.Errorf("%w: container has not been removed from sidechain", common.ErrAwaitTimeout)
+	errSetEACLTimeout = fmt.Errorf("%w: EACL has not been persisted on sidechain", common.ErrAwaitTimeout)
+)
+
+// initAwaitFlags registers flags of the await interval and timeout
+// for the command with `--await` flag.
+func initAwaitFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	flags.DurationVar(&containerAwaitTimeout, awaitTimeoutFlag, awaitTimeoutDefault, "timeout for --await")
+	flags.DurationVar(&containerAwaitInterval, awaitIntervalFlag, awaitIntervalDefault, "interval between polls for --await")
+}
+
+// await polls the cond with the configured interval until it returns true
+// or the configured timeout expires. Prints the elapsed time on success and
+// exits with timeoutErr otherwise.
+func await(cmd *cobra.Command, timeoutErr error, cond func() bool) {
+	if containerAwaitInterval <= 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("non-positive --%s value: %s", awaitIntervalFlag, containerAwaitInterval))
+	}
+
+	start := time.Now()
+	deadline := start.Add(containerAwaitTimeout)
+
+	for {
+		time.Sleep(containerAwaitInterval)
+
+		if cond() {
+			cmd.Printf("elapsed: %s\n", time.Since(start).Round(time.Millisecond))
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			common.ExitOnErr(cmd, "", timeoutErr)
+		}
+	}
+}
+
 func parseContainerID(cmd *cobra.Command) cid.ID {
 	if containerID == "" {
 		common.ExitOnErr(cmd, "", errors.New("container ID is not set"))
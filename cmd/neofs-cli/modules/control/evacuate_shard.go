@@ -22,6 +22,7 @@ func evacuateShard(cmd *cobra.Command, _ []string) {
 	req := &control.EvacuateShardRequest{Body: new(control.EvacuateShardRequest_Body)}
 	req.Body.Shard_ID = getShardID(cmd)
 	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+	req.Body.ErrorLimit, _ = cmd.Flags().GetUint32(errorLimitFlag)
 
 	signRequest(cmd, pk, req)
 
@@ -35,10 +36,11 @@ func evacuateShard(cmd *cobra.Command, _ []string) {
 	})
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
-	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
-
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
+	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	printSkippedErrors(cmd, resp.GetBody().GetErrorCount(), resp.GetBody().GetErrors())
+
 	cmd.Println("Shard has successfully been evacuated.")
 }
 
@@ -49,6 +51,7 @@ func initControlEvacuateShardCmd() {
 	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Uint32(errorLimitFlag, 0, "Abort after the specified number of skipped objects, 0 means no limit")
 
 	_ = evacuateShardCmd.MarkFlagRequired(shardIDFlag)
 }
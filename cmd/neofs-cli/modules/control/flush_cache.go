@@ -9,11 +9,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flushCacheForceFlag = "force"
+
 var flushCacheCmd = &cobra.Command{
 	Use:   "flush-cache",
 	Short: "Flush objects from the write-cache to the main storage",
-	Long:  "Flush objects from the write-cache to the main storage",
-	Run:   flushCache,
+	Long: `Flush objects from the write-cache to the main storage.
+The write-cache is switched to the read-only mode unless --force is set.`,
+	Run: flushCache,
 }
 
 func flushCache(cmd *cobra.Command, _ []string) {
@@ -21,6 +24,9 @@ func flushCache(cmd *cobra.Command, _ []string) {
 
 	req := &control.FlushCacheRequest{Body: new(control.FlushCacheRequest_Body)}
 	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.Force, _ = cmd.Flags().GetBool(flushCacheForceFlag)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+	req.Body.ErrorLimit, _ = cmd.Flags().GetUint32(errorLimitFlag)
 
 	signRequest(cmd, pk, req)
 
@@ -36,6 +42,7 @@ func flushCache(cmd *cobra.Command, _ []string) {
 
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
+	printSkippedErrors(cmd, resp.GetBody().GetErrorCount(), resp.GetBody().GetErrors())
 	cmd.Println("Write-cache has been flushed.")
 }
 
@@ -45,6 +52,9 @@ func initControlFlushCacheCmd() {
 	ff := flushCacheCmd.Flags()
 	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
 	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	ff.Bool(flushCacheForceFlag, false, "Flush the objects stored at the moment of the call without switching the write-cache to the read-only mode")
+	ff.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	ff.Uint32(errorLimitFlag, 0, "Abort after the specified number of skipped objects, 0 means no limit")
 
 	_ = flushCacheCmd.MarkFlagRequired(shardIDFlag)
 }
@@ -0,0 +1,88 @@
+package control
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const gcStatsCountFlag = "count"
+
+var gcStatsCmd = &cobra.Command{
+	Use:   "gc-stats",
+	Short: "Show the statistics of the latest shard GC runs",
+	Long: `Show the statistics of the latest garbage collector runs of the shard: garbage
+remover runs and expired objects handling on new epochs. For every run the number
+of the removal candidates, removed objects and freed bytes is printed along with
+the number of the candidates deferred because they are locked or because of the errors.`,
+	Run: gcStats,
+}
+
+func initControlGCStatsCmd() {
+	commonflags.InitWithoutRPC(gcStatsCmd)
+
+	flags := gcStatsCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	flags.Uint32(gcStatsCountFlag, 10, "Maximum number of the latest runs to show (0 means all the retained runs)")
+
+	_ = gcStatsCmd.MarkFlagRequired(shardIDFlag)
+}
+
+func gcStats(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetGCStatsRequest{Body: new(control.GetGCStatsRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.Count, _ = cmd.Flags().GetUint32(gcStatsCountFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetGCStatsResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetGCStats(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	runs := resp.GetBody().GetRuns()
+	if len(runs) == 0 {
+		cmd.Println("No GC runs yet.")
+		return
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "TIME\tTYPE\tDURATION\tEPOCH\tCANDIDATES\tREMOVED\tBYTES\tLOCKED\tDEFERRED\tERRORS")
+	for _, r := range runs {
+		epoch := "-"
+		if r.GetEpoch() != 0 {
+			epoch = strconv.FormatUint(r.GetEpoch(), 10)
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\n",
+			time.Unix(r.GetTime(), 0).Format(time.RFC3339),
+			r.GetType(),
+			time.Duration(r.GetDuration())*time.Millisecond,
+			epoch, r.GetCandidates(), r.GetRemoved(), r.GetBytes(),
+			r.GetLocked(), r.GetDeferred(), r.GetErrors())
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
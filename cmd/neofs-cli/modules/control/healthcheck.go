@@ -61,6 +61,11 @@ func healthCheck(cmd *cobra.Command, _ []string) {
 
 	cmd.Printf("Network status: %s\n", resp.GetBody().GetNetmapStatus())
 	cmd.Printf("Health status: %s\n", resp.GetBody().GetHealthStatus())
+	cmd.Printf("Storage health status: %s\n", resp.GetBody().GetStorageHealthStatus())
+
+	for _, p := range resp.GetBody().GetStorageProblems() {
+		cmd.Printf("\t%s\n", p)
+	}
 }
 
 func healthCheckIR(cmd *cobra.Command, key *ecdsa.PrivateKey, c *client.Client) {
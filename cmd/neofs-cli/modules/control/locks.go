@@ -0,0 +1,176 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	lockConfirmFlag = "confirm"
+	lockForceFlag   = "force"
+)
+
+var locksCmd = &cobra.Command{
+	Use:   "locks",
+	Short: "Operations with lock records in the node's local storage",
+	Long:  "Operations with lock records in the node's local storage",
+}
+
+var listLocksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List lock records stored on the node",
+	Long: `List lock records stored on the node: address of the lock object,
+number of the locked objects and whether the lock object itself is stored on the node.`,
+	Args: cobra.NoArgs,
+	Run:  listLocks,
+}
+
+var removeLockCmd = &cobra.Command{
+	Use:   "remove CONTAINER LOCK_OBJECT",
+	Short: "Remove lock records of the lock object from the node",
+	Long: `Remove lock records of the lock object from the node, so the objects locked
+by it can be removed. It is intended to drop the records of the lock objects lost
+by the node (e.g. after the shard wipe). Lock object itself is not removed.`,
+	Args: cobra.ExactArgs(2),
+	Run:  removeLock,
+}
+
+func initControlLocksCmd() {
+	locksCmd.AddCommand(listLocksCmd)
+	locksCmd.AddCommand(removeLockCmd)
+
+	initControlListLocksCmd()
+	initControlRemoveLockCmd()
+}
+
+func initControlListLocksCmd() {
+	commonflags.InitWithoutRPC(listLocksCmd)
+
+	ff := listLocksCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.Bool(commonflags.JSON, false, "Print lock records in JSON format")
+}
+
+func initControlRemoveLockCmd() {
+	commonflags.InitWithoutRPC(removeLockCmd)
+
+	ff := removeLockCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.Bool(lockConfirmFlag, false, "Confirm the removal of the lock records")
+	ff.Bool(lockForceFlag, false, "Remove the lock records even if the lock object is stored on the node")
+}
+
+func listLocks(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.ListLocksRequest{Body: new(control.ListLocksRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ListLocksResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ListLocks(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	locks := resp.GetBody().GetLocks()
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintLocksJSON(cmd, locks)
+		return
+	}
+
+	if len(locks) == 0 {
+		cmd.Println("No lock records are stored on the node.")
+		return
+	}
+
+	prettyPrintLocks(cmd, locks)
+}
+
+func prettyPrintLocksJSON(cmd *cobra.Command, locks []*control.ListLocksResponse_Body_Lock) {
+	out := make([]map[string]interface{}, 0, len(locks))
+	for _, l := range locks {
+		out = append(out, map[string]interface{}{
+			"address": l.GetAddress(),
+			"members": l.GetMembers(),
+			"found":   l.GetFound(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode lock records to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintLocks(cmd *cobra.Command, locks []*control.ListLocksResponse_Body_Lock) {
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "LOCK OBJECT\tMEMBERS\tFOUND")
+	for _, l := range locks {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%t\n", l.GetAddress(), l.GetMembers(), l.GetFound())
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
+
+func removeLock(cmd *cobra.Command, args []string) {
+	if confirmed, _ := cmd.Flags().GetBool(lockConfirmFlag); !confirmed {
+		common.ExitOnErr(cmd, "", fmt.Errorf("removal of the lock records must be confirmed with --%s flag", lockConfirmFlag))
+	}
+
+	var cnr cid.ID
+	common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(args[0]))
+
+	var obj oid.ID
+	common.ExitOnErr(cmd, "invalid object ID: %w", obj.DecodeString(args[1]))
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	pk := key.Get(cmd)
+
+	req := &control.RemoveLockRequest{Body: new(control.RemoveLockRequest_Body)}
+	req.Body.Address = addr.EncodeToString()
+	req.Body.Force, _ = cmd.Flags().GetBool(lockForceFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RemoveLockResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.RemoveLock(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Lock records have been successfully removed.")
+}
@@ -0,0 +1,77 @@
+package control
+
+import (
+	"encoding/hex"
+	"time"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var netmapStatusCmd = &cobra.Command{
+	Use:   "netmap-status",
+	Short: "Show the node's view of its state in the network map",
+	Long: `Show the node's view of its state in the network map: current epoch,
+status in the latest network map snapshot, the last bootstrap and the announced node information.`,
+	Run: netmapStatus,
+}
+
+func initControlNetmapStatusCmd() {
+	commonflags.InitWithoutRPC(netmapStatusCmd)
+
+	flags := netmapStatusCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+}
+
+func netmapStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := new(control.GetNetmapStatusRequest)
+	req.Body = new(control.GetNetmapStatusRequest_Body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetNetmapStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetNetmapStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	cmd.Printf("Current epoch: %d\n", body.GetEpoch())
+	cmd.Printf("Network map epoch: %d\n", body.GetNetmapEpoch())
+	cmd.Printf("Network map status: %s\n", body.GetNetmapStatus())
+
+	if body.GetBootstrapTime() == 0 {
+		cmd.Println("Last bootstrap: none")
+		return
+	}
+
+	cmd.Printf("Last bootstrap: epoch %d, %s\n", body.GetBootstrapEpoch(),
+		time.Unix(body.GetBootstrapTime(), 0).Format(time.RFC3339))
+
+	ni := body.GetAnnounced()
+
+	cmd.Println("Announced node info:")
+	cmd.Printf("\tPublic key: %s\n", hex.EncodeToString(ni.GetPublicKey()))
+	cmd.Printf("\tState: %s\n", ni.GetState())
+
+	for _, addr := range ni.GetAddresses() {
+		cmd.Printf("\tAddress: %s\n", addr)
+	}
+
+	for _, attr := range ni.GetAttributes() {
+		cmd.Printf("\tAttribute: %s=%s\n", attr.GetKey(), attr.GetValue())
+	}
+}
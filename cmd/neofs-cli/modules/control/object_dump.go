@@ -0,0 +1,91 @@
+package control
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+const objectDumpOutFlag = "out"
+
+var objectDumpCmd = &cobra.Command{
+	Use:   "dump CONTAINER OBJECT",
+	Short: "Save the object stored on the node to a file even if it has been removed",
+	Long: `Save the object stored on the node to a file in the binary NeoFS API format.
+Unlike the object service, the object is returned even if it has been removed
+but is still physically stored on the node, e.g. to inspect the recently
+tombstoned object. Each request is logged by the node.`,
+	Args: cobra.ExactArgs(2),
+	Run:  objectDump,
+}
+
+func initControlObjectDumpCmd() {
+	commonflags.InitWithoutRPC(objectDumpCmd)
+
+	ff := objectDumpCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(objectDumpOutFlag, "", "File to write the object to")
+
+	_ = objectDumpCmd.MarkFlagRequired(objectDumpOutFlag)
+}
+
+func objectDump(cmd *cobra.Command, args []string) {
+	var cnr cid.ID
+	common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(args[0]))
+
+	var obj oid.ID
+	common.ExitOnErr(cmd, "invalid object ID: %w", obj.DecodeString(args[1]))
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	pk := key.Get(cmd)
+
+	req := &control.DumpObjectRequest{Body: new(control.DumpObjectRequest_Body)}
+	req.Body.Address = addr.EncodeToString()
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var data bytes.Buffer
+	err := cli.ExecRaw(func(client *rawclient.Client) error {
+		r, err := control.DumpObject(client, req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp, err := r.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+
+			verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+			data.Write(resp.GetBody().GetChunk())
+		}
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	out, _ := cmd.Flags().GetString(objectDumpOutFlag)
+
+	err = os.WriteFile(out, data.Bytes(), 0644)
+	common.ExitOnErr(cmd, "could not write object to the file: %w", err)
+
+	cmd.Printf("Object has been saved to %s.\n", out)
+}
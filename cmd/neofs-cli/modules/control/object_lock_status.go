@@ -0,0 +1,131 @@
+package control
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+var objectLockStatusCmd = &cobra.Command{
+	Use:   "lock-status CONTAINER OBJECT",
+	Short: "Show whether the object is locked on the node",
+	Long: `Show whether the object is locked on the node, by which lock objects and until
+which epoch. It helps to find out why the object removal is refused with the
+'object is locked' status.`,
+	Args: cobra.ExactArgs(2),
+	Run:  objectLockStatus,
+}
+
+func initControlObjectLockStatusCmd() {
+	commonflags.InitWithoutRPC(objectLockStatusCmd)
+
+	ff := objectLockStatusCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.Bool(commonflags.JSON, false, "Print locks in JSON format")
+}
+
+// objectLocksFunc sends the ObjectLocks request to the node.
+type objectLocksFunc func(*control.ObjectLocksRequest) (*control.ObjectLocksResponse, error)
+
+func objectLockStatus(cmd *cobra.Command, args []string) {
+	var cnr cid.ID
+	common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(args[0]))
+
+	var obj oid.ID
+	common.ExitOnErr(cmd, "invalid object ID: %w", obj.DecodeString(args[1]))
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	pk := key.Get(cmd)
+	cli := getClient(cmd, pk)
+
+	printObjectLockStatus(cmd, pk, addr, func(req *control.ObjectLocksRequest) (resp *control.ObjectLocksResponse, err error) {
+		err = cli.ExecRaw(func(client *rawclient.Client) error {
+			resp, err = control.ObjectLocks(client, req)
+			return err
+		})
+		return
+	})
+}
+
+func printObjectLockStatus(cmd *cobra.Command, pk *ecdsa.PrivateKey, addr oid.Address, objectLocks objectLocksFunc) {
+	req := &control.ObjectLocksRequest{Body: new(control.ObjectLocksRequest_Body)}
+	req.Body.Address = addr.EncodeToString()
+
+	signRequest(cmd, pk, req)
+
+	resp, err := objectLocks(req)
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	locks := resp.GetBody().GetLocks()
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintObjectLocksJSON(cmd, locks)
+		return
+	}
+
+	if len(locks) == 0 {
+		cmd.Println("Object is not locked.")
+		return
+	}
+
+	prettyPrintObjectLocks(cmd, locks)
+}
+
+func prettyPrintObjectLocksJSON(cmd *cobra.Command, locks []*control.ObjectLocksResponse_Body_Lock) {
+	out := make([]map[string]interface{}, 0, len(locks))
+	for _, l := range locks {
+		out = append(out, map[string]interface{}{
+			"address":    l.GetAddress(),
+			"expiration": l.GetExpiration(),
+			"found":      l.GetFound(),
+			"malformed":  l.GetMalformed(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode object locks to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintObjectLocks(cmd *cobra.Command, locks []*control.ObjectLocksResponse_Body_Lock) {
+	cmd.Printf("Object is locked by %d lock object(s).\n", len(locks))
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "LOCK OBJECT\tEXPIRATION\tFOUND")
+	for _, l := range locks {
+		exp := "-"
+		if l.GetMalformed() {
+			exp = "malformed"
+		} else if l.GetExpiration() != 0 {
+			exp = strconv.FormatUint(l.GetExpiration(), 10)
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%t\n", l.GetAddress(), exp, l.GetFound())
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
@@ -0,0 +1,81 @@
+package control
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintObjectLockStatus(t *testing.T) {
+	clientKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	nodeKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr := oidtest.Address()
+
+	// mockObjectLocks returns the signed response with the given locks
+	// to the request for the addr object.
+	mockObjectLocks := func(locks []*control.ObjectLocksResponse_Body_Lock) objectLocksFunc {
+		return func(req *control.ObjectLocksRequest) (*control.ObjectLocksResponse, error) {
+			require.Equal(t, addr.EncodeToString(), req.GetBody().GetAddress())
+			require.Equal(t, clientKey.PublicKey().Bytes(), req.GetSignature().GetKey())
+
+			resp := &control.ObjectLocksResponse{
+				Body: &control.ObjectLocksResponse_Body{Locks: locks},
+			}
+
+			return resp, controlSvc.SignMessage(&nodeKey.PrivateKey, resp)
+		}
+	}
+
+	exec := func(t *testing.T, locks []*control.ObjectLocksResponse_Body_Lock) string {
+		var out bytes.Buffer
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool(commonflags.JSON, false, "")
+		cmd.SetOut(&out)
+
+		printObjectLockStatus(cmd, &clientKey.PrivateKey, addr, mockObjectLocks(locks))
+
+		return out.String()
+	}
+
+	t.Run("locked", func(t *testing.T) {
+		lock := oidtest.Address()
+
+		var orphanLock oid.Address
+		orphanLock.SetContainer(addr.Container())
+		orphanLock.SetObject(oidtest.ID())
+
+		malformedLock := oidtest.Address()
+
+		out := exec(t, []*control.ObjectLocksResponse_Body_Lock{
+			{Address: lock.EncodeToString(), Expiration: 100, Found: true},
+			{Address: orphanLock.EncodeToString()},
+			{Address: malformedLock.EncodeToString(), Found: true, Malformed: true},
+		})
+
+		lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+		require.Len(t, lines, 5)
+		require.Equal(t, "Object is locked by 3 lock object(s).", lines[0])
+		require.Equal(t, []string{"LOCK", "OBJECT", "EXPIRATION", "FOUND"}, strings.Fields(lines[1]))
+		require.Equal(t, []string{lock.EncodeToString(), "100", "true"}, strings.Fields(lines[2]))
+		require.Equal(t, []string{orphanLock.EncodeToString(), "-", "false"}, strings.Fields(lines[3]))
+		require.Equal(t, []string{malformedLock.EncodeToString(), "malformed", "true"}, strings.Fields(lines[4]))
+	})
+
+	t.Run("not locked", func(t *testing.T) {
+		require.Equal(t, "Object is not locked.\n", exec(t, nil))
+	})
+}
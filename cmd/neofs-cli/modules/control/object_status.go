@@ -0,0 +1,148 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/mr-tron/base58"
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+var objectCmd = &cobra.Command{
+	Use:   "object",
+	Short: "Operations with objects in the node's local storage",
+	Long:  "Operations with objects in the node's local storage",
+}
+
+var objectStatusCmd = &cobra.Command{
+	Use:   "status CONTAINER OBJECT",
+	Short: "Show where the object resides in the node's local storage",
+	Long: `Show where the object resides in the node's local storage: shard,
+write-cache, blobstor sub-storage and storage ID, GC mark, tombstone and lock status.`,
+	Args: cobra.ExactArgs(2),
+	Run:  objectStatus,
+}
+
+func initControlObjectCmd() {
+	objectCmd.AddCommand(objectStatusCmd)
+	objectCmd.AddCommand(objectLockStatusCmd)
+	objectCmd.AddCommand(objectDumpCmd)
+
+	initControlObjectStatusCmd()
+	initControlObjectLockStatusCmd()
+	initControlObjectDumpCmd()
+}
+
+func initControlObjectStatusCmd() {
+	commonflags.InitWithoutRPC(objectStatusCmd)
+
+	ff := objectStatusCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.Bool(commonflags.JSON, false, "Print status in JSON format")
+}
+
+func objectStatus(cmd *cobra.Command, args []string) {
+	var cnr cid.ID
+	common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(args[0]))
+
+	var obj oid.ID
+	common.ExitOnErr(cmd, "invalid object ID: %w", obj.DecodeString(args[1]))
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	pk := key.Get(cmd)
+
+	req := &control.ObjectStatusRequest{Body: new(control.ObjectStatusRequest_Body)}
+	req.Body.Address = addr.EncodeToString()
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ObjectStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ObjectStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	shards := resp.GetBody().GetShards()
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintObjectStatusJSON(cmd, shards)
+		return
+	}
+
+	if len(shards) == 0 {
+		cmd.Println("Object is not found in the local storage.")
+		return
+	}
+
+	prettyPrintObjectStatus(cmd, shards)
+}
+
+func prettyPrintObjectStatusJSON(cmd *cobra.Command, shards []*control.ObjectStatusResponse_Body_Shard) {
+	out := make([]map[string]interface{}, 0, len(shards))
+	for _, sh := range shards {
+		out = append(out, map[string]interface{}{
+			"shard_id":    base58.Encode(sh.GetShard_ID()),
+			"write_cache": sh.GetWriteCache(),
+			"metabase":    sh.GetInMetabase(),
+			"blobstor":    sh.GetBlobstorStorage(),
+			"storage_id":  base58.Encode(sh.GetStorage_ID()),
+			"gc_marked":   sh.GetGcMarked(),
+			"tombstone":   sh.GetTombstone(),
+			"locked":      sh.GetLocked(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode object status to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintObjectStatus(cmd *cobra.Command, shards []*control.ObjectStatusResponse_Body_Shard) {
+	valueOrDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "SHARD\tWRITE-CACHE\tMETABASE\tBLOBSTOR\tSTORAGE ID\tGC MARK\tTOMBSTONE\tLOCKED")
+	for _, sh := range shards {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%t\t%s\t%s\t%t\t%s\t%t\n",
+			base58.Encode(sh.GetShard_ID()),
+			valueOrDash(sh.GetWriteCache()),
+			sh.GetInMetabase(),
+			valueOrDash(sh.GetBlobstorStorage()),
+			valueOrDash(base58.Encode(sh.GetStorage_ID())),
+			sh.GetGcMarked(),
+			valueOrDash(sh.GetTombstone()),
+			sh.GetLocked())
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
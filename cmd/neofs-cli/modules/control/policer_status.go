@@ -0,0 +1,64 @@
+package control
+
+import (
+	"time"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var policerStatusCmd = &cobra.Command{
+	Use:   "policer-status",
+	Short: "Show the object policer work counters",
+	Long: `Show the object policer work counters: inspected and under-replicated objects,
+replication tasks and the completed passes over the local objects. Counters grow
+monotonically since the node start.`,
+	Run: policerStatus,
+}
+
+func initControlPolicerStatusCmd() {
+	commonflags.InitWithoutRPC(policerStatusCmd)
+
+	flags := policerStatusCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+}
+
+func policerStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := new(control.GetPolicerStatusRequest)
+	req.Body = new(control.GetPolicerStatusRequest_Body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetPolicerStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetPolicerStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	cmd.Printf("Inspected objects: %d\n", body.GetInspectedObjects())
+	cmd.Printf("Under-replicated objects: %d\n", body.GetUnderReplicatedObjects())
+	cmd.Printf("Replication tasks: %d queued, %d completed, %d failed\n",
+		body.GetQueuedTasks(), body.GetCompletedTasks(), body.GetFailedTasks())
+	cmd.Printf("Completed passes: %d\n", body.GetPasses())
+
+	if body.GetLastPassTime() == 0 {
+		cmd.Println("Last pass: none")
+		return
+	}
+
+	cmd.Printf("Last pass: %s\n", time.Unix(body.GetLastPassTime(), 0).Format(time.RFC3339))
+}
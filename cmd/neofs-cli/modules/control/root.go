@@ -32,6 +32,12 @@ func init() {
 		dropObjectsCmd,
 		shardsCmd,
 		synchronizeTreeCmd,
+		objectCmd,
+		netmapStatusCmd,
+		policerStatusCmd,
+		locksCmd,
+		gcStatsCmd,
+		supportBundleCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -39,4 +45,10 @@ func init() {
 	initControlDropObjectsCmd()
 	initControlShardsCmd()
 	initControlSynchronizeTreeCmd()
+	initControlObjectCmd()
+	initControlNetmapStatusCmd()
+	initControlPolicerStatusCmd()
+	initControlLocksCmd()
+	initControlGCStatsCmd()
+	initControlSupportBundleCmd()
 }
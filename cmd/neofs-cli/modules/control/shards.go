@@ -17,6 +17,11 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(resetShardErrorsCmd)
+	shardsCmd.AddCommand(checkShardCmd)
+	shardsCmd.AddCommand(fsTreeMigrationCmd)
+	shardsCmd.AddCommand(setShardWriteCacheCmd)
+	shardsCmd.AddCommand(resyncMetabaseCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
@@ -24,4 +29,9 @@ func initControlShardsCmd() {
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
 	initControlFlushCacheCmd()
+	initControlResetShardErrorsCmd()
+	initControlCheckShardCmd()
+	initControlFSTreeMigrationCmd()
+	initControlSetShardWriteCacheCmd()
+	initControlResyncMetabaseCmd()
 }
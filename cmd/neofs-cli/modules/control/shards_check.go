@@ -0,0 +1,78 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checkRepairOrphansFlag = "repair-orphans"
+	checkMarkDanglingFlag  = "mark-dangling"
+	checkResumeFlag        = "resume"
+	checkRateLimitFlag     = "rate-limit"
+)
+
+var checkShardCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check consistency between metabase and blobstor of the shard",
+	Long: `Check that every metabase record has a corresponding blob and every blob
+is registered in the metabase. Found inconsistencies are written to the node log
+and can be optionally repaired.`,
+	Run: checkShard,
+}
+
+func checkShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.CheckShardRequest{Body: new(control.CheckShardRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.RepairOrphans, _ = cmd.Flags().GetBool(checkRepairOrphansFlag)
+	req.Body.MarkDangling, _ = cmd.Flags().GetBool(checkMarkDanglingFlag)
+	req.Body.Resume, _ = cmd.Flags().GetBool(checkResumeFlag)
+	req.Body.RateLimit, _ = cmd.Flags().GetUint32(checkRateLimitFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.CheckShardResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.CheckShard(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	cmd.Printf("Checked metabase records: %d\n", body.GetRecords())
+	cmd.Printf("Checked blobs: %d\n", body.GetBlobs())
+	cmd.Printf("Records without a blob: %d (marked with GC mark: %d)\n", body.GetDangling(), body.GetMarkedDangling())
+	cmd.Printf("Blobs without a record: %d (registered in metabase: %d)\n", body.GetOrphans(), body.GetRepairedOrphans())
+
+	if body.GetCompleted() {
+		cmd.Println("Shard check has been completed.")
+	} else {
+		cmd.Println("Shard check has not been completed, use --" + checkResumeFlag + " flag to continue.")
+	}
+}
+
+func initControlCheckShardCmd() {
+	commonflags.InitWithoutRPC(checkShardCmd)
+
+	ff := checkShardCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	ff.Bool(checkRepairOrphansFlag, false, "Register blobs without a metabase record in the metabase")
+	ff.Bool(checkMarkDanglingFlag, false, "Mark metabase records without a blob for removal, so the object is replicated from other nodes")
+	ff.Bool(checkResumeFlag, false, "Continue the previous interrupted check")
+	ff.Uint32(checkRateLimitFlag, 0, "Maximum number of objects checked per second (0 means no limit)")
+
+	_ = checkShardCmd.MarkFlagRequired(shardIDFlag)
+}
@@ -0,0 +1,151 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	migrationRateLimitFlag = "rate-limit"
+	migrationRestartFlag   = "restart"
+)
+
+var fsTreeMigrationCmd = &cobra.Command{
+	Use:   "fstree-migration",
+	Short: "Move the shard objects from FSTree to the sub-storages they belong to",
+	Long: `Move the shard objects stored in FSTree to the blobstor sub-storages they
+belong to according to the current storage policies, e.g. to the blobovnicza tree
+after the small object size has been raised. Migration is performed in the
+background, the interrupted migration is continued by the next start.`,
+}
+
+var fsTreeMigrationStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start FSTree migration of the shard",
+	Long:  "Start FSTree migration of the shard",
+	Run:   startFSTreeMigration,
+}
+
+var fsTreeMigrationStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop FSTree migration of the shard",
+	Long:  "Stop FSTree migration of the shard, the progress is kept",
+	Run:   stopFSTreeMigration,
+}
+
+var fsTreeMigrationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the progress of the shard FSTree migration",
+	Long:  "Show the progress of the current or the latest FSTree migration of the shard",
+	Run:   fsTreeMigrationStatus,
+}
+
+func startFSTreeMigration(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartFSTreeMigrationRequest{Body: new(control.StartFSTreeMigrationRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.RateLimit, _ = cmd.Flags().GetUint32(migrationRateLimitFlag)
+	req.Body.Restart, _ = cmd.Flags().GetBool(migrationRestartFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartFSTreeMigrationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StartFSTreeMigration(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("FSTree migration has been started.")
+}
+
+func stopFSTreeMigration(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopFSTreeMigrationRequest{Body: new(control.StopFSTreeMigrationRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopFSTreeMigrationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StopFSTreeMigration(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("FSTree migration has been stopped.")
+}
+
+func fsTreeMigrationStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetFSTreeMigrationStatusRequest{Body: new(control.GetFSTreeMigrationStatusRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetFSTreeMigrationStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetFSTreeMigrationStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	cmd.Printf("Processed objects: %d\n", body.GetProcessed())
+	cmd.Printf("Moved objects: %d\n", body.GetMoved())
+	cmd.Printf("Failed objects: %d\n", body.GetFailed())
+
+	switch {
+	case body.GetRunning():
+		cmd.Println("FSTree migration is in progress.")
+	case body.GetCompleted():
+		cmd.Println("FSTree migration has been completed.")
+	case body.GetError() != "":
+		cmd.Printf("FSTree migration has failed: %s\n", body.GetError())
+	default:
+		cmd.Println("FSTree migration is not running.")
+	}
+}
+
+func initControlFSTreeMigrationCmd() {
+	fsTreeMigrationCmd.AddCommand(fsTreeMigrationStartCmd)
+	fsTreeMigrationCmd.AddCommand(fsTreeMigrationStopCmd)
+	fsTreeMigrationCmd.AddCommand(fsTreeMigrationStatusCmd)
+
+	for _, cmd := range []*cobra.Command{fsTreeMigrationStartCmd, fsTreeMigrationStopCmd, fsTreeMigrationStatusCmd} {
+		commonflags.InitWithoutRPC(cmd)
+
+		ff := cmd.Flags()
+		ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+		ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+
+		_ = cmd.MarkFlagRequired(shardIDFlag)
+	}
+
+	ff := fsTreeMigrationStartCmd.Flags()
+	ff.Uint32(migrationRateLimitFlag, 0, "Maximum number of objects processed per second (0 means no limit)")
+	ff.Bool(migrationRestartFlag, false, "Start the migration from the beginning instead of continuing the previous interrupted one")
+}
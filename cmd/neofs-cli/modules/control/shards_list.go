@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/mr-tron/base58"
 	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
@@ -62,12 +63,15 @@ func prettyPrintShardsJSON(cmd *cobra.Command, ii []*control.ShardInfo) {
 	out := make([]map[string]interface{}, 0, len(ii))
 	for _, i := range ii {
 		out = append(out, map[string]interface{}{
-			"shard_id":    base58.Encode(i.Shard_ID),
-			"mode":        shardModeToString(i.GetMode()),
-			"metabase":    i.GetMetabasePath(),
-			"blobstor":    i.GetBlobstorPath(),
-			"writecache":  i.GetWritecachePath(),
-			"error_count": i.GetErrorCount(),
+			"shard_id":            base58.Encode(i.Shard_ID),
+			"mode":                shardModeToString(i.GetMode()),
+			"forced_read_only":    i.GetForcedReadOnly(),
+			"metabase":            i.GetMetabasePath(),
+			"blobstor":            i.GetBlobstorPath(),
+			"writecache":          i.GetWritecachePath(),
+			"writecache_disabled": i.GetWritecacheDisabled(),
+			"error_count":         i.GetErrorCount(),
+			"last_error":          i.GetLastError(),
 		})
 	}
 
@@ -98,6 +102,19 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 			base58.Encode(i.Shard_ID),
 			shardModeToString(i.GetMode()),
 		)
+
+		if i.GetForcedReadOnly() {
+			cmd.Println("Forced read-only: mode can not be changed to allow writes")
+		}
+
+		if i.GetWritecacheDisabled() {
+			cmd.Println("Write-cache: disabled")
+		}
+
+		if lastErr := i.GetLastError(); lastErr != "" {
+			cmd.Printf("Last error: %s (%s)\n", lastErr,
+				time.Unix(i.GetLastErrorTime(), 0).Format(time.RFC3339))
+		}
 	}
 }
 
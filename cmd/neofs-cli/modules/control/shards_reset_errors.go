@@ -0,0 +1,50 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var resetShardErrorsCmd = &cobra.Command{
+	Use:   "reset-errors",
+	Short: "Reset error counter of the shard and drop recorded errors",
+	Long:  "Reset error counter of the shard and drop recorded errors",
+	Run:   resetShardErrors,
+}
+
+func resetShardErrors(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.ResetShardErrorsRequest{Body: new(control.ResetShardErrorsRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ResetShardErrorsResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.ResetShardErrors(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard errors have been reset.")
+}
+
+func initControlResetShardErrorsCmd() {
+	commonflags.InitWithoutRPC(resetShardErrorsCmd)
+
+	ff := resetShardErrorsCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+
+	_ = resetShardErrorsCmd.MarkFlagRequired(shardIDFlag)
+}
@@ -0,0 +1,56 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var resyncMetabaseCmd = &cobra.Command{
+	Use:   "resync-metabase",
+	Short: "Refill the shard metabase from the blobstor",
+	Long: `Drop the shard metabase and fill it again with the objects stored in the blobstor.
+The shard must be in the read-write mode, it doesn't serve the requests until the operation is finished.`,
+	Run: resyncMetabase,
+}
+
+func resyncMetabase(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.ResyncMetabaseRequest{Body: new(control.ResyncMetabaseRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+	req.Body.ErrorLimit, _ = cmd.Flags().GetUint32(errorLimitFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ResyncMetabaseResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.ResyncMetabase(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	printSkippedErrors(cmd, resp.GetBody().GetErrorCount(), resp.GetBody().GetErrors())
+	cmd.Println("Metabase has been resynchronized.")
+}
+
+func initControlResyncMetabaseCmd() {
+	commonflags.InitWithoutRPC(resyncMetabaseCmd)
+
+	ff := resyncMetabaseCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	ff.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	ff.Uint32(errorLimitFlag, 0, "Abort after the specified number of skipped objects, 0 means no limit")
+
+	_ = resyncMetabaseCmd.MarkFlagRequired(shardIDFlag)
+}
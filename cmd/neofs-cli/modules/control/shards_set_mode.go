@@ -44,7 +44,7 @@ func initControlSetShardModeCmd() {
 			shardModeDegraded,
 		),
 	)
-	flags.Bool(shardClearErrorsFlag, false, "Set shard error count to 0")
+	flags.Bool(shardClearErrorsFlag, false, "Set shard error count to 0 and drop recorded errors")
 }
 
 func setShardMode(cmd *cobra.Command, _ []string) {
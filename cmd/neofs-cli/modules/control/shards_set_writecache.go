@@ -0,0 +1,93 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	writeCacheDisableFlag      = "disable"
+	writeCacheEnableFlag       = "enable"
+	writeCacheFlushTimeoutFlag = "flush-timeout"
+
+	writeCacheFlushTimeoutDefault = time.Minute
+)
+
+var setShardWriteCacheCmd = &cobra.Command{
+	Use:   "set-writecache",
+	Short: "Disable or enable the write-cache of the shard",
+	Long: `Disable or enable the write-cache of the shard without restarting the node.
+On disabling, the write-cache stops accepting new objects and is flushed
+to the main storage, then the shard operates without it: objects are put
+directly to the main storage and are not read from the write-cache. The
+write-cache stays enabled if the flush is not finished within the timeout.
+Enabling attaches the disabled write-cache back. The shard must be in
+the read-write mode.
+
+The disabled state is not persisted: the write-cache is enabled again
+after the node restart. Disable it in the configuration to keep the
+shard without the write-cache.`,
+	Run: setShardWriteCache,
+}
+
+func setShardWriteCache(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	disable, _ := cmd.Flags().GetBool(writeCacheDisableFlag)
+	enable, _ := cmd.Flags().GetBool(writeCacheEnableFlag)
+	if disable == enable {
+		common.ExitOnErr(cmd, "", errors.New("either --disable or --enable flag must be set"))
+	}
+
+	timeout, _ := cmd.Flags().GetDuration(writeCacheFlushTimeoutFlag)
+	if disable && timeout < time.Millisecond {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid --%s value %s, must be at least 1ms", writeCacheFlushTimeoutFlag, timeout))
+	}
+
+	req := &control.SetShardWriteCacheRequest{Body: new(control.SetShardWriteCacheRequest_Body)}
+	req.Body.Shard_ID = getShardID(cmd)
+	req.Body.Disable = disable
+	req.Body.FlushTimeout = uint32(timeout / time.Millisecond)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.SetShardWriteCacheResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.SetShardWriteCache(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if disable {
+		cmd.Println("Write-cache has been flushed and disabled.")
+	} else {
+		cmd.Println("Write-cache has been enabled.")
+	}
+}
+
+func initControlSetShardWriteCacheCmd() {
+	commonflags.InitWithoutRPC(setShardWriteCacheCmd)
+
+	ff := setShardWriteCacheCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	ff.Bool(writeCacheDisableFlag, false, "Flush the write-cache and disable it")
+	ff.Bool(writeCacheEnableFlag, false, "Enable the disabled write-cache")
+	ff.Duration(writeCacheFlushTimeoutFlag, writeCacheFlushTimeoutDefault, "Time limit for flushing the write-cache on disabling")
+
+	_ = setShardWriteCacheCmd.MarkFlagRequired(shardIDFlag)
+	setShardWriteCacheCmd.MarkFlagsMutuallyExclusive(writeCacheDisableFlag, writeCacheEnableFlag)
+}
@@ -0,0 +1,86 @@
+package control
+
+import (
+	"os"
+	"time"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	supportBundleOutFlag              = "out"
+	supportBundleCountFlag            = "count"
+	supportBundleComponentTimeoutFlag = "component-timeout"
+)
+
+var supportBundleCmd = &cobra.Command{
+	Use:   "support-bundle",
+	Short: "Save the node state for the problem investigation to a file",
+	Long: `Save the node state useful for the problem investigation to a JSON file:
+node version and configuration file hash, health and network map status,
+sidechain connection state, shard information with write-cache backlogs,
+error counters and latest GC runs, latest summaries of the suppressed
+shard errors. Neither object data nor keys are included. The state of each
+component is collected within the time limit, the components which state
+could not be collected are listed in the "errors" field.`,
+	Run: supportBundle,
+}
+
+func initControlSupportBundleCmd() {
+	commonflags.InitWithoutRPC(supportBundleCmd)
+
+	ff := supportBundleCmd.Flags()
+	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(supportBundleOutFlag, "", "File to write the bundle to")
+	ff.Uint32(supportBundleCountFlag, 10, "Maximum number of the latest GC runs per shard and suppressed error summaries (0 means all the retained ones)")
+	ff.Duration(supportBundleComponentTimeoutFlag, 0, "Time limit for collecting the state of a single component (0 means the node default)")
+
+	_ = supportBundleCmd.MarkFlagRequired(supportBundleOutFlag)
+}
+
+func supportBundle(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	timeout, _ := cmd.Flags().GetDuration(supportBundleComponentTimeoutFlag)
+
+	req := &control.GetSupportBundleRequest{Body: new(control.GetSupportBundleRequest_Body)}
+	req.Body.Count, _ = cmd.Flags().GetUint32(supportBundleCountFlag)
+	req.Body.ComponentTimeout = uint32(timeout / time.Millisecond)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetSupportBundleResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetSupportBundle(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	data, err := protojson.MarshalOptions{
+		Multiline:       true,
+		EmitUnpopulated: true,
+	}.Marshal(resp.GetBody())
+	common.ExitOnErr(cmd, "could not encode the bundle: %w", err)
+
+	out, _ := cmd.Flags().GetString(supportBundleOutFlag)
+
+	err = os.WriteFile(out, data, 0644)
+	common.ExitOnErr(cmd, "could not write the bundle to the file: %w", err)
+
+	cmd.Printf("Support bundle has been saved to %s.\n", out)
+
+	for _, e := range resp.GetBody().GetErrors() {
+		cmd.Printf("Could not collect %s state: %s\n", e.GetComponent(), e.GetError())
+	}
+}
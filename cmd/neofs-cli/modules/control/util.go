@@ -48,3 +48,21 @@ func verifyResponse(cmd *cobra.Command,
 func getClient(cmd *cobra.Command, pk *ecdsa.PrivateKey) *client.Client {
 	return internalclient.GetSDKClientByFlag(cmd, pk, controlRPC)
 }
+
+// errorLimitFlag is the flag of the maintenance commands limiting the number
+// of the ignored errors.
+const errorLimitFlag = "error-limit"
+
+func printSkippedErrors(cmd *cobra.Command, count uint32, errs []string) {
+	if count == 0 {
+		return
+	}
+
+	cmd.Printf("Errors ignored: %d\n", count)
+	for i := range errs {
+		cmd.Printf("  %s\n", errs[i])
+	}
+	if int(count) > len(errs) {
+		cmd.Printf("  ...and %d more\n", int(count)-len(errs))
+	}
+}
@@ -0,0 +1,266 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+// splitChild describes the child object of the split chain.
+type splitChild struct {
+	ID oid.ID
+	// Size is the payload size of the child, zero if the child is missing.
+	Size uint64
+	// Err is the reason the child header can't be received,
+	// nil if the child is available.
+	Err error
+}
+
+// splitChain describes the child objects of the virtual object.
+type splitChain struct {
+	SplitID *objectSDK.SplitID
+	// Link is the linking object ID, nil if the chain is walked
+	// from the last part.
+	Link *oid.ID
+	// Children are the child objects in the payload order.
+	Children []splitChild
+	// Size is the total payload size of the available children.
+	Size uint64
+	// ParentSize is the payload size declared in the parent header,
+	// nil if the parent header can't be received.
+	ParentSize *uint64
+	// Broken is true if the chain walked from the last part is
+	// interrupted by the missing child, so the preceding children
+	// are unknown.
+	Broken bool
+}
+
+func (c *splitChain) add(id oid.ID, hdr *objectSDK.Object, err error) {
+	if err != nil {
+		c.Children = append(c.Children, splitChild{ID: id, Err: err})
+		return
+	}
+
+	c.Children = append(c.Children, splitChild{ID: id, Size: hdr.PayloadSize()})
+	c.Size += hdr.PayloadSize()
+}
+
+func (c *splitChain) setParent(parent *objectSDK.Object) {
+	if parent != nil {
+		sz := parent.PayloadSize()
+		c.ParentSize = &sz
+	}
+}
+
+// complete checks whether all the children of the chain are available.
+func (c splitChain) complete() bool {
+	if c.Broken {
+		return false
+	}
+
+	for i := range c.Children {
+		if c.Children[i].Err != nil {
+			return false
+		}
+	}
+
+	return c.ParentSize == nil || *c.ParentSize == c.Size
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c splitChain) MarshalJSON() ([]byte, error) {
+	type jsonChild struct {
+		ID      string `json:"id"`
+		Size    uint64 `json:"size"`
+		Missing bool   `json:"missing,omitempty"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	v := struct {
+		SplitID    string      `json:"splitID,omitempty"`
+		Link       string      `json:"link,omitempty"`
+		Children   []jsonChild `json:"children"`
+		Size       uint64      `json:"size"`
+		ParentSize *uint64     `json:"parentSize,omitempty"`
+		Broken     bool        `json:"broken,omitempty"`
+		Complete   bool        `json:"complete"`
+	}{
+		Children:   make([]jsonChild, len(c.Children)),
+		Size:       c.Size,
+		ParentSize: c.ParentSize,
+		Broken:     c.Broken,
+		Complete:   c.complete(),
+	}
+
+	if c.SplitID != nil {
+		v.SplitID = c.SplitID.String()
+	}
+
+	if c.Link != nil {
+		v.Link = c.Link.EncodeToString()
+	}
+
+	for i := range c.Children {
+		v.Children[i] = jsonChild{
+			ID:   c.Children[i].ID.EncodeToString(),
+			Size: c.Children[i].Size,
+		}
+
+		if c.Children[i].Err != nil {
+			v.Children[i].Missing = true
+			v.Children[i].Error = c.Children[i].Err.Error()
+		}
+	}
+
+	return json.Marshal(v)
+}
+
+// collectSplitChain collects the child objects of the virtual object using
+// the linking object, or walking the chain from the last part if the linking
+// object is not available. head must return *objectSDK.SplitInfoError for
+// the virtual objects. The children which headers can't be received are
+// marked as missing.
+func collectSplitChain(cnr cid.ID, parent oid.ID, head func(oid.Address) (*objectSDK.Object, error)) (splitChain, error) {
+	var (
+		res  splitChain
+		addr oid.Address
+	)
+
+	addr.SetContainer(cnr)
+	addr.SetObject(parent)
+
+	_, err := head(addr)
+	if err == nil {
+		return res, fmt.Errorf("object %s is not split", parent)
+	}
+
+	var errSplitInfo *objectSDK.SplitInfoError
+	if !errors.As(err, &errSplitInfo) {
+		return res, err
+	}
+
+	si := errSplitInfo.SplitInfo()
+	res.SplitID = si.SplitID()
+
+	link, withLink := si.Link()
+	last, withLast := si.LastPart()
+
+	if withLink {
+		addr.SetObject(link)
+
+		linkHdr, err := head(addr)
+		if err == nil {
+			res.Link = &link
+			res.setParent(linkHdr.Parent())
+
+			for _, id := range linkHdr.Children() {
+				addr.SetObject(id)
+
+				hdr, err := head(addr)
+				res.add(id, hdr, err)
+			}
+
+			return res, nil
+		}
+
+		if !withLast {
+			return res, fmt.Errorf("linking object %s: %w", link, err)
+		}
+	}
+
+	if !withLast {
+		return res, errors.New("split info contains neither linking object nor last part")
+	}
+
+	// the chain is walked backwards from the last part
+	var (
+		reversed splitChain
+		visited  = make(map[oid.ID]struct{})
+	)
+
+	for id := last; ; {
+		if _, ok := visited[id]; ok {
+			return res, fmt.Errorf("split chain has a cycle at %s", id)
+		}
+		visited[id] = struct{}{}
+
+		addr.SetObject(id)
+
+		hdr, err := head(addr)
+		reversed.add(id, hdr, err)
+
+		if err != nil {
+			res.Broken = true
+			break
+		}
+
+		if len(reversed.Children) == 1 {
+			// the last part carries the parent header
+			res.setParent(hdr.Parent())
+		}
+
+		prev, ok := hdr.PreviousID()
+		if !ok {
+			break
+		}
+
+		id = prev
+	}
+
+	res.Size = reversed.Size
+	res.Children = make([]splitChild, len(reversed.Children))
+	for i := range reversed.Children {
+		res.Children[len(res.Children)-1-i] = reversed.Children[i]
+	}
+
+	return res, nil
+}
+
+func printSplitChain(cmd *cobra.Command, chain splitChain) {
+	if !chain.complete() {
+		cmd.PrintErrln("Split chain is incomplete.")
+	}
+
+	if toJSON, _ := cmd.Flags().GetBool(commonflags.JSON); toJSON {
+		common.PrettyPrintJSON(cmd, chain, "split chain")
+		return
+	}
+
+	if chain.SplitID != nil {
+		cmd.Printf("Split ID: %s\n", chain.SplitID)
+	}
+
+	if chain.Link != nil {
+		cmd.Printf("Linking object: %s\n", chain.Link)
+	} else {
+		cmd.Println("Linking object: <unavailable>, the chain is walked from the last part")
+	}
+
+	if chain.Broken {
+		cmd.Println("Children (the chain is broken, the preceding ones are unknown):")
+	} else {
+		cmd.Println("Children:")
+	}
+
+	for i, c := range chain.Children {
+		if c.Err != nil {
+			cmd.Printf("  %d. %s MISSING (%v)\n", i+1, c.ID, c.Err)
+			continue
+		}
+
+		cmd.Printf("  %d. %s %d\n", i+1, c.ID, c.Size)
+	}
+
+	cmd.Printf("Total size: %d\n", chain.Size)
+
+	if chain.ParentSize != nil {
+		cmd.Printf("Parent size: %d\n", *chain.ParentSize)
+	}
+}
@@ -0,0 +1,189 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectSplitChain(t *testing.T) {
+	cnr := cidtest.ID()
+	splitID := objectSDK.NewSplitID()
+
+	parentID := oidtest.ID()
+	parent := objectSDK.New()
+	parent.SetPayloadSize(6)
+
+	link := oidtest.ID()
+	children := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+
+	// headers of the available objects, the parent is virtual
+	newHead := func(si *objectSDK.SplitInfo, missing ...oid.ID) func(oid.Address) (*objectSDK.Object, error) {
+		hdrs := make(map[oid.ID]*objectSDK.Object)
+
+		linkHdr := objectSDK.New()
+		linkHdr.SetParent(parent)
+		linkHdr.SetChildren(children...)
+		hdrs[link] = linkHdr
+
+		for i := range children {
+			hdr := objectSDK.New()
+			hdr.SetPayloadSize(uint64(i + 1))
+			hdr.SetSplitID(splitID)
+			if i > 0 {
+				hdr.SetPreviousID(children[i-1])
+			}
+			if i == len(children)-1 {
+				hdr.SetParent(parent)
+			}
+			hdrs[children[i]] = hdr
+		}
+
+		for i := range missing {
+			delete(hdrs, missing[i])
+		}
+
+		return func(addr oid.Address) (*objectSDK.Object, error) {
+			require.Equal(t, cnr, addr.Container())
+
+			if addr.Object().Equals(parentID) {
+				return nil, objectSDK.NewSplitInfoError(si)
+			}
+
+			hdr, ok := hdrs[addr.Object()]
+			if !ok {
+				return nil, apistatus.ObjectNotFound{}
+			}
+
+			return hdr, nil
+		}
+	}
+
+	withLink := objectSDK.NewSplitInfo()
+	withLink.SetSplitID(splitID)
+	withLink.SetLink(link)
+
+	withLast := objectSDK.NewSplitInfo()
+	withLast.SetSplitID(splitID)
+	withLast.SetLastPart(children[2])
+
+	withBoth := objectSDK.NewSplitInfo()
+	withBoth.SetLink(link)
+	withBoth.SetLastPart(children[2])
+
+	requireChildren := func(t *testing.T, chain splitChain, missing ...int) {
+		require.Len(t, chain.Children, len(children))
+
+		var size uint64
+		for i := range children {
+			require.Equal(t, children[i], chain.Children[i].ID)
+
+			if len(missing) > 0 && missing[0] == i {
+				missing = missing[1:]
+				require.Error(t, chain.Children[i].Err)
+				require.Zero(t, chain.Children[i].Size)
+				continue
+			}
+
+			require.NoError(t, chain.Children[i].Err)
+			require.EqualValues(t, i+1, chain.Children[i].Size)
+			size += uint64(i + 1)
+		}
+
+		require.Equal(t, size, chain.Size)
+		require.NotNil(t, chain.ParentSize)
+		require.EqualValues(t, 6, *chain.ParentSize)
+	}
+
+	t.Run("link", func(t *testing.T) {
+		chain, err := collectSplitChain(cnr, parentID, newHead(withLink))
+		require.NoError(t, err)
+		require.Equal(t, &link, chain.Link)
+		require.Equal(t, splitID, chain.SplitID)
+		requireChildren(t, chain)
+		require.True(t, chain.complete())
+	})
+
+	t.Run("link with missing child", func(t *testing.T) {
+		chain, err := collectSplitChain(cnr, parentID, newHead(withLink, children[1]))
+		require.NoError(t, err)
+		requireChildren(t, chain, 1)
+		require.False(t, chain.complete())
+	})
+
+	t.Run("last part", func(t *testing.T) {
+		chain, err := collectSplitChain(cnr, parentID, newHead(withLast))
+		require.NoError(t, err)
+		require.Nil(t, chain.Link)
+		requireChildren(t, chain)
+		require.True(t, chain.complete())
+	})
+
+	t.Run("missing link", func(t *testing.T) {
+		chain, err := collectSplitChain(cnr, parentID, newHead(withBoth, link))
+		require.NoError(t, err)
+		require.Nil(t, chain.Link)
+		requireChildren(t, chain)
+
+		_, err = collectSplitChain(cnr, parentID, newHead(withLink, link))
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	t.Run("broken chain", func(t *testing.T) {
+		chain, err := collectSplitChain(cnr, parentID, newHead(withLast, children[1]))
+		require.NoError(t, err)
+		require.True(t, chain.Broken)
+		require.False(t, chain.complete())
+
+		require.Len(t, chain.Children, 2)
+		require.Equal(t, children[1], chain.Children[0].ID)
+		require.Error(t, chain.Children[0].Err)
+		require.Equal(t, children[2], chain.Children[1].ID)
+		require.EqualValues(t, 3, chain.Size)
+
+		data, err := json.Marshal(chain)
+		require.NoError(t, err)
+
+		var v struct {
+			Children []struct {
+				ID      string
+				Missing bool
+			}
+			Broken   bool
+			Complete bool
+		}
+		require.NoError(t, json.Unmarshal(data, &v))
+		require.True(t, v.Broken)
+		require.False(t, v.Complete)
+		require.Len(t, v.Children, 2)
+		require.Equal(t, children[1].EncodeToString(), v.Children[0].ID)
+		require.True(t, v.Children[0].Missing)
+		require.False(t, v.Children[1].Missing)
+	})
+
+	t.Run("not split", func(t *testing.T) {
+		_, err := collectSplitChain(cnr, children[0], newHead(withLink))
+		require.Error(t, err)
+	})
+
+	t.Run("empty split info", func(t *testing.T) {
+		_, err := collectSplitChain(cnr, parentID, newHead(objectSDK.NewSplitInfo()))
+		require.Error(t, err)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		errTest := errors.New("test error")
+
+		_, err := collectSplitChain(cnr, parentID, func(oid.Address) (*objectSDK.Object, error) {
+			return nil, errTest
+		})
+		require.ErrorIs(t, err, errTest)
+	})
+}
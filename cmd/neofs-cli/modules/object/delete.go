@@ -1,22 +1,45 @@
 package object
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	sessionCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/session"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/spf13/cobra"
 )
 
+const (
+	delOIDsFileFlag = "oids-file"
+
+	// delTombstoneLifetimeDefault is a default lifetime of the batch
+	// tombstone, matches the one of the tombstones created by the nodes.
+	delTombstoneLifetimeDefault = 5
+)
+
 var objectDelCmd = &cobra.Command{
 	Use:     "delete",
 	Aliases: []string{"del"},
 	Short:   "Delete object from NeoFS",
-	Long:    "Delete object from NeoFS",
-	Run:     deleteObject,
+	Long: `Delete object from NeoFS.
+
+With --` + delOIDsFileFlag + ` flag all the listed objects are removed with a single tombstone
+object. The file must contain one object ID per line, empty lines are ignored.`,
+	Run: deleteObject,
 }
 
 func initObjectDeleteCmd() {
@@ -29,10 +52,24 @@ func initObjectDeleteCmd() {
 	_ = objectDelCmd.MarkFlagRequired("cid")
 
 	flags.String("oid", "", "Object ID")
-	_ = objectDelCmd.MarkFlagRequired("oid")
+	flags.String(delOIDsFileFlag, "", "File with IDs of the objects to remove with a single tombstone")
+	objectDelCmd.MarkFlagsMutuallyExclusive("oid", delOIDsFileFlag)
+
+	flags.Uint64P(commonflags.ExpireAt, "e", 0, "Tombstone expiration epoch (requires --"+delOIDsFileFlag+")")
+	flags.Uint64(commonflags.Lifetime, delTombstoneLifetimeDefault, "Tombstone lifetime in epochs (requires --"+delOIDsFileFlag+")")
+	objectDelCmd.MarkFlagsMutuallyExclusive(commonflags.ExpireAt, commonflags.Lifetime)
 }
 
 func deleteObject(cmd *cobra.Command, _ []string) {
+	if path, _ := cmd.Flags().GetString(delOIDsFileFlag); path != "" {
+		deleteObjectBatch(cmd, path)
+		return
+	}
+
+	if cmd.Flag("oid").Value.String() == "" {
+		common.ExitOnErr(cmd, "", fmt.Errorf("either --oid or --%s flag must be set", delOIDsFileFlag))
+	}
+
 	var cnr cid.ID
 	var obj oid.ID
 
@@ -52,3 +89,198 @@ func deleteObject(cmd *cobra.Command, _ []string) {
 	cmd.Println("Object removed successfully.")
 	cmd.Printf("  ID: %s\n  CID: %s\n", tomb, cnr)
 }
+
+// deleteObjectBatch stores a single tombstone object covering all the
+// objects listed in the file. The nodes inhume the members on the
+// tombstone receipt.
+func deleteObjectBatch(cmd *cobra.Command, path string) {
+	var cnr cid.ID
+	readCID(cmd, &cnr)
+
+	data, err := os.ReadFile(path)
+	common.ExitOnErr(cmd, "can't read object IDs file: %w", err)
+
+	members, err := parseObjectIDs(data)
+	common.ExitOnErr(cmd, "can't parse object IDs file: %w", err)
+
+	pk := key.GetOrGenerate(cmd)
+
+	var owner user.ID
+	user.IDFromKey(&owner, pk.PublicKey)
+
+	var (
+		headPrm internalclient.HeadObjectPrm
+		putPrm  internalclient.PutObjectPrm
+	)
+
+	sessionCli.Prepare(cmd, cnr, nil, pk, &headPrm, &putPrm)
+	Prepare(cmd, &headPrm, &putPrm)
+
+	headPrm.SetRawFlag(true)
+
+	members, err = collectTombstoneMembers(cnr, members, func(addr oid.Address) (*objectSDK.Object, error) {
+		headPrm.SetAddress(addr)
+
+		res, err := internalclient.HeadObject(headPrm)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Header(), nil
+	})
+	common.ExitOnErr(cmd, "tombstone members check: %w", err)
+
+	exp, _ := cmd.Flags().GetUint64(commonflags.ExpireAt)
+	if !cmd.Flags().Changed(commonflags.ExpireAt) {
+		lifetime, _ := cmd.Flags().GetUint64(commonflags.Lifetime)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		endpoint, _ := cmd.Flags().GetString(commonflags.RPC)
+
+		currEpoch, err := internalclient.GetCurrentEpoch(ctx, endpoint)
+		common.ExitOnErr(cmd, "Request current epoch: %w", err)
+
+		exp = currEpoch + lifetime
+	}
+
+	obj, err := newBatchTombstone(cnr, owner, members, exp)
+	common.ExitOnErr(cmd, "can't create tombstone object: %w", err)
+
+	putPrm.SetHeader(obj)
+
+	res, err := internalclient.PutObject(putPrm)
+	common.ExitOnErr(cmd, "Store tombstone object in NeoFS: %w", err)
+
+	cmd.Printf("%d objects removed successfully.\n", len(members))
+	cmd.Printf("  ID: %s\n  CID: %s\n", res.ID(), cnr)
+}
+
+// parseObjectIDs decodes object IDs listed one per line. Empty lines are
+// skipped, duplicates are not allowed.
+func parseObjectIDs(data []byte) ([]oid.ID, error) {
+	var (
+		res     []oid.ID
+		line    int
+		scanner = bufio.NewScanner(bytes.NewReader(data))
+		unique  = make(map[oid.ID]struct{})
+	)
+
+	for scanner.Scan() {
+		line++
+
+		str := string(bytes.TrimSpace(scanner.Bytes()))
+		if str == "" {
+			continue
+		}
+
+		var id oid.ID
+		if err := id.DecodeString(str); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if _, ok := unique[id]; ok {
+			return nil, fmt.Errorf("line %d: duplicated object ID %s", line, id)
+		}
+
+		unique[id] = struct{}{}
+		res = append(res, id)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("no object IDs")
+	}
+
+	return res, nil
+}
+
+// collectTombstoneMembers checks that the members are stored in the container
+// and supplements the virtual ones with their children listed in the linking
+// object. head must return *objectSDK.SplitInfoError for the virtual objects.
+func collectTombstoneMembers(cnr cid.ID, members []oid.ID, head func(oid.Address) (*objectSDK.Object, error)) ([]oid.ID, error) {
+	res := make([]oid.ID, 0, len(members))
+	unique := make(map[oid.ID]struct{}, len(members))
+
+	add := func(ids ...oid.ID) {
+		for i := range ids {
+			if _, ok := unique[ids[i]]; !ok {
+				unique[ids[i]] = struct{}{}
+				res = append(res, ids[i])
+			}
+		}
+	}
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	for i := range members {
+		addr.SetObject(members[i])
+
+		hdr, err := head(addr)
+		if err == nil {
+			if objCnr, ok := hdr.ContainerID(); ok && !objCnr.Equals(cnr) {
+				return nil, fmt.Errorf("object %s belongs to another container %s", members[i], objCnr)
+			}
+
+			add(members[i])
+			continue
+		}
+
+		var errSplitInfo *objectSDK.SplitInfoError
+		if !errors.As(err, &errSplitInfo) {
+			return nil, fmt.Errorf("object %s: %w", members[i], err)
+		}
+
+		link, ok := errSplitInfo.SplitInfo().Link()
+		if !ok {
+			return nil, fmt.Errorf("object %s is virtual and has no linking object, remove it separately", members[i])
+		}
+
+		addr.SetObject(link)
+
+		linkHdr, err := head(addr)
+		if err != nil {
+			return nil, fmt.Errorf("linking object %s of %s: %w", link, members[i], err)
+		}
+
+		add(members[i], link)
+		add(linkHdr.Children()...)
+	}
+
+	return res, nil
+}
+
+// newBatchTombstone creates a tombstone object removing all the members from
+// the container. The tombstone expires after the exp epoch.
+func newBatchTombstone(cnr cid.ID, owner user.ID, members []oid.ID, exp uint64) (*objectSDK.Object, error) {
+	if len(members) == 0 {
+		return nil, errors.New("no tombstone members")
+	}
+
+	tomb := objectSDK.NewTombstone()
+	tomb.SetMembers(members)
+	tomb.SetExpirationEpoch(exp)
+
+	payload, err := tomb.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal tombstone: %w", err)
+	}
+
+	var expirationAttr objectSDK.Attribute
+	expirationAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expirationAttr.SetValue(strconv.FormatUint(exp, 10))
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetType(objectSDK.TypeTombstone)
+	obj.SetAttributes(expirationAttr)
+	obj.SetPayload(payload)
+
+	return obj, nil
+}
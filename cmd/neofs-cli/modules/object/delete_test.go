@@ -0,0 +1,127 @@
+package object
+
+import (
+	"strings"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type deleteRecorder struct {
+	calls [][]oid.Address
+}
+
+func (r *deleteRecorder) DeleteObjects(_ oid.Address, _ uint64, addrs ...oid.Address) error {
+	r.calls = append(r.calls, addrs)
+	return nil
+}
+
+func TestParseObjectIDs(t *testing.T) {
+	ids := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	t.Run("valid", func(t *testing.T) {
+		data := "\n" + ids[0].EncodeToString() + "\n  " + ids[1].EncodeToString() + "  \n\n"
+
+		res, err := parseObjectIDs([]byte(data))
+		require.NoError(t, err)
+		require.Equal(t, ids, res)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := parseObjectIDs([]byte("\n\n"))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseObjectIDs([]byte(ids[0].EncodeToString() + "\nnot an ID"))
+		require.ErrorContains(t, err, "line 2")
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		data := strings.Join([]string{ids[0].EncodeToString(), ids[1].EncodeToString(), ids[0].EncodeToString()}, "\n")
+
+		_, err := parseObjectIDs([]byte(data))
+		require.ErrorContains(t, err, "line 3")
+	})
+}
+
+func TestCollectTombstoneMembers(t *testing.T) {
+	cnr := cidtest.ID()
+
+	regular := oidtest.ID()
+	parent := oidtest.ID()
+	link := oidtest.ID()
+	children := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	head := func(addr oid.Address) (*objectSDK.Object, error) {
+		obj := objectSDK.New()
+		obj.SetContainerID(addr.Container())
+
+		switch id := addr.Object(); {
+		case id.Equals(regular):
+		case id.Equals(parent):
+			si := objectSDK.NewSplitInfo()
+			si.SetLink(link)
+
+			return nil, objectSDK.NewSplitInfoError(si)
+		case id.Equals(link):
+			obj.SetChildren(children...)
+		default:
+			return nil, objectSDK.NewSplitInfoError(objectSDK.NewSplitInfo())
+		}
+
+		return obj, nil
+	}
+
+	t.Run("regular and virtual", func(t *testing.T) {
+		res, err := collectTombstoneMembers(cnr, []oid.ID{regular, parent}, head)
+		require.NoError(t, err)
+		require.Equal(t, []oid.ID{regular, parent, link, children[0], children[1]}, res)
+	})
+
+	t.Run("virtual without link", func(t *testing.T) {
+		_, err := collectTombstoneMembers(cnr, []oid.ID{regular, oidtest.ID()}, head)
+		require.Error(t, err)
+	})
+
+	t.Run("another container", func(t *testing.T) {
+		_, err := collectTombstoneMembers(cnr, []oid.ID{regular}, func(oid.Address) (*objectSDK.Object, error) {
+			obj := objectSDK.New()
+			obj.SetContainerID(cidtest.ID())
+
+			return obj, nil
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestNewBatchTombstone(t *testing.T) {
+	cnr := cidtest.ID()
+	members := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+
+	_, err := newBatchTombstone(cnr, *usertest.ID(), nil, 10)
+	require.Error(t, err)
+
+	obj, err := newBatchTombstone(cnr, *usertest.ID(), members, 10)
+	require.NoError(t, err)
+	require.Equal(t, objectSDK.TypeTombstone, obj.Type())
+
+	// all the members are inhumed by the node in a single operation
+	rec := new(deleteRecorder)
+	v := objectcore.NewFormatValidator(objectcore.WithDeleteHandler(rec))
+
+	require.NoError(t, v.ValidateContent(obj))
+	require.Len(t, rec.calls, 1)
+	require.Len(t, rec.calls[0], len(members))
+
+	for i := range members {
+		require.Equal(t, cnr, rec.calls[0][i].Container())
+		require.Equal(t, members[i], rec.calls[0][i].Object())
+	}
+}
@@ -19,11 +19,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const headChildrenFlag = "children"
+
 var objectHeadCmd = &cobra.Command{
 	Use:   "head",
 	Short: "Get object header",
-	Long:  "Get object header",
-	Run:   getObjectHeader,
+	Long: `Get object header.
+
+With --` + headChildrenFlag + ` flag the child objects of the virtual object are printed
+in the payload order with their sizes. The children are listed by the linking object
+or, if it is not available, by walking the chain from the last part.`,
+	Run: getObjectHeader,
 }
 
 func initObjectHeadCmd() {
@@ -43,6 +49,9 @@ func initObjectHeadCmd() {
 	flags.Bool(commonflags.JSON, false, "Marshal output in JSON")
 	flags.Bool("proto", false, "Marshal output in Protobuf")
 	flags.Bool(rawFlag, false, rawFlagDesc)
+	flags.Bool(headChildrenFlag, false, "Print the child objects of the virtual object")
+	objectHeadCmd.MarkFlagsMutuallyExclusive(headChildrenFlag, "proto")
+	objectHeadCmd.MarkFlagsMutuallyExclusive(headChildrenFlag, "file")
 }
 
 func getObjectHeader(cmd *cobra.Command, _ []string) {
@@ -54,6 +63,30 @@ func getObjectHeader(cmd *cobra.Command, _ []string) {
 	pk := key.GetOrGenerate(cmd)
 
 	var prm internalclient.HeadObjectPrm
+
+	if children, _ := cmd.Flags().GetBool(headChildrenFlag); children {
+		// the children are requested too, so the session
+		// is not bound to the parent object
+		sessionCli.Prepare(cmd, cnr, nil, pk, &prm)
+		Prepare(cmd, &prm)
+		prm.SetRawFlag(true)
+
+		chain, err := collectSplitChain(cnr, obj, func(addr oid.Address) (*object.Object, error) {
+			prm.SetAddress(addr)
+
+			res, err := internalclient.HeadObject(prm)
+			if err != nil {
+				return nil, err
+			}
+
+			return res.Header(), nil
+		})
+		common.ExitOnErr(cmd, "", err)
+
+		printSplitChain(cmd, chain)
+		return
+	}
+
 	sessionCli.Prepare(cmd, cnr, &obj, pk, &prm)
 	Prepare(cmd, &prm)
 
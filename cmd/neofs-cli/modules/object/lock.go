@@ -2,6 +2,7 @@ package object
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	sessionCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/session"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -20,6 +22,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const lockOwnerFlag = "owner"
+
 // object lock command.
 var objectLockCmd = &cobra.Command{
 	Use:   "lock CONTAINER OBJECT...",
@@ -44,7 +48,20 @@ var objectLockCmd = &cobra.Command{
 		key := key.GetOrGenerate(cmd)
 
 		var idOwner user.ID
-		user.IDFromKey(&idOwner, key.PublicKey)
+
+		ownerStr, _ := cmd.Flags().GetString(lockOwnerFlag)
+		if ownerStr == "" {
+			user.IDFromKey(&idOwner, key.PublicKey)
+		} else {
+			err = idOwner.DecodeString(ownerStr)
+			common.ExitOnErr(cmd, "can't decode owner ID wallet address: %w", err)
+
+			btok := common.ReadBearerToken(cmd, bearerTokenFlag)
+			tokenPath, _ := cmd.Flags().GetString(commonflags.SessionToken)
+
+			err = checkLockOwner(idOwner, key.PublicKey, btok, tokenPath != "")
+			common.ExitOnErr(cmd, "Lock owner verification: %w", err)
+		}
 
 		var lock objectSDK.Lock
 		lock.WriteMembers(lockList)
@@ -99,4 +116,41 @@ func initCommandObjectLock() {
 	objectLockCmd.Flags().Uint64P(commonflags.ExpireAt, "e", 0, "Lock expiration epoch")
 	objectLockCmd.Flags().Uint64(commonflags.Lifetime, 0, "Lock lifetime")
 	objectLockCmd.MarkFlagsMutuallyExclusive(commonflags.ExpireAt, commonflags.Lifetime)
+	objectLockCmd.Flags().String(lockOwnerFlag, "", "Owner of the lock object (omit to use owner from private key)")
+}
+
+// checkLockOwner checks that the signing key is allowed to create lock
+// object on behalf of the given owner. Key is allowed if it belongs to
+// the owner itself or if the owner issued a bearer token for the key's user.
+// Session tokens can't authorize the key since they are re-signed with it
+// before the request, so the explicit owner is rejected with a session token.
+func checkLockOwner(owner user.ID, key ecdsa.PublicKey, btok *bearer.Token, withSession bool) error {
+	var keyOwner user.ID
+	user.IDFromKey(&keyOwner, key)
+
+	if keyOwner.Equals(owner) {
+		return nil
+	}
+
+	if withSession {
+		return fmt.Errorf("session token can't authorize key of %s to act on behalf of %s, use bearer token instead", keyOwner, owner)
+	}
+
+	if btok == nil {
+		return fmt.Errorf("key of %s is not authorized to act on behalf of %s: bearer token is missing", keyOwner, owner)
+	}
+
+	if !btok.VerifySignature() {
+		return errors.New("invalid bearer token signature")
+	}
+
+	if issuer := bearer.ResolveIssuer(*btok); !issuer.Equals(owner) {
+		return fmt.Errorf("bearer token is issued by %s, not by the owner %s", issuer, owner)
+	}
+
+	if !btok.AssertUser(keyOwner) {
+		return fmt.Errorf("bearer token is not issued for %s", keyOwner)
+	}
+
+	return nil
 }
@@ -0,0 +1,71 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLockOwner(t *testing.T) {
+	ownerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var owner, signer user.ID
+	user.IDFromKey(&owner, ownerKey.PrivateKey.PublicKey)
+	user.IDFromKey(&signer, signerKey.PrivateKey.PublicKey)
+
+	t.Run("own key", func(t *testing.T) {
+		require.NoError(t, checkLockOwner(owner, ownerKey.PrivateKey.PublicKey, nil, false))
+	})
+
+	t.Run("explicit owner with bearer token", func(t *testing.T) {
+		var btok bearer.Token
+		btok.ForUser(signer)
+		require.NoError(t, btok.Sign(ownerKey.PrivateKey))
+
+		require.NoError(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, &btok, false))
+	})
+
+	t.Run("missing bearer token", func(t *testing.T) {
+		require.Error(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, nil, false))
+	})
+
+	t.Run("unsigned bearer token", func(t *testing.T) {
+		var btok bearer.Token
+		btok.ForUser(signer)
+
+		require.Error(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, &btok, false))
+	})
+
+	t.Run("bearer token from another issuer", func(t *testing.T) {
+		var btok bearer.Token
+		btok.ForUser(signer)
+		require.NoError(t, btok.Sign(signerKey.PrivateKey))
+
+		require.Error(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, &btok, false))
+	})
+
+	t.Run("bearer token for another user", func(t *testing.T) {
+		var btok bearer.Token
+		btok.ForUser(owner)
+		require.NoError(t, btok.Sign(ownerKey.PrivateKey))
+
+		require.Error(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, &btok, false))
+	})
+
+	t.Run("session token", func(t *testing.T) {
+		require.NoError(t, checkLockOwner(owner, ownerKey.PrivateKey.PublicKey, nil, true))
+
+		var btok bearer.Token
+		btok.ForUser(signer)
+		require.NoError(t, btok.Sign(ownerKey.PrivateKey))
+
+		require.Error(t, checkLockOwner(owner, signerKey.PrivateKey.PublicKey, &btok, true))
+	})
+}
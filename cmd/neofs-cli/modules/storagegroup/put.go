@@ -1,9 +1,12 @@
 package storagegroup
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"os"
+	"sync"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
@@ -11,6 +14,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	objectCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/object"
 	sessionCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/session"
+	objutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/storagegroup"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -19,9 +23,14 @@ import (
 	storagegroupSDK "github.com/nspcc-dev/neofs-sdk-go/storagegroup"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
-const sgMembersFlag = "members"
+const (
+	sgMembersFlag     = "members"
+	sgFromSearchFlag  = "from-search"
+	sgFiltersFileFlag = "filters-file"
+)
 
 var sgMembers []string
 
@@ -41,10 +50,15 @@ func initSGPutCmd() {
 	_ = sgPutCmd.MarkFlagRequired(cidFlag)
 
 	flags.StringSliceVarP(&sgMembers, sgMembersFlag, "m", nil, "ID list of storage group members")
-	_ = sgPutCmd.MarkFlagRequired(sgMembersFlag)
+	flags.Bool(sgFromSearchFlag, false, "Use root objects found in the container as storage group members")
+	flags.String(sgFiltersFileFlag, "", "File with search filters in protobuf JSON to select members with (requires --"+sgFromSearchFlag+")")
+	sgPutCmd.MarkFlagsMutuallyExclusive(sgMembersFlag, sgFromSearchFlag)
 
 	flags.Uint64(commonflags.Lifetime, 0, "Storage group lifetime in epochs")
 	_ = sgPutCmd.MarkFlagRequired(commonflags.Lifetime)
+
+	flags.Uint(sgConcurrencyFlag, sgConcurrencyDefault, "Maximum number of concurrent member requests")
+	flags.Bool(commonflags.JSON, false, "Print the result in JSON format")
 }
 
 func putSG(cmd *cobra.Command, _ []string) {
@@ -56,18 +70,33 @@ func putSG(cmd *cobra.Command, _ []string) {
 	var cnr cid.ID
 	readCID(cmd, &cnr)
 
-	members := make([]oid.ID, len(sgMembers))
-	uniqueFilter := make(map[oid.ID]struct{}, len(sgMembers))
+	concurrency := readConcurrency(cmd)
 
-	for i := range sgMembers {
-		err := members[i].DecodeString(sgMembers[i])
-		common.ExitOnErr(cmd, "could not parse object ID: %w", err)
+	var members []oid.ID
+	if fromSearch, _ := cmd.Flags().GetBool(sgFromSearchFlag); fromSearch {
+		members = searchMembers(cmd, pk, cnr)
+	} else {
+		if len(sgMembers) == 0 {
+			common.ExitOnErr(cmd, "", fmt.Errorf("either --%s or --%s flag must be set", sgMembersFlag, sgFromSearchFlag))
+		}
 
-		if _, alreadyExists := uniqueFilter[members[i]]; alreadyExists {
-			common.ExitOnErr(cmd, "", fmt.Errorf("%s member in not unique", members[i]))
+		if path, _ := cmd.Flags().GetString(sgFiltersFileFlag); path != "" {
+			common.ExitOnErr(cmd, "", fmt.Errorf("--%s flag requires --%s", sgFiltersFileFlag, sgFromSearchFlag))
 		}
 
-		uniqueFilter[members[i]] = struct{}{}
+		members = make([]oid.ID, len(sgMembers))
+		uniqueFilter := make(map[oid.ID]struct{}, len(sgMembers))
+
+		for i := range sgMembers {
+			err := members[i].DecodeString(sgMembers[i])
+			common.ExitOnErr(cmd, "could not parse object ID: %w", err)
+
+			if _, alreadyExists := uniqueFilter[members[i]]; alreadyExists {
+				common.ExitOnErr(cmd, "", fmt.Errorf("%s member in not unique", members[i]))
+			}
+
+			uniqueFilter[members[i]] = struct{}{}
+		}
 	}
 
 	var (
@@ -83,17 +112,17 @@ func putSG(cmd *cobra.Command, _ []string) {
 	resGetCnr, err := internalclient.GetContainer(getCnrPrm)
 	common.ExitOnErr(cmd, "get container RPC call: %w", err)
 
-	sessionCli.Prepare(cmd, cnr, nil, pk, &putPrm)
+	// the only session is used for all the member requests
+	sessionCli.Prepare(cmd, cnr, nil, pk, &putPrm, &headPrm)
 	objectCli.Prepare(cmd, &headPrm, &putPrm)
 
 	headPrm.SetRawFlag(true)
 
-	sg, err := storagegroup.CollectMembers(sgHeadReceiver{
-		cmd:     cmd,
-		key:     pk,
-		ownerID: &ownerID,
-		prm:     headPrm,
-	}, cnr, members, !container.IsHomomorphicHashingDisabled(resGetCnr.Container()))
+	heads, err := prefetchHeads(sgHeadReceiver{prm: headPrm}, cnr, members, concurrency)
+	common.ExitOnErr(cmd, "could not collect storage group members: %w", err)
+
+	sg, err := storagegroup.CollectMembers(heads, cnr, members,
+		!container.IsHomomorphicHashingDisabled(resGetCnr.Container()))
 	common.ExitOnErr(cmd, "could not collect storage group members: %w", err)
 
 	var netInfoPrm internalclient.NetworkInfoPrm
@@ -116,21 +145,123 @@ func putSG(cmd *cobra.Command, _ []string) {
 	res, err := internalclient.PutObject(putPrm)
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
+	if toJSON, _ := cmd.Flags().GetBool(commonflags.JSON); toJSON {
+		phyMembers := sg.Members()
+		out := map[string]interface{}{
+			"id":      res.ID().EncodeToString(),
+			"cid":     cnr.EncodeToString(),
+			"size":    sg.ValidationDataSize(),
+			"members": idsToStrings(phyMembers),
+		}
+
+		printJSON(cmd, out)
+		return
+	}
+
 	cmd.Println("Storage group successfully stored")
 	cmd.Printf("  ID: %s\n  CID: %s\n", res.ID(), cnr)
 }
 
+// searchMembers returns root objects of the container matching
+// the filters from the file.
+func searchMembers(cmd *cobra.Command, pk *ecdsa.PrivateKey, cnr cid.ID) []oid.ID {
+	fs := object.NewSearchFilters()
+
+	if path, _ := cmd.Flags().GetString(sgFiltersFileFlag); path != "" {
+		data, err := os.ReadFile(path)
+		common.ExitOnErr(cmd, "could not read search filters file: %w", err)
+
+		err = fs.UnmarshalJSON(data)
+		common.ExitOnErr(cmd, "could not unmarshal search filters: %w", err)
+	}
+
+	// only root objects, their parts are collected as members anyway
+	fs.AddRootFilter()
+
+	var prm internalclient.SearchObjectsPrm
+	sessionCli.Prepare(cmd, cnr, nil, pk, &prm)
+	objectCli.Prepare(cmd, &prm)
+	prm.SetContainerID(cnr)
+	prm.SetFilters(fs)
+
+	res, err := internalclient.SearchObjects(prm)
+	common.ExitOnErr(cmd, "search rpc error: %w", err)
+
+	ids := res.IDList()
+	if len(ids) == 0 {
+		common.ExitOnErr(cmd, "", errors.New("no objects found for storage group"))
+	}
+
+	common.PrintVerbose("Found %d storage group members", len(ids))
+
+	return ids
+}
+
+// headCache is a HeadReceiver returning prefetched headers.
+type headCache struct {
+	r objutil.HeadReceiver
+
+	mtx sync.Mutex
+	m   map[oid.Address]interface{}
+}
+
+// prefetchHeads requests headers of the members using at most concurrency
+// routines at once and returns the receiver using them. The first error
+// stops the requests and is returned.
+func prefetchHeads(r objutil.HeadReceiver, cnr cid.ID, members []oid.ID, concurrency int) (objutil.HeadReceiver, error) {
+	c := &headCache{
+		r: r,
+		m: make(map[oid.Address]interface{}, len(members)),
+	}
+
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(concurrency)
+
+	for i := range members {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(members[i])
+
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			hdr, err := r.Head(addr)
+			if err != nil {
+				return fmt.Errorf("could not get header of %s member: %w", addr.Object(), err)
+			}
+
+			c.mtx.Lock()
+			c.m[addr] = hdr
+			c.mtx.Unlock()
+
+			return nil
+		})
+	}
+
+	return c, g.Wait()
+}
+
+func (c *headCache) Head(addr oid.Address) (interface{}, error) {
+	c.mtx.Lock()
+	hdr, ok := c.m[addr]
+	c.mtx.Unlock()
+
+	if ok {
+		return hdr, nil
+	}
+
+	return c.r.Head(addr)
+}
+
+// sgHeadReceiver requests the headers with the prepared parameters,
+// so it is safe for concurrent use.
 type sgHeadReceiver struct {
-	cmd     *cobra.Command
-	key     *ecdsa.PrivateKey
-	ownerID *user.ID
-	prm     internalclient.HeadObjectPrm
+	prm internalclient.HeadObjectPrm
 }
 
 func (c sgHeadReceiver) Head(addr oid.Address) (interface{}, error) {
-	obj := addr.Object()
-
-	sessionCli.Prepare(c.cmd, addr.Container(), &obj, c.key, &c.prm)
 	c.prm.SetAddress(addr)
 
 	res, err := internalclient.HeadObject(c.prm)
@@ -0,0 +1,96 @@
+package storagegroup
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type testHeadReceiver struct {
+	mtx   sync.Mutex
+	calls map[oid.Address]int
+
+	running, maxRunning atomic.Int32
+
+	fail oid.ID
+}
+
+func (r *testHeadReceiver) Head(addr oid.Address) (interface{}, error) {
+	n := r.running.Inc()
+	defer r.running.Dec()
+
+	for {
+		m := r.maxRunning.Load()
+		if n <= m || r.maxRunning.CAS(m, n) {
+			break
+		}
+	}
+
+	r.mtx.Lock()
+	r.calls[addr]++
+	r.mtx.Unlock()
+
+	if addr.Object().Equals(r.fail) {
+		return nil, errors.New("test error")
+	}
+
+	return addr.Object(), nil
+}
+
+func TestPrefetchHeads(t *testing.T) {
+	const (
+		limit = 3
+		count = 20
+	)
+
+	cnr := cidtest.ID()
+	members := make([]oid.ID, count)
+	for i := range members {
+		members[i] = oidtest.ID()
+	}
+
+	t.Run("all members", func(t *testing.T) {
+		r := &testHeadReceiver{calls: make(map[oid.Address]int)}
+
+		heads, err := prefetchHeads(r, cnr, members, limit)
+		require.NoError(t, err)
+		require.LessOrEqual(t, r.maxRunning.Load(), int32(limit))
+		require.Len(t, r.calls, count)
+
+		for i := range members {
+			var addr oid.Address
+			addr.SetContainer(cnr)
+			addr.SetObject(members[i])
+
+			hdr, err := heads.Head(addr)
+			require.NoError(t, err)
+			require.Equal(t, members[i], hdr)
+			require.Equal(t, 1, r.calls[addr])
+		}
+
+		// the other headers are requested from the receiver
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(oidtest.ID())
+
+		_, err = heads.Head(addr)
+		require.NoError(t, err)
+		require.Equal(t, 1, r.calls[addr])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := &testHeadReceiver{
+			calls: make(map[oid.Address]int),
+			fail:  members[count/2],
+		}
+
+		_, err := prefetchHeads(r, cnr, members, limit)
+		require.ErrorContains(t, err, members[count/2].EncodeToString())
+	})
+}
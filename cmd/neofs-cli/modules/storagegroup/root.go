@@ -20,9 +20,12 @@ var Cmd = &cobra.Command{
 }
 
 const (
-	sgIDFlag  = "id"
-	sgRawFlag = "raw"
-	cidFlag   = "cid"
+	sgIDFlag          = "id"
+	sgRawFlag         = "raw"
+	cidFlag           = "cid"
+	sgConcurrencyFlag = "concurrency"
+
+	sgConcurrencyDefault = 10
 )
 
 func init() {
@@ -31,6 +34,7 @@ func init() {
 		sgGetCmd,
 		sgListCmd,
 		sgDelCmd,
+		sgVerifyCmd,
 	}
 
 	Cmd.AddCommand(storageGroupChildCommands...)
@@ -44,4 +48,5 @@ func init() {
 	initSGGetCmd()
 	initSGListCmd()
 	initSGDeleteCmd()
+	initSGVerifyCmd()
 }
@@ -1,6 +1,8 @@
 package storagegroup
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
@@ -42,3 +44,30 @@ func readSGID(cmd *cobra.Command, id *oid.ID) {
 	err := id.DecodeString(f.Value.String())
 	common.ExitOnErr(cmd, "decode storage group ID string: %w", err)
 }
+
+func readConcurrency(cmd *cobra.Command) int {
+	n, _ := cmd.Flags().GetUint(sgConcurrencyFlag)
+	if n == 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("%s flag must be positive", sgConcurrencyFlag))
+	}
+
+	return int(n)
+}
+
+func idsToStrings(ids []oid.ID) []string {
+	res := make([]string, len(ids))
+	for i := range ids {
+		res[i] = ids[i].EncodeToString()
+	}
+
+	return res
+}
+
+func printJSON(cmd *cobra.Command, v interface{}) {
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode result to JSON: %w", enc.Encode(v))
+
+	cmd.Print(buf.String())
+}
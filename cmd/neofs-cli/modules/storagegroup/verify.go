@@ -0,0 +1,265 @@
+package storagegroup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	objectCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/object"
+	sessionCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	storagegroupSDK "github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+var sgVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify storage group members",
+	Long: `Verify storage group members.
+Requests headers and payload homomorphic hashes of all the members
+and reports the missing ones and the ones which do not match the headers.
+Total size and hash of the members are compared with the storage group ones.`,
+	Run: verifySG,
+}
+
+func initSGVerifyCmd() {
+	commonflags.Init(sgVerifyCmd)
+
+	flags := sgVerifyCmd.Flags()
+
+	flags.String(cidFlag, "", "Container ID")
+	_ = sgVerifyCmd.MarkFlagRequired(cidFlag)
+
+	flags.StringVarP(&sgID, sgIDFlag, "", "", "storage group identifier")
+	_ = sgVerifyCmd.MarkFlagRequired(sgIDFlag)
+
+	flags.Uint(sgConcurrencyFlag, sgConcurrencyDefault, "Maximum number of concurrent member requests")
+	flags.Bool(commonflags.JSON, false, "Print the result in JSON format")
+}
+
+// memberStatus describes the verification result of the storage group member.
+type memberStatus struct {
+	size uint64
+	hash []byte
+
+	missing bool
+	// mismatch is set if the payload hash differs from the header one
+	mismatch bool
+	err      error
+}
+
+func verifySG(cmd *cobra.Command, _ []string) {
+	var (
+		cnr cid.ID
+		id  oid.ID
+	)
+
+	addr := readObjectAddress(cmd, &cnr, &id)
+	pk := key.GetOrGenerate(cmd)
+	concurrency := readConcurrency(cmd)
+
+	buf := bytes.NewBuffer(nil)
+
+	var getPrm internalclient.GetObjectPrm
+	sessionCli.Prepare(cmd, cnr, &id, pk, &getPrm)
+	objectCli.Prepare(cmd, &getPrm)
+	getPrm.SetAddress(addr)
+	getPrm.SetPayloadWriter(buf)
+
+	res, err := internalclient.GetObject(getPrm)
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	sgObj := res.Header()
+	sgObj.SetPayload(buf.Bytes())
+
+	var sg storagegroupSDK.StorageGroup
+
+	err = storagegroupSDK.ReadFromObject(&sg, *sgObj)
+	common.ExitOnErr(cmd, "could not read storage group from the obj: %w", err)
+
+	sgHash, withHash := sg.ValidationDataHash()
+	members := sg.Members()
+	statuses := make([]memberStatus, len(members))
+
+	var (
+		headPrm internalclient.HeadObjectPrm
+		hashPrm internalclient.HashPayloadRangesPrm
+	)
+
+	// the only session is used for all the member requests
+	sessionCli.Prepare(cmd, cnr, nil, pk, &headPrm, &hashPrm)
+	objectCli.Prepare(cmd, &headPrm, &hashPrm)
+
+	headPrm.SetRawFlag(true)
+
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+
+	for i := range members {
+		i := i
+		g.Go(func() error {
+			statuses[i] = verifyMember(headPrm, hashPrm, cnr, members[i], withHash)
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	var (
+		sumSize    uint64
+		hashes     = make([][]byte, 0, len(members))
+		missing    []string
+		mismatched []string
+		failed     = make(map[string]string)
+	)
+
+	for i := range statuses {
+		switch st := statuses[i]; {
+		case st.missing:
+			missing = append(missing, members[i].EncodeToString())
+		case st.err != nil:
+			failed[members[i].EncodeToString()] = st.err.Error()
+		default:
+			if st.mismatch {
+				mismatched = append(mismatched, members[i].EncodeToString())
+			}
+
+			sumSize += st.size
+			hashes = append(hashes, st.hash)
+		}
+	}
+
+	// size and hash can be compared only if all the members are received
+	complete := len(missing) == 0 && len(failed) == 0
+	sizeOK := complete && sumSize == sg.ValidationDataSize()
+	hashOK := complete
+
+	if complete && withHash {
+		sumHash, err := tz.Concat(hashes)
+		hashOK = err == nil && bytes.Equal(sumHash, sgHash.Value())
+	}
+
+	valid := sizeOK && hashOK && len(mismatched) == 0
+
+	if toJSON, _ := cmd.Flags().GetBool(commonflags.JSON); toJSON {
+		printJSON(cmd, map[string]interface{}{
+			"members":    len(members),
+			"missing":    nonNil(missing),
+			"mismatched": nonNil(mismatched),
+			"failed":     failed,
+			"size_ok":    sizeOK,
+			"hash_ok":    hashOK,
+			"valid":      valid,
+		})
+		return
+	}
+
+	cmd.Printf("Members: %d\n", len(members))
+	printIDs(cmd, "Missing members:", missing)
+	printIDs(cmd, "Members with mismatched payload hash:", mismatched)
+
+	if len(failed) > 0 {
+		cmd.Println("Failed members:")
+		for id, err := range failed {
+			cmd.Printf("\t%s: %s\n", id, err)
+		}
+	}
+
+	if complete {
+		cmd.Printf("Group size: %d, members size: %d\n", sg.ValidationDataSize(), sumSize)
+		if withHash {
+			cmd.Printf("Group hash matches: %t\n", hashOK)
+		}
+	}
+
+	if valid {
+		cmd.Println("Storage group is valid")
+	} else {
+		cmd.Println("Storage group is NOT valid")
+	}
+}
+
+// verifyMember requests the member header and, if withHash is set, the
+// homomorphic hash of its payload to compare it with the header one.
+// verifyMember checks the member using the prepared request parameters.
+// Errors are returned in the status, so it is safe for concurrent use.
+func verifyMember(headPrm internalclient.HeadObjectPrm, hashPrm internalclient.HashPayloadRangesPrm,
+	cnr cid.ID, id oid.ID, withHash bool) memberStatus {
+	var addr oid.Address
+
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	headPrm.SetAddress(addr)
+
+	res, err := internalclient.HeadObject(headPrm)
+	if err != nil {
+		return memberStatus{
+			missing: errors.As(err, new(apistatus.ObjectNotFound)),
+			err:     err,
+		}
+	}
+
+	hdr := res.Header()
+	st := memberStatus{size: hdr.PayloadSize()}
+
+	if !withHash {
+		return st
+	}
+
+	cs, ok := hdr.PayloadHomomorphicHash()
+	if !ok {
+		st.err = errors.New("missing homomorphic hash in the header")
+		return st
+	}
+
+	st.hash = cs.Value()
+
+	if st.size == 0 {
+		return st
+	}
+
+	rng := object.NewRange()
+	rng.SetLength(st.size)
+
+	hashPrm.SetAddress(addr)
+	hashPrm.SetRanges([]*object.Range{rng})
+	hashPrm.TZ()
+
+	hashRes, err := internalclient.HashPayloadRanges(hashPrm)
+	if err != nil {
+		st.err = fmt.Errorf("could not get payload hash: %w", err)
+		return st
+	}
+
+	st.mismatch = !bytes.Equal(hashRes.HashList()[0], st.hash)
+
+	return st
+}
+
+func printIDs(cmd *cobra.Command, title string, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	cmd.Println(title)
+	for i := range ids {
+		cmd.Printf("\t%s\n", ids[i])
+	}
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/fs"
 	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"sync"
 	atomicstd "sync/atomic"
 	"syscall"
@@ -31,6 +34,7 @@ import (
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
@@ -49,6 +53,7 @@ import (
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone"
 	tsourse "github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone/source"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	trustcontroller "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/controller"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
@@ -57,6 +62,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -86,9 +92,17 @@ type applicationConfiguration struct {
 	_read bool
 
 	EngineCfg struct {
-		errorThreshold uint32
-		shardPoolSize  uint32
-		shards         []shardCfg
+		errorThreshold   uint32
+		errorLogInterval time.Duration
+		shardPoolSize    uint32
+		readCacheBudget  uint64
+		panicRecovery    bool
+		readOnlyAll      bool
+		tombstonesBatch  int
+		compaction       engine.MetabaseCompaction
+		warmUp           engine.WarmUp
+		health           engine.HealthThresholds
+		shards           []shardCfg
 	}
 }
 
@@ -96,14 +110,23 @@ type shardCfg struct {
 	compress                  bool
 	smallSizeObjectLimit      uint64
 	uncompressableContentType []string
+	encryptionKeys            encryption.KeyProvider
+	placement                 shard.ContainerPlacement
 	refillMetabase            bool
 	mode                      shardmode.Mode
+	existenceFilterSize       uint64
+	inlineThreshold           uint64
 
 	metaCfg struct {
 		path          string
 		perm          fs.FileMode
 		maxBatchSize  int
 		maxBatchDelay time.Duration
+		maxTx         int
+		txQueue       int
+		gcPriority    meta.Priority
+		flushPriority meta.Priority
+		compactTxSize uint64
 	}
 
 	subStorages []subStorageCfg
@@ -111,6 +134,19 @@ type shardCfg struct {
 	gcCfg struct {
 		removerBatchSize     int
 		removerSleepInterval time.Duration
+		verifyGarbage        bool
+		expirationGrace      uint64
+		expiredObjectsLimit  uint32
+
+		orphanSampleSize    uint32
+		orphanConfirmations uint32
+		orphanMinAge        uint64
+		orphanRemoteChecks  uint32
+	}
+
+	readCacheCfg struct {
+		capacity      uint64
+		maxObjectSize uint64
 	}
 
 	writecacheCfg struct {
@@ -121,8 +157,16 @@ type shardCfg struct {
 		smallObjectSize  uint64
 		maxObjSize       uint64
 		flushWorkerCount int
+		flushInterval    time.Duration
+		slowFlush        time.Duration
+		bigFlushSize     uint64
+		bigFlushTime     time.Duration
+		drainTimeout     time.Duration
 		maxCacheSize     uint64
 		sizeLimit        uint64
+		repairOnInit     bool
+		validateObjects  bool
+		verifyPayload    bool
 	}
 
 	piloramaCfg struct {
@@ -142,10 +186,16 @@ type subStorageCfg struct {
 	perm  fs.FileMode
 	depth uint64
 
+	// objects with matching attributes are routed to the storage
+	attributes []blobstor.AttributeRule
+
 	// blobovnicza-specific
 	size            uint64
 	width           uint64
 	openedCacheSize int
+
+	geometryMigration     bool
+	allowGeometryMismatch bool
 }
 
 // readConfig fills applicationConfiguration with raw configuration values
@@ -173,6 +223,44 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 	a.EngineCfg.errorThreshold = engineconfig.ShardErrorThreshold(c)
 	a.EngineCfg.shardPoolSize = engineconfig.ShardPoolSize(c)
+	a.EngineCfg.errorLogInterval = engineconfig.ShardErrorLogInterval(c)
+	a.EngineCfg.readCacheBudget = engineconfig.ShardReadCacheBudget(c)
+	a.EngineCfg.panicRecovery = engineconfig.ShardPanicRecovery(c)
+	a.EngineCfg.readOnlyAll = engineconfig.ReadOnlyAll(c)
+	a.EngineCfg.tombstonesBatch = engineconfig.ExpiredTombstonesBatchSize(c)
+
+	a.EngineCfg.compaction.Interval = engineconfig.MetabaseCompactionInterval(c)
+	a.EngineCfg.compaction.FreeRatio = float64(engineconfig.MetabaseCompactionThreshold(c)) / 100
+
+	if window := engineconfig.MetabaseCompactionWindow(c); window != "" {
+		var err error
+
+		a.EngineCfg.compaction.Window, err = engine.ParseMaintenanceWindow(window)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.EngineCfg.warmUp = engine.WarmUp{
+		Enabled:    engineconfig.WarmUpEnabled(c),
+		Buckets:    engineconfig.WarmUpBuckets(c),
+		ByteLimit:  engineconfig.WarmUpByteLimit(c),
+		TimeLimit:  engineconfig.WarmUpTimeLimit(c),
+		WriteCache: engineconfig.WarmUpWriteCache(c),
+	}
+
+	a.EngineCfg.health = engine.HealthThresholds{
+		WriteCacheFill:    engineconfig.HealthWriteCacheFill(c),
+		WriteCacheStall:   engineconfig.HealthWriteCacheStall(c),
+		GCBacklog:         engineconfig.HealthGCBacklog(c),
+		ShardErrors:       engineconfig.HealthShardErrors(c),
+		FreeSpaceDegraded: engineconfig.HealthFreeSpaceDegraded(c),
+		FreeSpaceCritical: engineconfig.HealthFreeSpaceCritical(c),
+	}
+
+	if err := meta.CheckWarmUpBuckets(a.EngineCfg.warmUp.Buckets...); err != nil {
+		return fmt.Errorf("invalid warm-up buckets: %w", err)
+	}
 
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		var sh shardCfg
@@ -183,6 +271,22 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.uncompressableContentType = sc.UncompressableContentTypes()
 		sh.smallSizeObjectLimit = sc.SmallSizeLimit()
 
+		placement, err := containerPlacement(sc)
+		if err != nil {
+			return err
+		}
+
+		sh.placement = placement
+
+		if keyFiles := sc.EncryptionKeys(); len(keyFiles) > 0 {
+			keys, err := readEncryptionKeys(keyFiles)
+			if err != nil {
+				return fmt.Errorf("could not read encryption keys: %w", err)
+			}
+
+			sh.encryptionKeys = keys
+		}
+
 		// write-cache
 
 		writeCacheCfg := sc.WriteCache()
@@ -196,7 +300,15 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			wc.maxCacheSize = writeCacheCfg.MaxObjectSize()
 			wc.smallObjectSize = writeCacheCfg.SmallObjectSize()
 			wc.flushWorkerCount = writeCacheCfg.WorkersNumber()
+			wc.flushInterval = writeCacheCfg.FlushInterval()
+			wc.slowFlush = writeCacheCfg.SlowFlushThreshold()
+			wc.bigFlushSize = writeCacheCfg.BigFlushSize()
+			wc.bigFlushTime = writeCacheCfg.BigFlushTime()
+			wc.drainTimeout = writeCacheCfg.DrainTimeout()
 			wc.sizeLimit = writeCacheCfg.SizeLimit()
+			wc.repairOnInit = writeCacheCfg.RepairOnInit()
+			wc.validateObjects = writeCacheCfg.ValidateObjects()
+			wc.verifyPayload = writeCacheCfg.VerifyPayload()
 		}
 
 		// blobstor with substorages
@@ -225,6 +337,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			sCfg.typ = storagesCfg[i].Type()
 			sCfg.path = storagesCfg[i].Path()
 			sCfg.perm = storagesCfg[i].Perm()
+			sCfg.attributes = storagesCfg[i].Attributes()
 
 			switch storagesCfg[i].Type() {
 			case blobovniczatree.Type:
@@ -234,6 +347,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 				sCfg.depth = sub.ShallowDepth()
 				sCfg.width = sub.ShallowWidth()
 				sCfg.openedCacheSize = sub.OpenedCacheSize()
+				sCfg.geometryMigration = sub.GeometryMigration()
+				sCfg.allowGeometryMismatch = sub.AllowGeometryMismatch()
 			case fstree.Type:
 				sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
 				sCfg.depth = sub.Depth()
@@ -254,11 +369,33 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		m.perm = metabaseCfg.BoltDB().Perm()
 		m.maxBatchDelay = metabaseCfg.BoltDB().MaxBatchDelay()
 		m.maxBatchSize = metabaseCfg.BoltDB().MaxBatchSize()
+		m.maxTx = metabaseCfg.MaxTransactions()
+		m.txQueue = metabaseCfg.TransactionQueue()
+		m.gcPriority = metabaseCfg.GCPriority()
+		m.flushPriority = metabaseCfg.FlushPriority()
+		m.compactTxSize = metabaseCfg.CompactTxSize()
 
 		// GC
 
 		sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
 		sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
+		sh.gcCfg.verifyGarbage = gcCfg.VerifyGarbage()
+		sh.gcCfg.expirationGrace = gcCfg.ExpirationGracePeriod()
+		sh.gcCfg.expiredObjectsLimit = gcCfg.ExpiredObjectsLimit()
+		sh.gcCfg.orphanSampleSize = gcCfg.OrphanSampleSize()
+		sh.gcCfg.orphanConfirmations = gcCfg.OrphanConfirmations()
+		sh.gcCfg.orphanMinAge = gcCfg.OrphanMinAge()
+		sh.gcCfg.orphanRemoteChecks = gcCfg.OrphanRemoteChecks()
+
+		// read cache
+
+		readCacheCfg := sc.ReadCache()
+
+		sh.readCacheCfg.capacity = readCacheCfg.Capacity()
+		sh.readCacheCfg.maxObjectSize = readCacheCfg.MaxObjectSize()
+
+		sh.existenceFilterSize = sc.ExistenceFilterSize()
+		sh.inlineThreshold = sc.InlineThreshold()
 
 		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
 
@@ -266,6 +403,68 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 	})
 }
 
+// readEncryptionKeys reads hex-encoded encryption keys from the files. Key
+// IDs are the file names, the first key is used to encrypt new objects.
+func readEncryptionKeys(files []string) (encryption.KeyProvider, error) {
+	keys := make(map[string][]byte, len(files))
+
+	for i := range files {
+		data, err := os.ReadFile(files[i])
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in %s: %w", files[i], err)
+		}
+
+		id := filepath.Base(files[i])
+		if _, ok := keys[id]; ok {
+			return nil, fmt.Errorf("duplicated key ID %s", id)
+		}
+
+		keys[id] = key
+	}
+
+	return encryption.NewStaticKeys(filepath.Base(files[0]), keys)
+}
+
+// containerPlacement reads container placement restrictions of the shard.
+func containerPlacement(sc *shardconfig.Config) (shard.ContainerPlacement, error) {
+	var (
+		res shard.ContainerPlacement
+		err error
+	)
+
+	res.Allow, err = parseContainerIDs(sc.AllowedContainers())
+	if err != nil {
+		return res, fmt.Errorf("invalid allowed containers: %w", err)
+	}
+
+	res.Deny, err = parseContainerIDs(sc.DeniedContainers())
+	if err != nil {
+		return res, fmt.Errorf("invalid denied containers: %w", err)
+	}
+
+	return res, nil
+}
+
+func parseContainerIDs(ss []string) ([]cid.ID, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+
+	res := make([]cid.ID, len(ss))
+	for i := range ss {
+		if err := res[i].DecodeString(ss[i]); err != nil {
+			return nil, fmt.Errorf("invalid container ID %s: %w", ss[i], err)
+		}
+	}
+
+	return res, nil
+}
+
 // internals contains application-specific internals that are created
 // on application startup and are shared b/w the components during
 // the application life cycle.
@@ -330,6 +529,8 @@ type shared struct {
 
 	replicator *replicator.Replicator
 
+	policer *policer.Policer
+
 	treeService *tree.Service
 
 	metricsCollector *metrics.NodeMetrics
@@ -432,6 +633,10 @@ type cfgObject struct {
 
 	eaclSource container.EACLSource
 
+	// removalSource checks the removal of the objects in the network,
+	// it is used by the shard GC to collect orphaned child objects.
+	removalSource shard.RemovalSource
+
 	pool cfgObjectRoutines
 
 	cfgLocalStorage cfgLocalStorage
@@ -586,6 +791,14 @@ func (c *cfg) engineOpts() []engine.Option {
 	opts = append(opts,
 		engine.WithShardPoolSize(c.EngineCfg.shardPoolSize),
 		engine.WithErrorThreshold(c.EngineCfg.errorThreshold),
+		engine.WithErrorLogInterval(c.EngineCfg.errorLogInterval),
+		engine.WithShardReadCacheBudget(c.EngineCfg.readCacheBudget),
+		engine.WithMetabaseCompaction(c.EngineCfg.compaction),
+		engine.WithShardPanicRecovery(c.EngineCfg.panicRecovery),
+		engine.WithReadOnlyAll(c.EngineCfg.readOnlyAll),
+		engine.WithExpiredTombstonesBatchSize(c.EngineCfg.tombstonesBatch),
+		engine.WithWarmUp(c.EngineCfg.warmUp),
+		engine.WithHealthThresholds(c.EngineCfg.health),
 
 		engine.WithLogger(c.log),
 	)
@@ -598,8 +811,9 @@ func (c *cfg) engineOpts() []engine.Option {
 }
 
 type shardOptsWithMetaPath struct {
-	metaPath string
-	shOpts   []shard.Option
+	metaPath  string
+	placement shard.ContainerPlacement
+	shOpts    []shard.Option
 }
 
 func (c *cfg) shardOpts() []shardOptsWithMetaPath {
@@ -615,7 +829,14 @@ func (c *cfg) shardOpts() []shardOptsWithMetaPath {
 				writecache.WithMaxObjectSize(wcRead.maxObjSize),
 				writecache.WithSmallObjectSize(wcRead.smallObjectSize),
 				writecache.WithFlushWorkersCount(wcRead.flushWorkerCount),
+				writecache.WithFlushInterval(wcRead.flushInterval),
+				writecache.WithSlowFlushThreshold(wcRead.slowFlush),
+				writecache.WithBigObjectFlushBudget(wcRead.bigFlushSize, wcRead.bigFlushTime),
 				writecache.WithMaxCacheSize(wcRead.sizeLimit),
+				writecache.WithRepairOnInit(wcRead.repairOnInit),
+				writecache.WithPutValidation(wcRead.validateObjects),
+				writecache.WithPayloadValidation(wcRead.verifyPayload),
+				writecache.WithErrorLogInterval(c.EngineCfg.errorLogInterval),
 
 				writecache.WithLogger(c.log),
 			)
@@ -644,11 +865,14 @@ func (c *cfg) shardOpts() []shardOptsWithMetaPath {
 						blobovniczatree.WithBlobovniczaShallowDepth(sRead.depth),
 						blobovniczatree.WithBlobovniczaShallowWidth(sRead.width),
 						blobovniczatree.WithOpenedCacheSize(sRead.openedCacheSize),
+						blobovniczatree.WithGeometryMigration(sRead.geometryMigration),
+						blobovniczatree.WithGeometryMismatchAllowed(sRead.allowGeometryMismatch),
 
 						blobovniczatree.WithLogger(c.log)),
 					Policy: func(_ *objectSDK.Object, data []byte) bool {
 						return uint64(len(data)) < shCfg.smallSizeObjectLimit
 					},
+					Attributes: sRead.attributes,
 				})
 			case fstree.Type:
 				ss = append(ss, blobstor.SubStorage{
@@ -659,6 +883,7 @@ func (c *cfg) shardOpts() []shardOptsWithMetaPath {
 					Policy: func(_ *objectSDK.Object, data []byte) bool {
 						return true
 					},
+					Attributes: sRead.attributes,
 				})
 			default:
 				// should never happen, that has already
@@ -668,13 +893,16 @@ func (c *cfg) shardOpts() []shardOptsWithMetaPath {
 
 		var sh shardOptsWithMetaPath
 		sh.metaPath = shCfg.metaCfg.path
+		sh.placement = shCfg.placement
 		sh.shOpts = []shard.Option{
 			shard.WithLogger(c.log),
+			shard.WithContainerPlacement(shCfg.placement),
 			shard.WithRefillMetabase(shCfg.refillMetabase),
 			shard.WithMode(shCfg.mode),
 			shard.WithBlobStorOptions(
 				blobstor.WithCompressObjects(shCfg.compress),
 				blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
+				blobstor.WithEncryption(shCfg.encryptionKeys),
 				blobstor.WithStorages(ss),
 
 				blobstor.WithLogger(c.log),
@@ -684,18 +912,32 @@ func (c *cfg) shardOpts() []shardOptsWithMetaPath {
 				meta.WithPermissions(shCfg.metaCfg.perm),
 				meta.WithMaxBatchSize(shCfg.metaCfg.maxBatchSize),
 				meta.WithMaxBatchDelay(shCfg.metaCfg.maxBatchDelay),
+				meta.WithTxLimit(shCfg.metaCfg.maxTx, shCfg.metaCfg.txQueue),
+				meta.WithCompactTxMaxSize(int64(shCfg.metaCfg.compactTxSize)),
 				meta.WithBoltDBOptions(&bbolt.Options{
 					Timeout: 100 * time.Millisecond,
 				}),
 
 				meta.WithLogger(c.log),
 				meta.WithEpochState(c.cfgNetmap.state),
+				meta.WithExpirationGracePeriod(shCfg.gcCfg.expirationGrace),
 			),
 			shard.WithPiloramaOptions(piloramaOpts...),
 			shard.WithWriteCache(shCfg.writecacheCfg.enabled),
 			shard.WithWriteCacheOptions(writeCacheOpts...),
+			shard.WithWriteCacheDrainTimeout(shCfg.writecacheCfg.drainTimeout),
 			shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
 			shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
+			shard.WithGCVerification(shCfg.gcCfg.verifyGarbage),
+			shard.WithExpiredObjectsLimit(shCfg.gcCfg.expiredObjectsLimit),
+			shard.WithOrphanCollection(shCfg.gcCfg.orphanSampleSize),
+			shard.WithOrphanConfirmations(shCfg.gcCfg.orphanConfirmations),
+			shard.WithOrphanMinAge(shCfg.gcCfg.orphanMinAge),
+			shard.WithOrphanRemovalSource(c.cfgObject.removalSource, shCfg.gcCfg.orphanRemoteChecks),
+			shard.WithReadCache(shCfg.readCacheCfg.capacity, shCfg.readCacheCfg.maxObjectSize),
+			shard.WithExistenceFilter(shCfg.existenceFilterSize),
+			shard.WithInlineThreshold(shCfg.inlineThreshold),
+			shard.WithMetabasePriorities(shCfg.metaCfg.gcPriority, shCfg.metaCfg.flushPriority),
 			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
 				pool, err := ants.NewPool(sz)
 				fatalOnErr(err)
@@ -728,6 +970,7 @@ func initLocalStorage(c *cfg) {
 	var tssPrm tsourse.TombstoneSourcePrm
 	tssPrm.SetGetService(c.cfgObject.getSvc)
 	tombstoneSrc := tsourse.NewSource(tssPrm)
+	c.cfgObject.removalSource = tombstoneSrc
 
 	tombstoneSource := tombstone.NewChecker(
 		tombstone.WithLogger(c.log),
@@ -745,6 +988,9 @@ func initLocalStorage(c *cfg) {
 
 	c.cfgObject.cfgLocalStorage.localStorage = ls
 
+	c.workers = append(c.workers, newWorkerFromFunc(ls.RunMetabaseCompaction))
+	c.workers = append(c.workers, newWorkerFromFunc(ls.RunWarmUp))
+
 	c.onShutdown(func() {
 		c.log.Info("closing components of the storage engine...")
 
@@ -803,7 +1049,14 @@ func (c *cfg) bootstrap() error {
 	prm := nmClient.AddPeerPrm{}
 	prm.SetNodeInfo(ni)
 
-	return c.cfgNetmap.wrapper.AddPeer(prm)
+	err := c.cfgNetmap.wrapper.AddPeer(prm)
+	if err != nil {
+		return err
+	}
+
+	c.cfgNetmap.state.setLastBootstrap(ni)
+
+	return nil
 }
 
 // needBootstrap checks if local node should be registered in network on bootup.
@@ -836,6 +1089,7 @@ func (c *cfg) configWatcher(ctx context.Context) {
 			var rcfg engine.ReConfiguration
 			for _, optsWithMeta := range c.shardOpts() {
 				rcfg.AddShard(optsWithMeta.metaPath, optsWithMeta.shOpts)
+				rcfg.SetShardContainerPlacement(optsWithMeta.metaPath, optsWithMeta.placement)
 			}
 
 			err = c.cfgObject.cfgLocalStorage.localStorage.Reload(rcfg)
@@ -60,6 +60,12 @@ func New(_ Prm, opts ...Option) *Config {
 	}
 }
 
+// FilePath returns the path to the configuration file provided to the New,
+// empty if the configuration is read from the environment only.
+func (x *Config) FilePath() string {
+	return x.opts.path
+}
+
 // Reload reads configuration path if any was provided
 // to the New. Returns any
 func (x *Config) Reload() error {
@@ -3,6 +3,7 @@ package engineconfig
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
@@ -11,9 +12,47 @@ import (
 const (
 	subsection = "storage"
 
+	compactionSubsection = "metabase_compaction"
+
+	warmUpSubsection = "warm_up"
+
+	healthSubsection = "health"
+
 	// ShardPoolSizeDefault is a default value of routine pool size per-shard to
 	// process object PUT operations in a storage engine.
 	ShardPoolSizeDefault = 20
+
+	// ShardErrorLogIntervalDefault is a default interval during which repeated
+	// shard errors are aggregated in a single log message.
+	ShardErrorLogIntervalDefault = time.Minute
+
+	// MetabaseCompactionThresholdDefault is a default percentage of the free pages
+	// of the metabase file to be compacted.
+	MetabaseCompactionThresholdDefault = 50
+
+	// HealthWriteCacheFillDefault is a default write-cache fill percentage
+	// starting from which the shard health is degraded.
+	HealthWriteCacheFillDefault = 90
+
+	// HealthWriteCacheStallDefault is a default time the non-empty write-cache
+	// can go without flushes before the shard health is degraded.
+	HealthWriteCacheStallDefault = 5 * time.Minute
+
+	// HealthGCBacklogDefault is a default number of the objects waiting for
+	// the GC starting from which the shard health is degraded.
+	HealthGCBacklogDefault = 100_000
+
+	// HealthShardErrorsDefault is a default number of the shard errors
+	// starting from which the shard health is degraded.
+	HealthShardErrorsDefault = 10
+
+	// HealthFreeSpaceDegradedDefault is a default amount of the free disk
+	// space below which the shard health is degraded.
+	HealthFreeSpaceDegradedDefault = 1 << 30
+
+	// HealthFreeSpaceCriticalDefault is a default amount of the free disk
+	// space below which the shard health is critical.
+	HealthFreeSpaceCriticalDefault = 64 << 20
 )
 
 // ErrNoShardConfigured is returned when at least 1 shard is required but none are found.
@@ -76,3 +115,190 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// ShardReadCacheBudget returns the value of "shard_read_cache_budget" config parameter from "storage" section.
+//
+// Returns 0 if the value is missing, so the read caches are limited per shard only.
+func ShardReadCacheBudget(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection), "shard_read_cache_budget")
+}
+
+// ShardPanicRecovery returns the value of "shard_panic_recovery" config parameter from "storage" section.
+//
+// Returns false if the value is missing.
+func ShardPanicRecovery(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "shard_panic_recovery")
+}
+
+// ReadOnlyAll returns the value of "read_only_all" config parameter from "storage" section.
+//
+// Returns false if the value is missing.
+func ReadOnlyAll(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "read_only_all")
+}
+
+// ExpiredTombstonesBatchSize returns the value of "expired_tombstones_batch_size" config parameter from "storage" section.
+//
+// Returns 0 if the value is missing, so the engine default is used.
+func ExpiredTombstonesBatchSize(c *config.Config) int {
+	return int(config.IntSafe(c.Sub(subsection), "expired_tombstones_batch_size"))
+}
+
+// ShardErrorLogInterval returns the value of "shard_error_log_interval" config parameter from "storage" section.
+//
+// Returns ShardErrorLogIntervalDefault if the value is missing or not a positive duration.
+func ShardErrorLogInterval(c *config.Config) time.Duration {
+	v := config.DurationSafe(c.Sub(subsection), "shard_error_log_interval")
+	if v > 0 {
+		return v
+	}
+
+	return ShardErrorLogIntervalDefault
+}
+
+// MetabaseCompactionInterval returns the value of "interval" config parameter
+// from "storage.metabase_compaction" section.
+//
+// Returns 0 if the value is missing, so the compaction is disabled.
+func MetabaseCompactionInterval(c *config.Config) time.Duration {
+	return config.DurationSafe(c.Sub(subsection).Sub(compactionSubsection), "interval")
+}
+
+// MetabaseCompactionThreshold returns the value of "threshold" config parameter
+// from "storage.metabase_compaction" section.
+//
+// Returns MetabaseCompactionThresholdDefault if the value is missing or not a positive number.
+func MetabaseCompactionThreshold(c *config.Config) uint32 {
+	v := config.Uint32Safe(c.Sub(subsection).Sub(compactionSubsection), "threshold")
+	if v > 0 {
+		return v
+	}
+
+	return MetabaseCompactionThresholdDefault
+}
+
+// MetabaseCompactionWindow returns the value of "window" config parameter
+// from "storage.metabase_compaction" section.
+//
+// Returns empty string if the value is missing, so the writable shards are not compacted.
+func MetabaseCompactionWindow(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection).Sub(compactionSubsection), "window")
+}
+
+// WarmUpEnabled returns the value of "enabled" config parameter
+// from "storage.warm_up" section.
+//
+// Returns false if the value is missing, so the warm-up is disabled.
+func WarmUpEnabled(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection).Sub(warmUpSubsection), "enabled")
+}
+
+// WarmUpBuckets returns the value of "buckets" config parameter
+// from "storage.warm_up" section.
+//
+// Returns nil if the value is missing, so the default metabase buckets are read.
+func WarmUpBuckets(c *config.Config) []string {
+	return config.StringSliceSafe(c.Sub(subsection).Sub(warmUpSubsection), "buckets")
+}
+
+// WarmUpByteLimit returns the value of "byte_limit" config parameter
+// from "storage.warm_up" section.
+//
+// Returns 0 if the value is missing, so the number of bytes read is not limited.
+func WarmUpByteLimit(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection).Sub(warmUpSubsection), "byte_limit")
+}
+
+// WarmUpTimeLimit returns the value of "time_limit" config parameter
+// from "storage.warm_up" section.
+//
+// Returns 0 if the value is missing, so the warm-up duration is not limited.
+func WarmUpTimeLimit(c *config.Config) time.Duration {
+	return config.DurationSafe(c.Sub(subsection).Sub(warmUpSubsection), "time_limit")
+}
+
+// WarmUpWriteCache returns the value of "write_cache" config parameter
+// from "storage.warm_up" section.
+//
+// Returns false if the value is missing.
+func WarmUpWriteCache(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection).Sub(warmUpSubsection), "write_cache")
+}
+
+// HealthWriteCacheFill returns the value of "write_cache_fill" config parameter
+// from "storage.health" section.
+//
+// Returns HealthWriteCacheFillDefault if the value is missing. Zero value disables the check.
+func HealthWriteCacheFill(c *config.Config) uint64 {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("write_cache_fill") == nil {
+		return HealthWriteCacheFillDefault
+	}
+
+	return config.UintSafe(sub, "write_cache_fill")
+}
+
+// HealthWriteCacheStall returns the value of "write_cache_stall" config parameter
+// from "storage.health" section.
+//
+// Returns HealthWriteCacheStallDefault if the value is missing. Zero value disables the check.
+func HealthWriteCacheStall(c *config.Config) time.Duration {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("write_cache_stall") == nil {
+		return HealthWriteCacheStallDefault
+	}
+
+	return config.DurationSafe(sub, "write_cache_stall")
+}
+
+// HealthGCBacklog returns the value of "gc_backlog" config parameter
+// from "storage.health" section.
+//
+// Returns HealthGCBacklogDefault if the value is missing. Zero value disables the check.
+func HealthGCBacklog(c *config.Config) uint64 {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("gc_backlog") == nil {
+		return HealthGCBacklogDefault
+	}
+
+	return config.UintSafe(sub, "gc_backlog")
+}
+
+// HealthShardErrors returns the value of "shard_errors" config parameter
+// from "storage.health" section.
+//
+// Returns HealthShardErrorsDefault if the value is missing. Zero value disables the check.
+func HealthShardErrors(c *config.Config) uint32 {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("shard_errors") == nil {
+		return HealthShardErrorsDefault
+	}
+
+	return config.Uint32Safe(sub, "shard_errors")
+}
+
+// HealthFreeSpaceDegraded returns the value of "free_space_degraded" config parameter
+// from "storage.health" section.
+//
+// Returns HealthFreeSpaceDegradedDefault if the value is missing. Zero value disables the check.
+func HealthFreeSpaceDegraded(c *config.Config) uint64 {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("free_space_degraded") == nil {
+		return HealthFreeSpaceDegradedDefault
+	}
+
+	return config.SizeInBytesSafe(sub, "free_space_degraded")
+}
+
+// HealthFreeSpaceCritical returns the value of "free_space_critical" config parameter
+// from "storage.health" section.
+//
+// Returns HealthFreeSpaceCriticalDefault if the value is missing. Zero value disables the check.
+func HealthFreeSpaceCritical(c *config.Config) uint64 {
+	sub := c.Sub(subsection).Sub(healthSubsection)
+	if sub.Value("free_space_critical") == nil {
+		return HealthFreeSpaceCriticalDefault
+	}
+
+	return config.SizeInBytesSafe(sub, "free_space_critical")
+}
@@ -10,8 +10,14 @@ import (
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
 	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
+	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
+	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
+	readcacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/readcache"
+	writecacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/writecache"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	metabase "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +42,25 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.Equal(t, engineconfig.ShardErrorLogIntervalDefault, engineconfig.ShardErrorLogInterval(empty))
+		require.Zero(t, engineconfig.ShardReadCacheBudget(empty))
+		require.False(t, engineconfig.ShardPanicRecovery(empty))
+		require.False(t, engineconfig.ReadOnlyAll(empty))
+		require.Zero(t, engineconfig.ExpiredTombstonesBatchSize(empty))
+		require.Zero(t, engineconfig.MetabaseCompactionInterval(empty))
+		require.EqualValues(t, engineconfig.MetabaseCompactionThresholdDefault, engineconfig.MetabaseCompactionThreshold(empty))
+		require.Empty(t, engineconfig.MetabaseCompactionWindow(empty))
+		require.False(t, engineconfig.WarmUpEnabled(empty))
+		require.Empty(t, engineconfig.WarmUpBuckets(empty))
+		require.Zero(t, engineconfig.WarmUpByteLimit(empty))
+		require.Zero(t, engineconfig.WarmUpTimeLimit(empty))
+		require.False(t, engineconfig.WarmUpWriteCache(empty))
+		require.EqualValues(t, engineconfig.HealthWriteCacheFillDefault, engineconfig.HealthWriteCacheFill(empty))
+		require.Equal(t, engineconfig.HealthWriteCacheStallDefault, engineconfig.HealthWriteCacheStall(empty))
+		require.EqualValues(t, engineconfig.HealthGCBacklogDefault, engineconfig.HealthGCBacklog(empty))
+		require.EqualValues(t, engineconfig.HealthShardErrorsDefault, engineconfig.HealthShardErrors(empty))
+		require.EqualValues(t, engineconfig.HealthFreeSpaceDegradedDefault, engineconfig.HealthFreeSpaceDegraded(empty))
+		require.EqualValues(t, engineconfig.HealthFreeSpaceCriticalDefault, engineconfig.HealthFreeSpaceCritical(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
 	})
 
@@ -46,6 +71,25 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.Equal(t, 30*time.Second, engineconfig.ShardErrorLogInterval(c))
+		require.EqualValues(t, 48*1024*1024, engineconfig.ShardReadCacheBudget(c))
+		require.True(t, engineconfig.ShardPanicRecovery(c))
+		require.False(t, engineconfig.ReadOnlyAll(c))
+		require.Equal(t, 500, engineconfig.ExpiredTombstonesBatchSize(c))
+		require.Equal(t, time.Hour, engineconfig.MetabaseCompactionInterval(c))
+		require.EqualValues(t, 60, engineconfig.MetabaseCompactionThreshold(c))
+		require.Equal(t, "02:00-04:00", engineconfig.MetabaseCompactionWindow(c))
+		require.True(t, engineconfig.WarmUpEnabled(c))
+		require.Equal(t, []string{"primary", "graveyard", "locked"}, engineconfig.WarmUpBuckets(c))
+		require.EqualValues(t, 512*1024*1024, engineconfig.WarmUpByteLimit(c))
+		require.Equal(t, time.Minute, engineconfig.WarmUpTimeLimit(c))
+		require.True(t, engineconfig.WarmUpWriteCache(c))
+		require.EqualValues(t, 80, engineconfig.HealthWriteCacheFill(c))
+		require.Equal(t, 10*time.Minute, engineconfig.HealthWriteCacheStall(c))
+		require.EqualValues(t, 0, engineconfig.HealthGCBacklog(c))
+		require.EqualValues(t, 5, engineconfig.HealthShardErrors(c))
+		require.EqualValues(t, 2*1024*1024*1024, engineconfig.HealthFreeSpaceDegraded(c))
+		require.EqualValues(t, 128*1024*1024, engineconfig.HealthFreeSpaceCritical(c))
 
 		err := engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) error {
 			defer func() {
@@ -58,6 +102,7 @@ func TestEngineSection(t *testing.T) {
 			ss := blob.Storages()
 			pl := sc.Pilorama()
 			gc := sc.GC()
+			rc := sc.ReadCache()
 
 			switch num {
 			case 0:
@@ -74,14 +119,28 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 3221225472, wc.SizeLimit())
+				require.Equal(t, writecacheconfig.FlushIntervalDefault, wc.FlushInterval())
+				require.Equal(t, writecacheconfig.SlowFlushThresholdDefault, wc.SlowFlushThreshold())
+				require.Zero(t, wc.BigFlushSize())
+				require.Equal(t, writecacheconfig.BigFlushTimeDefault, wc.BigFlushTime())
+				require.Zero(t, wc.DrainTimeout())
+				require.False(t, wc.RepairOnInit())
+				require.False(t, wc.ValidateObjects())
+				require.False(t, wc.VerifyPayload())
 
 				require.Equal(t, "tmp/0/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
 				require.Equal(t, 100, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 10*time.Millisecond, meta.BoltDB().MaxBatchDelay())
+				require.Zero(t, meta.MaxTransactions())
+				require.Equal(t, metabaseconfig.TransactionQueueDefault, meta.TransactionQueue())
+				require.Equal(t, metabase.PriorityLow, meta.GCPriority())
+				require.Equal(t, metabase.PriorityLow, meta.FlushPriority())
+				require.EqualValues(t, metabaseconfig.CompactTxSizeDefault, meta.CompactTxSize())
 
 				require.Equal(t, true, sc.Compress())
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, []string{"/etc/neofs/keys/shard0_new", "/etc/neofs/keys/shard0_old"}, sc.EncryptionKeys())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -92,6 +151,8 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.True(t, blz.GeometryMigration())
+				require.False(t, blz.AllowGeometryMismatch())
 
 				require.Equal(t, "tmp/0/blob", ss[1].Path())
 				require.EqualValues(t, 0644, ss[1].Perm())
@@ -99,9 +160,23 @@ func TestEngineSection(t *testing.T) {
 
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
+				require.True(t, gc.VerifyGarbage())
+				require.EqualValues(t, 2, gc.ExpirationGracePeriod())
+				require.EqualValues(t, 100000, gc.ExpiredObjectsLimit())
+				require.EqualValues(t, 1000, gc.OrphanSampleSize())
+				require.EqualValues(t, 5, gc.OrphanConfirmations())
+				require.EqualValues(t, 20, gc.OrphanMinAge())
+				require.EqualValues(t, 100, gc.OrphanRemoteChecks())
+
+				require.EqualValues(t, 32<<20, rc.Capacity())
+				require.EqualValues(t, 16<<10, rc.MaxObjectSize())
 
 				require.Equal(t, false, sc.RefillMetabase())
+				require.EqualValues(t, 1<<20, sc.ExistenceFilterSize())
+				require.Zero(t, sc.InlineThreshold())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
+				require.Equal(t, []string{"7vFjgcXL3Tsgctb7T1DH4YNzvFkrUPFskZB223uXimTU"}, sc.AllowedContainers())
+				require.Equal(t, []string(nil), sc.DeniedContainers())
 			case 1:
 				require.Equal(t, "tmp/1/blob/pilorama.db", pl.Path())
 				require.Equal(t, fs.FileMode(0644), pl.Perm())
@@ -116,17 +191,31 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 4294967296, wc.SizeLimit())
+				require.Equal(t, 5*time.Second, wc.FlushInterval())
+				require.Equal(t, 2*time.Second, wc.SlowFlushThreshold())
+				require.EqualValues(t, 256*1024*1024, wc.BigFlushSize())
+				require.Equal(t, 10*time.Second, wc.BigFlushTime())
+				require.Equal(t, 30*time.Second, wc.DrainTimeout())
+				require.True(t, wc.RepairOnInit())
+				require.True(t, wc.ValidateObjects())
+				require.False(t, wc.VerifyPayload())
 
 				require.Equal(t, "tmp/1/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
 				require.Equal(t, 200, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 20*time.Millisecond, meta.BoltDB().MaxBatchDelay())
+				require.Equal(t, 4, meta.MaxTransactions())
+				require.Equal(t, 512, meta.TransactionQueue())
+				require.Equal(t, metabase.PriorityLow, meta.GCPriority())
+				require.Equal(t, metabase.PriorityHigh, meta.FlushPriority())
+				require.EqualValues(t, 16*1024*1024, meta.CompactTxSize())
 
 				require.Equal(t, false, sc.Compress())
 				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, []string(nil), sc.EncryptionKeys())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
-				require.Equal(t, 2, len(ss))
+				require.Equal(t, 3, len(ss))
 
 				blz := blobovniczaconfig.From((*config.Config)(ss[0]))
 				require.Equal(t, "tmp/1/blob/blobovnicza", ss[0].Path())
@@ -134,16 +223,39 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.False(t, blz.GeometryMigration())
+				require.False(t, blz.AllowGeometryMismatch())
 
 				require.Equal(t, "tmp/1/blob", ss[1].Path())
 				require.EqualValues(t, 0644, ss[1].Perm())
 				require.EqualValues(t, 5, fstreeconfig.From((*config.Config)(ss[1])).Depth())
+				require.Nil(t, ss[1].Attributes())
+
+				require.Equal(t, "tmp/1/cold", ss[2].Path())
+				require.Equal(t, []blobstor.AttributeRule{
+					{Key: "Tier", Value: "cold"},
+					{Key: "Tier", Value: "archive*"},
+				}, ss[2].Attributes())
 
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
+				require.False(t, gc.VerifyGarbage())
+				require.Zero(t, gc.ExpirationGracePeriod())
+				require.Zero(t, gc.ExpiredObjectsLimit())
+				require.Zero(t, gc.OrphanSampleSize())
+				require.EqualValues(t, gcconfig.OrphanConfirmationsDefault, gc.OrphanConfirmations())
+				require.EqualValues(t, gcconfig.OrphanMinAgeDefault, gc.OrphanMinAge())
+				require.Zero(t, gc.OrphanRemoteChecks())
+
+				require.Zero(t, rc.Capacity())
+				require.EqualValues(t, readcacheconfig.MaxObjectSizeDefault, rc.MaxObjectSize())
 
 				require.Equal(t, true, sc.RefillMetabase())
+				require.Zero(t, sc.ExistenceFilterSize())
+				require.EqualValues(t, 1<<10, sc.InlineThreshold())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
+				require.Equal(t, []string(nil), sc.AllowedContainers())
+				require.Equal(t, []string{"7vFjgcXL3Tsgctb7T1DH4YNzvFkrUPFskZB223uXimTU"}, sc.DeniedContainers())
 			}
 			return nil
 		})
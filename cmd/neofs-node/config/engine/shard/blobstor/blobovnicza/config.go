@@ -99,6 +99,26 @@ func (x *Config) OpenedCacheSize() int {
 	return OpenedCacheSizeDefault
 }
 
+// GeometryMigration returns the value of "geometry_migration" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) GeometryMigration() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"geometry_migration",
+	)
+}
+
+// AllowGeometryMismatch returns the value of "allow_geometry_mismatch" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) AllowGeometryMismatch() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"allow_geometry_mismatch",
+	)
+}
+
 // BoltDB returns config instance for querying bolt db specific parameters.
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
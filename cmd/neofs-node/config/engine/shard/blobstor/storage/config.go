@@ -1,9 +1,13 @@
 package storage
 
 import (
+	"fmt"
 	"io/fs"
+	"path"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 )
 
 type Config config.Config
@@ -53,3 +57,35 @@ func (x *Config) Perm() fs.FileMode {
 
 	return fs.FileMode(p)
 }
+
+// Attributes returns the value of "attributes" config parameter. Each value
+// is a "key=pattern" pair, the pattern is in path.Match syntax.
+//
+// Returns nil if the value is missing, i.e. any object is accepted.
+// Panics if any value is not a "key=pattern" pair with a valid pattern.
+func (x *Config) Attributes() []blobstor.AttributeRule {
+	vs := config.StringSliceSafe(
+		(*config.Config)(x),
+		"attributes",
+	)
+
+	rules := make([]blobstor.AttributeRule, 0, len(vs))
+	for _, v := range vs {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			panic(fmt.Sprintf("invalid substorage attribute %q, must be key=pattern", v))
+		}
+
+		if _, err := path.Match(kv[1], ""); err != nil {
+			panic(fmt.Sprintf("invalid substorage attribute pattern %q: %v", kv[1], err))
+		}
+
+		rules = append(rules, blobstor.AttributeRule{Key: kv[0], Value: kv[1]})
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return rules
+}
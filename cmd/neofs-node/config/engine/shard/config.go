@@ -8,6 +8,7 @@ import (
 	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
 	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
+	readcacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/readcache"
 	writecacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 )
@@ -43,6 +44,33 @@ func (x *Config) UncompressableContentTypes() []string {
 		"compression_exclude_content_types")
 }
 
+// EncryptionKeys returns the value of "encryption_keys" config parameter.
+//
+// Returns nil if the value is not a valid string slice.
+func (x *Config) EncryptionKeys() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"encryption_keys")
+}
+
+// AllowedContainers returns the value of "allowed_containers" config parameter.
+//
+// Returns nil if the value is not a valid string slice.
+func (x *Config) AllowedContainers() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"allowed_containers")
+}
+
+// DeniedContainers returns the value of "denied_containers" config parameter.
+//
+// Returns nil if the value is not a valid string slice.
+func (x *Config) DeniedContainers() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"denied_containers")
+}
+
 // SmallSizeLimit returns the value of "small_object_size" config parameter.
 //
 // Returns SmallSizeLimitDefault if the value is not a positive number.
@@ -91,6 +119,14 @@ func (x *Config) Pilorama() *piloramaconfig.Config {
 	)
 }
 
+// ReadCache returns "read_cache" subsection as a readcacheconfig.Config.
+func (x *Config) ReadCache() *readcacheconfig.Config {
+	return readcacheconfig.From(
+		(*config.Config)(x).
+			Sub("read_cache"),
+	)
+}
+
 // GC returns "gc" subsection as a gcconfig.Config.
 func (x *Config) GC() *gcconfig.Config {
 	return gcconfig.From(
@@ -109,6 +145,26 @@ func (x *Config) RefillMetabase() bool {
 	)
 }
 
+// ExistenceFilterSize returns the value of "existence_filter_size" config parameter.
+//
+// Returns 0 (filter is disabled) if the value is not a positive number.
+func (x *Config) ExistenceFilterSize() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"existence_filter_size",
+	)
+}
+
+// InlineThreshold returns the value of "inline_threshold" config parameter.
+//
+// Returns 0 (inlining is disabled) if the value is not a positive number.
+func (x *Config) InlineThreshold() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"inline_threshold",
+	)
+}
+
 // Mode return the value of "mode" config parameter.
 //
 // Panics if read the value is not one of predefined
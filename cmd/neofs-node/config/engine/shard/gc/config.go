@@ -17,6 +17,14 @@ const (
 
 	// RemoverSleepIntervalDefault is a default sleep interval of Shard GC's remover.
 	RemoverSleepIntervalDefault = time.Minute
+
+	// OrphanConfirmationsDefault is a default number of the GC passes confirming
+	// the removal of the parent of the orphaned child object.
+	OrphanConfirmationsDefault = 3
+
+	// OrphanMinAgeDefault is a default age in epochs of the child objects
+	// which can be collected as orphans.
+	OrphanMinAgeDefault = 10
 )
 
 // From wraps config section into Config.
@@ -41,6 +49,17 @@ func (x *Config) RemoverBatchSize() int {
 	return RemoverBatchSizeDefault
 }
 
+// VerifyGarbage returns the value of "verify_garbage"
+// config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) VerifyGarbage() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"verify_garbage",
+	)
+}
+
 // RemoverSleepInterval returns the value of "remover_sleep_interval"
 // config parameter.
 //
@@ -57,3 +76,82 @@ func (x *Config) RemoverSleepInterval() time.Duration {
 
 	return RemoverSleepIntervalDefault
 }
+
+// ExpirationGracePeriod returns the value of "expiration_grace_period"
+// config parameter.
+//
+// Returns 0 if the value is not a non-negative number.
+func (x *Config) ExpirationGracePeriod() uint64 {
+	return config.UintSafe(
+		(*config.Config)(x),
+		"expiration_grace_period",
+	)
+}
+
+// ExpiredObjectsLimit returns the value of "expired_objects_limit"
+// config parameter.
+//
+// Returns 0 (not limited) if the value is not a positive number.
+func (x *Config) ExpiredObjectsLimit() uint32 {
+	return config.Uint32Safe(
+		(*config.Config)(x),
+		"expired_objects_limit",
+	)
+}
+
+// OrphanSampleSize returns the value of "orphan_sample_size"
+// config parameter.
+//
+// Returns 0 (orphans are not collected) if the value is not a positive number.
+func (x *Config) OrphanSampleSize() uint32 {
+	return config.Uint32Safe(
+		(*config.Config)(x),
+		"orphan_sample_size",
+	)
+}
+
+// OrphanConfirmations returns the value of "orphan_confirmations"
+// config parameter.
+//
+// Returns OrphanConfirmationsDefault if the value is not a positive number.
+func (x *Config) OrphanConfirmations() uint32 {
+	v := config.Uint32Safe(
+		(*config.Config)(x),
+		"orphan_confirmations",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return OrphanConfirmationsDefault
+}
+
+// OrphanMinAge returns the value of "orphan_min_age"
+// config parameter.
+//
+// Returns OrphanMinAgeDefault if the value is not a positive number.
+func (x *Config) OrphanMinAge() uint64 {
+	v := config.UintSafe(
+		(*config.Config)(x),
+		"orphan_min_age",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return OrphanMinAgeDefault
+}
+
+// OrphanRemoteChecks returns the value of "orphan_remote_checks"
+// config parameter.
+//
+// Returns 0 (only the local graveyard is checked) if the value is not
+// a positive number.
+func (x *Config) OrphanRemoteChecks() uint32 {
+	return config.Uint32Safe(
+		(*config.Config)(x),
+		"orphan_remote_checks",
+	)
+}
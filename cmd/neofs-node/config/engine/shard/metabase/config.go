@@ -1,8 +1,21 @@
 package metabaseconfig
 
 import (
+	"fmt"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	boltdbconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/boltdb"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+)
+
+const (
+	// TransactionQueueDefault is a default size of the queue of the write
+	// operations waiting for the transaction limit.
+	TransactionQueueDefault = 1024
+
+	// CompactTxSizeDefault is a default maximum size of the data copied
+	// in a single transaction during the metabase compaction.
+	CompactTxSizeDefault = 64 << 20
 )
 
 // Config is a wrapper over the config section
@@ -34,3 +47,77 @@ func (x *Config) Path() string {
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
 }
+
+// MaxTransactions returns the value of "max_transactions" config parameter.
+//
+// Returns 0 (no limit) if the value is not a positive number.
+func (x *Config) MaxTransactions() int {
+	return int(config.UintSafe(
+		(*config.Config)(x),
+		"max_transactions",
+	))
+}
+
+// TransactionQueue returns the value of "transaction_queue" config parameter.
+//
+// Returns TransactionQueueDefault if the value is not a positive number.
+func (x *Config) TransactionQueue() int {
+	s := config.UintSafe(
+		(*config.Config)(x),
+		"transaction_queue",
+	)
+
+	if s > 0 {
+		return int(s)
+	}
+
+	return TransactionQueueDefault
+}
+
+// CompactTxSize returns the value of "compact_tx_size" config parameter.
+//
+// Returns CompactTxSizeDefault if the value is not a positive number.
+func (x *Config) CompactTxSize() uint64 {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"compact_tx_size",
+	)
+
+	if s > 0 {
+		return s
+	}
+
+	return CompactTxSizeDefault
+}
+
+// GCPriority returns the value of "gc_priority" config parameter.
+//
+// Returns meta.PriorityLow if the value is not set.
+// Panics if the value is neither "high" nor "low".
+func (x *Config) GCPriority() meta.Priority {
+	return x.priority("gc_priority")
+}
+
+// FlushPriority returns the value of "flush_priority" config parameter.
+//
+// Returns meta.PriorityLow if the value is not set.
+// Panics if the value is neither "high" nor "low".
+func (x *Config) FlushPriority() meta.Priority {
+	return x.priority("flush_priority")
+}
+
+func (x *Config) priority(name string) meta.Priority {
+	s := config.StringSafe(
+		(*config.Config)(x),
+		name,
+	)
+
+	switch s {
+	case "low", "":
+		return meta.PriorityLow
+	case "high":
+		return meta.PriorityHigh
+	default:
+		panic(fmt.Sprintf("unknown metabase %s: %s", name, s))
+	}
+}
@@ -0,0 +1,43 @@
+package readcacheconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's read cache configurations.
+type Config config.Config
+
+// MaxObjectSizeDefault is a default size limit of the cached objects.
+const MaxObjectSizeDefault = 64 << 10
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Capacity returns the value of "capacity" config parameter.
+//
+// Returns 0 (cache is disabled) if the value is not a positive number.
+func (x *Config) Capacity() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"capacity",
+	)
+}
+
+// MaxObjectSize returns the value of "max_object_size" config parameter.
+//
+// Returns MaxObjectSizeDefault if the value is not a positive number.
+func (x *Config) MaxObjectSize() uint64 {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"max_object_size",
+	)
+
+	if s > 0 {
+		return s
+	}
+
+	return MaxObjectSizeDefault
+}
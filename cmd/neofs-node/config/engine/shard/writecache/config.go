@@ -1,6 +1,8 @@
 package writecacheconfig
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	boltdbconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/boltdb"
 )
@@ -22,6 +24,17 @@ const (
 
 	// SizeLimitDefault is a default write-cache size limit.
 	SizeLimitDefault = 1 << 30
+
+	// FlushIntervalDefault is a default interval between the background flushes.
+	FlushIntervalDefault = time.Second
+
+	// SlowFlushThresholdDefault is a default duration of the object flush
+	// after which the object is logged as a slow one.
+	SlowFlushThresholdDefault = time.Second
+
+	// BigFlushTimeDefault is a default duration limit of the single flush
+	// cycle of the big objects.
+	BigFlushTimeDefault = 5 * time.Second
 )
 
 // From wraps config section into Config.
@@ -100,6 +113,90 @@ func (x *Config) WorkersNumber() int {
 	return WorkersNumberDefault
 }
 
+// FlushInterval returns the value of "flush_interval" config parameter.
+//
+// Returns FlushIntervalDefault if the value is not a positive duration.
+func (x *Config) FlushInterval() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"flush_interval",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return FlushIntervalDefault
+}
+
+// SlowFlushThreshold returns the value of "slow_flush_threshold" config parameter.
+//
+// Returns SlowFlushThresholdDefault if the value is missing or negative.
+// Zero value disables the slow flush logging.
+func (x *Config) SlowFlushThreshold() time.Duration {
+	if (*config.Config)(x).Value("slow_flush_threshold") == nil {
+		return SlowFlushThresholdDefault
+	}
+
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"slow_flush_threshold",
+	)
+
+	if d >= 0 {
+		return d
+	}
+
+	return SlowFlushThresholdDefault
+}
+
+// BigFlushSize returns the value of "big_flush_size" config parameter.
+//
+// Returns 0 if the value is not a positive number, which means no limit.
+func (x *Config) BigFlushSize() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"big_flush_size",
+	)
+}
+
+// BigFlushTime returns the value of "big_flush_time" config parameter.
+//
+// Returns BigFlushTimeDefault if the value is missing or negative.
+// Zero value means no limit.
+func (x *Config) BigFlushTime() time.Duration {
+	if (*config.Config)(x).Value("big_flush_time") == nil {
+		return BigFlushTimeDefault
+	}
+
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"big_flush_time",
+	)
+
+	if d >= 0 {
+		return d
+	}
+
+	return BigFlushTimeDefault
+}
+
+// DrainTimeout returns the value of "drain_timeout" config parameter.
+//
+// Returns 0 if the value is not a positive duration.
+func (x *Config) DrainTimeout() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"drain_timeout",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return 0
+}
+
 // SizeLimit returns the value of "capacity" config parameter.
 //
 // Returns SizeLimitDefault if the value is not a positive number.
@@ -116,6 +213,27 @@ func (x *Config) SizeLimit() uint64 {
 	return SizeLimitDefault
 }
 
+// RepairOnInit returns the value of "repair_on_init" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) RepairOnInit() bool {
+	return config.BoolSafe((*config.Config)(x), "repair_on_init")
+}
+
+// ValidateObjects returns the value of "validate_objects" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) ValidateObjects() bool {
+	return config.BoolSafe((*config.Config)(x), "validate_objects")
+}
+
+// VerifyPayload returns the value of "verify_payload" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (x *Config) VerifyPayload() bool {
+	return config.BoolSafe((*config.Config)(x), "verify_payload")
+}
+
 // BoltDB returns config instance for querying bolt db specific parameters.
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
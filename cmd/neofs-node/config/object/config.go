@@ -1,6 +1,8 @@
 package objectconfig
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 )
 
@@ -15,11 +17,55 @@ const (
 
 	putSubsection = "put"
 
+	getSubsection = "get"
+
+	searchSubsection = "search"
+
+	deleteSubsection = "delete"
+
+	auditSubsection = "audit"
+
+	nodeTimeoutSubsection = "node_timeout"
+
 	// PutPoolSizeDefault is a default value of routine pool size to
 	// process object.Put requests in object service.
 	PutPoolSizeDefault = 10
+
+	// NodeTimeoutMinDefault is a default lower limit of the time
+	// given to a single container node to process a request.
+	NodeTimeoutMinDefault = time.Second
 )
 
+// GetConfig is a wrapper over "get" config section which provides access
+// to object get pipeline configuration of object service.
+type GetConfig struct {
+	cfg *config.Config
+}
+
+// SearchConfig is a wrapper over "search" config section which provides access
+// to object search pipeline configuration of object service.
+type SearchConfig struct {
+	cfg *config.Config
+}
+
+// DeleteConfig is a wrapper over "delete" config section which provides access
+// to object delete pipeline configuration of object service.
+type DeleteConfig struct {
+	cfg *config.Config
+}
+
+// NodeTimeoutConfig is a wrapper over "node_timeout" config section which
+// provides access to the limits of the time given to a single container node.
+type NodeTimeoutConfig struct {
+	cfg *config.Config
+}
+
+// AuditConfig is a wrapper over "audit" config section which provides access
+// to the audit log configuration of object service.
+type AuditConfig struct {
+	cfg *config.Config
+}
+
 // Put returns structure that provides access to "put" subsection of
 // "object" section.
 func Put(c *config.Config) PutConfig {
@@ -39,3 +85,130 @@ func (g PutConfig) PoolSizeRemote() int {
 
 	return PutPoolSizeDefault
 }
+
+// Get returns structure that provides access to "get" subsection of
+// "object" section.
+func Get(c *config.Config) GetConfig {
+	return GetConfig{
+		c.Sub(subsection).Sub(getSubsection),
+	}
+}
+
+// VerifyPayload returns the value of "verify_payload" config parameter.
+//
+// Returns false if the value is missing.
+func (g GetConfig) VerifyPayload() bool {
+	return config.BoolSafe(g.cfg, "verify_payload")
+}
+
+// Search returns structure that provides access to "search" subsection of
+// "object" section.
+func Search(c *config.Config) SearchConfig {
+	return SearchConfig{
+		c.Sub(subsection).Sub(searchSubsection),
+	}
+}
+
+// VerifyPresence returns the value of "verify_presence" config parameter.
+//
+// Returns false if the value is missing.
+func (g SearchConfig) VerifyPresence() bool {
+	return config.BoolSafe(g.cfg, "verify_presence")
+}
+
+// Delete returns structure that provides access to "delete" subsection of
+// "object" section.
+func Delete(c *config.Config) DeleteConfig {
+	return DeleteConfig{
+		c.Sub(subsection).Sub(deleteSubsection),
+	}
+}
+
+// TombstoneCopies returns the value of "tombstone_copies" config parameter.
+//
+// Returns 0 if the value is missing, which means the number of replicas
+// in the container placement policy.
+func (g DeleteConfig) TombstoneCopies() uint32 {
+	return config.Uint32Safe(g.cfg, "tombstone_copies")
+}
+
+// Audit returns structure that provides access to "audit" subsection of
+// "object" section.
+func Audit(c *config.Config) AuditConfig {
+	return AuditConfig{
+		c.Sub(subsection).Sub(auditSubsection),
+	}
+}
+
+// Enabled returns the value of "enabled" config parameter.
+//
+// Returns false if the value is missing.
+func (g AuditConfig) Enabled() bool {
+	return config.BoolSafe(g.cfg, "enabled")
+}
+
+// Path returns the value of "path" config parameter.
+//
+// Returns empty string if the value is missing, which means
+// the standard output.
+func (g AuditConfig) Path() string {
+	return config.StringSafe(g.cfg, "path")
+}
+
+// BufferSize returns the value of "buffer_size" config parameter.
+//
+// Returns 0 if the value is not a positive number.
+func (g AuditConfig) BufferSize() int {
+	v := config.IntSafe(g.cfg, "buffer_size")
+	if v > 0 {
+		return int(v)
+	}
+
+	return 0
+}
+
+// Containers returns the value of "containers" config parameter.
+//
+// Returns nil if the value is missing, which means all the containers.
+func (g AuditConfig) Containers() []string {
+	return config.StringSliceSafe(g.cfg, "containers")
+}
+
+// Operations returns the value of "operations" config parameter.
+//
+// Returns nil if the value is missing, which means all the operations.
+func (g AuditConfig) Operations() []string {
+	return config.StringSliceSafe(g.cfg, "operations")
+}
+
+// NodeTimeout returns structure that provides access to "node_timeout"
+// subsection of "object" section.
+func NodeTimeout(c *config.Config) NodeTimeoutConfig {
+	return NodeTimeoutConfig{
+		c.Sub(subsection).Sub(nodeTimeoutSubsection),
+	}
+}
+
+// Min returns the value of "min" config parameter.
+//
+// Returns NodeTimeoutMinDefault if the value is not a positive duration.
+func (g NodeTimeoutConfig) Min() time.Duration {
+	v := config.DurationSafe(g.cfg, "min")
+	if v > 0 {
+		return v
+	}
+
+	return NodeTimeoutMinDefault
+}
+
+// Max returns the value of "max" config parameter.
+//
+// Returns 0 if the value is not a positive duration, which means no limit.
+func (g NodeTimeoutConfig) Max() time.Duration {
+	v := config.DurationSafe(g.cfg, "max")
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
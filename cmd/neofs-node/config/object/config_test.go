@@ -2,6 +2,7 @@ package objectconfig_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
@@ -14,12 +15,36 @@ func TestObjectSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
+		require.False(t, objectconfig.Get(empty).VerifyPayload())
+		require.False(t, objectconfig.Search(empty).VerifyPresence())
+		require.Zero(t, objectconfig.Delete(empty).TombstoneCopies())
+		require.Equal(t, objectconfig.NodeTimeoutMinDefault, objectconfig.NodeTimeout(empty).Min())
+		require.Zero(t, objectconfig.NodeTimeout(empty).Max())
+
+		audit := objectconfig.Audit(empty)
+		require.False(t, audit.Enabled())
+		require.Empty(t, audit.Path())
+		require.Zero(t, audit.BufferSize())
+		require.Empty(t, audit.Containers())
+		require.Empty(t, audit.Operations())
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
+		require.True(t, objectconfig.Get(c).VerifyPayload())
+		require.True(t, objectconfig.Search(c).VerifyPresence())
+		require.EqualValues(t, 2, objectconfig.Delete(c).TombstoneCopies())
+		require.Equal(t, 2*time.Second, objectconfig.NodeTimeout(c).Min())
+		require.Equal(t, 10*time.Second, objectconfig.NodeTimeout(c).Max())
+
+		audit := objectconfig.Audit(c)
+		require.True(t, audit.Enabled())
+		require.Equal(t, "/var/log/neofs/audit.log", audit.Path())
+		require.Equal(t, 2048, audit.BufferSize())
+		require.Equal(t, []string{"AQuqVJDeEyJLMbWGUtDpj4nKqvJJPcTBqcuGhEjHALNr"}, audit.Containers())
+		require.Equal(t, []string{"PUT", "DELETE"}, audit.Operations())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"net"
+	"os"
 
 	controlconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/control"
+	"github.com/nspcc-dev/neofs-node/misc"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"google.golang.org/grpc"
@@ -26,15 +30,19 @@ func initControlService(c *cfg) {
 	}
 
 	ctlSvc := controlSvc.New(
+		controlSvc.WithLogger(c.log),
 		controlSvc.WithKey(&c.key.PrivateKey),
 		controlSvc.WithAuthorizedKeys(rawPubs),
 		controlSvc.WithHealthChecker(c),
 		controlSvc.WithNetMapSource(c.netMapSource),
 		controlSvc.WithContainerSource(c.cfgObject.cnrSource),
 		controlSvc.WithReplicator(c.replicator),
+		controlSvc.WithPolicer(c.policer),
 		controlSvc.WithNodeState(c),
+		controlSvc.WithNetworkState(c.cfgNetmap.state),
 		controlSvc.WithLocalStorage(c.cfgObject.cfgLocalStorage.localStorage),
 		controlSvc.WithTreeService(c.treeService),
+		controlSvc.WithSupportInfo(c),
 	)
 
 	lis, err := net.Listen("tcp", endpoint)
@@ -70,3 +78,41 @@ func (c *cfg) setHealthStatus(st control.HealthStatus) {
 func (c *cfg) HealthStatus() control.HealthStatus {
 	return control.HealthStatus(c.healthStatus.Load())
 }
+
+func (c *cfg) Version() string {
+	return misc.Version
+}
+
+func (c *cfg) ConfigHash() ([]byte, error) {
+	path := c.appCfg.FilePath()
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	h := sha256.Sum256(data)
+
+	return h[:], nil
+}
+
+func (c *cfg) MorphState() (controlSvc.MorphState, error) {
+	var st controlSvc.MorphState
+
+	st.Endpoint, st.Connected = c.cfgMorph.client.Endpoint()
+	if !st.Connected {
+		return st, nil
+	}
+
+	height, err := c.cfgMorph.client.BlockCount()
+	if err != nil {
+		return st, fmt.Errorf("get sidechain height: %w", err)
+	}
+
+	st.Height = height
+
+	return st, nil
+}
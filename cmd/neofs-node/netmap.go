@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	netmapGRPC "github.com/nspcc-dev/neofs-api-go/v2/netmap/grpc"
 	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
@@ -15,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	netmapTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/netmap/grpc"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	netmapService "github.com/nspcc-dev/neofs-node/pkg/services/netmap"
 	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
@@ -31,6 +33,8 @@ type networkState struct {
 
 	nodeInfo atomic.Value // *netmapSDK.NodeInfo
 
+	lastBootstrap atomic.Value // controlSvc.BootstrapInfo
+
 	metrics *metrics.NodeMetrics
 }
 
@@ -70,6 +74,22 @@ func (s *networkState) setNodeInfo(ni *netmapSDK.NodeInfo) {
 	s.controlNetStatus.Store(ctrlNetSt)
 }
 
+// setLastBootstrap remembers the node information announced
+// in the bootstrap transaction sent at the current epoch.
+func (s *networkState) setLastBootstrap(ni netmapSDK.NodeInfo) {
+	s.lastBootstrap.Store(controlSvc.BootstrapInfo{
+		NodeInfo: ni,
+		Epoch:    s.CurrentEpoch(),
+		Time:     time.Now(),
+	})
+}
+
+// LastBootstrap implements controlSvc.NetworkState.
+func (s *networkState) LastBootstrap() (controlSvc.BootstrapInfo, bool) {
+	v, ok := s.lastBootstrap.Load().(controlSvc.BootstrapInfo)
+	return v, ok
+}
+
 func (s *networkState) controlNetmapStatus() control.NetmapStatus {
 	return s.controlNetStatus.Load().(control.NetmapStatus)
 }
@@ -5,9 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -103,9 +106,10 @@ type localObjectInhumer struct {
 	log *logger.Logger
 }
 
-func (r *localObjectInhumer) DeleteObjects(ts oid.Address, addr ...oid.Address) error {
+func (r *localObjectInhumer) DeleteObjects(ts oid.Address, exp uint64, addr ...oid.Address) error {
 	var prm engine.InhumePrm
 	prm.WithTarget(ts, addr...)
+	prm.WithTombstoneExpiration(exp)
 
 	_, err := r.storage.Inhume(prm)
 	return err
@@ -218,7 +222,12 @@ func initObjectService(c *cfg) {
 		),
 	)
 
-	pol := policer.New(
+	var policerMetrics policer.MetricRegister
+	if c.metricsCollector != nil {
+		policerMetrics = c.metricsCollector
+	}
+
+	c.policer = policer.New(
 		policer.WithLogger(c.log),
 		policer.WithLocalStorage(ls),
 		policer.WithContainerSource(c.cfgObject.cnrSource),
@@ -247,11 +256,21 @@ func initObjectService(c *cfg) {
 		policer.WithMaxCapacity(c.cfgObject.pool.putRemoteCapacity),
 		policer.WithPool(c.cfgObject.pool.replication),
 		policer.WithNodeLoader(c),
+		policer.WithMetrics(policerMetrics),
 	)
 
-	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
+	nodeTimeoutCfg := objectconfig.NodeTimeout(c.appCfg)
+	nodeTimeoutLimits := placement.WithNodeTimeoutLimits(nodeTimeoutCfg.Min(), nodeTimeoutCfg.Max())
 
-	c.workers = append(c.workers, pol)
+	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c).
+		WithTraverseOptions(nodeTimeoutLimits)
+
+	if c.EngineCfg.readOnlyAll {
+		// replicas can not be stored or removed locally
+		c.log.Info("all shards are read-only, policer is not started")
+	} else {
+		c.workers = append(c.workers, c.policer)
+	}
 
 	var os putsvc.ObjectStorage = engineWithoutNotifications{
 		e:     ls,
@@ -281,6 +300,7 @@ func initObjectService(c *cfg) {
 		),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote),
+		putsvc.WithTraverseOptions(nodeTimeoutLimits),
 		putsvc.WithLogger(c.log),
 	)
 
@@ -305,6 +325,8 @@ func initObjectService(c *cfg) {
 	sSearchV2 := searchsvcV2.NewService(
 		searchsvcV2.WithInternalService(sSearch),
 		searchsvcV2.WithKeyStorage(keyStorage),
+		searchsvcV2.WithResponseBodyLimit(maxMsgSize*3/4), // 25% to meta, 75% to object IDs
+		searchsvcV2.WithPresenceVerification(objectconfig.Search(c.appCfg).VerifyPresence()),
 	)
 
 	sGet := getsvc.New(
@@ -319,6 +341,7 @@ func initObjectService(c *cfg) {
 		getsvc.WithNetMapSource(c.netMapSource),
 		getsvc.WithKeyStorage(keyStorage),
 		getsvc.WithNodeState(&c.internals),
+		getsvc.WithPayloadVerification(objectconfig.Get(c.appCfg).VerifyPayload()),
 	)
 
 	*c.cfgObject.getSvc = *sGet // need smth better
@@ -333,6 +356,7 @@ func initObjectService(c *cfg) {
 		deletesvc.WithHeadService(sGet),
 		deletesvc.WithSearchService(sSearch),
 		deletesvc.WithPutService(sPut),
+		deletesvc.WithLocalStorageEngine(ls),
 		deletesvc.WithNetworkInfo(&delNetInfo{
 			State:      c.cfgNetmap.state,
 			tsLifetime: 5,
@@ -340,6 +364,8 @@ func initObjectService(c *cfg) {
 			cfg: c,
 		}),
 		deletesvc.WithKeyStorage(keyStorage),
+		deletesvc.WithContainerSource(c.cfgObject.cnrSource),
+		deletesvc.WithTombstoneCopies(objectconfig.Delete(c.appCfg).TombstoneCopies()),
 	)
 
 	sDeleteV2 := deletesvcV2.NewService(
@@ -347,7 +373,7 @@ func initObjectService(c *cfg) {
 	)
 
 	// build service pipeline
-	// grpc | <metrics> | signature | response | acl | split
+	// grpc | <metrics> | signature | <audit> | response | acl | split
 
 	splitSvc := objectService.NewTransportSplitter(
 		c.cfgGRPC.maxChunkSize,
@@ -383,9 +409,14 @@ func initObjectService(c *cfg) {
 		c.respSvc,
 	)
 
+	var signNext objectService.ServiceServer = respSvc
+	if objectconfig.Audit(c.appCfg).Enabled() {
+		signNext = initObjectAudit(c, respSvc)
+	}
+
 	signSvc := objectService.NewSignService(
 		&c.key.PrivateKey,
-		respSvc,
+		signNext,
 	)
 
 	var firstSvc objectService.ServiceServer = signSvc
@@ -400,6 +431,68 @@ func initObjectService(c *cfg) {
 	}
 }
 
+func initObjectAudit(c *cfg, next objectService.ServiceServer) objectService.ServiceServer {
+	auditCfg := objectconfig.Audit(c.appCfg)
+
+	var opts []objectService.AuditOption
+
+	if sz := auditCfg.BufferSize(); sz > 0 {
+		opts = append(opts, objectService.WithAuditBufferSize(sz))
+	}
+
+	if strs := auditCfg.Containers(); len(strs) > 0 {
+		cnrs := make([]cid.ID, len(strs))
+		for i := range strs {
+			err := cnrs[i].DecodeString(strs[i])
+			fatalOnErrDetails("invalid container in the audit config", err)
+		}
+
+		opts = append(opts, objectService.WithAuditContainers(cnrs...))
+	}
+
+	if ops := auditCfg.Operations(); len(ops) > 0 {
+		for i := range ops {
+			switch ops[i] {
+			case objectService.AuditPut, objectService.AuditGet, objectService.AuditHead,
+				objectService.AuditSearch, objectService.AuditDelete, objectService.AuditRange,
+				objectService.AuditRangeHash:
+			default:
+				fatalOnErr(fmt.Errorf("invalid operation in the audit config: %s", ops[i]))
+			}
+		}
+
+		opts = append(opts, objectService.WithAuditOperations(ops...))
+	}
+
+	if c.metricsCollector != nil {
+		opts = append(opts, objectService.WithAuditMetrics(c.metricsCollector))
+	}
+
+	w := io.Writer(os.Stdout)
+
+	if path := auditCfg.Path(); path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+		fatalOnErrDetails("could not open audit log", err)
+
+		c.onShutdown(func() { _ = f.Close() })
+
+		w = f
+	}
+
+	svc := objectService.NewAuditService(next, w, opts...)
+
+	c.workers = append(c.workers, newWorkerFromFunc(svc.Run))
+
+	c.onShutdown(func() {
+		if dropped := svc.Dropped(); dropped > 0 {
+			c.log.Warn("object audit records were dropped",
+				zap.Uint64("count", dropped))
+		}
+	})
+
+	return svc
+}
+
 type morphEACLFetcher struct {
 	w *cntClient.Client
 }
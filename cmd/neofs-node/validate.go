@@ -10,13 +10,15 @@ import (
 	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 )
 
 // validateConfig validates storage node configuration.
 func validateConfig(c *config.Config) error {
 	shardNum := 0
 	paths := make(map[string]pathDescription)
-	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
+	var placements []shard.ContainerPlacement
+	err := engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		if sc.WriteCache().Enabled() {
 			err := addPath(paths, "writecache", shardNum, sc.WriteCache().Path())
 			if err != nil {
@@ -36,10 +38,35 @@ func validateConfig(c *config.Config) error {
 			}
 		}
 
+		if sc.InlineThreshold() > 0 && len(sc.EncryptionKeys()) > 0 {
+			// inline objects are stored in the metabase not encrypted
+			return fmt.Errorf("inline_threshold can't be used with encryption_keys (shard %d)", shardNum)
+		}
+
 		blobstor := sc.BlobStor().Storages()
-		if len(blobstor) != 2 {
+		var routed, idStorages int
+		for i := range blobstor {
+			if blobstor[i].Type() == blobovniczatree.Type {
+				idStorages++
+			}
+			if blobstor[i].Attributes() == nil {
+				continue
+			}
+			// the objects with non-empty storage IDs are looked
+			// for in the only component which assigns them
+			if blobstor[i].Type() != fstree.Type {
+				return fmt.Errorf("blobstor component with attributes must be %s, got: %s (shard %d)",
+					fstree.Type, blobstor[i].Type(), shardNum)
+			}
+			routed++
+		}
+		if idStorages > 1 {
+			return fmt.Errorf("blobstor section must have at most one %s component, got: %d (shard %d)",
+				blobovniczatree.Type, idStorages, shardNum)
+		}
+		if len(blobstor)-routed != 2 {
 			// TODO (@fyrcik): remove after #1522
-			return fmt.Errorf("blobstor section must have 2 components, got: %d", len(blobstor))
+			return fmt.Errorf("blobstor section must have 2 components without attributes, got: %d", len(blobstor)-routed)
 		}
 		for i := range blobstor {
 			switch blobstor[i].Type() {
@@ -64,9 +91,25 @@ func validateConfig(c *config.Config) error {
 			}
 		}
 
+		placement, err := containerPlacement(sc)
+		if err != nil {
+			return fmt.Errorf("%w (shard %d)", err, shardNum)
+		}
+
+		placements = append(placements, placement)
+
 		shardNum++
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if err := shard.CheckContainerPlacement(placements); err != nil {
+		return fmt.Errorf("invalid container placement: %w", err)
+	}
+
+	return nil
 }
 
 type pathDescription struct {
@@ -23,6 +23,30 @@ func TestValidate(t *testing.T) {
 		})
 	})
 
+	t.Run("inline with encryption", func(t *testing.T) {
+		dir := t.TempDir()
+		p := filepath.Join(dir, "config.yml")
+		require.NoError(t, os.WriteFile(p, []byte(`
+storage:
+  shard:
+    0:
+      inline_threshold: 1kb
+      encryption_keys:
+        - `+filepath.Join(dir, "key")+`
+      metabase:
+        path: `+filepath.Join(dir, "meta")+`
+      blobstor:
+        - type: blobovnicza
+          path: `+filepath.Join(dir, "blobovnicza")+`
+        - type: fstree
+          path: `+filepath.Join(dir, "fstree")+`
+`), 0o600))
+
+		os.Clearenv() // ENVs have priority over config files, so we do this in tests
+		c := config.New(config.Prm{}, config.WithConfigFile(p))
+		require.ErrorContains(t, validateConfig(c), "inline_threshold can't be used with encryption_keys")
+	})
+
 	t.Run("mainnet", func(t *testing.T) {
 		os.Clearenv() // ENVs have priority over config files, so we do this in tests
 		p := filepath.Join(exampleConfigPrefix, "mainnet/config.yml")
@@ -35,11 +35,13 @@ type cfg struct {
 
 // DeleteHandler is an interface of delete queue processor.
 type DeleteHandler interface {
-	// DeleteObjects places objects to a removal queue.
+	// DeleteObjects places objects to a removal queue. The first
+	// parameters are the address and the expiration epoch of the
+	// tombstone the objects are removed with.
 	//
 	// Returns apistatus.LockNonRegularObject if at least one object
 	// is locked.
-	DeleteObjects(oid.Address, ...oid.Address) error
+	DeleteObjects(oid.Address, uint64, ...oid.Address) error
 }
 
 // Locker is an object lock storage interface.
@@ -47,7 +49,8 @@ type Locker interface {
 	// Lock list of objects as locked by locker in the specified container.
 	//
 	// Returns apistatus.LockNonRegularObject if at least object in locked
-	// list is irregular (not type of REGULAR).
+	// list is irregular (not type of REGULAR) and apistatus.ObjectAlreadyRemoved
+	// if at least one object is already removed.
 	Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 }
 
@@ -214,7 +217,7 @@ func (v *FormatValidator) ValidateContent(o *object.Object) error {
 		}
 
 		if v.deleteHandler != nil {
-			err = v.deleteHandler.DeleteObjects(AddressOf(o), addrList...)
+			err = v.deleteHandler.DeleteObjects(AddressOf(o), exp, addrList...)
 			if err != nil {
 				return fmt.Errorf("delete objects from %s object content: %w", o.Type(), err)
 			}
@@ -70,3 +70,44 @@ func (b *Blobovnicza) Get(prm GetPrm) (GetRes, error) {
 		obj: data,
 	}, nil
 }
+
+// View calls f with the binary representation of the object stored in
+// Blobovnicza by address. Unlike Get, the data is not copied, so it is valid
+// only during the f call and must not be modified.
+//
+// Returns any error encountered that
+// did not allow to read the object or returned by f.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is not
+// presented in Blobovnicza.
+func (b *Blobovnicza) View(addr oid.Address, f func(data []byte) error) error {
+	var (
+		found   bool
+		fErr    error
+		addrKey = addressKey(addr)
+	)
+
+	if err := b.boltDB.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(_ []byte, buck *bbolt.Bucket) error {
+			data := buck.Get(addrKey)
+			if data == nil {
+				return nil
+			}
+
+			found = true
+			fErr = f(data)
+
+			return errInterruptForEach
+		})
+	}); err != nil && err != errInterruptForEach {
+		return err
+	}
+
+	if !found {
+		var errNotFound apistatus.ObjectNotFound
+
+		return errNotFound
+	}
+
+	return fErr
+}
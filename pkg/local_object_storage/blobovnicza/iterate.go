@@ -3,6 +3,7 @@ package blobovnicza
 import (
 	"fmt"
 
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 )
@@ -146,18 +147,49 @@ func (b *Blobovnicza) Iterate(prm IteratePrm) (IterateRes, error) {
 	return IterateRes{}, nil
 }
 
-// IterateAddresses is a helper function which iterates over Blobovnicza and passes addresses of the objects to f.
-func IterateAddresses(blz *Blobovnicza, f func(oid.Address) error) error {
-	var prm IteratePrm
+// AddressHandler is a processor of the object addresses. If the data is
+// requested, the second argument returns a copy of the stored object, it
+// must be called before the handler returns. Otherwise, it is nil.
+type AddressHandler func(oid.Address, func() ([]byte, error)) error
 
-	prm.DecodeAddresses()
-	prm.WithoutData()
+// IterateAddresses goes through all stored objects and passes their addresses
+// to the handler until error return. Unlike Iterate, the object data is never
+// accessed unless lazyData is set and the handler calls the data getter, so
+// the callers interested in the addresses only do not pay for the data.
+//
+// Returns handler's errors directly. Returns an error if any object
+// address can not be decoded unless errHandler is set. Otherwise, the
+// decoding errors are passed to errHandler and the iteration is aborted
+// only if it returns an error.
+func (b *Blobovnicza) IterateAddresses(handler AddressHandler, lazyData bool, errHandler func(error) error) error {
+	var addr oid.Address
+
+	return b.boltDB.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, buck *bbolt.Bucket) error {
+			return buck.ForEach(func(k, v []byte) error {
+				if err := addressFromKey(&addr, k); err != nil {
+					err = fmt.Errorf("could not decode address key: %w", err)
+					if errHandler != nil {
+						return errHandler(err)
+					}
+					return err
+				}
 
-	prm.SetHandler(func(elem IterationElement) error {
-		return f(elem.Address())
-	})
+				if !lazyData {
+					return handler(addr, nil)
+				}
 
-	_, err := blz.Iterate(prm)
+				return handler(addr, func() ([]byte, error) {
+					return slice.Copy(v), nil
+				})
+			})
+		})
+	})
+}
 
-	return err
+// IterateAddresses is a helper function which iterates over Blobovnicza and passes addresses of the objects to f.
+func IterateAddresses(blz *Blobovnicza, f func(oid.Address) error) error {
+	return blz.IterateAddresses(func(addr oid.Address, _ func() ([]byte, error)) error {
+		return f(addr)
+	}, false, nil)
 }
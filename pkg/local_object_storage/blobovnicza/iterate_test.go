@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
@@ -55,3 +56,137 @@ func TestBlobovniczaIterate(t *testing.T) {
 	})
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestBlobovnicza_IterateAddresses(t *testing.T) {
+	b := New(WithPath(filepath.Join(t.TempDir(), "blob")))
+	require.NoError(t, b.Open())
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { require.NoError(t, b.Close()) })
+
+	objs := map[oid.Address][]byte{
+		oidtest.Address(): {0, 1, 2, 3},
+		oidtest.Address(): {5, 6, 7, 8},
+	}
+
+	for addr, data := range objs {
+		_, err := b.Put(PutPrm{addr: addr, objData: data})
+		require.NoError(t, err)
+	}
+
+	t.Run("without data", func(t *testing.T) {
+		var addrs []oid.Address
+
+		err := b.IterateAddresses(func(addr oid.Address, data func() ([]byte, error)) error {
+			require.Nil(t, data)
+			addrs = append(addrs, addr)
+			return nil
+		}, false, nil)
+		require.NoError(t, err)
+		require.Len(t, addrs, len(objs))
+
+		for i := range addrs {
+			require.Contains(t, objs, addrs[i])
+		}
+	})
+
+	t.Run("lazy data", func(t *testing.T) {
+		seen := make(map[oid.Address][]byte)
+
+		err := b.IterateAddresses(func(addr oid.Address, data func() ([]byte, error)) error {
+			v, err := data()
+			if err != nil {
+				return err
+			}
+
+			seen[addr] = v
+			return nil
+		}, true, nil)
+		require.NoError(t, err)
+		require.Equal(t, objs, seen)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		require.NoError(t, b.boltDB.Update(func(tx *bbolt.Tx) error {
+			buck := tx.Bucket(bucketKeyFromBounds(firstBucketBound))
+			return buck.Put([]byte("invalid address"), []byte{1})
+		}))
+
+		err := b.IterateAddresses(func(oid.Address, func() ([]byte, error)) error {
+			return nil
+		}, false, nil)
+		require.Error(t, err)
+
+		var (
+			addrs  []oid.Address
+			errNum int
+		)
+
+		err = b.IterateAddresses(func(addr oid.Address, _ func() ([]byte, error)) error {
+			addrs = append(addrs, addr)
+			return nil
+		}, false, func(error) error {
+			errNum++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, addrs, len(objs))
+		require.Equal(t, 1, errNum)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		expectedErr := errors.New("stop iteration")
+
+		err := b.IterateAddresses(func(oid.Address, func() ([]byte, error)) error {
+			return expectedErr
+		}, false, nil)
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func BenchmarkBlobovnicza_IterateAddresses(b *testing.B) {
+	const objNum = 100_000
+
+	blz := New(WithPath(filepath.Join(b.TempDir(), "blob")))
+	require.NoError(b, blz.Open())
+	require.NoError(b, blz.Init())
+	b.Cleanup(func() { require.NoError(b, blz.Close()) })
+
+	data := make([]byte, 128)
+
+	require.NoError(b, blz.boltDB.Update(func(tx *bbolt.Tx) error {
+		buck := tx.Bucket(bucketKeyFromBounds(firstBucketBound))
+		for i := 0; i < objNum; i++ {
+			if err := buck.Put(addressKey(oidtest.Address()), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	b.Run("addresses only", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			err := blz.IterateAddresses(func(oid.Address, func() ([]byte, error)) error {
+				return nil
+			}, false, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("with data", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			err := blz.IterateAddresses(func(_ oid.Address, data func() ([]byte, error)) error {
+				_, err := data()
+				return err
+			}, true, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package blobovnicza
+
+// Utilization returns the current size of the stored data and the size limit
+// set via WithFullSizeLimit. Blobovnicza does not accept new objects when the
+// limit is reached, so the ratio of the values can be used to avoid Blobovniczas
+// which are about to be filled.
+//
+// The used size is the one compared with the limit on Put.
+func (b *Blobovnicza) Utilization() (usedBytes, capacityBytes uint64, err error) {
+	return b.filled.Load(), b.fullSizeLimit, nil
+}
@@ -0,0 +1,91 @@
+package blobovnicza
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobovnicza_Utilization(t *testing.T) {
+	newBlz := func(t *testing.T, sizeLim uint64) *Blobovnicza {
+		blz := New(
+			WithPath(filepath.Join(t.TempDir(), "blz")),
+			WithObjectSizeLimit(sizeLim),
+			WithFullSizeLimit(sizeLim),
+			WithLogger(test.NewLogger(false)),
+		)
+
+		require.NoError(t, blz.Open())
+		require.NoError(t, blz.Init())
+
+		t.Cleanup(func() { require.NoError(t, blz.Close()) })
+
+		return blz
+	}
+
+	t.Run("far from full", func(t *testing.T) {
+		const sizeLim = 1 << 30
+
+		blz := newBlz(t, sizeLim)
+		testPutGet(t, blz, oidtest.Address(), 1<<10, nil, nil)
+
+		used, capacity, err := blz.Utilization()
+		require.NoError(t, err)
+		require.EqualValues(t, sizeLim, capacity)
+		require.NotZero(t, used)
+		require.Less(t, used, capacity/100)
+	})
+
+	t.Run("near full", func(t *testing.T) {
+		const (
+			sizeLim = 1 << 20
+			objSize = 32 << 10
+		)
+
+		blz := newBlz(t, sizeLim)
+
+		for {
+			var prm PutPrm
+			prm.SetAddress(oidtest.Address())
+			prm.SetMarshaledObject(make([]byte, objSize))
+
+			_, err := blz.Put(prm)
+			if errors.Is(err, ErrFull) {
+				break
+			}
+			require.NoError(t, err)
+		}
+
+		used, capacity, err := blz.Utilization()
+		require.NoError(t, err)
+		require.EqualValues(t, sizeLim, capacity)
+		require.GreaterOrEqual(t, used*100/capacity, uint64(90))
+	})
+
+	t.Run("deleted objects", func(t *testing.T) {
+		blz := newBlz(t, 1<<30)
+
+		used, _, err := blz.Utilization()
+		require.NoError(t, err)
+
+		addr := oidtest.Address()
+		testPutGet(t, blz, addr, 1<<20, nil, nil)
+
+		withObject, _, err := blz.Utilization()
+		require.NoError(t, err)
+		require.Equal(t, used+1<<20, withObject)
+
+		var prm DeletePrm
+		prm.SetAddress(addr)
+		_, err = blz.Delete(prm)
+		require.NoError(t, err)
+
+		deleted, _, err := blz.Utilization()
+		require.NoError(t, err)
+		require.Equal(t, used, deleted)
+	})
+}
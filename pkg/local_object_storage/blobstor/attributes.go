@@ -0,0 +1,34 @@
+package blobstor
+
+import (
+	"path"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// AttributeRule describes the object attribute routing the object
+// to the sub-storage.
+type AttributeRule struct {
+	// Key is an exact attribute key.
+	Key string
+	// Value is a pattern of the attribute value in path.Match syntax.
+	Value string
+}
+
+// matchAttributes checks whether the object has at least one attribute
+// matched by any of the rules.
+func matchAttributes(obj *objectSDK.Object, rules []AttributeRule) bool {
+	for _, a := range obj.Attributes() {
+		for i := range rules {
+			if a.Key() != rules[i].Key {
+				continue
+			}
+
+			if ok, _ := path.Match(rules[i].Value, a.Value()); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
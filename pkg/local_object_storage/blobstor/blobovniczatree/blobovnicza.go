@@ -75,6 +75,16 @@ type Blobovniczas struct {
 	// list of active (opened, non-filled) Blobovniczas
 	activeMtx sync.RWMutex
 	active    map[string]blobovniczaWithIndex
+
+	// geometry the objects are being migrated from,
+	// nil if there is no migration in progress
+	geoMtx      sync.RWMutex
+	oldGeometry *geometry
+
+	updateStorageID common.StorageIDUpdater
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
 }
 
 type blobovniczaWithIndex struct {
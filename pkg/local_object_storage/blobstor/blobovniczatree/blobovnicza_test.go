@@ -14,6 +14,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 )
@@ -162,3 +163,44 @@ func testFillOrder(t *testing.T, depth uint64) {
 		require.True(t, strings.HasSuffix(string(res.StorageID), "/0"))
 	}
 }
+
+func TestPutNoOverflow(t *testing.T) {
+	const (
+		objSize = 2 * 1024
+		blzSize = 5*objSize + objSize/2
+	)
+
+	b := NewBlobovniczaTree(
+		WithLogger(zaptest.NewLogger(t)),
+		WithObjectSizeLimit(blzSize),
+		WithBlobovniczaShallowWidth(2),
+		WithBlobovniczaShallowDepth(1),
+		WithRootPath(t.TempDir()),
+		WithBlobovniczaSize(blzSize))
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { require.NoError(t, b.Close()) })
+
+	// at least one of the blobovniczas is filled
+	ids := make(map[string]struct{})
+	for i := 0; i < 15; i++ {
+		var prm common.PutPrm
+		prm.Address = oidtest.Address()
+		prm.RawData = make([]byte, objSize)
+		prm.DontCompress = true
+
+		res, err := b.Put(prm)
+		require.NoError(t, err, i)
+
+		ids[string(res.StorageID)] = struct{}{}
+	}
+
+	for id := range ids {
+		blz, err := b.openBlobovnicza(id)
+		require.NoError(t, err)
+
+		used, capacity, err := blz.Utilization()
+		require.NoError(t, err)
+		require.LessOrEqual(t, used, capacity, id)
+	}
+}
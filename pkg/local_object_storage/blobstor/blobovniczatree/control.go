@@ -20,12 +20,16 @@ func (b *Blobovniczas) Open(readOnly bool) error {
 func (b *Blobovniczas) Init() error {
 	b.log.Debug("initializing Blobovnicza's")
 
+	if err := b.checkGeometry(); err != nil {
+		return err
+	}
+
 	if b.readOnly {
 		b.log.Debug("read-only mode, skip blobovniczas initialization...")
 		return nil
 	}
 
-	return b.iterateLeaves(func(p string) (bool, error) {
+	err := b.iterateLeaves(func(p string) (bool, error) {
 		blz, err := b.openBlobovniczaNoCache(p)
 		if err != nil {
 			return true, err
@@ -39,10 +43,19 @@ func (b *Blobovniczas) Init() error {
 		b.log.Debug("blobovnicza successfully initialized, closing...", zap.String("id", p))
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	b.startMigration()
+
+	return nil
 }
 
 // Close implements common.Storage.
 func (b *Blobovniczas) Close() error {
+	b.stopMigration()
+
 	b.activeMtx.Lock()
 
 	b.lruMtx.Lock()
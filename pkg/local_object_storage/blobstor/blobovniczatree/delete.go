@@ -21,14 +21,19 @@ func (b *Blobovniczas) Delete(prm common.DeletePrm) (res common.DeleteRes, err e
 	var bPrm blobovnicza.DeletePrm
 	bPrm.SetAddress(prm.Address)
 
-	if prm.StorageID != nil {
+	if prm.StorageID != nil && b.openableByID(prm.StorageID) {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
 		if err != nil {
 			return res, err
 		}
 
-		return b.deleteObject(blz, bPrm, prm)
+		res, err = b.deleteObject(blz, bPrm, prm)
+		if err == nil || !blobovnicza.IsErrNotFound(err) || b.migratingFrom() == nil {
+			return res, err
+		}
+
+		// the object could have been moved by the geometry migration
 	}
 
 	activeCache := make(map[string]struct{})
@@ -60,6 +65,14 @@ func (b *Blobovniczas) Delete(prm common.DeletePrm) (res common.DeleteRes, err e
 		return err == nil, nil
 	})
 
+	if err == nil && !objectFound {
+		err = b.iterateOldBlobovniczas(prm.Address, func(blz *blobovnicza.Blobovnicza) bool {
+			_, delErr := b.deleteObject(blz, bPrm, prm)
+			objectFound = delErr == nil
+			return objectFound
+		})
+	}
+
 	if err == nil && !objectFound {
 		// not found in any blobovnicza
 		var errNotFound apistatus.ObjectNotFound
@@ -35,5 +35,13 @@ func (b *Blobovniczas) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
 		return found, nil
 	})
 
+	if err == nil && !found {
+		err = b.iterateOldBlobovniczas(prm.Address, func(blz *blobovnicza.Blobovnicza) bool {
+			_, getErr := b.getObject(blz, gPrm)
+			found = getErr == nil
+			return found
+		})
+	}
+
 	return common.ExistsRes{Exists: found}, err
 }
@@ -0,0 +1,252 @@
+package blobovniczatree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"go.uber.org/zap"
+)
+
+// geometryFileName is a name of the file in the tree root which
+// stores the geometry the Blobovniczas were created with.
+const geometryFileName = ".geometry"
+
+// oldGeometryDir is a name of the directory in the tree root which holds
+// the Blobovniczas of the geometry being migrated from.
+const oldGeometryDir = ".old_geometry"
+
+// ErrGeometryMismatch is returned on initialization if the configured tree
+// geometry differs from the stored one and neither migration nor mismatch
+// compatibility is enabled.
+var ErrGeometryMismatch = errors.New("blobovnicza tree geometry mismatch")
+
+// geometry describes the shape of the Blobovnicza tree.
+type geometry struct {
+	Depth uint64 `json:"depth"`
+	Width uint64 `json:"width"`
+}
+
+func (g geometry) String() string {
+	return fmt.Sprintf("depth %d, width %d", g.Depth, g.Width)
+}
+
+// contains checks whether p is a path of the Blobovnicza of the tree.
+func (g geometry) contains(p string) bool {
+	parts := strings.Split(p, string(filepath.Separator))
+	if uint64(len(parts)) != g.Depth+1 {
+		return false
+	}
+
+	for i := range parts {
+		ind, err := strconv.ParseUint(parts[i], 16, 64)
+		if err != nil || ind >= g.Width {
+			return false
+		}
+	}
+
+	return true
+}
+
+// geometryState is a content of the geometry file.
+type geometryState struct {
+	geometry
+
+	// MigratingFrom is set while the objects are moved from the
+	// Blobovniczas of the old geometry.
+	MigratingFrom *geometry `json:"migrating_from,omitempty"`
+}
+
+// readGeometry reads the stored geometry of the tree. Returns false if the
+// geometry has never been stored.
+func (b *Blobovniczas) readGeometry() (geometryState, bool, error) {
+	var st geometryState
+
+	data, err := os.ReadFile(filepath.Join(b.rootPath, geometryFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return st, false, nil
+		}
+
+		return st, false, fmt.Errorf("could not read geometry file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, false, fmt.Errorf("could not decode geometry file: %w", err)
+	}
+
+	return st, true, nil
+}
+
+// writeGeometry stores the geometry of the tree.
+func (b *Blobovniczas) writeGeometry(st geometryState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+
+	if err := util.MkdirAllX(b.rootPath, b.perm); err != nil {
+		return fmt.Errorf("could not create tree root: %w", err)
+	}
+
+	tmp := filepath.Join(b.rootPath, geometryFileName+".tmp")
+	if err := os.WriteFile(tmp, data, b.perm); err != nil {
+		return fmt.Errorf("could not write geometry file: %w", err)
+	}
+
+	return os.Rename(tmp, filepath.Join(b.rootPath, geometryFileName))
+}
+
+// checkGeometry compares the configured geometry with the stored one.
+// Increased width of the same depth tree is compatible with the stored
+// geometry. Otherwise, the migration from the stored geometry is prepared
+// or the mismatch is ignored depending on the configuration.
+func (b *Blobovniczas) checkGeometry() error {
+	stored, ok, err := b.readGeometry()
+	if err != nil {
+		return err
+	}
+
+	current := b.geometry()
+
+	if !ok {
+		if b.readOnly {
+			return nil
+		}
+
+		return b.writeGeometry(geometryState{geometry: current})
+	}
+
+	if stored.MigratingFrom != nil {
+		if stored.geometry != current {
+			return fmt.Errorf("%w: migration from %s to %s is not completed, configured %s",
+				ErrGeometryMismatch, stored.MigratingFrom, stored.geometry, current)
+		}
+
+		b.setMigratingFrom(stored.MigratingFrom)
+
+		return nil
+	}
+
+	if stored.geometry == current {
+		return nil
+	}
+
+	switch {
+	case stored.Depth == current.Depth && stored.Width < current.Width:
+		// the Blobovniczas of the stored geometry are still within the tree
+		// and are searched through when the storage ID is unknown
+		b.log.Info("blobovnicza tree width increased",
+			zap.Stringer("stored", stored.geometry),
+			zap.Stringer("configured", current))
+
+		if b.readOnly {
+			return nil
+		}
+
+		return b.writeGeometry(geometryState{geometry: current})
+	case b.migrateGeometry:
+		if b.readOnly {
+			return fmt.Errorf("%w: stored %s, configured %s, migration is not possible in read-only mode",
+				ErrGeometryMismatch, stored.geometry, current)
+		}
+
+		b.log.Info("blobovnicza tree geometry changed, migrating objects",
+			zap.Stringer("stored", stored.geometry),
+			zap.Stringer("configured", current))
+
+		// the paths of the old Blobovniczas can clash with the new ones
+		if err := b.moveToOldGeometryDir(); err != nil {
+			return err
+		}
+
+		err := b.writeGeometry(geometryState{
+			geometry:      current,
+			MigratingFrom: &stored.geometry,
+		})
+		if err != nil {
+			return err
+		}
+
+		b.setMigratingFrom(&stored.geometry)
+
+		return nil
+	case b.allowGeometryMismatch:
+		if stored.Depth != current.Depth {
+			return fmt.Errorf("%w: stored %s, configured %s, depth can not be changed without migration",
+				ErrGeometryMismatch, stored.geometry, current)
+		}
+
+		b.log.Warn("blobovnicza tree geometry differs from the stored one, objects stored with the old geometry are available by storage ID only",
+			zap.Stringer("stored", stored.geometry),
+			zap.Stringer("configured", current))
+
+		return nil
+	default:
+		return fmt.Errorf("%w: stored %s, configured %s", ErrGeometryMismatch, stored.geometry, current)
+	}
+}
+
+// moveToOldGeometryDir moves the Blobovniczas of the tree root
+// to the directory of the geometry being migrated from.
+func (b *Blobovniczas) moveToOldGeometryDir() error {
+	entries, err := os.ReadDir(b.rootPath)
+	if err != nil {
+		return fmt.Errorf("could not read tree root: %w", err)
+	}
+
+	oldDir := filepath.Join(b.rootPath, oldGeometryDir)
+	if err := util.MkdirAllX(oldDir, b.perm); err != nil {
+		return fmt.Errorf("could not create directory for the old geometry: %w", err)
+	}
+
+	for i := range entries {
+		name := entries[i].Name()
+		if strings.HasPrefix(name, ".") {
+			// service files
+			continue
+		}
+
+		if err := os.Rename(filepath.Join(b.rootPath, name), filepath.Join(oldDir, name)); err != nil {
+			return fmt.Errorf("could not move %s to the directory for the old geometry: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// geometry returns the configured geometry of the tree.
+func (b *Blobovniczas) geometry() geometry {
+	return geometry{
+		Depth: b.blzShallowDepth,
+		Width: b.blzShallowWidth,
+	}
+}
+
+// migratingFrom returns the geometry the objects are being migrated from.
+// Returns nil if there is no migration in progress.
+func (b *Blobovniczas) migratingFrom() *geometry {
+	b.geoMtx.RLock()
+	defer b.geoMtx.RUnlock()
+
+	return b.oldGeometry
+}
+
+func (b *Blobovniczas) setMigratingFrom(g *geometry) {
+	b.geoMtx.Lock()
+	b.oldGeometry = g
+	b.geoMtx.Unlock()
+}
+
+// openableByID checks whether the Blobovnicza can be accessed by the
+// storage ID directly. During the migration, storage IDs of the objects
+// stored with the old geometry can point outside the current tree.
+func (b *Blobovniczas) openableByID(id []byte) bool {
+	return b.migratingFrom() == nil || b.geometry().contains(blobovnicza.NewIDFromBytes(id).String())
+}
@@ -0,0 +1,174 @@
+package blobovniczatree
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestGeometryMigration(t *testing.T) {
+	dir := t.TempDir()
+
+	newTree := func(depth, width uint64, opts ...Option) *Blobovniczas {
+		return NewBlobovniczaTree(append([]Option{
+			WithLogger(zaptest.NewLogger(t)),
+			WithObjectSizeLimit(2048),
+			WithBlobovniczaShallowDepth(depth),
+			WithBlobovniczaShallowWidth(width),
+			WithRootPath(dir),
+			WithBlobovniczaSize(1 << 20),
+		}, opts...)...)
+	}
+
+	// fill the tree with the old geometry
+	b := newTree(1, 2)
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+
+	objects := make(map[oid.Address][]byte)
+	for i := 0; i < 10; i++ {
+		var prm common.PutPrm
+		prm.Object = blobstortest.NewObject(1024)
+		prm.Address = object.AddressOf(prm.Object)
+
+		var err error
+		prm.RawData, err = prm.Object.Marshal()
+		require.NoError(t, err)
+
+		res, err := b.Put(prm)
+		require.NoError(t, err)
+
+		objects[prm.Address] = res.StorageID
+	}
+	require.NoError(t, b.Close())
+
+	requireObjects := func(b *Blobovniczas) {
+		for addr := range objects {
+			_, err := b.Get(common.GetPrm{Address: addr})
+			require.NoError(t, err)
+
+			res, err := b.Exists(common.ExistsPrm{Address: addr})
+			require.NoError(t, err)
+			require.True(t, res.Exists)
+		}
+	}
+
+	t.Run("mismatch", func(t *testing.T) {
+		b := newTree(2, 2)
+		require.NoError(t, b.Open(false))
+		require.ErrorIs(t, b.Init(), ErrGeometryMismatch)
+		require.NoError(t, b.Close())
+	})
+
+	t.Run("mismatch allowed", func(t *testing.T) {
+		// depth can be changed with the migration only
+		b := newTree(2, 2, WithGeometryMismatchAllowed(true))
+		require.NoError(t, b.Open(false))
+		require.ErrorIs(t, b.Init(), ErrGeometryMismatch)
+		require.NoError(t, b.Close())
+
+		b = newTree(1, 1, WithGeometryMismatchAllowed(true))
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+
+		for addr, id := range objects {
+			_, err := b.Get(common.GetPrm{Address: addr, StorageID: id})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, b.Close())
+
+		// the stored geometry must not be changed
+		b = newTree(1, 1)
+		require.NoError(t, b.Open(false))
+		require.ErrorIs(t, b.Init(), ErrGeometryMismatch)
+		require.NoError(t, b.Close())
+	})
+
+	t.Run("width increased", func(t *testing.T) {
+		dir := t.TempDir()
+
+		b := NewBlobovniczaTree(
+			WithLogger(zaptest.NewLogger(t)),
+			WithBlobovniczaShallowDepth(1),
+			WithBlobovniczaShallowWidth(2),
+			WithRootPath(dir))
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+		require.NoError(t, b.Close())
+
+		b = NewBlobovniczaTree(
+			WithLogger(zaptest.NewLogger(t)),
+			WithBlobovniczaShallowDepth(1),
+			WithBlobovniczaShallowWidth(3),
+			WithRootPath(dir))
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+
+		st, ok, err := b.readGeometry()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, geometryState{geometry: geometry{Depth: 1, Width: 3}}, st)
+		require.NoError(t, b.Close())
+	})
+
+	t.Run("migration", func(t *testing.T) {
+		var (
+			mtx     sync.Mutex
+			updated = make(map[oid.Address][]byte)
+		)
+
+		b := newTree(2, 2, WithGeometryMigration(true))
+		b.SetStorageIDUpdater(func(addr oid.Address, id []byte) error {
+			mtx.Lock()
+			updated[addr] = id
+			mtx.Unlock()
+			return nil
+		})
+
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+
+		// objects are available during the migration
+		requireObjects(b)
+
+		for addr, id := range objects {
+			_, err := b.Get(common.GetPrm{Address: addr, StorageID: id})
+			require.NoError(t, err)
+		}
+
+		require.Eventually(t, func() bool {
+			return b.migratingFrom() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		mtx.Lock()
+		require.Len(t, updated, len(objects))
+		for addr, id := range updated {
+			_, err := b.Get(common.GetPrm{Address: addr, StorageID: id})
+			require.NoError(t, err)
+		}
+		mtx.Unlock()
+
+		requireObjects(b)
+		require.NoError(t, b.Close())
+
+		_, err := os.Stat(filepath.Join(dir, oldGeometryDir))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		// the new geometry is stored
+		b = newTree(2, 2)
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+		requireObjects(b)
+		require.NoError(t, b.Close())
+	})
+}
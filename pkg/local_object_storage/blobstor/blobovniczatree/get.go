@@ -19,14 +19,19 @@ func (b *Blobovniczas) Get(prm common.GetPrm) (res common.GetRes, err error) {
 	var bPrm blobovnicza.GetPrm
 	bPrm.SetAddress(prm.Address)
 
-	if prm.StorageID != nil {
+	if prm.StorageID != nil && b.openableByID(prm.StorageID) {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
 		if err != nil {
 			return res, err
 		}
 
-		return b.getObject(blz, bPrm)
+		res, err = b.getObject(blz, bPrm)
+		if err == nil || !blobovnicza.IsErrNotFound(err) || b.migratingFrom() == nil {
+			return res, err
+		}
+
+		// the object could have been moved by the geometry migration
 	}
 
 	activeCache := make(map[string]struct{})
@@ -52,6 +57,14 @@ func (b *Blobovniczas) Get(prm common.GetPrm) (res common.GetRes, err error) {
 		return err == nil, nil
 	})
 
+	if err == nil && res.Object == nil {
+		err = b.iterateOldBlobovniczas(prm.Address, func(blz *blobovnicza.Blobovnicza) bool {
+			var getErr error
+			res, getErr = b.getObject(blz, bPrm)
+			return getErr == nil
+		})
+	}
+
 	if err == nil && res.Object == nil {
 		// not found in any blobovnicza
 		var errNotFound apistatus.ObjectNotFound
@@ -1,11 +1,14 @@
 package blobovniczatree
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
 
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
@@ -16,14 +19,19 @@ import (
 // If blobocvnicza ID is specified, only this blobovnicza is processed.
 // Otherwise, all Blobovniczas are processed descending weight.
 func (b *Blobovniczas) GetRange(prm common.GetRangePrm) (res common.GetRangeRes, err error) {
-	if prm.StorageID != nil {
+	if prm.StorageID != nil && b.openableByID(prm.StorageID) {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
 		if err != nil {
 			return common.GetRangeRes{}, err
 		}
 
-		return b.getObjectRange(blz, prm)
+		res, err = b.getObjectRange(blz, prm)
+		if err == nil || !blobovnicza.IsErrNotFound(err) || b.migratingFrom() == nil {
+			return res, err
+		}
+
+		// the object could have been moved by the geometry migration
 	}
 
 	activeCache := make(map[string]struct{})
@@ -56,6 +64,19 @@ func (b *Blobovniczas) GetRange(prm common.GetRangePrm) (res common.GetRangeRes,
 		return err == nil, nil
 	})
 
+	if err == nil && !objectFound {
+		var rngErr error
+
+		err = b.iterateOldBlobovniczas(prm.Address, func(blz *blobovnicza.Blobovnicza) bool {
+			res, rngErr = b.getObjectRange(blz, prm)
+			objectFound = rngErr == nil
+			return objectFound || isErrOutOfRange(rngErr)
+		})
+		if err == nil && isErrOutOfRange(rngErr) {
+			return common.GetRangeRes{}, rngErr
+		}
+	}
+
 	if err == nil && !objectFound {
 		// not found in any blobovnicza
 		var errNotFound apistatus.ObjectNotFound
@@ -140,21 +161,34 @@ func (b *Blobovniczas) getRangeFromLevel(prm common.GetRangePrm, blzPath string,
 }
 
 // reads range of object payload data from blobovnicza and returns GetRangeSmallRes.
+//
+// The payload range is read from the stored data directly if it is neither
+// compressed nor encrypted, otherwise the whole object is decoded.
 func (b *Blobovniczas) getObjectRange(blz *blobovnicza.Blobovnicza, prm common.GetRangePrm) (common.GetRangeRes, error) {
-	var gPrm blobovnicza.GetPrm
-	gPrm.SetAddress(prm.Address)
-
-	// we don't use GetRange call for now since blobovnicza
-	// stores data that is compressed on BlobStor side.
-	// If blobovnicza learns to do the compression itself,
-	// we can start using GetRange.
-	res, err := blz.Get(gPrm)
-	if err != nil {
-		return common.GetRangeRes{}, err
+	var (
+		res     common.GetRangeRes
+		encoded []byte
+	)
+
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	err := blz.View(prm.Address, func(data []byte) error {
+		if !compression.IsRaw(data) {
+			encoded = slice.Copy(data)
+			return nil
+		}
+
+		var err error
+		res.Data, err = common.ReadPayloadRange(bytes.NewReader(data), int64(len(data)), from, prm.Range.GetLength())
+		return err
+	})
+	if err != nil || encoded == nil {
+		return res, err
 	}
 
 	// decompress the data
-	data, err := b.compression.Decompress(res.Object())
+	data, err := b.compression.Decompress(encoded)
 	if err != nil {
 		return common.GetRangeRes{}, fmt.Errorf("could not decompress object data: %w", err)
 	}
@@ -165,8 +199,6 @@ func (b *Blobovniczas) getObjectRange(blz *blobovnicza.Blobovnicza, prm common.G
 		return common.GetRangeRes{}, fmt.Errorf("could not unmarshal the object: %w", err)
 	}
 
-	from := prm.Range.GetOffset()
-	to := from + prm.Range.GetLength()
 	payload := obj.Payload()
 
 	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
@@ -174,6 +206,7 @@ func (b *Blobovniczas) getObjectRange(blz *blobovnicza.Blobovnicza, prm common.G
 	}
 
 	return common.GetRangeRes{
-		Data: payload[from:to],
+		Data:     payload[from:to],
+		FullRead: true,
 	}, nil
 }
@@ -2,6 +2,7 @@ package blobovniczatree
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/nspcc-dev/hrw"
@@ -11,8 +12,34 @@ import (
 )
 
 // Iterate iterates over all objects in b.
+//
+// If the lazy handler is set, the object data is read and decompressed only
+// when the handler requests it.
 func (b *Blobovniczas) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
 	return common.IterateRes{}, b.iterateBlobovniczas(prm.IgnoreErrors, func(p string, blz *blobovnicza.Blobovnicza) error {
+		if prm.Handler == nil {
+			var errHandler func(error) error
+			if prm.IgnoreErrors {
+				errHandler = func(err error) error {
+					if prm.ErrorHandler != nil {
+						return prm.ErrorHandler(oid.Address{}, err)
+					}
+					return nil
+				}
+			}
+
+			return blz.IterateAddresses(func(addr oid.Address, data func() ([]byte, error)) error {
+				return prm.LazyHandler(addr, func() ([]byte, error) {
+					raw, err := data()
+					if err != nil {
+						return nil, err
+					}
+
+					return b.compression.Decompress(raw)
+				})
+			}, true, errHandler)
+		}
+
 		var subPrm blobovnicza.IteratePrm
 		subPrm.SetHandler(func(elem blobovnicza.IterationElement) error {
 			data, err := b.compression.Decompress(elem.ObjectData())
@@ -26,27 +53,26 @@ func (b *Blobovniczas) Iterate(prm common.IteratePrm) (common.IterateRes, error)
 				return fmt.Errorf("could not decompress object data: %w", err)
 			}
 
-			if prm.Handler != nil {
-				return prm.Handler(common.IterationElement{
-					Address:    elem.Address(),
-					ObjectData: data,
-					StorageID:  []byte(p),
-				})
-			}
-			return prm.LazyHandler(elem.Address(), func() ([]byte, error) {
-				return data, err
+			return prm.Handler(common.IterationElement{
+				Address:    elem.Address(),
+				ObjectData: data,
+				StorageID:  []byte(p),
 			})
 		})
 		subPrm.DecodeAddresses()
+		if prm.IgnoreErrors {
+			subPrm.IgnoreErrors()
+		}
 
 		_, err := blz.Iterate(subPrm)
 		return err
 	})
 }
 
-// iterator over all Blobovniczas in unsorted order. Break on f's error return.
+// iterator over all Blobovniczas in unsorted order including the ones
+// of the geometry being migrated from. Break on f's error return.
 func (b *Blobovniczas) iterateBlobovniczas(ignoreErrors bool, f func(string, *blobovnicza.Blobovnicza) error) error {
-	return b.iterateLeaves(func(p string) (bool, error) {
+	fn := func(p string) (bool, error) {
 		blz, err := b.openBlobovnicza(p)
 		if err != nil {
 			if ignoreErrors {
@@ -58,21 +84,54 @@ func (b *Blobovniczas) iterateBlobovniczas(ignoreErrors bool, f func(string, *bl
 		err = f(p, blz)
 
 		return err != nil, err
-	})
+	}
+
+	if err := b.iterateLeaves(fn); err != nil {
+		return err
+	}
+
+	return b.iterateOldLeaves(nil, fn)
 }
 
 // iterator over the paths of Blobovniczas sorted by weight.
 func (b *Blobovniczas) iterateSortedLeaves(addr *oid.Address, f func(string) (bool, error)) error {
+	return b.iterateSortedLeavesOf(b.geometry(), addr, f)
+}
+
+// iterator over the paths of Blobovniczas of the g geometry sorted by weight.
+func (b *Blobovniczas) iterateSortedLeavesOf(g geometry, addr *oid.Address, f func(string) (bool, error)) error {
 	_, err := b.iterateSorted(
 		addr,
-		make([]string, 0, b.blzShallowDepth),
-		b.blzShallowDepth,
+		make([]string, 0, g.Depth),
+		g.Depth,
+		g.Width,
 		func(p []string) (bool, error) { return f(filepath.Join(p...)) },
 	)
 
 	return err
 }
 
+// iterator over the paths of existing Blobovniczas of the geometry being
+// migrated from. Paths are relative to the tree root. Does nothing if there
+// is no migration in progress.
+func (b *Blobovniczas) iterateOldLeaves(addr *oid.Address, f func(string) (bool, error)) error {
+	old := b.migratingFrom()
+	if old == nil {
+		return nil
+	}
+
+	return b.iterateSortedLeavesOf(*old, addr, func(p string) (bool, error) {
+		p = filepath.Join(oldGeometryDir, p)
+
+		// do not create missing databases
+		if _, err := os.Stat(filepath.Join(b.rootPath, p)); err != nil {
+			return false, nil
+		}
+
+		return f(p)
+	})
+}
+
 // iterator over directories with Blobovniczas sorted by weight.
 func (b *Blobovniczas) iterateDeepest(addr oid.Address, f func(string) (bool, error)) error {
 	depth := b.blzShallowDepth
@@ -84,6 +143,7 @@ func (b *Blobovniczas) iterateDeepest(addr oid.Address, f func(string) (bool, er
 		&addr,
 		make([]string, 0, depth),
 		depth,
+		b.blzShallowWidth,
 		func(p []string) (bool, error) { return f(filepath.Join(p...)) },
 	)
 
@@ -91,8 +151,8 @@ func (b *Blobovniczas) iterateDeepest(addr oid.Address, f func(string) (bool, er
 }
 
 // iterator over particular level of directories.
-func (b *Blobovniczas) iterateSorted(addr *oid.Address, curPath []string, execDepth uint64, f func([]string) (bool, error)) (bool, error) {
-	indices := indexSlice(b.blzShallowWidth)
+func (b *Blobovniczas) iterateSorted(addr *oid.Address, curPath []string, execDepth, width uint64, f func([]string) (bool, error)) (bool, error) {
+	indices := indexSlice(width)
 
 	hrw.SortSliceByValue(indices, addressHash(addr, filepath.Join(curPath...)))
 
@@ -111,7 +171,7 @@ func (b *Blobovniczas) iterateSorted(addr *oid.Address, curPath []string, execDe
 			} else if stop {
 				return true, nil
 			}
-		} else if stop, err := b.iterateSorted(addr, curPath, execDepth, f); err != nil {
+		} else if stop, err := b.iterateSorted(addr, curPath, execDepth, width, f); err != nil {
 			return false, err
 		} else if stop {
 			return true, nil
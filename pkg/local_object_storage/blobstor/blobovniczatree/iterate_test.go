@@ -0,0 +1,103 @@
+package blobovniczatree
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestIterateIgnoreErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	newTree := func() *Blobovniczas {
+		return NewBlobovniczaTree(
+			WithLogger(test.NewLogger(false)),
+			WithObjectSizeLimit(2048),
+			WithBlobovniczaShallowWidth(1),
+			WithBlobovniczaShallowDepth(1),
+			WithRootPath(dir),
+			WithBlobovniczaSize(1<<20))
+	}
+
+	b := newTree()
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+
+	var prm common.PutPrm
+	prm.Object = blobstortest.NewObject(1024)
+	prm.Address = object.AddressOf(prm.Object)
+
+	res, err := b.Put(prm)
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+
+	// add the record with the undecodable key
+	db, err := bbolt.Open(filepath.Join(dir, string(res.StorageID)), 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(_ []byte, b *bbolt.Bucket) error {
+			return b.Put([]byte("invalid address"), []byte{1})
+		})
+	}))
+	require.NoError(t, db.Close())
+
+	b = newTree()
+	require.NoError(t, b.Open(true))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { require.NoError(t, b.Close()) })
+
+	var addrs []oid.Address
+
+	for _, tc := range []struct {
+		name string
+		prm  common.IteratePrm
+	}{
+		{name: "eager", prm: common.IteratePrm{
+			Handler: func(elem common.IterationElement) error {
+				addrs = append(addrs, elem.Address)
+				return nil
+			},
+		}},
+		{name: "lazy", prm: common.IteratePrm{
+			LazyHandler: func(addr oid.Address, _ func() ([]byte, error)) error {
+				addrs = append(addrs, addr)
+				return nil
+			},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := b.Iterate(tc.prm)
+			require.Error(t, err)
+
+			addrs = nil
+			tc.prm.IgnoreErrors = true
+
+			_, err = b.Iterate(tc.prm)
+			require.NoError(t, err)
+			require.Equal(t, []oid.Address{prm.Address}, addrs)
+		})
+	}
+
+	t.Run("error handler", func(t *testing.T) {
+		expectedErr := errors.New("stop iteration")
+
+		_, err := b.Iterate(common.IteratePrm{
+			LazyHandler: func(oid.Address, func() ([]byte, error)) error {
+				return nil
+			},
+			IgnoreErrors: true,
+			ErrorHandler: func(oid.Address, error) error {
+				return expectedErr
+			},
+		})
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
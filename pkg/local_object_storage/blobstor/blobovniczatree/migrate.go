@@ -0,0 +1,195 @@
+package blobovniczatree
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+var errMigrationInterrupted = errors.New("migration interrupted")
+
+// SetStorageIDUpdater sets the callback used to update storage IDs of
+// the objects moved during the geometry migration. Migration is not
+// started without the callback, old objects remain available for reading.
+func (b *Blobovniczas) SetStorageIDUpdater(f common.StorageIDUpdater) {
+	b.updateStorageID = f
+}
+
+// iterateOldBlobovniczas calls f for the Blobovniczas of the geometry being
+// migrated from sorted by weight until f returns true.
+func (b *Blobovniczas) iterateOldBlobovniczas(addr oid.Address, f func(*blobovnicza.Blobovnicza) bool) error {
+	return b.iterateOldLeaves(&addr, func(p string) (bool, error) {
+		blz, err := b.openBlobovnicza(p)
+		if err != nil {
+			b.log.Debug("could not open blobovnicza of the old geometry",
+				zap.String("path", p),
+				zap.String("error", err.Error()),
+			)
+
+			return false, nil
+		}
+
+		return f(blz), nil
+	})
+}
+
+// startMigration starts the background relocation of the objects
+// stored with the old geometry if there is one.
+func (b *Blobovniczas) startMigration() {
+	if b.readOnly || !b.migrateGeometry || b.migratingFrom() == nil {
+		return
+	}
+
+	if b.updateStorageID == nil {
+		b.log.Warn("storage ID updater is not set, objects are not migrated to the new blobovnicza tree geometry")
+		return
+	}
+
+	b.closeCh = make(chan struct{})
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+
+		if err := b.migrate(); err != nil {
+			b.log.Error("blobovnicza tree geometry migration failed", zap.Error(err))
+		}
+	}()
+}
+
+// stopMigration interrupts the migration and waits for it to finish.
+func (b *Blobovniczas) stopMigration() {
+	if b.closeCh != nil {
+		close(b.closeCh)
+		b.wg.Wait()
+		b.closeCh = nil
+	}
+}
+
+// migrate moves all the objects stored with the old geometry to the Blobovniczas
+// of the current one. After that, the current geometry is stored and the old
+// Blobovniczas are removed.
+func (b *Blobovniczas) migrate() error {
+	var paths []string
+
+	err := b.iterateOldLeaves(nil, func(p string) (bool, error) {
+		select {
+		case <-b.closeCh:
+			return true, errMigrationInterrupted
+		default:
+		}
+
+		paths = append(paths, p)
+
+		return false, b.migrateBlobovnicza(p)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := b.writeGeometry(geometryState{geometry: b.geometry()}); err != nil {
+		return err
+	}
+
+	b.setMigratingFrom(nil)
+
+	b.lruMtx.Lock()
+	for _, p := range paths {
+		b.opened.Remove(p) // closes the Blobovnicza
+	}
+	b.lruMtx.Unlock()
+
+	if err := os.RemoveAll(filepath.Join(b.rootPath, oldGeometryDir)); err != nil {
+		b.log.Warn("could not remove blobovniczas of the old geometry", zap.Error(err))
+	}
+
+	b.log.Info("blobovnicza tree geometry migration completed",
+		zap.Stringer("geometry", b.geometry()),
+		zap.Int("moved blobovniczas", len(paths)),
+	)
+
+	return nil
+}
+
+// migrateBlobovnicza moves all the objects from the Blobovnicza with path p
+// to the Blobovniczas of the current geometry.
+func (b *Blobovniczas) migrateBlobovnicza(p string) error {
+	blz, err := b.openBlobovnicza(p)
+	if err != nil {
+		return err
+	}
+
+	var addrs []oid.Address
+
+	err = blobovnicza.IterateAddresses(blz, func(addr oid.Address) error {
+		addrs = append(addrs, addr)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not list objects of blobovnicza %s: %w", p, err)
+	}
+
+	for i := range addrs {
+		select {
+		case <-b.closeCh:
+			return errMigrationInterrupted
+		default:
+		}
+
+		if err := b.migrateObject(blz, addrs[i]); err != nil {
+			return fmt.Errorf("could not move object %s from blobovnicza %s: %w", addrs[i], p, err)
+		}
+	}
+
+	b.log.Debug("blobovnicza of the old geometry has been emptied",
+		zap.String("path", p),
+		zap.Int("objects", len(addrs)),
+	)
+
+	return nil
+}
+
+func (b *Blobovniczas) migrateObject(blz *blobovnicza.Blobovnicza, addr oid.Address) error {
+	var gPrm blobovnicza.GetPrm
+	gPrm.SetAddress(addr)
+
+	res, err := blz.Get(gPrm)
+	if err != nil {
+		if blobovnicza.IsErrNotFound(err) {
+			// removed concurrently
+			return nil
+		}
+
+		return err
+	}
+
+	// the data is already compressed if needed
+	putRes, err := b.Put(common.PutPrm{
+		Address:      addr,
+		RawData:      res.Object(),
+		DontCompress: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := b.updateStorageID(addr, putRes.StorageID); err != nil {
+		return fmt.Errorf("could not update storage ID: %w", err)
+	}
+
+	var dPrm blobovnicza.DeletePrm
+	dPrm.SetAddress(addr)
+
+	_, err = blz.Delete(dPrm)
+	if err != nil && !blobovnicza.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -17,7 +17,11 @@ type cfg struct {
 	blzShallowDepth uint64
 	blzShallowWidth uint64
 	compression     *compression.Config
-	blzOpts         []blobovnicza.Option
+
+	migrateGeometry       bool
+	allowGeometryMismatch bool
+
+	blzOpts []blobovnicza.Option
 }
 
 type Option func(*cfg)
@@ -87,3 +91,22 @@ func WithObjectSizeLimit(sz uint64) Option {
 		c.blzOpts = append(c.blzOpts, blobovnicza.WithObjectSizeLimit(sz))
 	}
 }
+
+// WithGeometryMigration returns an option to migrate the objects stored with
+// another tree depth or width to the configured geometry. During the migration
+// the objects are read from both geometries, new objects are written with the
+// configured one.
+func WithGeometryMigration(migrate bool) Option {
+	return func(c *cfg) {
+		c.migrateGeometry = migrate
+	}
+}
+
+// WithGeometryMismatchAllowed returns an option to start the tree with the
+// configured geometry even if it differs from the stored one. The objects
+// stored with the old geometry remain available by storage ID only.
+func WithGeometryMismatchAllowed(allow bool) Option {
+	return func(c *cfg) {
+		c.allowGeometryMismatch = allow
+	}
+}
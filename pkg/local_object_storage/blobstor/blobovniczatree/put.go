@@ -21,9 +21,14 @@ func (b *Blobovniczas) Put(prm common.PutPrm) (common.PutRes, error) {
 		prm.RawData = b.compression.Compress(prm.RawData)
 	}
 
+	data, err := b.compression.Encrypt(prm.RawData)
+	if err != nil {
+		return common.PutRes{}, err
+	}
+
 	var putPrm blobovnicza.PutPrm
 	putPrm.SetAddress(prm.Address)
-	putPrm.SetMarshaledObject(prm.RawData)
+	putPrm.SetMarshaledObject(data)
 
 	var (
 		fn      func(string) (bool, error)
@@ -41,7 +46,14 @@ func (b *Blobovniczas) Put(prm common.PutPrm) (common.PutRes, error) {
 			return false, nil
 		}
 
-		if _, err := active.blz.Put(putPrm); err != nil {
+		if used, capacity, _ := active.blz.Utilization(); used+uint64(len(data)) > capacity {
+			// do not overflow the blobovnicza which is about to be filled
+			err = blobovnicza.ErrFull
+		} else {
+			_, err = active.blz.Put(putPrm)
+		}
+
+		if err != nil {
 			// check if blobovnicza is full
 			if errors.Is(err, blobovnicza.ErrFull) {
 				b.log.Debug("blobovnicza overflowed",
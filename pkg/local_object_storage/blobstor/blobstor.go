@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
@@ -16,6 +17,13 @@ import (
 type SubStorage struct {
 	Storage common.Storage
 	Policy  func(*objectSDK.Object, []byte) bool
+
+	// Attributes route the objects with at least one matching attribute
+	// to the sub-storage. Such objects are put to the first sub-storage
+	// with matching attributes whose policy accepts them regardless of
+	// the sub-storage order, other objects never get to it. Empty
+	// Attributes do not restrict the sub-storage.
+	Attributes []AttributeRule
 }
 
 // BlobStor represents NeoFS local BLOB storage.
@@ -24,6 +32,17 @@ type BlobStor struct {
 
 	modeMtx sync.RWMutex
 	mode    mode.Mode
+
+	// attrRouting is true if any sub-storage has Attributes.
+	attrRouting bool
+
+	// emptyIDStorages contains the indices of the sub-storages which may hold
+	// the objects with empty storage ID, starting from the last one.
+	emptyIDStorages []int
+
+	// idStorage is the index of the sub-storage which holds the objects
+	// with non-empty storage ID.
+	idStorage int
 }
 
 type Info = fstree.Info
@@ -35,6 +54,8 @@ type cfg struct {
 	compression compression.Config
 	log         *logger.Logger
 	storage     []SubStorage
+
+	updateStorageID common.StorageIDUpdater
 }
 
 func initConfig(c *cfg) {
@@ -52,11 +73,35 @@ func New(opts ...Option) *BlobStor {
 
 	for i := range bs.storage {
 		bs.storage[i].Storage.SetCompressor(&bs.compression)
+
+		if s, ok := bs.storage[i].Storage.(storageIDUpdaterSetter); ok && bs.updateStorageID != nil {
+			s.SetStorageIDUpdater(bs.updateStorageID)
+		}
+	}
+
+	for i := len(bs.storage) - 1; i >= 0; i-- {
+		if len(bs.storage[i].Attributes) > 0 {
+			bs.attrRouting = true
+		}
+		if bs.storage[i].Storage.Type() == fstree.Type {
+			bs.emptyIDStorages = append(bs.emptyIDStorages, i)
+		} else {
+			bs.idStorage = i
+		}
+	}
+	if len(bs.emptyIDStorages) == 0 && len(bs.storage) > 0 {
+		bs.emptyIDStorages = []int{len(bs.storage) - 1}
 	}
 
 	return bs
 }
 
+// storageIDUpdaterSetter is implemented by the sub-storages
+// which can relocate the stored objects.
+type storageIDUpdaterSetter interface {
+	SetStorageIDUpdater(common.StorageIDUpdater)
+}
+
 // SetLogger sets logger. It is used after the shard ID was generated to use it in logs.
 func (b *BlobStor) SetLogger(l *zap.Logger) {
 	b.log = l
@@ -97,3 +142,29 @@ func WithUncompressableContentTypes(values []string) Option {
 		c.compression.UncompressableContentTypes = values
 	}
 }
+
+// WithEncryption returns option to encrypt the stored objects with
+// AES-GCM using the keys from the provider. Encryption is applied after
+// the compression. Objects are decrypted on read with the key they have
+// been encrypted with, so the provider must keep the previous keys after
+// the rotation until all the objects are rewritten.
+//
+// Nil provider disables encryption of the new objects.
+func WithEncryption(keys encryption.KeyProvider) Option {
+	return func(c *cfg) {
+		if keys == nil {
+			c.compression.Encryption = nil
+			return
+		}
+
+		c.compression.Encryption = encryption.New(keys)
+	}
+}
+
+// WithStorageIDUpdater returns option to set the callback updating storage
+// IDs of the objects relocated inside the sub-storages.
+func WithStorageIDUpdater(f common.StorageIDUpdater) Option {
+	return func(c *cfg) {
+		c.updateStorageID = f
+	}
+}
@@ -1,13 +1,18 @@
 package blobstor
 
 import (
+	"bytes"
+	"crypto/rand"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/stretchr/testify/require"
@@ -171,3 +176,245 @@ func TestBlobstor_needsCompression(t *testing.T) {
 		require.False(t, b.NeedsCompression(obj))
 	})
 }
+
+func TestBlobStor_StorageIDOrder(t *testing.T) {
+	const smallSizeLimit = 512
+
+	dir := t.TempDir()
+
+	// FSTree goes first, the objects with non-empty storage ID are in the second component
+	bs := New(WithStorages([]SubStorage{
+		{
+			Storage: fstree.New(fstree.WithPath(dir)),
+			Policy: func(_ *objectSDK.Object, data []byte) bool {
+				return uint64(len(data)) > smallSizeLimit
+			},
+		},
+		{
+			Storage: blobovniczatree.NewBlobovniczaTree(
+				blobovniczatree.WithRootPath(filepath.Join(dir, "blobovniczas")),
+				blobovniczatree.WithBlobovniczaShallowWidth(1)),
+		},
+	}))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+	t.Cleanup(func() { _ = bs.Close() })
+
+	obj := testObject(smallSizeLimit / 2)
+	addr := object.AddressOf(obj)
+
+	res, err := bs.Put(common.PutPrm{Object: obj})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.StorageID)
+
+	gRes, err := bs.Get(common.GetPrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+	require.Equal(t, obj, gRes.Object)
+
+	var rng objectSDK.Range
+	rng.SetLength(1)
+
+	_, err = bs.GetRange(common.GetRangePrm{Address: addr, Range: rng, StorageID: res.StorageID})
+	require.NoError(t, err)
+
+	_, err = bs.Delete(common.DeletePrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+}
+
+func TestBlobStor_CanPut(t *testing.T) {
+	const smallSizeLimit = 512
+
+	newBlobStor := func(t *testing.T, compress bool) *BlobStor {
+		dir := t.TempDir()
+
+		// no sub-storage for the big objects
+		bs := New(
+			WithCompressObjects(compress),
+			WithStorages(defaultStorages(dir, smallSizeLimit)[:1]))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { _ = bs.Close() })
+		return bs
+	}
+
+	// compressed object fits the limit
+	obj := testObject(smallSizeLimit / 2)
+	obj.SetPayload(make([]byte, 2*smallSizeLimit))
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	t.Run("compressed", func(t *testing.T) {
+		bs := newBlobStor(t, true)
+
+		require.True(t, bs.CanPut(obj, data))
+
+		_, err := bs.Put(common.PutPrm{Object: obj, RawData: data})
+		require.NoError(t, err)
+	})
+	t.Run("uncompressed", func(t *testing.T) {
+		bs := newBlobStor(t, false)
+
+		require.False(t, bs.CanPut(obj, data))
+
+		_, err := bs.Put(common.PutPrm{Object: obj, RawData: data})
+		require.ErrorIs(t, err, ErrNoPlaceFound)
+	})
+}
+
+func TestEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	const smallSizeLimit = 512
+
+	newKey := func() []byte {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		return key
+	}
+
+	keys := map[string][]byte{"1": newKey()}
+
+	newBlobStor := func(t *testing.T, current string, compress bool) *BlobStor {
+		var opts = []Option{
+			WithCompressObjects(compress),
+			WithStorages(defaultStorages(dir, smallSizeLimit)),
+		}
+
+		if current != "" {
+			kp, err := encryption.NewStaticKeys(current, keys)
+			require.NoError(t, err)
+
+			opts = append(opts, WithEncryption(kp))
+		}
+
+		bs := New(opts...)
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		return bs
+	}
+
+	newObject := func(sz int) *objectSDK.Object {
+		obj := testObject(uint64(sz))
+		payload := make([]byte, len(obj.Payload()))
+		_, _ = rand.Read(payload)
+		obj.SetPayload(payload)
+		return obj
+	}
+
+	var objs []*objectSDK.Object
+
+	testPut := func(t *testing.T, b *BlobStor) {
+		for _, obj := range []*objectSDK.Object{
+			newObject(smallSizeLimit / 2), // blobovnicza
+			newObject(smallSizeLimit * 2), // FSTree
+		} {
+			_, err := b.Put(common.PutPrm{Object: obj})
+			require.NoError(t, err)
+
+			objs = append(objs, obj)
+		}
+	}
+
+	testGet := func(t *testing.T, b *BlobStor) {
+		for _, obj := range objs {
+			res, err := b.Get(common.GetPrm{Address: object.AddressOf(obj)})
+			require.NoError(t, err)
+			require.Equal(t, obj, res.Object)
+
+			var rng objectSDK.Range
+			rng.SetOffset(1)
+			rng.SetLength(10)
+
+			rngRes, err := b.GetRange(common.GetRangePrm{Address: object.AddressOf(obj), Range: rng})
+			require.NoError(t, err)
+			require.Equal(t, obj.Payload()[1:11], rngRes.Data)
+		}
+	}
+
+	// plaintext must not be stored on disk
+	requireNoPlaintext := func(t *testing.T) {
+		err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+
+			for _, obj := range objs {
+				require.False(t, bytes.Contains(data, obj.Payload()[:64]), "plaintext payload found in %s", p)
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	b := newBlobStor(t, "1", false)
+	testPut(t, b)
+	testGet(t, b)
+	require.NoError(t, b.Close())
+	requireNoPlaintext(t)
+
+	t.Run("key rotation", func(t *testing.T) {
+		keys["2"] = newKey()
+
+		b := newBlobStor(t, "2", true)
+		testGet(t, b) // objects encrypted with the previous key
+		testPut(t, b)
+		testGet(t, b)
+		require.NoError(t, b.Close())
+		requireNoPlaintext(t)
+
+		// new objects are tagged with the new key
+		ids := make(map[string]int)
+		err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || strings.Contains(p, blobovniczaDir) {
+				return err
+			}
+
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+
+			id, ok := encryption.KeyID(data)
+			require.True(t, ok)
+			ids[id]++
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"1": 1, "2": 1}, ids)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		delete(keys, "1")
+
+		b := newBlobStor(t, "2", false)
+		defer func() { require.NoError(t, b.Close()) }()
+
+		_, err := b.Get(common.GetPrm{Address: object.AddressOf(objs[0])})
+		require.Error(t, err)
+
+		_, err = b.Get(common.GetPrm{Address: object.AddressOf(objs[1])})
+		require.ErrorIs(t, err, encryption.ErrKeyNotFound)
+
+		// objects encrypted with the current key are available
+		_, err = b.Get(common.GetPrm{Address: object.AddressOf(objs[len(objs)-1])})
+		require.NoError(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		b := newBlobStor(t, "", false)
+		defer func() { require.NoError(t, b.Close()) }()
+
+		for _, obj := range objs {
+			_, err := b.Get(common.GetPrm{Address: object.AddressOf(obj)})
+			require.Error(t, err)
+		}
+	})
+}
@@ -12,5 +12,11 @@ type GetRangePrm struct {
 }
 
 type GetRangeRes struct {
+	// Data is the requested payload range. If the read fails in the middle,
+	// Data may be set to the leading part of the range read before the failure
+	// along with the returned error.
 	Data []byte
+	// FullRead is true if the whole object has been read to get the range,
+	// e.g. because it is stored compressed.
+	FullRead bool
 }
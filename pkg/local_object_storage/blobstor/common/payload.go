@@ -0,0 +1,107 @@
+package common
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+const (
+	// payloadFieldNum is the number of the payload field of the object message.
+	payloadFieldNum = 4
+	// bytesWireType is the protobuf wire type of the length-delimited fields.
+	bytesWireType = 2
+)
+
+// ReadPayloadRange reads ln bytes of the payload starting at off from the
+// binary object of the given size. Only the object fields preceding the
+// payload and the requested range are read from r, so the whole object is
+// not loaded into memory.
+//
+// The object must be neither compressed nor encrypted.
+//
+// Returns an error of type apistatus.ObjectOutOfRange if the requested range
+// is out of the payload bounds. If the payload read fails in the middle, the
+// bytes read before the failure are returned along with the error.
+func ReadPayloadRange(r io.ReaderAt, size int64, off, ln uint64) ([]byte, error) {
+	var (
+		pos        int64
+		payloadOff int64
+		payloadLen uint64
+	)
+
+	for pos < size {
+		tag, n, err := readUvarint(r, pos, size)
+		if err != nil {
+			return nil, fmt.Errorf("could not read field tag: %w", err)
+		}
+
+		pos += int64(n)
+
+		if tag&7 != bytesWireType {
+			return nil, fmt.Errorf("unexpected wire type %d of the field %d", tag&7, tag>>3)
+		}
+
+		l, n, err := readUvarint(r, pos, size)
+		if err != nil {
+			return nil, fmt.Errorf("could not read field length: %w", err)
+		}
+
+		pos += int64(n)
+
+		if l > uint64(size-pos) {
+			return nil, fmt.Errorf("field %d length overflows the object", tag>>3)
+		}
+
+		if tag>>3 == payloadFieldNum {
+			payloadOff, payloadLen = pos, l
+			break
+		}
+
+		pos += int64(l)
+	}
+
+	to := off + ln
+	if to < off || payloadLen < to {
+		return nil, apistatus.ObjectOutOfRange{}
+	}
+
+	data := make([]byte, ln)
+	if ln == 0 {
+		return data, nil
+	}
+
+	n, err := r.ReadAt(data, payloadOff+int64(off))
+	if n < len(data) {
+		if err == nil || errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return data[:n], fmt.Errorf("could not read payload: %w", err)
+	}
+
+	return data, nil
+}
+
+// readUvarint reads the varint-encoded number starting at pos.
+func readUvarint(r io.ReaderAt, pos, size int64) (uint64, int, error) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	if rest := size - pos; rest < int64(len(buf)) {
+		buf = buf[:rest]
+	}
+
+	n, err := r.ReadAt(buf, pos)
+	if n < len(buf) && err != nil && !errors.Is(err, io.EOF) {
+		return 0, 0, err
+	}
+
+	v, ln := binary.Uvarint(buf[:n])
+	if ln <= 0 {
+		return 0, 0, errors.New("invalid varint")
+	}
+
+	return v, ln, nil
+}
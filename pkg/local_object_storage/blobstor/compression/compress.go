@@ -2,9 +2,11 @@ package compression
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
@@ -13,6 +15,9 @@ type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
+	// Encryption encrypts the data after the compression, nil if disabled.
+	Encryption *encryption.Cipher
+
 	encoder *zstd.Encoder
 	decoder *zstd.Decoder
 }
@@ -71,15 +76,38 @@ func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
 	return c.Enabled
 }
 
-// Decompress decompresses data if it starts with the magic
-// and returns data untouched otherwise.
+// errNoEncryption is returned on attempt to read the encrypted data
+// without the encryption configured.
+var errNoEncryption = errors.New("data is encrypted, but encryption is not configured")
+
+// Decompress decrypts data if it is encrypted, then decompresses data if
+// it starts with the magic and returns data untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
+	if encryption.IsEncrypted(data) {
+		if c == nil || c.Encryption == nil {
+			return nil, errNoEncryption
+		}
+
+		var err error
+		data, err = c.Encryption.Decrypt(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
 		return data, nil
 	}
 	return c.decoder.DecodeAll(data, nil)
 }
 
+// IsRaw returns true if data is neither compressed nor encrypted, i.e. it is
+// the binary object itself which can be read partially. Only the first bytes
+// of data are checked, so the prefix of the stored data is enough.
+func IsRaw(data []byte) bool {
+	return !encryption.IsEncrypted(data) && (len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic))
+}
+
 // Compress compresses data if compression is enabled
 // and returns data untouched otherwise.
 func (c *Config) Compress(data []byte) []byte {
@@ -89,6 +117,15 @@ func (c *Config) Compress(data []byte) []byte {
 	return c.encoder.EncodeAll(data, make([]byte, 0, len(data)))
 }
 
+// Encrypt encrypts data if encryption is enabled and returns data
+// untouched otherwise. Already encrypted data is not encrypted again.
+func (c *Config) Encrypt(data []byte) ([]byte, error) {
+	if c == nil || c.Encryption == nil || encryption.IsEncrypted(data) {
+		return data, nil
+	}
+	return c.Encryption.Encrypt(data)
+}
+
 // Close closes encoder and decoder, returns any error occured.
 func (c *Config) Close() error {
 	var err error
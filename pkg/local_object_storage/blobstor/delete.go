@@ -26,7 +26,16 @@ func (b *BlobStor) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
 		}
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.Delete(prm)
+		// there may be several FSTree sub-storages
+		for _, i := range b.emptyIDStorages {
+			res, err := b.storage[i].Storage.Delete(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.DeleteRes{}, errNotFound
 	}
-	return b.storage[0].Storage.Delete(prm)
+	return b.storage[b.idStorage].Storage.Delete(prm)
 }
@@ -0,0 +1,191 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// KeyProvider provides the keys to encrypt and decrypt the data with.
+// Each key is identified by a non-empty ID of at most 255 bytes which is
+// stored along with the encrypted data, so the keys can be rotated: new
+// data is encrypted with the current key while the data encrypted with
+// the previous keys stays readable as long as the keys are provided.
+type KeyProvider interface {
+	// CurrentKey returns the ID and the key to encrypt new data with.
+	CurrentKey() (string, []byte, error)
+
+	// Key returns the key with the given ID.
+	Key(id string) ([]byte, error)
+}
+
+// ErrKeyNotFound is returned by StaticKeys if there is no key with the requested ID.
+var ErrKeyNotFound = errors.New("encryption key not found")
+
+// encryptedMagic contains first 4 bytes of any encrypted data. Neither
+// marshaled object (starting with protobuf field tag) nor compressed data
+// (starting with zstd frame magic) can start with it.
+var encryptedMagic = []byte{0x00, 'e', 'n', 'c'}
+
+// Cipher encrypts and decrypts the data with AES-GCM.
+//
+// Encrypted data consists of the magic, the length of the key ID (1 byte),
+// the key ID, the nonce and the sealed data authenticated together with
+// the key ID.
+type Cipher struct {
+	keys KeyProvider
+}
+
+// New returns new Cipher using the keys from the provider.
+func New(keys KeyProvider) *Cipher {
+	return &Cipher{keys: keys}
+}
+
+// IsEncrypted returns true if data is encrypted by Cipher.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(encryptedMagic) && bytes.Equal(data[:len(encryptedMagic)], encryptedMagic)
+}
+
+// KeyID returns the ID of the key data is encrypted with.
+// Returns false if data is not encrypted.
+func KeyID(data []byte) (string, bool) {
+	id, _, err := splitHeader(data)
+	return id, err == nil
+}
+
+// Encrypt encrypts data with the current key of the provider.
+func (c *Cipher) Encrypt(data []byte) ([]byte, error) {
+	id, key, err := c.keys.CurrentKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current encryption key: %w", err)
+	}
+
+	if len(id) == 0 || len(id) > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid encryption key ID length %d", len(id))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	hdrLen := len(encryptedMagic) + 1 + len(id)
+	res := make([]byte, hdrLen+aead.NonceSize(), hdrLen+aead.NonceSize()+len(data)+aead.Overhead())
+
+	copy(res, encryptedMagic)
+	res[len(encryptedMagic)] = byte(len(id))
+	copy(res[len(encryptedMagic)+1:], id)
+
+	nonce := res[hdrLen:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("could not generate nonce: %w", err)
+	}
+
+	return aead.Seal(res, nonce, data, []byte(id)), nil
+}
+
+// Decrypt decrypts data encrypted by Encrypt with the key
+// the data is tagged with.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	id, rest, err := splitHeader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.keys.Key(id)
+	if err != nil {
+		return nil, fmt.Errorf("could not get encryption key %q: %w", id, err)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("encrypted data is too short")
+	}
+
+	res, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt data with key %q: %w", id, err)
+	}
+
+	return res, nil
+}
+
+func splitHeader(data []byte) (string, []byte, error) {
+	if !IsEncrypted(data) {
+		return "", nil, errors.New("data is not encrypted")
+	}
+
+	data = data[len(encryptedMagic):]
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return "", nil, errors.New("invalid encrypted data header")
+	}
+
+	return string(data[1 : 1+data[0]]), data[1+data[0]:], nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// StaticKeys is a KeyProvider with the fixed set of keys.
+type StaticKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewStaticKeys returns KeyProvider with the keys from the map. The key with
+// the current ID is used to encrypt new data, the other keys are used to
+// decrypt the data encrypted before. Keys must be 16, 24 or 32 bytes long
+// to select AES-128, AES-192 or AES-256.
+func NewStaticKeys(current string, keys map[string][]byte) (*StaticKeys, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("missing current encryption key %q", current)
+	}
+
+	res := &StaticKeys{
+		current: current,
+		keys:    make(map[string][]byte, len(keys)),
+	}
+
+	for id, key := range keys {
+		if len(id) == 0 || len(id) > math.MaxUint8 {
+			return nil, fmt.Errorf("invalid encryption key ID length %d", len(id))
+		}
+
+		if _, err := aes.NewCipher(key); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", id, err)
+		}
+
+		res.keys[id] = key
+	}
+
+	return res, nil
+}
+
+// CurrentKey implements KeyProvider.
+func (x *StaticKeys) CurrentKey() (string, []byte, error) {
+	return x.current, x.keys[x.current], nil
+}
+
+// Key implements KeyProvider.
+func (x *StaticKeys) Key(id string) ([]byte, error) {
+	key, ok := x.keys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
@@ -0,0 +1,105 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T, sz int) []byte {
+	key := make([]byte, sz)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestCipher(t *testing.T) {
+	data := []byte("some data to be encrypted")
+
+	keys := map[string][]byte{"old": newKey(t, 16)}
+
+	kp, err := NewStaticKeys("old", keys)
+	require.NoError(t, err)
+
+	encOld, err := New(kp).Encrypt(data)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encOld))
+	require.False(t, bytes.Contains(encOld, data))
+
+	id, ok := KeyID(encOld)
+	require.True(t, ok)
+	require.Equal(t, "old", id)
+
+	keys["new"] = newKey(t, 32)
+	kp, err = NewStaticKeys("new", keys)
+	require.NoError(t, err)
+
+	c := New(kp)
+
+	encNew, err := c.Encrypt(data)
+	require.NoError(t, err)
+
+	id, _ = KeyID(encNew)
+	require.Equal(t, "new", id)
+
+	for _, enc := range [][]byte{encOld, encNew} {
+		res, err := c.Decrypt(enc)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	}
+
+	t.Run("nonce", func(t *testing.T) {
+		enc, err := c.Encrypt(data)
+		require.NoError(t, err)
+		require.NotEqual(t, encNew, enc)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		enc := append([]byte(nil), encNew...)
+		enc[len(enc)-1]++
+
+		_, err := c.Decrypt(enc)
+		require.Error(t, err)
+
+		// key ID is authenticated
+		kp, err := NewStaticKeys("new", map[string][]byte{"new": keys["new"], "wen": keys["new"]})
+		require.NoError(t, err)
+
+		enc = append([]byte(nil), encNew...)
+		copy(enc[len(encryptedMagic)+1:], "wen")
+
+		_, err = New(kp).Decrypt(enc)
+		require.Error(t, err)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		kp, err := NewStaticKeys("new", map[string][]byte{"new": keys["new"]})
+		require.NoError(t, err)
+
+		_, err = New(kp).Decrypt(encOld)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		require.False(t, IsEncrypted(data))
+
+		_, ok := KeyID(data)
+		require.False(t, ok)
+
+		_, err := c.Decrypt(data)
+		require.Error(t, err)
+	})
+}
+
+func TestNewStaticKeys(t *testing.T) {
+	_, err := NewStaticKeys("1", map[string][]byte{"2": newKey(t, 32)})
+	require.Error(t, err)
+
+	_, err = NewStaticKeys("1", map[string][]byte{"1": newKey(t, 10)})
+	require.Error(t, err)
+
+	_, err = NewStaticKeys("", map[string][]byte{"": newKey(t, 32)})
+	require.Error(t, err)
+}
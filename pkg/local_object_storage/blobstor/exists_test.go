@@ -1,6 +1,7 @@
 package blobstor
 
 import (
+	"crypto/rand"
 	"os"
 	"path/filepath"
 	"testing"
@@ -85,7 +86,10 @@ func testObject(sz uint64) *objectSDK.Object {
 	raw.SetID(oidtest.ID())
 	raw.SetContainerID(cidtest.ID())
 
-	raw.SetPayload(make([]byte, sz))
+	// random payload is not compressed, so the written size is close to sz
+	payload := make([]byte, sz)
+	_, _ = rand.Read(payload)
+	raw.SetPayload(payload)
 
 	// fit the binary size to the required
 	data, _ := raw.Marshal()
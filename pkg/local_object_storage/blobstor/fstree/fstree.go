@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -92,16 +93,59 @@ func addressFromString(s string) (*oid.Address, error) {
 	return &addr, nil
 }
 
+// AddressFromPath returns the address of the object stored in the file
+// with the specified path. Returns an error if the path is not the path of
+// the object file in the tree.
+func (t *FSTree) AddressFromPath(p string) (oid.Address, error) {
+	rel, err := filepath.Rel(t.RootPath, p)
+	if err != nil {
+		return oid.Address{}, err
+	}
+
+	parts := strings.Split(rel, string(filepath.Separator))
+	if uint64(len(parts)) != t.Depth+1 {
+		return oid.Address{}, fmt.Errorf("invalid path depth %d", len(parts)-1)
+	}
+
+	for i := range parts[:t.Depth] {
+		if len(parts[i]) != t.DirNameLen {
+			return oid.Address{}, fmt.Errorf("invalid directory name %s", parts[i])
+		}
+	}
+
+	addr, err := addressFromString(strings.Join(parts, ""))
+	if err != nil {
+		return oid.Address{}, err
+	}
+
+	if t.treePath(*addr) != filepath.Join(t.RootPath, rel) {
+		return oid.Address{}, errors.New("non-canonical object path")
+	}
+
+	return *addr, nil
+}
+
 // Iterate iterates over all stored objects.
 func (t *FSTree) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
-	return common.IterateRes{}, t.iterate(0, []string{t.RootPath}, prm)
+	return common.IterateRes{}, t.iterate(0, []string{t.RootPath}, prm, "")
+}
+
+// IterateAfter is the same as Iterate, but skips the objects preceding the
+// object with the specified address (inclusive) in the iteration order, so
+// that the interrupted iteration can be continued. Objects are iterated in
+// the order of their paths in the tree.
+func (t *FSTree) IterateAfter(addr oid.Address, prm common.IteratePrm) (common.IterateRes, error) {
+	return common.IterateRes{}, t.iterate(0, []string{t.RootPath}, prm, stringifyAddress(addr))
 }
 
-func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm) error {
+func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm, after string) error {
 	curName := strings.Join(curPath[1:], "")
 	des, err := os.ReadDir(filepath.Join(curPath...))
 	if err != nil {
 		if prm.IgnoreErrors {
+			if prm.ErrorHandler != nil {
+				return prm.ErrorHandler(oid.Address{}, err)
+			}
 			return nil
 		}
 		return err
@@ -114,8 +158,17 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 	for i := range des {
 		curPath[l] = des[i].Name()
 
+		if after != "" && skipPath(curName+des[i].Name(), after, isLast) {
+			continue
+		}
+
 		if !isLast && des[i].IsDir() {
-			err := t.iterate(depth+1, curPath, prm)
+			if len(des[i].Name()) != t.DirNameLen {
+				// not a directory of the tree (e.g. quarantine)
+				continue
+			}
+
+			err := t.iterate(depth+1, curPath, prm, after)
 			if err != nil {
 				// Must be error from handler in case errors are ignored.
 				// Need to report.
@@ -145,7 +198,9 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 			if err != nil {
 				if prm.IgnoreErrors {
 					if prm.ErrorHandler != nil {
-						return prm.ErrorHandler(*addr, err)
+						if err := prm.ErrorHandler(*addr, err); err != nil {
+							return err
+						}
 					}
 					continue
 				}
@@ -167,6 +222,21 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 	return nil
 }
 
+// skipPath checks whether the path of the tree precedes the path of the
+// object with stringified address after in the iteration order. Directories
+// are compared with the prefix of the address of the same length.
+func skipPath(name, after string, isLast bool) bool {
+	if isLast {
+		return name <= after
+	}
+
+	if len(name) < len(after) {
+		after = after[:len(name)]
+	}
+
+	return name < after
+}
+
 func (t *FSTree) treePath(addr oid.Address) string {
 	sAddr := stringifyAddress(addr)
 
@@ -238,7 +308,12 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 		prm.RawData = t.Compress(prm.RawData)
 	}
 
-	err := os.WriteFile(p, prm.RawData, t.Permissions)
+	data, err := t.Encrypt(prm.RawData)
+	if err != nil {
+		return common.PutRes{}, err
+	}
+
+	err = os.WriteFile(p, data, t.Permissions)
 	if err != nil {
 		var pe *fs.PathError
 		if errors.As(err, &pe) && pe.Err == syscall.ENOSPC {
@@ -297,7 +372,40 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 }
 
 // GetRange implements common.Storage.
+//
+// Only the requested payload range is read from the file if the object is
+// stored neither compressed nor encrypted, otherwise the whole object is read.
 func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	f, err := os.Open(t.treePath(prm.Address))
+	if err != nil {
+		if os.IsNotExist(err) {
+			var errNotFound apistatus.ObjectNotFound
+			return common.GetRangeRes{}, errNotFound
+		}
+		return common.GetRangeRes{}, err
+	}
+	defer f.Close()
+
+	st, err := f.Stat()
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	prefix := make([]byte, 4)
+	n, err := f.ReadAt(prefix, 0)
+	if n < len(prefix) && !errors.Is(err, io.EOF) {
+		return common.GetRangeRes{}, err
+	}
+
+	if compression.IsRaw(prefix[:n]) {
+		data, err := common.ReadPayloadRange(f, st.Size(), prm.Range.GetOffset(), prm.Range.GetLength())
+		if err != nil {
+			return common.GetRangeRes{Data: data}, err
+		}
+
+		return common.GetRangeRes{Data: data}, nil
+	}
+
 	res, err := t.Get(common.GetPrm{Address: prm.Address})
 	if err != nil {
 		return common.GetRangeRes{}, err
@@ -312,7 +420,8 @@ func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
 	}
 
 	return common.GetRangeRes{
-		Data: payload[from:to],
+		Data:     payload[from:to],
+		FullRead: true,
 	}, nil
 }
 
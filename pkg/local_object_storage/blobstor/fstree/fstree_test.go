@@ -1,8 +1,11 @@
 package fstree
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
@@ -14,3 +17,81 @@ func TestAddressToString(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, addr, *actual)
 }
+
+func TestFSTree_IterateAfter(t *testing.T) {
+	fs := New(WithPath(t.TempDir()), WithDepth(2))
+	require.NoError(t, fs.Open(false))
+	require.NoError(t, fs.Init())
+
+	const n = 20
+
+	for i := 0; i < n; i++ {
+		_, err := fs.Put(common.PutPrm{Address: oidtest.Address(), RawData: []byte{byte(i)}})
+		require.NoError(t, err)
+	}
+
+	list := func(t *testing.T, f func(common.IteratePrm) (common.IterateRes, error)) []oid.Address {
+		var res []oid.Address
+		_, err := f(common.IteratePrm{
+			Handler: func(elem common.IterationElement) error {
+				res = append(res, elem.Address)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	all := list(t, fs.Iterate)
+	require.Len(t, all, n)
+
+	for i := range all {
+		after := list(t, func(prm common.IteratePrm) (common.IterateRes, error) {
+			return fs.IterateAfter(all[i], prm)
+		})
+		require.Len(t, after, n-i-1)
+		if len(after) > 0 {
+			require.Equal(t, all[i+1:], after)
+		}
+	}
+
+	t.Run("missing object", func(t *testing.T) {
+		// any address is suitable, objects are iterated in the same order
+		addr := oidtest.Address()
+		after := list(t, func(prm common.IteratePrm) (common.IterateRes, error) {
+			return fs.IterateAfter(addr, prm)
+		})
+
+		s := stringifyAddress(addr)
+		for i := range all {
+			if stringifyAddress(all[i]) > s {
+				require.Equal(t, all[i:], after)
+				return
+			}
+		}
+		require.Empty(t, after)
+	})
+}
+
+func TestFSTree_AddressFromPath(t *testing.T) {
+	fs := New(WithPath(t.TempDir()), WithDepth(2))
+
+	addr := oidtest.Address()
+	p := fs.treePath(addr)
+
+	actual, err := fs.AddressFromPath(p)
+	require.NoError(t, err)
+	require.Equal(t, addr, actual)
+
+	sAddr := stringifyAddress(addr)
+	for _, p := range []string{
+		filepath.Join(fs.RootPath, sAddr),
+		filepath.Join(fs.RootPath, sAddr[:1], sAddr[1:]),
+		filepath.Join(fs.RootPath, sAddr[:2], sAddr[2:3], sAddr[3:]),
+		filepath.Join(fs.RootPath, sAddr[:1], sAddr[1:2], "tmp"+sAddr[2:]),
+		filepath.Join(fs.RootPath, "quarantine", sAddr[:1], sAddr[1:]),
+	} {
+		_, err := fs.AddressFromPath(p)
+		require.Error(t, err, p)
+	}
+}
@@ -8,8 +8,9 @@ import (
 )
 
 // Get reads the object from b.
-// If the descriptor is present, only one sub-storage is tried,
-// Otherwise, each sub-storage is tried in order.
+// If the descriptor is present, only the sub-storages which could save
+// the object with such descriptor are tried, otherwise, each sub-storage
+// is tried in order.
 func (b *BlobStor) Get(prm common.GetPrm) (common.GetRes, error) {
 	if prm.StorageID == nil {
 		for i := range b.storage {
@@ -23,7 +24,16 @@ func (b *BlobStor) Get(prm common.GetPrm) (common.GetRes, error) {
 		return common.GetRes{}, errNotFound
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.Get(prm)
+		// there may be several FSTree sub-storages
+		for _, i := range b.emptyIDStorages {
+			res, err := b.storage[i].Storage.Get(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetRes{}, errNotFound
 	}
-	return b.storage[0].Storage.Get(prm)
+	return b.storage[b.idStorage].Storage.Get(prm)
 }
@@ -8,8 +8,9 @@ import (
 )
 
 // GetRange reads object payload data from b.
-// If the descriptor is present, only one sub-storage is tried,
-// Otherwise, each sub-storage is tried in order.
+// If the descriptor is present, only the sub-storages which could save
+// the object with such descriptor are tried, otherwise, each sub-storage
+// is tried in order.
 func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
 	if prm.StorageID == nil {
 		for i := range b.storage {
@@ -23,7 +24,16 @@ func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error)
 		return common.GetRangeRes{}, errNotFound
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.GetRange(prm)
+		// there may be several FSTree sub-storages
+		for _, i := range b.emptyIDStorages {
+			res, err := b.storage[i].Storage.GetRange(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetRangeRes{}, errNotFound
 	}
-	return b.storage[0].Storage.GetRange(prm)
+	return b.storage[b.idStorage].Storage.GetRange(prm)
 }
@@ -0,0 +1,58 @@
+package blobstor
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStor_GetRange(t *testing.T) {
+	const smallSizeLimit = 512
+
+	for _, compress := range []bool{false, true} {
+		t.Run(fmt.Sprintf("compress=%t", compress), func(t *testing.T) {
+			bs := New(
+				WithCompressObjects(compress),
+				WithStorages(defaultStorages(t.TempDir(), smallSizeLimit)))
+			require.NoError(t, bs.Open(false))
+			require.NoError(t, bs.Init())
+			t.Cleanup(func() { require.NoError(t, bs.Close()) })
+
+			for _, sz := range []uint64{smallSizeLimit / 2, smallSizeLimit * 2} {
+				obj := testObject(sz)
+
+				payload := make([]byte, len(obj.Payload()))
+				_, _ = rand.Read(payload)
+				obj.SetPayload(payload)
+
+				_, err := bs.Put(common.PutPrm{Address: object.AddressOf(obj), Object: obj})
+				require.NoError(t, err)
+
+				var prm common.GetRangePrm
+				prm.Address = object.AddressOf(obj)
+				prm.Range.SetOffset(10)
+				prm.Range.SetLength(100)
+
+				res, err := bs.GetRange(prm)
+				require.NoError(t, err)
+				require.Equal(t, payload[10:110], res.Data)
+				// only uncompressed objects can be read partially
+				require.Equal(t, compress, res.FullRead)
+
+				rng := objectSDK.NewRange()
+				rng.SetOffset(uint64(len(payload)) - 10)
+				rng.SetLength(11)
+				prm.Range = *rng
+
+				_, err = bs.GetRange(prm)
+				require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package blobstor
 
 import (
+	"crypto/rand"
 	"encoding/binary"
 	"os"
 	"testing"
@@ -51,6 +52,10 @@ func TestIterateObjects(t *testing.T) {
 		}
 
 		data := make([]byte, sz)
+		if big {
+			// the size limit is checked against compressed data
+			_, _ = rand.Read(data)
+		}
 		binary.BigEndian.PutUint64(data, i)
 
 		addr := oidtest.Address()
@@ -0,0 +1,151 @@
+package blobstor
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ErrNoFSTree is returned by MigrateFSTree if BlobStor has no FSTree sub-storage.
+var ErrNoFSTree = errors.New("no FSTree sub-storage")
+
+// FSTreeMigrationPrm groups the parameters of MigrateFSTree operation.
+type FSTreeMigrationPrm struct {
+	// After is the address of the last object processed by the previous
+	// migration. If set, the objects preceding it are skipped.
+	After *oid.Address
+	// Handler is called for every processed FSTree object. Migration is
+	// stopped and the error is returned if the handler returns an error.
+	Handler func(FSTreeMigrationElement) error
+}
+
+// FSTreeMigrationElement describes the FSTree object processed by MigrateFSTree.
+type FSTreeMigrationElement struct {
+	Address oid.Address
+	// Moved is true if the object has been moved to another sub-storage.
+	Moved bool
+	// Err is the error that did not allow to move the object.
+	Err error
+}
+
+// MigrateFSTree moves the objects stored in FSTree to the sub-storages they
+// belong to according to the current storage policies, e.g. to the blobovnicza
+// tree after the small object size has been raised. If there are several FSTree
+// sub-storages, the last one without Attributes is migrated. Object is put to the new
+// sub-storage, its storage ID is updated with the storage ID updater (see
+// WithStorageIDUpdater), and only after that the object is removed from FSTree.
+//
+// Objects are processed in the FSTree iteration order, so the interrupted
+// migration can be continued from the last processed object.
+//
+// Returns ErrNoFSTree if there is no FSTree sub-storage.
+func (b *BlobStor) MigrateFSTree(prm FSTreeMigrationPrm) error {
+	if b.updateStorageID == nil {
+		return errors.New("storage ID updater is not set")
+	}
+
+	var fsTree *fstree.FSTree
+	for i := len(b.storage) - 1; i >= 0; i-- {
+		// the one accepting the objects without routing attributes
+		if t, ok := b.storage[i].Storage.(*fstree.FSTree); ok && len(b.storage[i].Attributes) == 0 {
+			fsTree = t
+			break
+		}
+	}
+
+	if fsTree == nil {
+		return ErrNoFSTree
+	}
+
+	var iPrm common.IteratePrm
+	iPrm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+		moved, err := b.migrateFromFSTree(fsTree, addr, f)
+		return prm.Handler(FSTreeMigrationElement{
+			Address: addr,
+			Moved:   moved,
+			Err:     err,
+		})
+	}
+
+	var err error
+	if prm.After != nil {
+		_, err = fsTree.IterateAfter(*prm.After, iPrm)
+	} else {
+		_, err = fsTree.Iterate(iPrm)
+	}
+
+	return err
+}
+
+func (b *BlobStor) migrateFromFSTree(fsTree *fstree.FSTree, addr oid.Address, f func() ([]byte, error)) (bool, error) {
+	raw, err := f()
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// removed concurrently
+			return false, nil
+		}
+		return false, fmt.Errorf("could not read object: %w", err)
+	}
+
+	data, err := fsTree.Decompress(raw)
+	if err != nil {
+		return false, fmt.Errorf("could not decompress object: %w", err)
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return false, fmt.Errorf("could not unmarshal object: %w", err)
+	}
+
+	i, ok := b.storageFor(obj, data, raw)
+	if !ok || b.storage[i].Storage == common.Storage(fsTree) {
+		return false, nil
+	}
+
+	target := b.storage[i].Storage
+
+	// the data is already compressed if needed
+	res, err := target.Put(common.PutPrm{
+		Address:      addr,
+		Object:       obj,
+		RawData:      raw,
+		DontCompress: true,
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not put object to %s: %w", target.Type(), err)
+	}
+
+	if err := b.updateStorageID(addr, res.StorageID); err != nil {
+		// the object is still referenced as FSTree one, remove the new copy
+		if _, delErr := target.Delete(common.DeletePrm{Address: addr, StorageID: res.StorageID}); delErr != nil {
+			b.log.Warn("could not remove migrated object copy after storage ID update failure",
+				zap.Stringer("address", addr),
+				zap.String("type", target.Type()),
+				zap.String("error", delErr.Error()))
+
+			return false, fmt.Errorf("could not update storage ID: %w (remove copy from %s: %v)", err, target.Type(), delErr)
+		}
+		return false, fmt.Errorf("could not update storage ID: %w", err)
+	}
+
+	if _, err := fsTree.Delete(common.DeletePrm{Address: addr}); err != nil {
+		b.log.Warn("could not remove migrated object from FSTree",
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()))
+	}
+
+	storagelog.Write(b.log,
+		storagelog.AddressField(addr),
+		storagelog.OpField("MIGRATE"),
+		zap.String("type", target.Type()),
+		zap.String("storage ID", string(res.StorageID)))
+
+	return true, nil
+}
@@ -0,0 +1,166 @@
+package blobstor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStor_MigrateFSTree(t *testing.T) {
+	const (
+		smallSizeLimit = 512
+		objCount       = 5
+	)
+
+	dir := t.TempDir()
+
+	// all the objects are put to FSTree with the zero limit
+	bs := New(WithCompressObjects(true), WithStorages(defaultStorages(dir, 0)))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+
+	small := make(map[oid.Address]struct{}, objCount)
+	for i := 0; i < objCount; i++ {
+		for _, obj := range []*objectSDK.Object{testObject(smallSizeLimit / 2), testObject(smallSizeLimit * 2)} {
+			_, err := bs.Put(common.PutPrm{Object: obj})
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, bs.Close())
+
+	open := func(t *testing.T, update common.StorageIDUpdater) *BlobStor {
+		bs := New(
+			WithCompressObjects(true),
+			WithStorages(defaultStorages(dir, smallSizeLimit)),
+			WithStorageIDUpdater(update))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { _ = bs.Close() })
+		return bs
+	}
+
+	migrate := func(t *testing.T, bs *BlobStor) []FSTreeMigrationElement {
+		var res []FSTreeMigrationElement
+		err := bs.MigrateFSTree(FSTreeMigrationPrm{
+			Handler: func(elem FSTreeMigrationElement) error {
+				res = append(res, elem)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("storage ID update failure", func(t *testing.T) {
+		updateErr := errors.New("update failure")
+		bs := open(t, func(addr oid.Address, _ []byte) error {
+			small[addr] = struct{}{}
+			return updateErr
+		})
+
+		res := migrate(t, bs)
+		require.Len(t, res, 2*objCount)
+		require.Len(t, small, objCount)
+
+		for i := range res {
+			_, isSmall := small[res[i].Address]
+
+			require.False(t, res[i].Moved)
+			if isSmall {
+				require.ErrorIs(t, res[i].Err, updateErr)
+			} else {
+				require.NoError(t, res[i].Err)
+			}
+
+			// the new copy is removed
+			typ, err := bs.ObjectStorage(res[i].Address)
+			require.NoError(t, err)
+			require.Equal(t, fstree.Type, typ)
+		}
+	})
+
+	t.Run("copy removal failure", func(t *testing.T) {
+		var (
+			updateErr = errors.New("update failure")
+			deleteErr = errors.New("delete failure")
+			storages  = defaultStorages(dir, smallSizeLimit)
+		)
+
+		storages[0].Storage = failingDeleteStorage{Storage: storages[0].Storage, err: deleteErr}
+
+		bs := New(
+			WithCompressObjects(true),
+			WithStorages(storages),
+			WithStorageIDUpdater(func(oid.Address, []byte) error { return updateErr }))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { _ = bs.Close() })
+
+		for _, elem := range migrate(t, bs) {
+			if _, isSmall := small[elem.Address]; isSmall {
+				require.ErrorIs(t, elem.Err, updateErr)
+				require.Contains(t, elem.Err.Error(), deleteErr.Error())
+			}
+		}
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		ids := make(map[oid.Address][]byte)
+		bs := open(t, func(addr oid.Address, id []byte) error {
+			ids[addr] = id
+			return nil
+		})
+
+		res := migrate(t, bs)
+		require.Len(t, res, 2*objCount)
+		require.Len(t, ids, objCount)
+
+		for i := range res {
+			addr := res[i].Address
+			_, isSmall := small[addr]
+
+			require.NoError(t, res[i].Err)
+			require.Equal(t, isSmall, res[i].Moved)
+
+			typ, err := bs.ObjectStorage(addr)
+			require.NoError(t, err)
+
+			if isSmall {
+				require.Equal(t, blobovniczatree.Type, typ)
+
+				gRes, err := bs.Get(common.GetPrm{Address: addr, StorageID: ids[addr]})
+				require.NoError(t, err)
+				require.Equal(t, addr, object.AddressOf(gRes.Object))
+			} else {
+				require.Equal(t, fstree.Type, typ)
+			}
+		}
+
+		// only big objects are left
+		require.Len(t, migrate(t, bs), objCount)
+	})
+
+	t.Run("no FSTree", func(t *testing.T) {
+		bs := New(
+			WithStorages(defaultStorages(dir, smallSizeLimit)[:1]),
+			WithStorageIDUpdater(func(oid.Address, []byte) error { return nil }))
+
+		require.ErrorIs(t, bs.MigrateFSTree(FSTreeMigrationPrm{}), ErrNoFSTree)
+	})
+}
+
+type failingDeleteStorage struct {
+	common.Storage
+	err error
+}
+
+func (s failingDeleteStorage) Delete(common.DeletePrm) (common.DeleteRes, error) {
+	return common.DeleteRes{}, s.err
+}
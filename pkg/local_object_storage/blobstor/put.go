@@ -36,23 +36,78 @@ func (b *BlobStor) Put(prm common.PutPrm) (common.PutRes, error) {
 		prm.RawData = data
 	}
 
-	for i := range b.storage {
-		if b.storage[i].Policy == nil || b.storage[i].Policy(prm.Object, prm.RawData) {
-			res, err := b.storage[i].Storage.Put(prm)
-			if err == nil {
-				storagelog.Write(b.log,
-					storagelog.AddressField(prm.Address),
-					storagelog.OpField("PUT"),
-					zap.String("type", b.storage[i].Storage.Type()),
-					zap.String("storage ID", string(res.StorageID)))
-			}
-			return res, err
+	// Sub-storage policies are checked against the data actually written.
+	data := prm.RawData
+	if !prm.DontCompress {
+		data = b.cfg.compression.Compress(data)
+	}
+
+	if i, ok := b.storageFor(prm.Object, prm.RawData, data); ok {
+		prm.RawData = data
+		prm.DontCompress = true
+
+		res, err := b.storage[i].Storage.Put(prm)
+		if err == nil {
+			storagelog.Write(b.log,
+				storagelog.AddressField(prm.Address),
+				storagelog.OpField("PUT"),
+				zap.String("type", b.storage[i].Storage.Type()),
+				zap.String("storage ID", string(res.StorageID)))
 		}
+		return res, err
 	}
 
 	return common.PutRes{}, ErrNoPlaceFound
 }
 
+// CanPut checks whether the object can be saved to any sub-storage
+// component according to their policies. Put of the object for which
+// CanPut returns false fails with ErrNoPlaceFound. The policies are
+// checked against the data compressed if the object needs compression,
+// as the write-cache flushes it.
+func (b *BlobStor) CanPut(obj *objectSDK.Object, data []byte) bool {
+	written := data
+	if obj != nil && b.NeedsCompression(obj) {
+		written = b.cfg.compression.Compress(data)
+	}
+
+	_, ok := b.storageFor(obj, data, written)
+	return ok
+}
+
+// storageFor returns the index of the sub-storage component the object
+// is routed to. The sub-storages with the object attributes matching
+// theirs are tried first, then the first sub-storage component without
+// attributes whose policy accepts the object is returned. Policies are
+// checked against the written data, the attributes are read from the
+// raw object data if the object is not provided.
+func (b *BlobStor) storageFor(obj *objectSDK.Object, raw, written []byte) (int, bool) {
+	if b.attrRouting && obj == nil {
+		// e.g. write-cache flush
+		obj = objectSDK.New()
+		if err := obj.Unmarshal(raw); err != nil {
+			obj = nil
+		}
+	}
+
+	if b.attrRouting && obj != nil {
+		for i := range b.storage {
+			if len(b.storage[i].Attributes) > 0 && matchAttributes(obj, b.storage[i].Attributes) &&
+				(b.storage[i].Policy == nil || b.storage[i].Policy(obj, written)) {
+				return i, true
+			}
+		}
+	}
+
+	for i := range b.storage {
+		if len(b.storage[i].Attributes) == 0 &&
+			(b.storage[i].Policy == nil || b.storage[i].Policy(obj, written)) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 // NeedsCompression returns true if the object should be compressed.
 // For an object to be compressed 2 conditions must hold:
 // 1. Compression is enabled in settings.
@@ -0,0 +1,28 @@
+package blobstor
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectStorage returns the type of the sub-storage which contains the object.
+// Returns empty string if the object is not found.
+//
+// Returns any error encountered that did not allow
+// to completely check object existence.
+func (b *BlobStor) ObjectStorage(addr oid.Address) (string, error) {
+	var prm common.ExistsPrm
+	prm.Address = addr
+
+	var lastErr error
+	for i := range b.storage {
+		res, err := b.storage[i].Storage.Exists(prm)
+		if err == nil && res.Exists {
+			return b.storage[i].Storage.Type(), nil
+		} else if err != nil {
+			lastErr = err
+		}
+	}
+
+	return "", lastErr
+}
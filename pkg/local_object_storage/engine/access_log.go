@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Operations recorded in the access log.
+const (
+	AccessGet    = "GET"
+	AccessSelect = "SELECT"
+)
+
+// AccessIdentity describes the requester of the read operation.
+type AccessIdentity struct {
+	// Session is an issuer of the session token
+	// attached to the request. Nil if there is no session.
+	Session *user.ID
+
+	// Bearer is an issuer of the bearer token
+	// attached to the request. Nil if there is no bearer token.
+	Bearer *user.ID
+
+	// ControlKey is a public key of the Control service client.
+	// Set for the node operator's requests only.
+	ControlKey []byte
+}
+
+// RequestTokens is an interface of the request parameters carrying
+// the tokens the requester identity is resolved from.
+type RequestTokens interface {
+	// SessionToken returns the session token attached
+	// to the request. Nil if there is no session.
+	SessionToken() *session.Object
+
+	// BearerToken returns the bearer token attached
+	// to the request. Nil if there is no bearer token.
+	BearerToken() *bearer.Token
+}
+
+// AccessIdentityFromTokens returns the requester identity
+// derived from the session and bearer token issuers.
+func AccessIdentityFromTokens(t RequestTokens) AccessIdentity {
+	var res AccessIdentity
+
+	if tok := t.SessionToken(); tok != nil {
+		issuer := tok.Issuer()
+		res.Session = &issuer
+	}
+
+	if tok := t.BearerToken(); tok != nil {
+		issuer := bearer.ResolveIssuer(*tok)
+		res.Bearer = &issuer
+	}
+
+	return res
+}
+
+// AccessEntry describes a single read operation of the StorageEngine.
+type AccessEntry struct {
+	// Operation is AccessGet or AccessSelect.
+	Operation string
+
+	// Time is the time the operation was started at.
+	Time time.Time
+
+	// Identity is the requester identity. Empty
+	// for the node's internal reads.
+	Identity AccessIdentity
+
+	// Address is the requested object address. Set for AccessGet only.
+	Address oid.Address
+
+	// Container is the container ID. Set for AccessSelect only.
+	Container cid.ID
+
+	// Filters are the search filters. Set for AccessSelect only.
+	Filters object.SearchFilters
+
+	// IgnoreGCMark is true if the removed object has been requested
+	// (see GetPrm.WithIgnoreGCMark).
+	IgnoreGCMark bool
+}
+
+// AccessLogger records read accesses to the stored objects.
+//
+// LogAccess is called synchronously in the read path, so
+// implementations should not block for long.
+type AccessLogger interface {
+	LogAccess(AccessEntry)
+}
+
+func (e *StorageEngine) logAccess(entry AccessEntry) {
+	entry.Time = time.Now()
+	e.accessLog.LogAccess(entry)
+}
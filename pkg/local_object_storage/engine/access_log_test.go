@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type accessRecorder struct {
+	entries []AccessEntry
+}
+
+func (r *accessRecorder) LogAccess(entry AccessEntry) {
+	r.entries = append(r.entries, entry)
+}
+
+func TestAccessLog(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 2)
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	cnr := cidtest.ID()
+	obj := generateObjectWithCID(t, cnr)
+	addr := objectCore.AddressOf(obj)
+
+	require.NoError(t, Put(e, obj))
+
+	// no logger, no entries
+	_, err := Get(e, addr)
+	require.NoError(t, err)
+
+	rec := new(accessRecorder)
+	e.accessLog = rec
+
+	sessionIssuer := *usertest.ID()
+	bearerIssuer := *usertest.ID()
+
+	var getPrm GetPrm
+	getPrm.WithAddress(addr)
+	getPrm.WithIdentity(AccessIdentity{
+		Session: &sessionIssuer,
+		Bearer:  &bearerIssuer,
+	})
+
+	_, err = e.Get(context.Background(), getPrm)
+	require.NoError(t, err)
+
+	var fs objectSDK.SearchFilters
+	fs.AddObjectOwnerIDFilter(objectSDK.MatchStringEqual, *obj.OwnerID())
+
+	var selectPrm SelectPrm
+	selectPrm.WithContainerID(cnr)
+	selectPrm.WithFilters(fs)
+	selectPrm.WithIdentity(AccessIdentity{Bearer: &bearerIssuer})
+
+	res, err := e.Select(context.Background(), selectPrm)
+	require.NoError(t, err)
+	require.Equal(t, []oid.Address{addr}, res.AddressList())
+
+	require.Len(t, rec.entries, 2)
+
+	getEntry := rec.entries[0]
+	require.Equal(t, AccessGet, getEntry.Operation)
+	require.Equal(t, addr, getEntry.Address)
+	require.Equal(t, sessionIssuer, *getEntry.Identity.Session)
+	require.Equal(t, bearerIssuer, *getEntry.Identity.Bearer)
+	require.False(t, getEntry.Time.IsZero())
+
+	selectEntry := rec.entries[1]
+	require.Equal(t, AccessSelect, selectEntry.Operation)
+	require.Equal(t, cnr, selectEntry.Container)
+	require.Equal(t, fs, selectEntry.Filters)
+	require.Nil(t, selectEntry.Identity.Session)
+	require.Equal(t, bearerIssuer, *selectEntry.Identity.Bearer)
+	require.False(t, selectEntry.Time.Before(getEntry.Time))
+}
+
+type testRequestTokens struct {
+	session *session.Object
+	bearer  *bearer.Token
+}
+
+func (t testRequestTokens) SessionToken() *session.Object { return t.session }
+func (t testRequestTokens) BearerToken() *bearer.Token    { return t.bearer }
+
+func TestAccessIdentityFromTokens(t *testing.T) {
+	require.Zero(t, AccessIdentityFromTokens(testRequestTokens{}))
+
+	sessionTok := sessiontest.ObjectSigned()
+	bearerTok := bearertest.Token()
+
+	id := AccessIdentityFromTokens(testRequestTokens{
+		session: sessionTok,
+		bearer:  &bearerTok,
+	})
+	require.Equal(t, sessionTok.Issuer(), *id.Session)
+	require.Equal(t, bearer.ResolveIssuer(bearerTok), *id.Bearer)
+}
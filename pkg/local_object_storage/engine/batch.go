@@ -0,0 +1,214 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Operation is a single operation of the Batch.
+type Operation struct {
+	obj *objectSDK.Object
+
+	inhume *InhumePrm
+}
+
+// BatchResult groups the resulting values of Batch operation.
+type BatchResult struct {
+	applied int
+
+	rolledBack []oid.Address
+
+	notRolledBack []oid.Address
+
+	inhumed []oid.Address
+}
+
+// WithPut makes the operation save the object like Put does.
+//
+// Should not be called along with WithInhume.
+func (o *Operation) WithPut(obj *objectSDK.Object) {
+	o.obj = obj
+	o.inhume = nil
+}
+
+// WithInhume makes the operation inhume the objects like Inhume does.
+//
+// Should not be called along with WithPut.
+func (o *Operation) WithInhume(prm InhumePrm) {
+	o.inhume = &prm
+	o.obj = nil
+}
+
+// Applied returns the number of operations that have been applied
+// successfully (including rolled back ones).
+func (r BatchResult) Applied() int {
+	return r.applied
+}
+
+// RolledBack returns the addresses of the objects saved by the batch and
+// removed back after the failure.
+func (r BatchResult) RolledBack() []oid.Address {
+	return r.rolledBack
+}
+
+// NotRolledBack returns the addresses of the objects saved by the batch that
+// could not be removed after the failure.
+func (r BatchResult) NotRolledBack() []oid.Address {
+	return r.notRolledBack
+}
+
+// Inhumed returns the addresses of the objects inhumed by the batch. Inhume
+// operations are not rolled back, so the objects stay inhumed even if the
+// batch fails, including the ones inhumed by the failed operation before the
+// failure.
+func (r BatchResult) Inhumed() []oid.Address {
+	return r.inhumed
+}
+
+var errEmptyOperation = errors.New("empty batch operation")
+
+// batchPut is the object saved by the batch, kept to be rolled back.
+type batchPut struct {
+	addr oid.Address
+	sh   *shard.Shard
+}
+
+// Batch applies the operations in the given order with all-or-nothing
+// semantics as far as the storage allows:
+//   - before anything is written, the objects to be inhumed are checked for
+//     locks (unless WithForceRemoval is set), so the batch with a locked
+//     object fails without any changes;
+//   - if any operation fails, the objects saved by the preceding Put operations
+//     are removed from the shards they have been saved to. Objects that existed
+//     before the batch are never removed.
+//
+// Atomicity limits:
+//   - inhume operations are final, they are not reverted in case of a failure
+//     of the subsequent operations, so they should go last in the batch, the
+//     inhumed objects are reported in BatchResult.Inhumed;
+//   - the batch is not isolated, concurrent operations can see and change
+//     the intermediate state;
+//   - the objects are stored in different shards, so the rollback is
+//     performed shard by shard, and the shard failure or engine crash
+//     during the rollback can leave some objects saved (see
+//     BatchResult.NotRolledBack).
+//
+// Returns the error of the failed operation with its index.
+// Returns apistatus.ObjectLocked if at least one object to inhume is locked.
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) Batch(ops []Operation) (res BatchResult, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.batch(ops)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) batch(ops []Operation) (BatchResult, error) {
+	var res BatchResult
+
+	for i := range ops {
+		if ops[i].obj == nil && ops[i].inhume == nil {
+			return res, fmt.Errorf("operation #%d: %w", i, errEmptyOperation)
+		}
+
+		if err := e.checkInhumeLocks(ops[i].inhume); err != nil {
+			return res, fmt.Errorf("operation #%d: %w", i, err)
+		}
+	}
+
+	var puts []batchPut
+
+	for i := range ops {
+		var err error
+
+		if ops[i].obj != nil {
+			var sh *shard.Shard
+
+			sh, err = e.putObject(ops[i].obj)
+			if err == nil && sh != nil {
+				puts = append(puts, batchPut{addr: object.AddressOf(ops[i].obj), sh: sh})
+			}
+		} else {
+			err = e.batchInhume(*ops[i].inhume, &res)
+		}
+
+		if err != nil {
+			e.rollbackPuts(puts, &res)
+			return res, fmt.Errorf("operation #%d: %w", i, err)
+		}
+
+		res.applied++
+	}
+
+	return res, nil
+}
+
+// batchInhume inhumes the objects one by one, so the objects inhumed before
+// the failure are reported in the result.
+func (e *StorageEngine) batchInhume(prm InhumePrm, res *BatchResult) error {
+	addrs := prm.addrs
+
+	for i := range addrs {
+		prm.addrs = addrs[i : i+1]
+
+		if _, err := e.inhume(prm); err != nil {
+			return fmt.Errorf("object %s: %w", addrs[i], err)
+		}
+
+		res.inhumed = append(res.inhumed, addrs[i])
+	}
+
+	return nil
+}
+
+// checkInhumeLocks returns apistatus.ObjectLocked if any object to be
+// inhumed is locked in any shard.
+func (e *StorageEngine) checkInhumeLocks(prm *InhumePrm) error {
+	if prm == nil || prm.forceRemoval {
+		return nil
+	}
+
+	for i := range prm.addrs {
+		sts, err := e.ObjectStatus(prm.addrs[i])
+		if err != nil {
+			return err
+		}
+
+		for j := range sts {
+			if sts[j].Status.Metabase.Locked {
+				return apistatus.ObjectLocked{}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *StorageEngine) rollbackPuts(puts []batchPut, res *BatchResult) {
+	for i := len(puts) - 1; i >= 0; i-- {
+		var delPrm shard.DeletePrm
+		delPrm.SetAddresses(puts[i].addr)
+
+		_, err := puts[i].sh.Delete(delPrm)
+		if err != nil {
+			e.log.Error("could not roll back batch put",
+				zap.Stringer("shard_id", puts[i].sh.ID()),
+				zap.Stringer("address", puts[i].addr),
+				zap.String("error", err.Error()))
+
+			res.notRolledBack = append(res.notRolledBack, puts[i].addr)
+			continue
+		}
+
+		res.rolledBack = append(res.rolledBack, puts[i].addr)
+	}
+}
@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_Batch(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	cnr := cidtest.ID()
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	requireExists := func(t *testing.T, obj *objectSDK.Object, exists bool) {
+		ok, err := e.exists(context.Background(), object.AddressOf(obj))
+		require.NoError(t, err)
+		require.Equal(t, exists, ok)
+	}
+
+	putOp := func(obj *objectSDK.Object) Operation {
+		var op Operation
+		op.WithPut(obj)
+		return op
+	}
+
+	inhumeOp := func(objs ...*objectSDK.Object) Operation {
+		addrs := make([]oid.Address, len(objs))
+		for i := range objs {
+			addrs[i] = object.AddressOf(objs[i])
+		}
+
+		var prm InhumePrm
+		prm.WithTarget(oidtest.Address(), addrs...)
+
+		var op Operation
+		op.WithInhume(prm)
+		return op
+	}
+
+	t.Run("success", func(t *testing.T) {
+		old := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, old))
+
+		newObjs := []*objectSDK.Object{
+			generateObjectWithCID(t, cnr),
+			generateObjectWithCID(t, cnr),
+		}
+
+		res, err := e.Batch([]Operation{putOp(newObjs[0]), putOp(newObjs[1]), inhumeOp(old)})
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Applied())
+		require.Empty(t, res.RolledBack())
+		require.Equal(t, []oid.Address{object.AddressOf(old)}, res.Inhumed())
+
+		requireExists(t, newObjs[0], true)
+		requireExists(t, newObjs[1], true)
+
+		_, err = e.exists(context.Background(), object.AddressOf(old))
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		existing := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, existing))
+
+		lock := generateObjectWithCID(t, cnr)
+		lock.SetType(objectSDK.TypeLock)
+		require.NoError(t, Put(e, lock))
+
+		newObj := generateObjectWithCID(t, cnr)
+
+		// inhume of the lock object fails after the puts are applied
+		res, err := e.Batch([]Operation{putOp(newObj), putOp(existing), inhumeOp(lock)})
+		require.ErrorIs(t, err, meta.ErrLockObjectRemoval)
+		require.Equal(t, 2, res.Applied())
+		require.Equal(t, []oid.Address{object.AddressOf(newObj)}, res.RolledBack())
+		require.Empty(t, res.NotRolledBack())
+
+		requireExists(t, newObj, false)
+		requireExists(t, existing, true)
+		requireExists(t, lock, true)
+	})
+
+	t.Run("partial inhume", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, obj))
+
+		lock := generateObjectWithCID(t, cnr)
+		lock.SetType(objectSDK.TypeLock)
+		require.NoError(t, Put(e, lock))
+
+		// inhumes are not rolled back, but reported
+		res, err := e.Batch([]Operation{inhumeOp(obj, lock)})
+		require.ErrorIs(t, err, meta.ErrLockObjectRemoval)
+		require.Zero(t, res.Applied())
+		require.Equal(t, []oid.Address{object.AddressOf(obj)}, res.Inhumed())
+
+		_, err = e.exists(context.Background(), object.AddressOf(obj))
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+		requireExists(t, lock, true)
+	})
+
+	t.Run("locked object", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, obj))
+
+		lock := generateObjectWithCID(t, cnr)
+		lock.SetType(objectSDK.TypeLock)
+		require.NoError(t, Put(e, lock))
+
+		id, _ := obj.ID()
+		idLock, _ := lock.ID()
+		require.NoError(t, e.Lock(cnr, idLock, []oid.ID{id}))
+
+		newObj := generateObjectWithCID(t, cnr)
+
+		// nothing is written since the locks are checked in advance
+		res, err := e.Batch([]Operation{putOp(newObj), inhumeOp(obj)})
+		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+		require.Zero(t, res.Applied())
+
+		requireExists(t, newObj, false)
+		requireExists(t, obj, true)
+	})
+
+	t.Run("empty operation", func(t *testing.T) {
+		_, err := e.Batch([]Operation{{}})
+		require.ErrorIs(t, err, errEmptyOperation)
+	})
+}
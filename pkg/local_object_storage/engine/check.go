@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// CheckShardPrm groups the parameters of CheckShard operation.
+type CheckShardPrm struct {
+	shardID *shard.ID
+	prm     shard.CheckPrm
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *CheckShardPrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// SetRepairOrphans sets flag to register blobs without a metabase record.
+func (p *CheckShardPrm) SetRepairOrphans(v bool) {
+	p.prm.WithRepairOrphans(v)
+}
+
+// SetMarkDangling sets flag to mark metabase records without a blob with GC mark.
+func (p *CheckShardPrm) SetMarkDangling(v bool) {
+	p.prm.WithMarkDangling(v)
+}
+
+// SetResume sets flag to continue the previous interrupted check.
+func (p *CheckShardPrm) SetResume(v bool) {
+	p.prm.WithResume(v)
+}
+
+// SetRateLimit sets the maximum number of objects checked per second.
+func (p *CheckShardPrm) SetRateLimit(objPerSec uint32) {
+	p.prm.WithRateLimit(objPerSec)
+}
+
+// CheckShardRes groups the resulting values of CheckShard operation.
+type CheckShardRes struct {
+	summary shard.CheckSummary
+}
+
+// Summary returns the summary of the check.
+func (r CheckShardRes) Summary() shard.CheckSummary {
+	return r.summary
+}
+
+// CheckShard checks consistency between metabase and blobstor of a single shard.
+func (e *StorageEngine) CheckShard(ctx context.Context, p CheckShardPrm) (CheckShardRes, error) {
+	sh, err := e.shardByID(p.shardID)
+	if err != nil {
+		return CheckShardRes{}, err
+	}
+
+	res, err := sh.CheckConsistency(ctx, p.prm)
+	return CheckShardRes{summary: res.Summary()}, err
+}
@@ -0,0 +1,161 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// MetabaseCompaction groups the parameters of the background
+// compaction of the shard metabases.
+type MetabaseCompaction struct {
+	// Interval is the time between the checks of the shard metabases.
+	// Non-positive value disables the compaction.
+	Interval time.Duration
+
+	// FreeRatio is the minimum ratio of the free pages of the metabase
+	// file to be compacted.
+	FreeRatio float64
+
+	// Window is the time of the day when the writable shards are compacted.
+	// The writable shards are not compacted if the window is empty,
+	// read-only shards are compacted at any time.
+	Window MaintenanceWindow
+}
+
+// MaintenanceWindow is a daily time interval in the local time zone.
+// End before Start means that the window crosses midnight.
+type MaintenanceWindow struct {
+	// Start is the time since midnight the window starts at.
+	Start time.Duration
+
+	// End is the time since midnight the window ends at.
+	End time.Duration
+}
+
+// ParseMaintenanceWindow parses the maintenance window in "HH:MM-HH:MM" format.
+func ParseMaintenanceWindow(s string) (MaintenanceWindow, error) {
+	var sh, sm, eh, em int
+
+	n, err := fmt.Sscanf(s, "%d:%d-%d:%d", &sh, &sm, &eh, &em)
+	if err != nil || n != 4 || !validClock(sh, sm) || !validClock(eh, em) {
+		return MaintenanceWindow{}, fmt.Errorf("invalid maintenance window %q, expected HH:MM-HH:MM", s)
+	}
+
+	return MaintenanceWindow{
+		Start: time.Duration(sh)*time.Hour + time.Duration(sm)*time.Minute,
+		End:   time.Duration(eh)*time.Hour + time.Duration(em)*time.Minute,
+	}, nil
+}
+
+func validClock(h, m int) bool {
+	return h >= 0 && h < 24 && m >= 0 && m < 60
+}
+
+// Empty returns true if the window has zero length.
+func (w MaintenanceWindow) Empty() bool {
+	return w.Start == w.End
+}
+
+// Contains returns true if t is within the window.
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	if w.Empty() {
+		return false
+	}
+
+	y, m, d := t.Date()
+	since := t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+
+	if w.Start < w.End {
+		return since >= w.Start && since < w.End
+	}
+
+	return since >= w.Start || since < w.End
+}
+
+// metabaseCompactionResult describes the metabase compaction of the single shard.
+type metabaseCompactionResult struct {
+	id *shard.ID
+
+	sizeBefore, sizeAfter int64
+
+	err error
+}
+
+// RunMetabaseCompaction periodically compacts the metabases of the shards
+// according to the parameters set via WithMetabaseCompaction. Blocks until
+// the context is done. Returns immediately if the compaction is disabled.
+func (e *StorageEngine) RunMetabaseCompaction(ctx context.Context) {
+	if e.metabaseCompaction.Interval <= 0 {
+		return
+	}
+
+	t := time.NewTicker(e.metabaseCompaction.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			e.compactMetabases(now)
+		}
+	}
+}
+
+// compactMetabases compacts the metabases of the shards which have enough
+// free pages and are either read-only or within the maintenance window.
+func (e *StorageEngine) compactMetabases(now time.Time) []metabaseCompactionResult {
+	var (
+		res      []metabaseCompactionResult
+		inWindow = e.metabaseCompaction.Window.Contains(now)
+	)
+
+	for _, sh := range e.unsortedShards() {
+		if m := sh.GetMode(); m.NoMetabase() || !m.ReadOnly() && !inWindow || sh.ForcedReadOnly() {
+			continue
+		}
+
+		ratio, err := sh.MetabaseFreeRatio()
+		if err != nil {
+			if !errors.Is(err, shard.ErrDegradedMode) {
+				e.log.Warn("could not get metabase free pages ratio",
+					zap.Stringer("shard_id", sh.ID()),
+					zap.Error(err))
+			}
+			continue
+		}
+
+		if ratio < e.metabaseCompaction.FreeRatio {
+			continue
+		}
+
+		r := metabaseCompactionResult{id: sh.ID()}
+
+		cRes, err := sh.CompactMetabase()
+		if err != nil {
+			r.err = err
+
+			e.log.Error("could not compact metabase",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Error(err))
+		} else {
+			r.sizeBefore = cRes.SizeBefore()
+			r.sizeAfter = cRes.SizeAfter()
+
+			e.log.Info("metabase compacted",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Float64("free ratio", ratio),
+				zap.Int64("size before", r.sizeBefore),
+				zap.Int64("size after", r.sizeAfter))
+		}
+
+		res = append(res, r)
+	}
+
+	return res
+}
@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	w, err := ParseMaintenanceWindow("23:30-02:15")
+	require.NoError(t, err)
+	require.Equal(t, MaintenanceWindow{
+		Start: 23*time.Hour + 30*time.Minute,
+		End:   2*time.Hour + 15*time.Minute,
+	}, w)
+
+	for _, s := range []string{"", "23:30", "24:00-01:00", "01:60-02:00", "1-2"} {
+		_, err := ParseMaintenanceWindow(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 10, 1, h, m, 0, 0, time.Local)
+	}
+
+	w := MaintenanceWindow{Start: 2 * time.Hour, End: 4 * time.Hour}
+	require.False(t, w.Contains(at(1, 59)))
+	require.True(t, w.Contains(at(2, 0)))
+	require.True(t, w.Contains(at(3, 59)))
+	require.False(t, w.Contains(at(4, 0)))
+
+	w = MaintenanceWindow{Start: 23 * time.Hour, End: time.Hour}
+	require.True(t, w.Contains(at(23, 30)))
+	require.True(t, w.Contains(at(0, 30)))
+	require.False(t, w.Contains(at(12, 0)))
+
+	require.False(t, MaintenanceWindow{}.Contains(at(0, 0)))
+}
+
+func TestCompactMetabases(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 2)
+	defer e.Close()
+
+	shards := e.unsortedShards()
+
+	cnr := cidtest.ID()
+	for i := 0; i < 50; i++ {
+		obj := generateObjectWithCID(t, cnr)
+
+		var prm PutPrm
+		prm.WithObject(obj)
+		_, err := e.Put(prm)
+		require.NoError(t, err)
+
+		var delPrm DeletePrm
+		delPrm.WithForceRemoval()
+		delPrm.WithAddress(object.AddressOf(obj))
+		_, err = e.Delete(delPrm)
+		require.NoError(t, err)
+	}
+
+	e.metabaseCompaction = MetabaseCompaction{
+		FreeRatio: 0.01,
+		Window:    MaintenanceWindow{Start: 2 * time.Hour, End: 4 * time.Hour},
+	}
+
+	outside := time.Date(2022, 10, 1, 12, 0, 0, 0, time.Local)
+	inside := time.Date(2022, 10, 1, 3, 0, 0, 0, time.Local)
+
+	// writable shards are compacted within the window only
+	require.Empty(t, e.compactMetabases(outside))
+
+	require.NoError(t, shards[0].SetMode(mode.ReadOnly))
+
+	res := e.compactMetabases(outside)
+	require.Len(t, res, 1)
+	require.Equal(t, shards[0].ID(), res[0].id)
+	require.NoError(t, res[0].err)
+	require.LessOrEqual(t, res[0].sizeAfter, res[0].sizeBefore)
+
+	res = e.compactMetabases(inside)
+	for i := range res {
+		require.NoError(t, res[i].err)
+		require.LessOrEqual(t, res[i].sizeAfter, res[i].sizeBefore)
+	}
+
+	// shards are operational after compaction
+	require.NoError(t, shards[0].SetMode(mode.ReadWrite))
+
+	obj := generateObjectWithCID(t, cnr)
+
+	var prm PutPrm
+	prm.WithObject(obj)
+	_, err := e.Put(prm)
+	require.NoError(t, err)
+
+	_, err = Get(e, object.AddressOf(obj))
+	require.NoError(t, err)
+
+	e.metabaseCompaction.FreeRatio = 1.01
+	require.Empty(t, e.compactMetabases(inside))
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListContainers(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	got, err := ListContainers(e)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	const cnrNum = 5
+
+	expected := make([]cid.ID, 0, cnrNum)
+
+	for i := 0; i < cnrNum; i++ {
+		cnr := cidtest.ID()
+		expected = append(expected, cnr)
+
+		// put objects of the same container directly to both shards
+		// to make sure the result does not contain duplicates
+		for _, sh := range []*shard.Shard{s1, s2} {
+			for j := 0; j < 2; j++ {
+				var prm shard.PutPrm
+				prm.SetObject(generateObjectWithCID(t, cnr))
+
+				_, err := sh.Put(prm)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	got, err = ListContainers(e)
+	require.NoError(t, err)
+	require.Len(t, got, cnrNum)
+	require.ElementsMatch(t, expected, got)
+}
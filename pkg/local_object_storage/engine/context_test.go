@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ContextCancellation(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+	obj := generateObjectWithCID(t, cnr)
+	obj.SetPayload([]byte("payload"))
+	require.NoError(t, Put(e, obj))
+
+	addr := object.AddressOf(obj)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	requireCanceled := func(t *testing.T, op string, err error) {
+		require.ErrorIs(t, err, context.Canceled)
+		require.Contains(t, err.Error(), op)
+	}
+
+	t.Run("get", func(t *testing.T) {
+		var prm GetPrm
+		prm.WithAddress(addr)
+
+		_, err := e.Get(ctx, prm)
+		requireCanceled(t, "get", err)
+
+		res, err := e.Get(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload(), res.Object().Payload())
+	})
+
+	t.Run("get range", func(t *testing.T) {
+		var prm RngPrm
+		prm.WithAddress(addr)
+		prm.WithPayloadRange(objectSDK.NewRange())
+
+		_, err := e.GetRange(ctx, prm)
+		requireCanceled(t, "get range", err)
+
+		_, err = e.GetPayloadRange(ctx, addr, 0, 1)
+		requireCanceled(t, "get range", err)
+
+		data, err := e.GetPayloadRange(context.Background(), addr, 1, 3)
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload()[1:4], data)
+	})
+
+	t.Run("head", func(t *testing.T) {
+		var prm HeadPrm
+		prm.WithAddress(addr)
+
+		_, err := e.Head(ctx, prm)
+		requireCanceled(t, "head", err)
+
+		_, err = e.Head(context.Background(), prm)
+		require.NoError(t, err)
+	})
+
+	t.Run("select", func(t *testing.T) {
+		var prm SelectPrm
+		prm.WithContainerID(cnr)
+
+		_, err := e.Select(ctx, prm)
+		requireCanceled(t, "select", err)
+
+		res, err := e.Select(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{addr}, res.AddressList())
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		_, err := e.exists(ctx, addr)
+		requireCanceled(t, "exists", err)
+
+		ok, err := e.exists(context.Background(), addr)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+}
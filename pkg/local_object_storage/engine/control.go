@@ -90,6 +90,10 @@ func (e *StorageEngine) Init() error {
 		return errors.New("failed initialization on all shards")
 	}
 
+	if e.metrics != nil {
+		go e.updateStateMetricsLoop()
+	}
+
 	return nil
 }
 
@@ -100,6 +104,8 @@ var errClosed = errors.New("storage engine is closed")
 //
 // The method is supposed to be called when the application exits.
 func (e *StorageEngine) Close() error {
+	e.closeOnce.Do(func() { close(e.closeCh) })
+
 	return e.setBlockExecErr(errClosed)
 }
 
@@ -123,6 +129,8 @@ func (e *StorageEngine) close(releasePools bool) error {
 		}
 	}
 
+	e.errLog.Flush()
+
 	return nil
 }
 
@@ -204,6 +212,8 @@ type ReConfiguration struct {
 	shardPoolSize   uint32
 
 	shards map[string][]shard.Option // meta path -> shard opts
+
+	placements map[string]shard.ContainerPlacement // meta path -> container placement
 }
 
 // SetErrorsThreshold sets a size amount of errors after which
@@ -231,8 +241,32 @@ func (rCfg *ReConfiguration) AddShard(metaPath string, opts []shard.Option) {
 	rCfg.shards[metaPath] = opts
 }
 
+// SetShardContainerPlacement sets container placement restrictions of the
+// shard identified by the path to its metabase. Placement is applied to the
+// existing shards as well as to the added ones, shards without the placement
+// set accept all the containers.
+func (rCfg *ReConfiguration) SetShardContainerPlacement(metaPath string, p shard.ContainerPlacement) {
+	if rCfg.placements == nil {
+		rCfg.placements = make(map[string]shard.ContainerPlacement)
+	}
+
+	rCfg.placements[metaPath] = p
+}
+
 // Reload reloads StorageEngine's configuration in runtime.
+//
+// Returns an error without changing the configuration if the objects of
+// some container can not be placed to any shard (see shard.CheckContainerPlacement).
 func (e *StorageEngine) Reload(rcfg ReConfiguration) error {
+	placements := make([]shard.ContainerPlacement, 0, len(rcfg.shards))
+	for metaPath := range rcfg.shards {
+		placements = append(placements, rcfg.placements[metaPath])
+	}
+
+	if err := shard.CheckContainerPlacement(placements); err != nil {
+		return fmt.Errorf("invalid container placement: %w", err)
+	}
+
 	e.mtx.RLock()
 
 	var shardsToRemove []string // shards IDs
@@ -240,10 +274,15 @@ func (e *StorageEngine) Reload(rcfg ReConfiguration) error {
 
 	// mark removed shards for removal
 	for id, sh := range e.shards {
-		_, ok := rcfg.shards[sh.Shard.DumpInfo().MetaBaseInfo.Path]
+		metaPath := sh.Shard.DumpInfo().MetaBaseInfo.Path
+
+		_, ok := rcfg.shards[metaPath]
 		if !ok {
 			shardsToRemove = append(shardsToRemove, id)
+			continue
 		}
+
+		sh.SetContainerPlacement(rcfg.placements[metaPath])
 	}
 
 	// mark new shards for addition
@@ -271,6 +310,10 @@ func (e *StorageEngine) Reload(rcfg ReConfiguration) error {
 			return fmt.Errorf("could not add new shard with '%s' metabase path: %w", newPath, err)
 		}
 
+		if p, ok := rcfg.placements[newPath]; ok {
+			sh.SetContainerPlacement(p)
+		}
+
 		idStr := sh.ID().String()
 
 		err = sh.Open()
@@ -63,6 +63,10 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 	}
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		if !sh.MayContain(prm.addr) {
+			return false
+		}
+
 		var existsPrm shard.ExistsPrm
 		existsPrm.SetAddress(prm.addr)
 
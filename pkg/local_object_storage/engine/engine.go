@@ -1,8 +1,14 @@
 package engine
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
+	"unicode/utf8"
 
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -21,18 +27,105 @@ type StorageEngine struct {
 
 	shardPools map[string]util.WorkerPool
 
+	// reloading contains the IDs of the shards being reloaded, guarded by mtx.
+	reloading map[string]struct{}
+
 	blockExec struct {
 		mtx sync.RWMutex
 
 		err error
 	}
+
+	reserved reservations
+
+	// errLog deduplicates shard error messages.
+	errLog *logger.Suppressor
+
+	// closeCh is closed on Close to abort the background operations
+	// which block it.
+	closeCh   chan struct{}
+	closeOnce sync.Once
+
+	// stateMetricsCh requests the immediate update of the storage state metrics.
+	stateMetricsCh chan struct{}
+
+	subscribers eventSubscribers
 }
 
 type shardWrapper struct {
 	errorCount *atomic.Uint32
+	lastErrors *errorList
 	*shard.Shard
 }
 
+const (
+	// maxLastErrors is the number of the most recent errors kept for each shard.
+	maxLastErrors = 10
+
+	// maxErrorLength is the maximum length of the error message kept for the shard.
+	maxErrorLength = 512
+
+	// defaultErrorLogInterval is the default interval between summaries
+	// of the repeated shard errors.
+	defaultErrorLogInterval = time.Minute
+)
+
+// errorList is a bounded list of the most recent shard errors.
+type errorList struct {
+	mtx sync.RWMutex
+
+	// ordered from the oldest to the newest one.
+	list []shard.ErrorInfo
+}
+
+func newErrorList() *errorList {
+	return &errorList{list: make([]shard.ErrorInfo, 0, maxLastErrors)}
+}
+
+// add stores the error in the list dropping the oldest one if the list is full.
+// Long messages are truncated on the UTF-8 character boundary.
+func (l *errorList) add(msg string) {
+	if len(msg) > maxErrorLength {
+		n := maxErrorLength
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+
+		msg = msg[:n] + "..."
+	}
+
+	l.mtx.Lock()
+	if len(l.list) == maxLastErrors {
+		copy(l.list, l.list[1:])
+		l.list = l.list[:len(l.list)-1]
+	}
+
+	l.list = append(l.list, shard.ErrorInfo{
+		Time:    time.Now(),
+		Message: msg,
+	})
+	l.mtx.Unlock()
+}
+
+// get returns stored errors starting from the newest one.
+func (l *errorList) get() []shard.ErrorInfo {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	res := make([]shard.ErrorInfo, len(l.list))
+	for i := range l.list {
+		res[len(l.list)-i-1] = l.list[i]
+	}
+
+	return res
+}
+
+func (l *errorList) reset() {
+	l.mtx.Lock()
+	l.list = l.list[:0]
+	l.mtx.Unlock()
+}
+
 // reportShardError checks that the amount of errors doesn't exceed the configured threshold.
 // If it does, shard is set to read-only mode.
 func (e *StorageEngine) reportShardError(
@@ -41,7 +134,8 @@ func (e *StorageEngine) reportShardError(
 	err error,
 	fields ...zap.Field) {
 	errCount := sh.errorCount.Inc()
-	e.log.Warn(msg, append([]zap.Field{
+	sh.lastErrors.add(msg + ": " + err.Error())
+	e.errLog.Warn(sh.ID().String()+" "+logger.ErrorClass(err), msg, append([]zap.Field{
 		zap.Stringer("shard_id", sh.ID()),
 		zap.Uint32("error count", errCount),
 		zap.String("error", err.Error()),
@@ -51,7 +145,13 @@ func (e *StorageEngine) reportShardError(
 		return
 	}
 
-	err = sh.SetMode(mode.DegradedReadOnly)
+	m := mode.DegradedReadOnly
+	err = sh.SetMode(m)
+	if errors.Is(err, shard.ErrInlineObjects) {
+		// the inline objects are available with the metabase only
+		m = mode.ReadOnly
+		err = sh.SetMode(m)
+	}
 	if err != nil {
 		e.log.Error("failed to move shard in degraded mode",
 			zap.Uint32("error count", errCount),
@@ -59,6 +159,7 @@ func (e *StorageEngine) reportShardError(
 	} else {
 		e.log.Info("shard is moved in degraded mode due to error threshold",
 			zap.Stringer("shard_id", sh.ID()),
+			zap.Stringer("mode", m),
 			zap.Uint32("error count", errCount))
 	}
 }
@@ -73,7 +174,31 @@ type cfg struct {
 
 	metrics MetricRegister
 
+	accessLog AccessLogger
+
+	readCacheBudget *shard.ReadCacheBudget
+
 	shardPoolSize uint32
+
+	reservationTimeout time.Duration
+
+	shardDiskSpace func(*shard.Shard) (shard.DiskSpace, error)
+
+	tombstonesBatchSize int
+
+	handleExpiredTombstones func(*shard.Shard, []meta.TombstonedObject)
+
+	errorLogInterval time.Duration
+
+	healthThresholds HealthThresholds
+
+	metabaseCompaction MetabaseCompaction
+
+	shardPanicRecovery bool
+
+	warmUp WarmUp
+
+	readOnlyAll bool
 }
 
 func defaultCfg() *cfg {
@@ -81,6 +206,18 @@ func defaultCfg() *cfg {
 		log: zap.L(),
 
 		shardPoolSize: 20,
+
+		reservationTimeout: defaultReservationTimeout,
+
+		shardDiskSpace: (*shard.Shard).DiskSpace,
+
+		tombstonesBatchSize: defaultTombstonesBatchSize,
+
+		handleExpiredTombstones: (*shard.Shard).HandleExpiredTombstones,
+
+		errorLogInterval: defaultErrorLogInterval,
+
+		healthThresholds: DefaultHealthThresholds(),
 	}
 }
 
@@ -97,6 +234,11 @@ func New(opts ...Option) *StorageEngine {
 		mtx:        new(sync.RWMutex),
 		shards:     make(map[string]shardWrapper),
 		shardPools: make(map[string]util.WorkerPool),
+		reloading:  make(map[string]struct{}),
+		errLog:     logger.NewSuppressor(c.log, c.errorLogInterval),
+		closeCh:    make(chan struct{}),
+
+		stateMetricsCh: make(chan struct{}, 1),
 	}
 }
 
@@ -113,6 +255,30 @@ func WithMetrics(v MetricRegister) Option {
 	}
 }
 
+// WithAccessLogger returns an option to set the logger of the read accesses
+// to the stored objects. Accesses are not logged by default.
+func WithAccessLogger(l AccessLogger) Option {
+	return func(c *cfg) {
+		c.accessLog = l
+	}
+}
+
+// WithShardReadCacheBudget returns an option to limit the total size of the
+// shard read caches (see shard.WithReadCache). If the limit is exceeded, the
+// objects are evicted from the biggest caches. Zero value means that the size
+// of the read caches is limited per shard only.
+//
+// Other caches (e.g. opened Blobovniczas or write-cache) are not accounted.
+func WithShardReadCacheBudget(sz uint64) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.readCacheBudget = shard.NewReadCacheBudget(sz)
+		} else {
+			c.readCacheBudget = nil
+		}
+	}
+}
+
 // WithShardPoolSize returns option to specify size of worker pool for each shard.
 func WithShardPoolSize(sz uint32) Option {
 	return func(c *cfg) {
@@ -127,3 +293,85 @@ func WithErrorThreshold(sz uint32) Option {
 		c.errorsThreshold = sz
 	}
 }
+
+// WithExpiredTombstonesBatchSize returns an option to specify the maximum
+// number of the expired tombstones handled by the shard at once. Non-positive
+// value means the default size.
+func WithExpiredTombstonesBatchSize(sz int) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.tombstonesBatchSize = sz
+		} else {
+			c.tombstonesBatchSize = defaultTombstonesBatchSize
+		}
+	}
+}
+
+// WithReservationTimeout returns an option to specify the time after which
+// unused capacity reservation is released.
+func WithReservationTimeout(d time.Duration) Option {
+	return func(c *cfg) {
+		c.reservationTimeout = d
+	}
+}
+
+// WithErrorLogInterval returns an option to specify the interval between
+// summaries of the repeated shard errors. Only the first error of the same
+// class is logged during the interval, others are counted. Non-positive
+// value disables deduplication.
+func WithErrorLogInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.errorLogInterval = d
+	}
+}
+
+// WithHealthThresholds returns an option to specify the limits the storage
+// health status is derived from. DefaultHealthThresholds are used by default.
+func WithHealthThresholds(t HealthThresholds) Option {
+	return func(c *cfg) {
+		c.healthThresholds = t
+	}
+}
+
+// WithMetabaseCompaction returns an option to specify the parameters
+// of the background metabase compaction. Compaction is disabled by default.
+func WithMetabaseCompaction(c MetabaseCompaction) Option {
+	return func(cfg *cfg) {
+		cfg.metabaseCompaction = c
+	}
+}
+
+// WithShardPanicRecovery returns an option to recover from the panics of the
+// shard storage in the shard operations (see shard.WithStoragePanicCallback).
+// Shard is moved to the degraded mode after the panic. Disabled by default.
+func WithShardPanicRecovery(enabled bool) Option {
+	return func(c *cfg) {
+		c.shardPanicRecovery = enabled
+	}
+}
+
+// WithWarmUp returns an option to specify the parameters of the warm-up
+// of the shard storages after the start. Warm-up is disabled by default.
+func WithWarmUp(w WarmUp) Option {
+	return func(c *cfg) {
+		c.warmUp = w
+	}
+}
+
+// WithReadOnlyAll returns an option to keep all the shards read-only for
+// the whole engine lifetime (see shard.WithForcedReadOnly). Disabled by default.
+func WithReadOnlyAll(v bool) Option {
+	return func(c *cfg) {
+		c.readOnlyAll = v
+	}
+}
+
+// ctxError returns the error of the done context wrapped with the
+// operation name. Returns nil if the context is not done.
+func ctxError(ctx context.Context, op string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s operation is interrupted: %w", op, err)
+	}
+
+	return nil
+}
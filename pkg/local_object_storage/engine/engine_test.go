@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -43,12 +44,18 @@ func BenchmarkExists(b *testing.B) {
 	b.Run("8 shards", func(b *testing.B) {
 		benchmarkExists(b, 8)
 	})
+	b.Run("16 shards", func(b *testing.B) {
+		benchmarkExists(b, 16)
+	})
+	b.Run("16 shards with existence filter", func(b *testing.B) {
+		benchmarkExists(b, 16, shard.WithExistenceFilter(1<<10))
+	})
 }
 
-func benchmarkExists(b *testing.B, shardNum int) {
+func benchmarkExists(b *testing.B, shardNum int, opts ...shard.Option) {
 	shards := make([]*shard.Shard, shardNum)
 	for i := 0; i < shardNum; i++ {
-		shards[i] = testNewShard(b, i)
+		shards[i] = testNewShard(b, i, opts...)
 	}
 
 	e := testNewEngineWithShards(shards...)
@@ -69,7 +76,7 @@ func benchmarkExists(b *testing.B, shardNum int) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		ok, err := e.exists(addr)
+		ok, err := e.exists(context.Background(), addr)
 		if err != nil || ok {
 			b.Fatalf("%t %v", ok, err)
 		}
@@ -87,6 +94,7 @@ func testNewEngineWithShards(shards ...*shard.Shard) *StorageEngine {
 
 		engine.shards[s.ID().String()] = shardWrapper{
 			errorCount: atomic.NewUint32(0),
+			lastErrors: newErrorList(),
 			Shard:      s,
 		}
 		engine.shardPools[s.ID().String()] = pool
@@ -115,11 +123,11 @@ func newStorages(root string, smallSize uint64) []blobstor.SubStorage {
 	}
 }
 
-func testNewShard(t testing.TB, id int) *shard.Shard {
+func testNewShard(t testing.TB, id int, opts ...shard.Option) *shard.Shard {
 	sid, err := generateShardID()
 	require.NoError(t, err)
 
-	s := shard.New(
+	s := shard.New(append([]shard.Option{
 		shard.WithID(sid),
 		shard.WithLogger(zap.L()),
 		shard.WithBlobStorOptions(
@@ -131,7 +139,7 @@ func testNewShard(t testing.TB, id int) *shard.Shard {
 			meta.WithPath(filepath.Join(t.Name(), fmt.Sprintf("%d.metabase", id))),
 			meta.WithPermissions(0700),
 			meta.WithEpochState(epochState{}),
-		))
+		)}, opts...)...)
 
 	require.NoError(t, s.Open())
 	require.NoError(t, s.Init())
@@ -139,23 +147,29 @@ func testNewShard(t testing.TB, id int) *shard.Shard {
 	return s
 }
 
+// testShardOpts returns the options of the i-th test shard storing its
+// components under the root directory.
+func testShardOpts(root string, i int) []shard.Option {
+	return []shard.Option{
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages(
+				newStorages(filepath.Join(root, fmt.Sprintf("blobstor%d", i)),
+					1<<20)),
+		),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(root, fmt.Sprintf("metabase%d", i))),
+			meta.WithPermissions(0700),
+			meta.WithEpochState(epochState{}),
+		),
+		shard.WithPiloramaOptions(
+			pilorama.WithPath(filepath.Join(root, fmt.Sprintf("pilorama%d", i)))),
+	}
+}
+
 func testEngineFromShardOpts(t *testing.T, num int, extraOpts []shard.Option) *StorageEngine {
 	engine := New()
 	for i := 0; i < num; i++ {
-		_, err := engine.AddShard(append([]shard.Option{
-			shard.WithBlobStorOptions(
-				blobstor.WithStorages(
-					newStorages(filepath.Join(t.Name(), fmt.Sprintf("blobstor%d", i)),
-						1<<20)),
-			),
-			shard.WithMetaBaseOptions(
-				meta.WithPath(filepath.Join(t.Name(), fmt.Sprintf("metabase%d", i))),
-				meta.WithPermissions(0700),
-				meta.WithEpochState(epochState{}),
-			),
-			shard.WithPiloramaOptions(
-				pilorama.WithPath(filepath.Join(t.Name(), fmt.Sprintf("pilorama%d", i)))),
-		}, extraOpts...)...)
+		_, err := engine.AddShard(append(testShardOpts(t.Name(), i), extraOpts...)...)
 		require.NoError(t, err)
 	}
 
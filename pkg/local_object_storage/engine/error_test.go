@@ -1,11 +1,15 @@
 package engine
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
@@ -74,7 +78,7 @@ func TestErrorReporting(t *testing.T) {
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+		_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -83,7 +87,7 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < 3; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -104,7 +108,7 @@ func TestErrorReporting(t *testing.T) {
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+		_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -113,14 +117,14 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < errThreshold; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
 		}
 
 		for i := uint32(0); i < 2; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], errThreshold+i, mode.DegradedReadOnly)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -134,6 +138,50 @@ func TestErrorReporting(t *testing.T) {
 	})
 }
 
+func TestShardLastErrors(t *testing.T) {
+	e, _, id := newEngineWithErrorThreshold(t, "", 0)
+
+	e.mtx.RLock()
+	sh := e.shards[id[0].String()]
+	e.mtx.RUnlock()
+
+	for i := 0; i < maxLastErrors+2; i++ {
+		e.reportShardError(hashedShard(sh), "test", fmt.Errorf("error %d", i))
+	}
+
+	bigErr := errors.New(strings.Repeat("x", maxErrorLength*2))
+	e.reportShardError(hashedShard(sh), "test", bigErr)
+
+	var info shard.Info
+	for _, si := range e.DumpInfo().Shards {
+		if si.ID.String() == id[0].String() {
+			info = si
+		}
+	}
+
+	require.Equal(t, uint32(maxLastErrors+3), info.ErrorCount)
+	require.Len(t, info.LastErrors, maxLastErrors)
+	require.Equal(t, maxErrorLength+len("..."), len(info.LastErrors[0].Message))
+	require.Equal(t, "test: error 11", info.LastErrors[1].Message)
+	require.Equal(t, "test: error 3", info.LastErrors[maxLastErrors-1].Message)
+
+	// multi-byte characters are not split
+	msg := strings.Repeat("€", maxErrorLength)
+	e.reportShardError(hashedShard(sh), "test", errors.New(msg))
+
+	lastErr := sh.lastErrors.get()[0].Message
+	require.True(t, utf8.ValidString(lastErr))
+	require.True(t, strings.HasSuffix(lastErr, "..."))
+	require.True(t, strings.HasPrefix("test: "+msg, strings.TrimSuffix(lastErr, "...")))
+	require.LessOrEqual(t, len(lastErr), maxErrorLength+len("..."))
+
+	require.NoError(t, e.ResetShardErrors(id[0]))
+	checkShardState(t, e, id[0], 0, mode.ReadWrite)
+	require.Empty(t, sh.lastErrors.get())
+
+	require.ErrorIs(t, e.ResetShardErrors(shard.NewIDFromBytes([]byte{1, 2, 3})), errShardNotFound)
+}
+
 // Issue #1186.
 func TestBlobstorFailback(t *testing.T) {
 	dir, err := os.MkdirTemp("", "*")
@@ -158,9 +206,9 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		_, err = e.Get(GetPrm{addr: addr})
+		_, err = e.Get(context.Background(), GetPrm{addr: addr})
 		require.NoError(t, err)
-		_, err = e.GetRange(RngPrm{addr: addr})
+		_, err = e.GetRange(context.Background(), RngPrm{addr: addr})
 		require.NoError(t, err)
 	}
 
@@ -178,15 +226,15 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		getRes, err := e.Get(GetPrm{addr: addr})
+		getRes, err := e.Get(context.Background(), GetPrm{addr: addr})
 		require.NoError(t, err)
 		require.Equal(t, objs[i], getRes.Object())
 
-		rngRes, err := e.GetRange(RngPrm{addr: addr, off: 1, ln: 10})
+		rngRes, err := e.GetRange(context.Background(), RngPrm{addr: addr, off: 1, ln: 10})
 		require.NoError(t, err)
 		require.Equal(t, objs[i].Payload()[1:11], rngRes.Object().Payload())
 
-		_, err = e.GetRange(RngPrm{addr: addr, off: errSmallSize + 10, ln: 1})
+		_, err = e.GetRange(context.Background(), RngPrm{addr: addr, off: errSmallSize + 10, ln: 1})
 		require.ErrorAs(t, err, &apistatus.ObjectOutOfRange{})
 	}
 
@@ -218,3 +266,20 @@ func corruptSubDir(t *testing.T, dir string) {
 		}
 	}
 }
+
+func TestHandleShardPanic(t *testing.T) {
+	e, _, id := newEngineWithErrorThreshold(t, "", 0)
+
+	e.handleShardPanic(id[0].String(), fmt.Errorf("%w: test", shard.ErrStoragePanic))
+
+	e.mtx.RLock()
+	sh0, sh1 := e.shards[id[0].String()], e.shards[id[1].String()]
+	e.mtx.RUnlock()
+
+	// shard is degraded regardless of the error threshold
+	require.Equal(t, mode.DegradedReadOnly, sh0.GetMode())
+	require.Equal(t, uint32(1), sh0.errorCount.Load())
+
+	require.Equal(t, mode.ReadWrite, sh1.GetMode())
+	require.Zero(t, sh1.errorCount.Load())
+}
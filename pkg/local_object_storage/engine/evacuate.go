@@ -15,13 +15,14 @@ import (
 
 // EvacuateShardPrm represents parameters for the EvacuateShard operation.
 type EvacuateShardPrm struct {
-	shardID      *shard.ID
-	handler      func(oid.Address, *objectSDK.Object) error
-	ignoreErrors bool
+	shardID     *shard.ID
+	handler     func(oid.Address, *objectSDK.Object) error
+	maintenance MaintenancePrm
 }
 
 // EvacuateShardRes represents result of the EvacuateShard operation.
 type EvacuateShardRes struct {
+	MaintenanceRes
 	count int
 }
 
@@ -30,9 +31,10 @@ func (p *EvacuateShardPrm) WithShardID(id *shard.ID) {
 	p.shardID = id
 }
 
-// WithIgnoreErrors sets flag to ignore errors.
-func (p *EvacuateShardPrm) WithIgnoreErrors(ignore bool) {
-	p.ignoreErrors = ignore
+// WithMaintenance sets the error handling parameters. Only the objects
+// which can't be read from the evacuated shard are skipped in IgnoreErrors mode.
+func (p *EvacuateShardPrm) WithMaintenance(prm MaintenancePrm) {
+	p.maintenance = prm
 }
 
 // WithFaultHandler sets handler to call for objects which cannot be saved on other shards.
@@ -57,7 +59,7 @@ var errMustHaveTwoShards = errors.New("amount of shards must be > 2")
 
 // Evacuate moves data from one shard to the others.
 // The shard being moved must be in read-only mode.
-func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
+func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (res EvacuateShardRes, err error) {
 	sid := prm.shardID.String()
 
 	e.mtx.RLock()
@@ -97,8 +99,13 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 	var listPrm shard.ListWithCursorPrm
 	listPrm.WithCount(defaultEvacuateBatchSize)
 
+	if e.metrics != nil {
+		defer func() {
+			e.metrics.SetEvacuationProgress(sid, uint64(res.count), false)
+		}()
+	}
+
 	var c *meta.Cursor
-	var res EvacuateShardRes
 	for {
 		listPrm.WithCursor(c)
 
@@ -122,10 +129,11 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 
 			getRes, err := sh.Get(getPrm)
 			if err != nil {
-				if prm.ignoreErrors {
-					continue
+				err = res.handleError(prm.maintenance, fmt.Errorf("could not get object %s: %w", lst[i], err))
+				if err != nil {
+					return res, err
 				}
-				return res, err
+				continue
 			}
 
 			hrw.SortSliceByWeightValue(shards, weights, hrw.Hash([]byte(lst[i].EncodeToString())))
@@ -133,7 +141,11 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 				if shards[j].ID().String() == sid {
 					continue
 				}
-				putDone, exists := e.putToShard(shards[j].hashedShard, j, shards[j].pool, lst[i], getRes.Object())
+				putDone, exists, err := e.putToShard(shards[j].hashedShard, j, shards[j].pool, lst[i], getRes.Object())
+				if err != nil {
+					// the object is rejected by the other shards too
+					break
+				}
 				if putDone || exists {
 					if putDone {
 						e.log.Debug("object is moved to another shard",
@@ -148,7 +160,7 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 			}
 
 			if prm.handler == nil {
-				// Do not check ignoreErrors flag here because
+				// Do not check IgnoreErrors flag here because
 				// ignoring errors on put make this command kinda useless.
 				return res, fmt.Errorf("%w: %s", errPutShard, lst[i])
 			}
@@ -161,5 +173,9 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 		}
 
 		c = listRes.Cursor()
+
+		if e.metrics != nil {
+			e.metrics.SetEvacuationProgress(sid, uint64(res.count), true)
+		}
 	}
 }
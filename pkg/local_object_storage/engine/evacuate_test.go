@@ -1,8 +1,10 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -82,7 +84,7 @@ func TestEvacuateShard(t *testing.T) {
 			var prm GetPrm
 			prm.WithAddress(objectCore.AddressOf(objects[i]))
 
-			_, err := e.Get(prm)
+			_, err := e.Get(context.Background(), prm)
 			require.NoError(t, err)
 		}
 	}
@@ -125,6 +127,45 @@ func TestEvacuateShard(t *testing.T) {
 	checkHasObjects(t)
 }
 
+func TestEvacuateShardIgnoreErrors(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 3, 3)
+
+	sh := e.shards[ids[2].String()]
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	// make two objects of the evacuated shard unreadable
+	var removed int
+	root := sh.DumpInfo().BlobStorInfo.RootPath
+	require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || removed == 2 {
+			return err
+		}
+
+		removed++
+		return os.Remove(path)
+	}))
+	require.Equal(t, 2, removed)
+
+	var prm EvacuateShardPrm
+	prm.WithShardID(ids[2])
+
+	_, err := e.Evacuate(prm)
+	require.Error(t, err)
+
+	prm.WithMaintenance(MaintenancePrm{IgnoreErrors: true, ErrorLimit: 1})
+
+	res, err := e.Evacuate(prm)
+	require.ErrorIs(t, err, ErrErrorLimitExceeded)
+	require.Equal(t, 2, res.ErrorCount())
+	require.Len(t, res.Errors(), 2)
+
+	prm.WithMaintenance(MaintenancePrm{IgnoreErrors: true, ErrorLimit: 2})
+
+	res, err = e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.ErrorCount())
+}
+
 func TestEvacuateNetwork(t *testing.T) {
 	var errReplication = errors.New("handler error")
 
@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// eventBufferSize is the number of events buffered for each subscriber.
+// Events are dropped if the subscriber lags behind more.
+const eventBufferSize = 256
+
+// StorageEventType is a type of the storage event.
+type StorageEventType uint8
+
+const (
+	_ StorageEventType = iota
+
+	// EventObjectStored is emitted when the object is saved to the shard.
+	EventObjectStored
+
+	// EventObjectInhumed is emitted when the object is marked as removed.
+	EventObjectInhumed
+
+	// EventObjectFlushed is emitted when the object is flushed from the
+	// write-cache of the shard to its blobstor.
+	EventObjectFlushed
+
+	// EventShardModeChanged is emitted when the mode of the shard is changed.
+	EventShardModeChanged
+)
+
+// String implements fmt.Stringer.
+func (t StorageEventType) String() string {
+	switch t {
+	case EventObjectStored:
+		return "object stored"
+	case EventObjectInhumed:
+		return "object inhumed"
+	case EventObjectFlushed:
+		return "object flushed"
+	case EventShardModeChanged:
+		return "shard mode changed"
+	default:
+		return "unknown"
+	}
+}
+
+// StorageEvent describes the change of the storage engine state.
+type StorageEvent struct {
+	// Type is the type of the event.
+	Type StorageEventType
+
+	// ShardID is the identifier of the shard the event happened in.
+	// Nil for EventObjectInhumed.
+	ShardID *shard.ID
+
+	// Address is the address of the object. Empty for EventShardModeChanged.
+	Address oid.Address
+
+	// Mode is the new mode of the shard. Set for EventShardModeChanged only.
+	Mode mode.Mode
+
+	// Dropped is the number of events dropped for the subscriber before
+	// this one because the subscriber did not read them in time.
+	Dropped uint64
+}
+
+// subscriber is a receiver of the storage events.
+type subscriber struct {
+	ch chan StorageEvent
+
+	// dropped is the number of events dropped since the last delivered one.
+	dropped *atomic.Uint64
+
+	// total is the total number of dropped events.
+	total *atomic.Uint64
+}
+
+// eventSubscribers is a set of the storage event subscribers.
+type eventSubscribers struct {
+	mtx sync.RWMutex
+
+	list map[*subscriber]struct{}
+}
+
+// Subscribe returns the channel of the storage events and the function
+// to cancel the subscription which closes the channel.
+//
+// Events are sent without blocking the storage operations: if the subscriber
+// does not read the events in time, the new ones are dropped and counted,
+// the number of the dropped events is passed in the next delivered one.
+func (e *StorageEngine) Subscribe() (<-chan StorageEvent, func()) {
+	s := &subscriber{
+		ch:      make(chan StorageEvent, eventBufferSize),
+		dropped: atomic.NewUint64(0),
+		total:   atomic.NewUint64(0),
+	}
+
+	e.subscribers.mtx.Lock()
+	if e.subscribers.list == nil {
+		e.subscribers.list = make(map[*subscriber]struct{})
+	}
+	e.subscribers.list[s] = struct{}{}
+	e.subscribers.mtx.Unlock()
+
+	var once sync.Once
+
+	return s.ch, func() {
+		once.Do(func() {
+			e.subscribers.mtx.Lock()
+			delete(e.subscribers.list, s)
+			close(s.ch)
+			e.subscribers.mtx.Unlock()
+
+			if total := s.total.Load(); total > 0 {
+				e.log.Info("storage events were dropped for the slow subscriber",
+					zap.Uint64("count", total))
+			}
+		})
+	}
+}
+
+// notify sends the event to all the subscribers without blocking.
+func (e *StorageEngine) notify(ev StorageEvent) {
+	e.subscribers.mtx.RLock()
+	defer e.subscribers.mtx.RUnlock()
+
+	for s := range e.subscribers.list {
+		ev.Dropped = s.dropped.Load()
+
+		select {
+		case s.ch <- ev:
+			s.dropped.Sub(ev.Dropped)
+		default:
+			s.dropped.Inc()
+			s.total.Inc()
+		}
+	}
+}
+
+// processFlushedObject notifies the subscribers about the object
+// flushed from the write-cache of the shard.
+func (e *StorageEngine) processFlushedObject(id *shard.ID, addr oid.Address) {
+	e.notify(StorageEvent{
+		Type:    EventObjectFlushed,
+		ShardID: id,
+		Address: addr,
+	})
+}
+
+// processModeChange notifies the subscribers about the shard mode change.
+func (e *StorageEngine) processModeChange(id *shard.ID, m mode.Mode) {
+	e.notify(StorageEvent{
+		Type:    EventShardModeChanged,
+		ShardID: id,
+		Mode:    m,
+	})
+}
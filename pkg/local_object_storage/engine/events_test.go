@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_Subscribe(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testEngineFromShardOpts(t, 1, []shard.Option{
+		shard.WithWriteCache(true),
+		shard.WithWriteCacheOptions(writecache.WithPath(filepath.Join(t.Name(), "writecache"))),
+	})
+	defer e.Close()
+
+	ch, unsubscribe := e.Subscribe()
+	defer unsubscribe()
+
+	var received []StorageEvent
+
+	// requireEvent waits for the event satisfying f among the received ones.
+	requireEvent := func(f func(StorageEvent) bool) StorageEvent {
+		timer := time.NewTimer(5 * time.Second)
+		defer timer.Stop()
+
+		for i := 0; ; i++ {
+			for ; i < len(received); i++ {
+				if f(received[i]) {
+					return received[i]
+				}
+			}
+
+			select {
+			case ev := <-ch:
+				received = append(received, ev)
+				i--
+			case <-timer.C:
+				require.FailNow(t, "event is not delivered")
+			}
+		}
+	}
+
+	var shardID *shard.ID
+	for _, sh := range e.DumpInfo().Shards {
+		shardID = sh.ID
+	}
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	require.NoError(t, Put(e, obj))
+
+	ev := requireEvent(func(ev StorageEvent) bool { return ev.Type == EventObjectStored })
+	require.Equal(t, addr, ev.Address)
+	require.Equal(t, shardID, ev.ShardID)
+
+	var flushPrm FlushWriteCachePrm
+	flushPrm.SetShardID(shardID)
+
+	_, err := e.FlushWriteCache(flushPrm)
+	require.NoError(t, err)
+
+	ev = requireEvent(func(ev StorageEvent) bool { return ev.Type == EventObjectFlushed })
+	require.Equal(t, addr, ev.Address)
+	require.Equal(t, shardID, ev.ShardID)
+
+	require.NoError(t, e.SetShardMode(shardID, mode.ReadOnly, false))
+
+	ev = requireEvent(func(ev StorageEvent) bool { return ev.Type == EventShardModeChanged })
+	require.Equal(t, shardID, ev.ShardID)
+	require.Equal(t, mode.ReadOnly, ev.Mode)
+
+	require.NoError(t, e.SetShardMode(shardID, mode.ReadWrite, false))
+
+	requireEvent(func(ev StorageEvent) bool {
+		return ev.Type == EventShardModeChanged && ev.Mode == mode.ReadWrite
+	})
+
+	var inhumePrm InhumePrm
+	inhumePrm.MarkAsGarbage(addr)
+
+	_, err = e.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	ev = requireEvent(func(ev StorageEvent) bool { return ev.Type == EventObjectInhumed })
+	require.Equal(t, addr, ev.Address)
+
+	for i := range received {
+		require.Zero(t, received[i].Dropped)
+	}
+
+	unsubscribe()
+
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestStorageEngine_SubscribeSlow(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 1)
+	defer e.Close()
+
+	slow, unsubscribeSlow := e.Subscribe()
+	defer unsubscribeSlow()
+
+	fast, unsubscribeFast := e.Subscribe()
+	defer unsubscribeFast()
+
+	const dropped = 10
+
+	done := make(chan uint64)
+	go func() {
+		var fastDropped uint64
+
+		for i := 0; i < eventBufferSize+dropped; i++ {
+			e.notify(StorageEvent{
+				Type:    EventObjectInhumed,
+				Address: oidtest.Address(),
+			})
+
+			// the fast subscriber reads all the events
+			ev := <-fast
+			fastDropped += ev.Dropped
+		}
+
+		done <- fastDropped
+	}()
+
+	select {
+	case fastDropped := <-done:
+		require.Zero(t, fastDropped)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "slow subscriber blocks the events")
+	}
+
+	// storage operations are not blocked too
+	obj := generateObjectWithCID(t, cidtest.ID())
+	require.NoError(t, Put(e, obj))
+
+	for i := 0; i < eventBufferSize; i++ {
+		ev := <-slow
+		require.Equal(t, EventObjectInhumed, ev.Type)
+		require.Zero(t, ev.Dropped)
+	}
+
+	e.notify(StorageEvent{Type: EventObjectInhumed})
+
+	ev := <-slow
+	require.Equal(t, uint64(dropped+1), ev.Dropped)
+}
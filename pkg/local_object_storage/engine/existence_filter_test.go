@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ExistenceFilter(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	s1 := testNewShard(t, 1, shard.WithExistenceFilter(1<<10))
+	s2 := testNewShard(t, 2, shard.WithExistenceFilter(1<<10))
+
+	e := testNewEngineWithShards(s1, s2)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+	splitID := object.NewSplitID()
+
+	parent := generateObjectWithCID(t, cnr)
+	idParent, _ := parent.ID()
+
+	child := generateObjectWithCID(t, cnr)
+	child.SetParent(parent)
+	child.SetParentID(idParent)
+	child.SetSplitID(splitID)
+
+	link := generateObjectWithCID(t, cnr)
+	link.SetParent(parent)
+	link.SetParentID(idParent)
+	idChild, _ := child.ID()
+	link.SetChildren(idChild)
+	link.SetSplitID(splitID)
+
+	for _, p := range []struct {
+		sh  *shard.Shard
+		obj *object.Object
+	}{{s1, child}, {s2, link}} {
+		var putPrm shard.PutPrm
+		putPrm.SetObject(p.obj)
+
+		_, err := p.sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	removed := oidtest.Address()
+
+	var inhumePrm InhumePrm
+	inhumePrm.WithTarget(oidtest.Address(), removed)
+
+	_, err := e.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	check := func(t *testing.T) {
+		for _, obj := range []*object.Object{child, link} {
+			_, err := Get(e, objectCore.AddressOf(obj))
+			require.NoError(t, err)
+		}
+
+		// split info is collected from both shards
+		var headPrm HeadPrm
+		headPrm.WithAddress(objectCore.AddressOf(parent))
+		headPrm.WithRaw(true)
+
+		_, err = e.Head(context.Background(), headPrm)
+
+		var siErr *object.SplitInfoError
+		require.ErrorAs(t, err, &siErr)
+
+		_, ok := siErr.SplitInfo().Link()
+		require.True(t, ok)
+		_, ok = siErr.SplitInfo().LastPart()
+		require.True(t, ok)
+
+		_, err = Get(e, removed)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+
+		_, err = Get(e, oidtest.Address())
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	}
+
+	t.Run("updated filter", check)
+
+	for _, sh := range []*shard.Shard{s1, s2} {
+		require.NoError(t, sh.Close())
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+	}
+
+	t.Run("built filter", check)
+}
@@ -1,21 +1,38 @@
 package engine
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
-func (e *StorageEngine) exists(addr oid.Address) (bool, error) {
+func (e *StorageEngine) exists(ctx context.Context, addr oid.Address) (bool, error) {
 	var shPrm shard.ExistsPrm
 	shPrm.SetAddress(addr)
+	shPrm.SetContext(ctx)
 	alreadyRemoved := false
 	exists := false
 
+	var ctxErr error
+
 	e.iterateOverSortedShards(addr, func(_ int, sh hashedShard) (stop bool) {
+		if ctxErr = ctxError(ctx, "exists"); ctxErr != nil {
+			return true
+		}
+
+		if !sh.MayContain(addr) {
+			return false
+		}
+
 		res, err := sh.Exists(shPrm)
 		if err != nil {
+			if ctxErr = ctxError(ctx, "exists"); ctxErr != nil {
+				return true
+			}
+
 			if shard.IsErrRemoved(err) {
 				alreadyRemoved = true
 
@@ -44,6 +61,10 @@ func (e *StorageEngine) exists(addr oid.Address) (bool, error) {
 		return false
 	})
 
+	if ctxErr != nil {
+		return false, ctxErr
+	}
+
 	if alreadyRemoved {
 		var errRemoved apistatus.ObjectAlreadyRemoved
 
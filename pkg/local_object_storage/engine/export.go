@@ -0,0 +1,288 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// exportBatchSize is the number of objects listed from the shard at once
+// during the container export.
+const exportBatchSize = 100
+
+// ExportContainerPrm groups the parameters of ExportContainer operation.
+type ExportContainerPrm struct {
+	cnr       cid.ID
+	stream    io.Writer
+	rateLimit uint64
+	cursor    *Cursor
+	progress  func(ExportProgress)
+}
+
+// WithContainerID sets the identifier of the container to export.
+func (p *ExportContainerPrm) WithContainerID(cnr cid.ID) {
+	p.cnr = cnr
+}
+
+// WithStream sets the destination stream of the export.
+func (p *ExportContainerPrm) WithStream(w io.Writer) {
+	p.stream = w
+}
+
+// WithRateLimit sets the maximum number of bytes written to the stream
+// per second. Zero value means no limit.
+func (p *ExportContainerPrm) WithRateLimit(bytesPerSec uint64) {
+	p.rateLimit = bytesPerSec
+}
+
+// WithCursor sets the cursor to resume the interrupted export from, see
+// ExportContainerRes.Cursor. The dump header is not written in this case,
+// so the stream must be positioned after the previously exported objects.
+func (p *ExportContainerPrm) WithCursor(c *Cursor) {
+	p.cursor = c
+}
+
+// WithProgressHandler sets the handler called after each exported batch
+// of objects and when the export is finished.
+func (p *ExportContainerPrm) WithProgressHandler(f func(ExportProgress)) {
+	p.progress = f
+}
+
+// ExportProgress describes the progress of the container export.
+type ExportProgress struct {
+	// Objects is the number of objects exported by the current call.
+	Objects uint64
+
+	// Bytes is the number of object bytes exported by the current call.
+	Bytes uint64
+}
+
+// ExportContainerRes groups the resulting values of ExportContainer operation.
+type ExportContainerRes struct {
+	progress ExportProgress
+	cursor   *Cursor
+}
+
+// Progress returns the number of exported objects and their size.
+func (r ExportContainerRes) Progress() ExportProgress {
+	return r.progress
+}
+
+// Cursor returns the cursor to resume the interrupted export from.
+// Returns nil if the export is completed.
+func (r ExportContainerRes) Cursor() *Cursor {
+	return r.cursor
+}
+
+// ExportContainer writes all the objects of the container stored in the
+// engine to the stream in the format of the shard dump (see shard.Dump), so
+// they can be restored with shard.Restore. Objects are listed from the
+// metabases shard by shard.
+//
+// The export is interrupted on context cancellation or any error, the result
+// contains the cursor to resume it from the object following the last written
+// one in this case.
+func (e *StorageEngine) ExportContainer(ctx context.Context, prm ExportContainerPrm) (ExportContainerRes, error) {
+	var (
+		res     ExportContainerRes
+		limiter = newRateLimiter(prm.rateLimit)
+		cursor  Cursor
+	)
+
+	if prm.cursor != nil {
+		cursor = *prm.cursor
+	} else if err := shard.WriteDumpHeader(prm.stream); err != nil {
+		return res, fmt.Errorf("could not write dump header: %w", err)
+	}
+
+	e.mtx.RLock()
+	shardIDs := make([]string, 0, len(e.shards))
+	for id := range e.shards {
+		shardIDs = append(shardIDs, id)
+	}
+	e.mtx.RUnlock()
+
+	sort.Strings(shardIDs)
+
+	e.log.Info("exporting container",
+		zap.Stringer("cid", prm.cnr),
+		zap.Bool("resumed", prm.cursor != nil))
+
+	report := func() {
+		if prm.progress != nil {
+			prm.progress(res.progress)
+		}
+	}
+
+	for _, id := range shardIDs {
+		if id < cursor.shardID {
+			continue
+		}
+
+		e.mtx.RLock()
+		sh, ok := e.shards[id]
+		e.mtx.RUnlock()
+		if !ok {
+			continue
+		}
+
+		if id != cursor.shardID {
+			cursor = Cursor{shardID: id}
+		}
+
+		for {
+			var listPrm shard.ListWithCursorPrm
+			listPrm.WithCount(exportBatchSize)
+			listPrm.WithCursor(cursor.shardCursor)
+			listPrm.WithContainerID(prm.cnr)
+
+			listRes, err := sh.ListWithCursor(listPrm)
+			if err != nil {
+				if errors.Is(err, shard.ErrEndOfListing) {
+					break
+				}
+
+				res.cursor = &cursor
+				return res, fmt.Errorf("could not list objects of shard %s: %w", id, err)
+			}
+
+			lst := listRes.AddressList()
+			for i := range lst {
+				n, err := e.exportObject(ctx, sh.Shard, lst[i], prm.stream, limiter)
+				if err != nil {
+					report()
+					res.cursor = e.exportCursor(sh.Shard, prm.cnr, cursor, i)
+					return res, err
+				}
+
+				if n > 0 {
+					res.progress.Objects++
+					res.progress.Bytes += n
+				}
+			}
+
+			cursor.shardCursor = listRes.Cursor()
+			report()
+		}
+	}
+
+	e.log.Info("container is exported",
+		zap.Stringer("cid", prm.cnr),
+		zap.Uint64("objects", res.progress.Objects),
+		zap.Uint64("bytes", res.progress.Bytes))
+
+	report()
+
+	return res, nil
+}
+
+// exportObject writes the object to the dump and returns its size.
+// Objects removed after the listing are skipped.
+func (e *StorageEngine) exportObject(ctx context.Context, sh *shard.Shard, addr oid.Address, w io.Writer, limiter *rateLimiter) (uint64, error) {
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(addr)
+
+	getRes, err := sh.Get(getPrm)
+	if err != nil {
+		if shard.IsErrNotFound(err) || shard.IsErrRemoved(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not get %s object: %w", addr, err)
+	}
+
+	data, err := getRes.Object().Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("could not marshal %s object: %w", addr, err)
+	}
+
+	if err := limiter.wait(ctx, uint64(len(data))); err != nil {
+		return 0, err
+	}
+
+	if err := shard.WriteDumpObject(w, data); err != nil {
+		return 0, fmt.Errorf("could not write %s object: %w", addr, err)
+	}
+
+	return uint64(len(data)), nil
+}
+
+// exportCursor returns the cursor pointing to the n-th object of the batch
+// listed from the shard starting from the batch cursor. If the position can
+// not be restored, the batch cursor is returned, so some objects can be
+// exported twice after the resumption.
+func (e *StorageEngine) exportCursor(sh *shard.Shard, cnr cid.ID, batch Cursor, n int) *Cursor {
+	if n == 0 {
+		return &batch
+	}
+
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(uint32(n))
+	listPrm.WithCursor(batch.shardCursor)
+	listPrm.WithContainerID(cnr)
+
+	listRes, err := sh.ListWithCursor(listPrm)
+	if err != nil {
+		e.log.Warn("could not restore the position of the interrupted export",
+			zap.String("shard_id", batch.shardID),
+			zap.Error(err))
+		return &batch
+	}
+
+	return &Cursor{
+		shardID:     batch.shardID,
+		shardCursor: listRes.Cursor(),
+	}
+}
+
+// rateLimiter limits the number of bytes processed per second.
+type rateLimiter struct {
+	// limit is the maximum number of bytes per second, 0 means no limit.
+	limit uint64
+
+	start time.Time
+	total uint64
+}
+
+func newRateLimiter(bytesPerSec uint64) *rateLimiter {
+	return &rateLimiter{
+		limit: bytesPerSec,
+		start: time.Now(),
+	}
+}
+
+// wait blocks until the next n bytes can be processed according to the rate limit.
+func (l *rateLimiter) wait(ctx context.Context, n uint64) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	l.total += n
+	if l.limit == 0 {
+		return nil
+	}
+
+	d := time.Duration(float64(l.total)/float64(l.limit)*float64(time.Second)) - time.Since(l.start)
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
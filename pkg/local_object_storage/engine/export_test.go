@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+// cancelingWriter cancels the context after the specified number of objects
+// is written to the dump.
+type cancelingWriter struct {
+	io.Writer
+	cancel func()
+	left   int
+}
+
+func (w *cancelingWriter) Write(p []byte) (int, error) {
+	// each object is written with 2 calls: size and data
+	if w.left--; w.left == 0 {
+		w.cancel()
+	}
+	return w.Writer.Write(p)
+}
+
+func readDump(t *testing.T, data []byte) []oid.Address {
+	require.True(t, bytes.HasPrefix(data, []byte("NEOF")))
+	data = data[4:]
+
+	var res []oid.Address
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 4)
+		ln := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+
+		obj := objectSDK.New()
+		require.NoError(t, obj.Unmarshal(data[:ln]))
+		data = data[ln:]
+
+		res = append(res, object.AddressOf(obj))
+	}
+
+	return res
+}
+
+func TestStorageEngine_ExportContainer(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	const objCount = 30
+
+	var (
+		cnr      = cidtest.ID()
+		expected []oid.Address
+		size     uint64
+	)
+
+	for i := 0; i < objCount; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetPayload(make([]byte, 1024))
+		require.NoError(t, Put(e, obj))
+
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		expected = append(expected, object.AddressOf(obj))
+		size += uint64(len(data))
+
+		// objects of the other containers are not exported
+		require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+	}
+
+	t.Run("rate limit", func(t *testing.T) {
+		var (
+			buf      bytes.Buffer
+			prm      ExportContainerPrm
+			progress []ExportProgress
+		)
+
+		prm.WithContainerID(cnr)
+		prm.WithStream(&buf)
+		prm.WithRateLimit(size * 4)
+		prm.WithProgressHandler(func(p ExportProgress) {
+			progress = append(progress, p)
+		})
+
+		start := time.Now()
+		res, err := e.ExportContainer(context.Background(), prm)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+		require.Nil(t, res.Cursor())
+		require.Equal(t, ExportProgress{Objects: objCount, Bytes: size}, res.Progress())
+		require.NotEmpty(t, progress)
+		require.Equal(t, res.Progress(), progress[len(progress)-1])
+		require.ElementsMatch(t, expected, readDump(t, buf.Bytes()))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		var (
+			buf    bytes.Buffer
+			cursor *Cursor
+			runs   int
+			total  uint64
+		)
+
+		for {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			var prm ExportContainerPrm
+			prm.WithContainerID(cnr)
+			prm.WithCursor(cursor)
+			prm.WithStream(&cancelingWriter{
+				Writer: &buf,
+				cancel: cancel,
+				left:   7 * 2,
+			})
+
+			res, err := e.ExportContainer(ctx, prm)
+			cancel()
+			runs++
+			total += res.Progress().Objects
+
+			if res.Cursor() == nil {
+				require.NoError(t, err)
+				break
+			}
+			require.ErrorIs(t, err, context.Canceled)
+
+			// the cursor survives the restart
+			cursor = new(Cursor)
+			require.NoError(t, cursor.Unmarshal(res.Cursor().Marshal()))
+		}
+
+		require.Greater(t, runs, 1)
+		require.EqualValues(t, objCount, total)
+		require.ElementsMatch(t, expected, readDump(t, buf.Bytes()))
+	})
+}
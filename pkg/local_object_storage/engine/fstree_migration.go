@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// FSTreeMigrationPrm groups the parameters of StartFSTreeMigration operation.
+type FSTreeMigrationPrm struct {
+	shardID *shard.ID
+	prm     shard.FSTreeMigrationPrm
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *FSTreeMigrationPrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// SetRateLimit sets the maximum number of objects processed per second.
+func (p *FSTreeMigrationPrm) SetRateLimit(objPerSec uint32) {
+	p.prm.WithRateLimit(objPerSec)
+}
+
+// SetRestart sets flag to start the migration from the beginning instead of
+// continuing the previous interrupted one.
+func (p *FSTreeMigrationPrm) SetRestart(v bool) {
+	p.prm.WithRestart(v)
+}
+
+// StartFSTreeMigration starts the background migration of the objects stored
+// in FSTree of a single shard to the sub-storages they belong to according to
+// the current storage policies (see shard.StartFSTreeMigration).
+func (e *StorageEngine) StartFSTreeMigration(p FSTreeMigrationPrm) error {
+	sh, err := e.shardByID(p.shardID)
+	if err != nil {
+		return err
+	}
+
+	return sh.StartFSTreeMigration(p.prm)
+}
+
+// StopFSTreeMigration interrupts the FSTree migration of a single shard
+// and waits for it to finish.
+func (e *StorageEngine) StopFSTreeMigration(id *shard.ID) error {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return err
+	}
+
+	sh.StopFSTreeMigration()
+	return nil
+}
+
+// FSTreeMigrationStatus returns the progress of the current or the latest
+// FSTree migration of a single shard.
+func (e *StorageEngine) FSTreeMigrationStatus(id *shard.ID) (shard.FSTreeMigrationStatus, error) {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return shard.FSTreeMigrationStatus{}, err
+	}
+
+	return sh.FSTreeMigrationStatus(), nil
+}
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// GCHistory returns the statistics of at most n latest GC runs of a single
+// shard in the order they were performed (see shard.GCHistory).
+func (e *StorageEngine) GCHistory(id *shard.ID, n int) ([]shard.GCTickStat, error) {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return sh.GCHistory(n), nil
+}
+
+// TriggerGC runs the GC remover of a single shard immediately and returns the
+// statistics of the run (see shard.TriggerGC).
+//
+// Returns shard.ErrReadOnlyMode or shard.ErrDegradedMode if the shard is not
+// in the read-write mode.
+func (e *StorageEngine) TriggerGC(ctx context.Context, id *shard.ID) (shard.GCTickStat, error) {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return shard.GCTickStat{}, err
+	}
+
+	return sh.TriggerGC(ctx)
+}
+
+// PreviewGarbage returns the addresses of at most limit objects of a single
+// shard which are marked as garbage and wait for the GC to remove them (see
+// shard.PreviewGarbage). Non-positive limit means no limit.
+//
+// Returns shard.ErrDegradedMode if the shard works without the metabase.
+func (e *StorageEngine) PreviewGarbage(id *shard.ID, limit int) ([]oid.Address, error) {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return sh.PreviewGarbage(limit)
+}
@@ -0,0 +1,133 @@
+package engine_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/teststorage"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+// errShardNotFound is the text of the error returned for the unknown shard.
+const errShardNotFound = "shard not found"
+
+func TestStorageEngine_ShardAdministration(t *testing.T) {
+	e := teststorage.NewEngine(t, 1, teststorage.WithWriteCache(true))
+	id := e.Shards[0].ID
+
+	t.Run("unknown shard", func(t *testing.T) {
+		rawID := make([]byte, 16)
+		_, _ = rand.Read(rawID)
+		unknown := shard.NewIDFromBytes(rawID)
+
+		var flushPrm engine.FlushWriteCachePrm
+		flushPrm.SetShardID(unknown)
+
+		_, err := e.FlushWriteCache(flushPrm)
+		require.EqualError(t, err, errShardNotFound)
+
+		_, err = e.TriggerGC(context.Background(), unknown)
+		require.EqualError(t, err, errShardNotFound)
+
+		_, err = e.PreviewGarbage(unknown, 0)
+		require.EqualError(t, err, errShardNotFound)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		obj := teststorage.GenerateObject(cidtest.ID())
+		require.NoError(t, engine.Put(e.StorageEngine, obj))
+
+		addr := objectCore.AddressOf(obj)
+
+		var inhumePrm engine.InhumePrm
+		inhumePrm.MarkAsGarbage(addr)
+
+		_, err := e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		garbage, err := e.PreviewGarbage(id, 0)
+		require.NoError(t, err)
+		require.Equal(t, garbage, []oid.Address{addr})
+
+		stat, err := e.TriggerGC(context.Background(), id)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stat.Candidates)
+		require.EqualValues(t, 1, stat.Removed)
+
+		history, err := e.GCHistory(id, 1)
+		require.NoError(t, err)
+		require.Equal(t, []shard.GCTickStat{stat}, history)
+
+		garbage, err = e.PreviewGarbage(id, 0)
+		require.NoError(t, err)
+		require.Empty(t, garbage)
+	})
+
+	t.Run("preview limit", func(t *testing.T) {
+		addrs := make([]oid.Address, 3)
+		for i := range addrs {
+			obj := teststorage.GenerateObject(cidtest.ID())
+			require.NoError(t, engine.Put(e.StorageEngine, obj))
+
+			addrs[i] = objectCore.AddressOf(obj)
+		}
+
+		var inhumePrm engine.InhumePrm
+		inhumePrm.MarkAsGarbage(addrs...)
+
+		_, err := e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		garbage, err := e.PreviewGarbage(id, 2)
+		require.NoError(t, err)
+		require.Len(t, garbage, 2)
+	})
+
+	t.Run("read-only mode", func(t *testing.T) {
+		require.NoError(t, e.SetShardMode(id, mode.ReadOnly, false))
+		t.Cleanup(func() { require.NoError(t, e.SetShardMode(id, mode.ReadWrite, false)) })
+
+		var flushPrm engine.FlushWriteCachePrm
+		flushPrm.SetShardID(id)
+
+		_, err := e.FlushWriteCache(flushPrm)
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+
+		_, err = e.TriggerGC(context.Background(), id)
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+
+		_, err = e.PreviewGarbage(id, 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("degraded mode", func(t *testing.T) {
+		require.NoError(t, e.SetShardMode(id, mode.DegradedReadOnly, false))
+		t.Cleanup(func() { require.NoError(t, e.SetShardMode(id, mode.ReadWrite, false)) })
+
+		var flushPrm engine.FlushWriteCachePrm
+		flushPrm.SetShardID(id)
+
+		_, err := e.FlushWriteCache(flushPrm)
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+
+		_, err = e.TriggerGC(context.Background(), id)
+		require.ErrorIs(t, err, shard.ErrDegradedMode)
+
+		_, err = e.PreviewGarbage(id, 0)
+		require.ErrorIs(t, err, shard.ErrDegradedMode)
+	})
+
+	t.Run("closed shard", func(t *testing.T) {
+		require.NoError(t, e.Close())
+
+		_, err := e.TriggerGC(context.Background(), id)
+		require.Error(t, err)
+	})
+}
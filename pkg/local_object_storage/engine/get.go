@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -14,6 +15,10 @@ import (
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
 	addr oid.Address
+
+	identity AccessIdentity
+
+	ignoreGCMark bool
 }
 
 // GetRes groups the resulting values of Get operation.
@@ -28,6 +33,20 @@ func (p *GetPrm) WithAddress(addr oid.Address) {
 	p.addr = addr
 }
 
+// WithIdentity is a Get option to set the requester identity
+// recorded in the access log.
+func (p *GetPrm) WithIdentity(id AccessIdentity) {
+	p.identity = id
+}
+
+// WithIgnoreGCMark is a Get option to return the object removed with
+// a tombstone or marked with GC if it is still physically stored. The
+// option is recorded in the access log. It must be used for the node
+// operator's requests only and must not be reachable via the client APIs.
+func (p *GetPrm) WithIgnoreGCMark() {
+	p.ignoreGCMark = true
+}
+
 // Object returns the requested object.
 func (r GetRes) Object() *objectSDK.Object {
 	return r.obj
@@ -42,20 +61,31 @@ func (r GetRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Get(prm GetPrm) (res GetRes, err error) {
+// Returns the context error if the context is done before the object is read.
+func (e *StorageEngine) Get(ctx context.Context, prm GetPrm) (res GetRes, err error) {
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.get(prm)
+		res, err = e.get(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
+func (e *StorageEngine) get(ctx context.Context, prm GetPrm) (GetRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddGetDuration)()
 	}
 
+	if e.accessLog != nil {
+		e.logAccess(AccessEntry{
+			Operation: AccessGet,
+			Identity:  prm.identity,
+			Address:   prm.addr,
+
+			IgnoreGCMark: prm.ignoreGCMark,
+		})
+	}
+
 	var (
 		obj   *objectSDK.Object
 		siErr *objectSDK.SplitInfoError
@@ -67,14 +97,26 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 
 		shardWithMeta hashedShard
 		metaError     error
+
+		ctxErr error
 	)
 
 	var shPrm shard.GetPrm
 	shPrm.SetAddress(prm.addr)
+	shPrm.SetIgnoreGCMark(prm.ignoreGCMark)
+	shPrm.SetContext(ctx)
 
 	var hasDegraded bool
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		if ctxErr = ctxError(ctx, "get"); ctxErr != nil {
+			return true
+		}
+
+		if !sh.MayContain(prm.addr) {
+			return false
+		}
+
 		noMeta := sh.GetMode().NoMetabase()
 		shPrm.SetIgnoreMeta(noMeta)
 
@@ -82,6 +124,10 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 
 		res, err := sh.Get(shPrm)
 		if err != nil {
+			if ctxErr = ctxError(ctx, "get"); ctxErr != nil {
+				return true
+			}
+
 			if res.HasMeta() {
 				shardWithMeta = sh
 				metaError = err
@@ -127,6 +173,10 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 		return true
 	})
 
+	if ctxErr != nil {
+		return GetRes{}, ctxErr
+	}
+
 	if outSI != nil {
 		return GetRes{}, objectSDK.NewSplitInfoError(outSI)
 	}
@@ -147,10 +197,21 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 				return false
 			}
 
+			if ctxErr = ctxError(ctx, "get"); ctxErr != nil {
+				return true
+			}
+
 			res, err := sh.Get(shPrm)
+			if ctxErr = ctxError(ctx, "get"); ctxErr != nil {
+				return true
+			}
+
 			obj = res.Object()
 			return err == nil
 		})
+		if ctxErr != nil {
+			return GetRes{}, ctxErr
+		}
 		if obj == nil {
 			return GetRes{}, outError
 		}
@@ -170,7 +231,7 @@ func Get(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 	var getPrm GetPrm
 	getPrm.WithAddress(addr)
 
-	res, err := storage.Get(getPrm)
+	res, err := storage.Get(context.Background(), getPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -12,8 +13,10 @@ import (
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
-	addr oid.Address
-	raw  bool
+	addr         oid.Address
+	raw          bool
+	short        bool
+	ignoreGCMark bool
 }
 
 // HeadRes groups the resulting values of Head operation.
@@ -35,6 +38,21 @@ func (p *HeadPrm) WithRaw(raw bool) {
 	p.raw = raw
 }
 
+// WithShortHeader is a Head option to request only the fields of the short
+// object header. If it is set, Head may return object with the other header
+// fields missing.
+func (p *HeadPrm) WithShortHeader(short bool) {
+	p.short = short
+}
+
+// WithIgnoreGCMark is a Head option to return the header of the object
+// removed with a tombstone or marked with GC if it is still stored. It must
+// be used for the node operator's requests only and must not be reachable
+// via the client APIs.
+func (p *HeadPrm) WithIgnoreGCMark() {
+	p.ignoreGCMark = true
+}
+
 // Header returns the requested object header.
 //
 // Instance has empty payload.
@@ -51,16 +69,17 @@ func (r HeadRes) Header() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object was inhumed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Head(prm HeadPrm) (res HeadRes, err error) {
+// Returns the context error if the context is done before the header is read.
+func (e *StorageEngine) Head(ctx context.Context, prm HeadPrm) (res HeadRes, err error) {
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.head(prm)
+		res, err = e.head(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
+func (e *StorageEngine) head(ctx context.Context, prm HeadPrm) (HeadRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
@@ -73,15 +92,32 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 
 		outSI    *objectSDK.SplitInfo
 		outError error = errNotFound
+
+		ctxErr error
 	)
 
 	var shPrm shard.HeadPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRaw(prm.raw)
+	shPrm.SetShortHeader(prm.short)
+	shPrm.SetIgnoreGCMark(prm.ignoreGCMark)
+	shPrm.SetContext(ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		if ctxErr = ctxError(ctx, "head"); ctxErr != nil {
+			return true
+		}
+
+		if !sh.MayContain(prm.addr) {
+			return false
+		}
+
 		res, err := sh.Head(shPrm)
 		if err != nil {
+			if ctxErr = ctxError(ctx, "head"); ctxErr != nil {
+				return true
+			}
+
 			switch {
 			case shard.IsErrNotFound(err):
 				return false // ignore, go to next shard
@@ -126,6 +162,10 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 		return true
 	})
 
+	if ctxErr != nil {
+		return HeadRes{}, ctxErr
+	}
+
 	if outSI != nil {
 		return HeadRes{}, objectSDK.NewSplitInfoError(outSI)
 	}
@@ -144,7 +184,7 @@ func Head(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 	var headPrm HeadPrm
 	headPrm.WithAddress(addr)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(context.Background(), headPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +199,7 @@ func HeadRaw(storage *StorageEngine, addr oid.Address, raw bool) (*objectSDK.Obj
 	headPrm.WithAddress(addr)
 	headPrm.WithRaw(raw)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(context.Background(), headPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -65,7 +66,7 @@ func TestHeadRaw(t *testing.T) {
 		headPrm.WithAddress(parentAddr)
 		headPrm.WithRaw(true)
 
-		_, err = e.Head(headPrm)
+		_, err = e.Head(context.Background(), headPrm)
 		require.Error(t, err)
 
 		si, ok := err.(*object.SplitInfoError)
@@ -0,0 +1,237 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+)
+
+// HealthStatus is a status of the storage health.
+type HealthStatus uint8
+
+const (
+	// HealthOK means that the storage works normally.
+	HealthOK HealthStatus = iota
+
+	// HealthDegraded means that the storage works with limitations
+	// or is close to the limits.
+	HealthDegraded
+
+	// HealthCritical means that the storage can not serve the requests.
+	HealthCritical
+)
+
+// String implements fmt.Stringer.
+func (s HealthStatus) String() string {
+	switch s {
+	case HealthOK:
+		return "OK"
+	case HealthDegraded:
+		return "DEGRADED"
+	case HealthCritical:
+		return "CRITICAL"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+// HealthThresholds groups the limits the storage health status is derived
+// from. Zero value of a limit disables the corresponding check.
+type HealthThresholds struct {
+	// WriteCacheFill is the write-cache fill percentage
+	// starting from which the shard is degraded.
+	WriteCacheFill uint64
+
+	// WriteCacheStall is the time the non-empty write-cache can go without
+	// flushes before it is considered stalled and the shard is degraded.
+	WriteCacheStall time.Duration
+
+	// GCBacklog is the number of objects waiting for the GC
+	// starting from which the shard is degraded.
+	GCBacklog uint64
+
+	// ShardErrors is the number of shard errors starting
+	// from which the shard is degraded.
+	ShardErrors uint32
+
+	// FreeSpaceDegraded is the amount of free disk space in bytes
+	// below which the shard is degraded.
+	FreeSpaceDegraded uint64
+
+	// FreeSpaceCritical is the amount of free disk space in bytes
+	// below which the shard is critical.
+	FreeSpaceCritical uint64
+}
+
+// DefaultHealthThresholds returns the limits used to derive the storage
+// health status by default.
+func DefaultHealthThresholds() HealthThresholds {
+	return HealthThresholds{
+		WriteCacheFill:    90,
+		WriteCacheStall:   5 * time.Minute,
+		GCBacklog:         100_000,
+		ShardErrors:       10,
+		FreeSpaceDegraded: 1 << 30,
+		FreeSpaceCritical: 64 << 20,
+	}
+}
+
+// ShardHealth groups the health indicators of the shard.
+type ShardHealth struct {
+	// ID is the shard identifier.
+	ID *shard.ID
+
+	// Mode is the shard mode.
+	Mode mode.Mode
+
+	// ForcedReadOnly is true if the shard is forced to stay read-only.
+	ForcedReadOnly bool
+
+	// ErrorCount is the number of errors occurred in the shard operations.
+	ErrorCount uint32
+
+	// WriteCache is the write-cache statistics, nil if the write-cache is disabled.
+	WriteCache *writecache.Stats
+
+	// GCBacklog is the number of objects waiting for the GC.
+	GCBacklog uint64
+
+	// FreeSpace is the amount of free disk space in bytes.
+	FreeSpace uint64
+
+	// Status is the health status of the shard.
+	Status HealthStatus
+
+	// Problems describes the reasons of the non-OK status.
+	Problems []string
+}
+
+// HealthReport groups the health indicators of the storage.
+type HealthReport struct {
+	// Status is the overall health status of the storage. The storage
+	// is critical if all the shards are critical and degraded if any
+	// of the shards is not OK.
+	Status HealthStatus
+
+	// Shards contains the health indicators of the shards.
+	Shards []ShardHealth
+}
+
+// HealthSummary returns the health indicators of all the shards along
+// with the overall status derived from the thresholds (see WithHealthThresholds).
+// Shards in DEGRADED_READ_ONLY mode are critical. The storage without shards
+// is critical.
+func (e *StorageEngine) HealthSummary() HealthReport {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	res := HealthReport{
+		Status: HealthOK,
+		Shards: make([]ShardHealth, 0, len(e.shards)),
+	}
+
+	var critical int
+
+	for _, sh := range e.shards {
+		h := e.shardHealth(sh)
+		if h.Status == HealthCritical {
+			critical++
+		}
+
+		if h.Status > res.Status {
+			res.Status = h.Status
+		}
+
+		res.Shards = append(res.Shards, h)
+	}
+
+	if critical < len(e.shards) && res.Status == HealthCritical {
+		// other shards still serve the requests
+		res.Status = HealthDegraded
+	} else if len(e.shards) == 0 {
+		res.Status = HealthCritical
+	}
+
+	return res
+}
+
+func (e *StorageEngine) shardHealth(sh shardWrapper) ShardHealth {
+	var (
+		t   = e.healthThresholds
+		res = ShardHealth{
+			ID:             sh.ID(),
+			Mode:           sh.GetMode(),
+			ForcedReadOnly: sh.ForcedReadOnly(),
+			ErrorCount:     sh.errorCount.Load(),
+		}
+	)
+
+	report := func(st HealthStatus, format string, args ...interface{}) {
+		if st > res.Status {
+			res.Status = st
+		}
+
+		res.Problems = append(res.Problems, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case res.Mode == mode.ReadWrite:
+	case res.Mode == mode.DegradedReadOnly:
+		report(HealthCritical, "shard is in %s mode", res.Mode)
+	case res.ForcedReadOnly:
+		report(HealthDegraded, "shard is forced to %s mode", res.Mode)
+	default:
+		report(HealthDegraded, "shard is in %s mode", res.Mode)
+	}
+
+	if t.ShardErrors > 0 && res.ErrorCount >= t.ShardErrors {
+		report(HealthDegraded, "%d errors occurred", res.ErrorCount)
+	}
+
+	if st, ok := sh.WriteCacheStats(); ok {
+		res.WriteCache = &st
+
+		if t.WriteCacheFill > 0 && st.Capacity > 0 && st.Size*100 >= st.Capacity*t.WriteCacheFill {
+			report(HealthDegraded, "write-cache is %d%% full", st.Size*100/st.Capacity)
+		}
+
+		// read-only write-cache is not flushed in background
+		if t.WriteCacheStall > 0 && res.Mode == mode.ReadWrite && st.Objects > 0 &&
+			time.Since(st.LastFlush) >= t.WriteCacheStall {
+			report(HealthDegraded, "write-cache has not been flushed since %s", st.LastFlush.Format(time.RFC3339))
+		}
+	}
+
+	if !res.Mode.NoMetabase() {
+		backlog, err := sh.GCBacklog()
+		if err != nil {
+			report(HealthDegraded, "could not read GC backlog: %v", err)
+		} else {
+			res.GCBacklog = backlog
+
+			if t.GCBacklog > 0 && backlog >= t.GCBacklog {
+				report(HealthDegraded, "%d objects wait for GC", backlog)
+			}
+		}
+	}
+
+	ds, err := e.shardDiskSpace(sh.Shard)
+	if err != nil {
+		report(HealthDegraded, "could not read free space: %v", err)
+	} else {
+		free := ds.Free
+		res.FreeSpace = free
+
+		switch {
+		case t.FreeSpaceCritical > 0 && free < t.FreeSpaceCritical:
+			report(HealthCritical, "%d bytes of disk space left", free)
+		case t.FreeSpaceDegraded > 0 && free < t.FreeSpaceDegraded:
+			report(HealthDegraded, "%d bytes of disk space left", free)
+		}
+	}
+
+	return res
+}
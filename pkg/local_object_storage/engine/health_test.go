@@ -0,0 +1,193 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// failingPutStorage is a storage which does not accept new objects.
+type failingPutStorage struct {
+	common.Storage
+}
+
+func (failingPutStorage) Type() string {
+	return "failing"
+}
+
+func (failingPutStorage) Put(common.PutPrm) (common.PutRes, error) {
+	return common.PutRes{}, errors.New("put is not allowed")
+}
+
+func TestStorageEngine_HealthSummary(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	const freeSpace = 1 << 20
+
+	newEngine := func(t *testing.T, shards ...*shard.Shard) *StorageEngine {
+		e := testNewEngineWithShards(shards...)
+		e.shardDiskSpace = func(*shard.Shard) (shard.DiskSpace, error) {
+			return shard.DiskSpace{Free: freeSpace}, nil
+		}
+		e.healthThresholds = HealthThresholds{
+			WriteCacheStall:   time.Millisecond,
+			ShardErrors:       2,
+			FreeSpaceDegraded: freeSpace / 2,
+			FreeSpaceCritical: freeSpace / 4,
+		}
+		t.Cleanup(func() { _ = e.Close() })
+		return e
+	}
+
+	t.Run("no shards", func(t *testing.T) {
+		e := newEngine(t)
+		require.Equal(t, HealthCritical, e.HealthSummary().Status)
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		e := newEngine(t, testNewShard(t, 1), testNewShard(t, 2))
+		require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+
+		res := e.HealthSummary()
+		require.Equal(t, HealthOK, res.Status)
+		require.Len(t, res.Shards, 2)
+
+		for _, sh := range res.Shards {
+			require.Equal(t, HealthOK, sh.Status)
+			require.Equal(t, mode.ReadWrite, sh.Mode)
+			require.Empty(t, sh.Problems)
+			require.Nil(t, sh.WriteCache)
+			require.Equal(t, uint64(freeSpace), sh.FreeSpace)
+		}
+	})
+
+	t.Run("degraded shard", func(t *testing.T) {
+		s1 := testNewShard(t, 1)
+		s2 := testNewShard(t, 2)
+		e := newEngine(t, s1, s2)
+
+		require.NoError(t, s1.SetMode(mode.ReadOnly))
+
+		res := e.HealthSummary()
+		require.Equal(t, HealthDegraded, res.Status)
+
+		sh := shardHealthByID(t, res, s1.ID())
+		require.Equal(t, HealthDegraded, sh.Status)
+		require.Equal(t, mode.ReadOnly, sh.Mode)
+		require.Len(t, sh.Problems, 1)
+
+		require.Equal(t, HealthOK, shardHealthByID(t, res, s2.ID()).Status)
+
+		// one of the shards still works
+		require.NoError(t, s1.SetMode(mode.DegradedReadOnly))
+		res = e.HealthSummary()
+		require.Equal(t, HealthDegraded, res.Status)
+		require.Equal(t, HealthCritical, shardHealthByID(t, res, s1.ID()).Status)
+
+		require.NoError(t, s2.SetMode(mode.DegradedReadOnly))
+		require.Equal(t, HealthCritical, e.HealthSummary().Status)
+	})
+
+	t.Run("shard errors", func(t *testing.T) {
+		s := testNewShard(t, 1)
+		e := newEngine(t, s)
+
+		e.shards[s.ID().String()].errorCount.Store(2)
+
+		res := e.HealthSummary()
+		require.Equal(t, HealthDegraded, res.Status)
+		require.Equal(t, uint32(2), res.Shards[0].ErrorCount)
+	})
+
+	t.Run("low free space", func(t *testing.T) {
+		e := newEngine(t, testNewShard(t, 1))
+
+		e.shardDiskSpace = func(*shard.Shard) (shard.DiskSpace, error) {
+			return shard.DiskSpace{Free: freeSpace / 3}, nil
+		}
+		require.Equal(t, HealthDegraded, e.HealthSummary().Status)
+
+		e.shardDiskSpace = func(*shard.Shard) (shard.DiskSpace, error) {
+			return shard.DiskSpace{Free: freeSpace / 5}, nil
+		}
+		require.Equal(t, HealthCritical, e.HealthSummary().Status)
+	})
+
+	t.Run("stalled write-cache", func(t *testing.T) {
+		dir := filepath.Join(t.Name(), "shard")
+
+		sid, err := generateShardID()
+		require.NoError(t, err)
+
+		// objects can not be flushed from the write-cache
+		s := shard.New(
+			shard.WithID(sid),
+			shard.WithLogger(zap.L()),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages([]blobstor.SubStorage{{
+					Storage: failingPutStorage{fstree.New(fstree.WithPath(filepath.Join(dir, "fstree")))},
+				}})),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, "metabase")),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+			shard.WithWriteCache(true),
+			shard.WithWriteCacheOptions(
+				writecache.WithPath(filepath.Join(dir, "writecache")),
+				writecache.WithSmallObjectSize(1024),
+				writecache.WithMaxObjectSize(2048),
+				writecache.WithMaxCacheSize(2048)),
+		)
+		require.NoError(t, s.Open())
+		require.NoError(t, s.Init())
+
+		e := newEngine(t, s)
+
+		res := e.HealthSummary()
+		require.Equal(t, HealthOK, res.Status)
+		require.NotNil(t, res.Shards[0].WriteCache)
+
+		require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+		time.Sleep(2 * e.healthThresholds.WriteCacheStall)
+
+		res = e.HealthSummary()
+		require.Equal(t, HealthDegraded, res.Status)
+		require.Equal(t, uint64(1), res.Shards[0].WriteCache.Objects)
+		require.Len(t, res.Shards[0].Problems, 1)
+
+		// half of the write-cache is used
+		e.healthThresholds.WriteCacheFill = 50
+		e.healthThresholds.WriteCacheStall = 0
+
+		res = e.HealthSummary()
+		require.Equal(t, HealthDegraded, res.Status)
+		require.Len(t, res.Shards[0].Problems, 1)
+	})
+}
+
+func shardHealthByID(t *testing.T, r HealthReport, id *shard.ID) ShardHealth {
+	for i := range r.Shards {
+		if r.Shards[i].ID.String() == id.String() {
+			return r.Shards[i]
+		}
+	}
+
+	require.FailNow(t, "missing shard", id.String())
+	return ShardHealth{}
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 )
 
 // Info groups the information about StorageEngine.
@@ -19,8 +20,17 @@ func (e *StorageEngine) DumpInfo() (i Info) {
 	for _, sh := range e.shards {
 		info := sh.DumpInfo()
 		info.ErrorCount = sh.errorCount.Load()
+		info.LastErrors = sh.lastErrors.get()
+		info.WriteCacheInfo = sh.WriteCacheInfo()
 		i.Shards = append(i.Shards, info)
 	}
 
 	return
 }
+
+// SuppressedErrors returns at most n latest summaries of the repeated shard
+// errors suppressed in the log (see WithErrorLogInterval) starting from the
+// newest one. Non-positive n means all the retained summaries.
+func (e *StorageEngine) SuppressedErrors(n int) []logger.SuppressedSummary {
+	return e.errLog.Summaries(n)
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -16,7 +17,11 @@ type InhumePrm struct {
 	tombstone *oid.Address
 	addrs     []oid.Address
 
+	tombExpiration uint64
+
 	forceRemoval bool
+
+	skipExistenceCheck bool
 }
 
 // InhumeRes encapsulates results of inhume operation.
@@ -32,6 +37,15 @@ func (p *InhumePrm) WithTarget(tombstone oid.Address, addrs ...oid.Address) {
 	p.tombstone = &tombstone
 }
 
+// WithTombstoneExpiration sets the expiration epoch of the tombstone set
+// via WithTarget. If set, Inhume checks that the tombstone does not expire
+// before the locks of the inhumed objects, see ErrTombstoneExpiresBeforeLock.
+//
+// Zero value disables the check.
+func (p *InhumePrm) WithTombstoneExpiration(epoch uint64) {
+	p.tombExpiration = epoch
+}
+
 // MarkAsGarbage marks an object to be physically removed from local storage.
 //
 // Should not be called along with WithTarget.
@@ -41,20 +55,41 @@ func (p *InhumePrm) MarkAsGarbage(addrs ...oid.Address) {
 }
 
 // WithForceRemoval inhumes objects specified via MarkAsGarbage with GC mark
-// without any object restrictions checks.
+// without any object restrictions checks. The tombstone expiration is not
+// checked either.
 func (p *InhumePrm) WithForceRemoval() {
 	p.forceRemoval = true
 	p.tombstone = nil
 }
 
+// WithoutExistenceCheck disables the check of the object presence in the shards
+// before inhume, so the objects are marked in the shard with the highest priority
+// for the object address. The shard stores the object unless the object has been
+// put to another shard due to the failures, so the option speeds up the bulk
+// inhume of the objects known to be stored in the engine.
+func (p *InhumePrm) WithoutExistenceCheck() {
+	p.skipExistenceCheck = true
+}
+
 var errInhumeFailure = errors.New("inhume operation failed")
 
+// ErrTombstoneExpiresBeforeLock is returned by Inhume if the tombstone
+// expires earlier than the lock of at least one of the inhumed objects.
+// The error wraps apistatus.ObjectLocked, since the lock prevents the
+// removal in the end.
+var ErrTombstoneExpiresBeforeLock = fmt.Errorf("%w: tombstone expires before the object lock", apistatus.ObjectLocked{})
+
 // Inhume calls metabase. Inhume method to mark an object as removed. It won't be
 // removed physically from the shard until `Delete` operation.
 //
 // Allows inhuming non-locked objects only. Returns apistatus.ObjectLocked
 // if at least one object is locked.
 //
+// Returns ErrTombstoneExpiresBeforeLock (which is apistatus.ObjectLocked too)
+// if the tombstone expiration is set (see WithTombstoneExpiration) and the
+// tombstone expires earlier than any lock of the objects stored in any shard.
+// No object is inhumed then.
+//
 // NOTE: Marks any object as removed (despite any prohibitions on operations
 // with that object) if WithForceRemoval option has been provided.
 //
@@ -73,6 +108,15 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 		defer elapsed(e.metrics.AddInhumeDuration)()
 	}
 
+	if prm.tombstone != nil && prm.tombExpiration > 0 && !prm.forceRemoval {
+		for i := range prm.addrs {
+			err := checkTombstoneExpiration(*prm.tombstone, prm.tombExpiration, prm.addrs[i], e.locksFor(prm.addrs[i]))
+			if err != nil {
+				return InhumeRes{}, err
+			}
+		}
+	}
+
 	var shPrm shard.InhumePrm
 	if prm.forceRemoval {
 		shPrm.ForceRemoval()
@@ -85,24 +129,57 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 			shPrm.MarkAsGarbage(prm.addrs[i])
 		}
 
-		switch e.inhumeAddr(prm.addrs[i], shPrm, true) {
+		var status uint8
+		if !prm.skipExistenceCheck {
+			status = e.inhumeAddr(prm.addrs[i], shPrm, true)
+		}
+
+		if status == 0 {
+			status = e.inhumeAddr(prm.addrs[i], shPrm, false)
+		}
+
+		switch status {
 		case 2:
 			return InhumeRes{}, meta.ErrLockObjectRemoval
 		case 1:
 			return InhumeRes{}, apistatus.ObjectLocked{}
 		case 0:
-			switch e.inhumeAddr(prm.addrs[i], shPrm, false) {
-			case 1:
-				return InhumeRes{}, apistatus.ObjectLocked{}
-			case 0:
-				return InhumeRes{}, errInhumeFailure
-			}
+			return InhumeRes{}, errInhumeFailure
 		}
+
+		e.notify(StorageEvent{
+			Type:    EventObjectInhumed,
+			Address: prm.addrs[i],
+		})
 	}
 
 	return InhumeRes{}, nil
 }
 
+// checkTombstoneExpiration returns ErrTombstoneExpiresBeforeLock if the
+// tombstone expiring after the exp epoch does not outlive any of the object
+// locks. Locks without the expiration epoch never expire. Locks with the
+// LOCK object missing on the node are skipped, their expiration is unknown.
+func checkTombstoneExpiration(tomb oid.Address, exp uint64, addr oid.Address, locks []meta.ObjectLock) error {
+	for i := range locks {
+		if !locks[i].LockerFound {
+			continue
+		}
+
+		if locks[i].Expiration == 0 {
+			return fmt.Errorf("%w: tombstone %s expires after epoch %d, object %s is locked by %s without expiration",
+				ErrTombstoneExpiresBeforeLock, tomb, exp, addr, locks[i].Locker)
+		}
+
+		if locks[i].Expiration > exp {
+			return fmt.Errorf("%w: tombstone %s expires after epoch %d, object %s is locked by %s until epoch %d",
+				ErrTombstoneExpiresBeforeLock, tomb, exp, addr, locks[i].Locker, locks[i].Expiration)
+		}
+	}
+
+	return nil
+}
+
 // Returns:
 //   - 0: fail
 //   - 1: object locked
@@ -123,6 +200,10 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, prm shard.InhumePrm, checkE
 		}()
 
 		if checkExists {
+			if !sh.MayContain(addr) {
+				return
+			}
+
 			existPrm.SetAddress(addr)
 			exRes, err := sh.Exists(existPrm)
 			if err != nil {
@@ -167,16 +248,33 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, prm shard.InhumePrm, checkE
 	return
 }
 
+// defaultTombstonesBatchSize is the default number of the expired tombstones
+// handled by the shard at once.
+const defaultTombstonesBatchSize = 100
+
+// processExpiredTombstones passes the expired tombstones to every shard in
+// batches of the configured size to not block the shard for a long time.
+// Context is checked between the batches.
 func (e *StorageEngine) processExpiredTombstones(ctx context.Context, addrs []meta.TombstonedObject) {
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
-		sh.HandleExpiredTombstones(addrs)
+		for batch := addrs; len(batch) > 0; {
+			n := e.tombstonesBatchSize
+			if n > len(batch) {
+				n = len(batch)
+			}
 
-		select {
-		case <-ctx.Done():
-			return true
-		default:
-			return false
+			e.handleExpiredTombstones(sh.Shard, batch[:n])
+			batch = batch[n:]
+
+			select {
+			case <-ctx.Done():
+				e.log.Info("interrupt processing the expired tombstones by context")
+				return true
+			default:
+			}
 		}
+
+		return false
 	})
 }
 
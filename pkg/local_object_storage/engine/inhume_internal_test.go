@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ProcessExpiredTombstones(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	const (
+		shardNum  = 2
+		batchSize = 3
+		tsNum     = 2*batchSize + 1
+	)
+
+	e := testNewEngineWithShardNum(t, shardNum)
+	t.Cleanup(func() { _ = e.Close() })
+
+	WithExpiredTombstonesBatchSize(batchSize)(e.cfg)
+
+	var batches map[*shard.Shard][][]meta.TombstonedObject
+	e.handleExpiredTombstones = func(sh *shard.Shard, tss []meta.TombstonedObject) {
+		batches[sh] = append(batches[sh], tss)
+	}
+
+	tss := make([]meta.TombstonedObject, tsNum)
+
+	t.Run("batches", func(t *testing.T) {
+		batches = make(map[*shard.Shard][][]meta.TombstonedObject)
+
+		e.processExpiredTombstones(context.Background(), tss)
+
+		require.Len(t, batches, shardNum)
+		for _, bb := range batches {
+			require.Len(t, bb, 3)
+			require.Len(t, bb[0], batchSize)
+			require.Len(t, bb[1], batchSize)
+			require.Len(t, bb[2], 1)
+
+			var handled []meta.TombstonedObject
+			for i := range bb {
+				handled = append(handled, bb[i]...)
+			}
+			require.Equal(t, tss, handled)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		batches = make(map[*shard.Shard][][]meta.TombstonedObject)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		e.processExpiredTombstones(ctx, tss)
+
+		require.Len(t, batches, 1)
+		for _, bb := range batches {
+			require.Len(t, bb, 1)
+		}
+	})
+}
+
+func TestCheckTombstoneExpiration(t *testing.T) {
+	tomb := oidtest.Address()
+	addr := oidtest.Address()
+
+	lock := func(exp uint64, found bool) meta.ObjectLock {
+		return meta.ObjectLock{Locker: oidtest.Address(), Expiration: exp, LockerFound: found}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		locks []meta.ObjectLock
+		fail  bool
+	}{
+		{name: "no locks"},
+		{name: "earlier lock", locks: []meta.ObjectLock{lock(99, true)}},
+		{name: "same epoch", locks: []meta.ObjectLock{lock(100, true)}},
+		{name: "later lock", locks: []meta.ObjectLock{lock(99, true), lock(101, true)}, fail: true},
+		{name: "no lock expiration", locks: []meta.ObjectLock{lock(0, true)}, fail: true},
+		{name: "missing locker", locks: []meta.ObjectLock{lock(0, false), lock(101, false)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkTombstoneExpiration(tomb, 100, addr, tc.locks)
+			if tc.fail {
+				require.ErrorIs(t, err, ErrTombstoneExpiresBeforeLock)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,35 +1,58 @@
-package engine
+package engine_test
 
 import (
-	"os"
+	"context"
+	"strconv"
 	"testing"
 
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/teststorage"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
 
-func TestStorageEngine_Inhume(t *testing.T) {
-	defer os.RemoveAll(t.Name())
+// putToShard puts the object to the i-th shard of the engine
+// by switching the other shards to read-only mode.
+func putToShard(t testing.TB, e *teststorage.Engine, i int, obj *objectSDK.Object) {
+	for j := range e.Shards {
+		if j != i {
+			require.NoError(t, e.SetShardMode(e.Shards[j].ID, mode.ReadOnly, false))
+		}
+	}
+
+	require.NoError(t, engine.Put(e.StorageEngine, obj))
 
+	for j := range e.Shards {
+		if j != i {
+			require.NoError(t, e.SetShardMode(e.Shards[j].ID, mode.ReadWrite, false))
+		}
+	}
+}
+
+func TestStorageEngine_Inhume(t *testing.T) {
 	cnr := cidtest.ID()
 	splitID := objectSDK.NewSplitID()
 
 	fs := objectSDK.SearchFilters{}
 	fs.AddRootFilter()
 
-	tombstoneID := object.AddressOf(generateObjectWithCID(t, cnr))
-	parent := generateObjectWithCID(t, cnr)
+	tombstoneID := object.AddressOf(teststorage.GenerateObject(cnr))
+	parent := teststorage.GenerateObject(cnr)
 
-	child := generateObjectWithCID(t, cnr)
+	child := teststorage.GenerateObject(cnr)
 	child.SetParent(parent)
 	idParent, _ := parent.ID()
 	child.SetParentID(idParent)
 	child.SetSplitID(splitID)
 
-	link := generateObjectWithCID(t, cnr)
+	link := teststorage.GenerateObject(cnr)
 	link.SetParent(parent)
 	link.SetParentID(idParent)
 	idChild, _ := child.ID()
@@ -37,48 +60,249 @@ func TestStorageEngine_Inhume(t *testing.T) {
 	link.SetSplitID(splitID)
 
 	t.Run("delete small object", func(t *testing.T) {
-		e := testNewEngineWithShardNum(t, 1)
-		defer e.Close()
+		e := teststorage.NewEngine(t, 1)
 
-		err := Put(e, parent)
+		err := engine.Put(e.StorageEngine, parent)
 		require.NoError(t, err)
 
-		var inhumePrm InhumePrm
+		var inhumePrm engine.InhumePrm
 		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
 
 		_, err = e.Inhume(inhumePrm)
 		require.NoError(t, err)
 
-		addrs, err := Select(e, cnr, fs)
+		addrs, err := engine.Select(e.StorageEngine, cnr, fs)
 		require.NoError(t, err)
 		require.Empty(t, addrs)
 	})
 
 	t.Run("delete big object", func(t *testing.T) {
-		s1 := testNewShard(t, 1)
-		s2 := testNewShard(t, 2)
+		e := teststorage.NewEngine(t, 2)
 
-		e := testNewEngineWithShards(s1, s2)
-		defer e.Close()
+		putToShard(t, e, 0, child)
+		putToShard(t, e, 1, link)
 
-		var putChild shard.PutPrm
-		putChild.SetObject(child)
-		_, err := s1.Put(putChild)
+		var inhumePrm engine.InhumePrm
+		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
+
+		_, err := e.Inhume(inhumePrm)
 		require.NoError(t, err)
 
-		var putLink shard.PutPrm
-		putLink.SetObject(link)
-		_, err = s2.Put(putLink)
+		addrs, err := engine.Select(e.StorageEngine, cnr, fs)
 		require.NoError(t, err)
+		require.Empty(t, addrs)
+	})
+	t.Run("without existence check", func(t *testing.T) {
+		e := teststorage.NewEngine(t, 3)
 
-		var inhumePrm InhumePrm
-		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
+		addrs := make([]oid.Address, 10)
+		for i := range addrs {
+			obj := teststorage.GenerateObject(cnr)
+			require.NoError(t, engine.Put(e.StorageEngine, obj))
+
+			addrs[i] = object.AddressOf(obj)
+		}
+
+		locked := addrs[0]
+		require.NoError(t, e.Lock(cnr, oidtest.ID(), []oid.ID{locked.Object()}))
+
+		var inhumePrm engine.InhumePrm
+		inhumePrm.WithTarget(tombstoneID, addrs...)
+		inhumePrm.WithoutExistenceCheck()
+
+		_, err := e.Inhume(inhumePrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+
+		inhumePrm.WithTarget(tombstoneID, addrs[1:]...)
 
 		_, err = e.Inhume(inhumePrm)
 		require.NoError(t, err)
 
-		addrs, err := Select(e, cnr, fs)
+		for i := range addrs[1:] {
+			_, err = engine.Get(e.StorageEngine, addrs[1+i])
+			require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+		}
+
+		_, err = engine.Get(e.StorageEngine, locked)
 		require.NoError(t, err)
-		require.Empty(t, addrs)
+
+		// repeated inhume is idempotent
+		_, err = e.Inhume(inhumePrm)
+		require.NoError(t, err)
+	})
+	t.Run("write-cache", func(t *testing.T) {
+		e := teststorage.NewEngine(t, 1, teststorage.WithWriteCache(true))
+
+		obj := teststorage.GenerateObject(cnr)
+		require.NoError(t, engine.Put(e.StorageEngine, obj))
+		require.Equal(t, 1, e.Shards[0].WriteCache.Len())
+
+		var inhumePrm engine.InhumePrm
+		inhumePrm.WithTarget(tombstoneID, object.AddressOf(obj))
+
+		_, err := e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		_, err = engine.Get(e.StorageEngine, object.AddressOf(obj))
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+}
+
+func BenchmarkInhume(b *testing.B) {
+	b.Run("with existence check", func(b *testing.B) {
+		benchmarkInhume(b, false)
+	})
+	b.Run("without existence check", func(b *testing.B) {
+		benchmarkInhume(b, true)
+	})
+}
+
+func benchmarkInhume(b *testing.B, skipCheck bool) {
+	const shardNum = 4
+
+	e := teststorage.NewEngine(b, shardNum)
+
+	cnr := cidtest.ID()
+	tombstone := oidtest.Address()
+
+	addrs := make([]oid.Address, 100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := range addrs {
+			obj := teststorage.GenerateObject(cnr)
+			if err := engine.Put(e.StorageEngine, obj); err != nil {
+				b.Fatal(err)
+			}
+
+			addrs[j] = object.AddressOf(obj)
+		}
+
+		var prm engine.InhumePrm
+		prm.WithTarget(tombstone, addrs...)
+		if skipCheck {
+			prm.WithoutExistenceCheck()
+		}
+		b.StartTimer()
+
+		if _, err := e.Inhume(prm); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestStorageEngine_IgnoreGCMark(t *testing.T) {
+	e := teststorage.NewEngine(t, 2)
+
+	cnr := cidtest.ID()
+
+	removed := teststorage.GenerateObject(cnr)
+	garbage := teststorage.GenerateObject(cnr)
+
+	require.NoError(t, engine.Put(e.StorageEngine, removed))
+	require.NoError(t, engine.Put(e.StorageEngine, garbage))
+
+	var inhumePrm engine.InhumePrm
+	inhumePrm.WithTarget(object.AddressOf(teststorage.GenerateObject(cnr)), object.AddressOf(removed))
+
+	_, err := e.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	inhumePrm.MarkAsGarbage(object.AddressOf(garbage))
+
+	_, err = e.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	for _, obj := range []*objectSDK.Object{removed, garbage} {
+		addr := object.AddressOf(obj)
+
+		var getPrm engine.GetPrm
+		getPrm.WithAddress(addr)
+
+		_, err = e.Get(context.Background(), getPrm)
+		require.Error(t, err)
+
+		getPrm.WithIgnoreGCMark()
+
+		res, err := e.Get(context.Background(), getPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+
+		var headPrm engine.HeadPrm
+		headPrm.WithAddress(addr)
+
+		_, err = e.Head(context.Background(), headPrm)
+		require.Error(t, err)
+
+		headPrm.WithIgnoreGCMark()
+
+		hRes, err := e.Head(context.Background(), headPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj.CutPayload(), hRes.Header())
+	}
+
+	t.Run("missing object", func(t *testing.T) {
+		var getPrm engine.GetPrm
+		getPrm.WithAddress(oidtest.Address())
+		getPrm.WithIgnoreGCMark()
+
+		_, err := e.Get(context.Background(), getPrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
+
+func TestStorageEngine_InhumeTombstoneExpiration(t *testing.T) {
+	e := teststorage.NewEngine(t, 3)
+
+	cnr := cidtest.ID()
+
+	// the member and its lock are stored in the different shards
+	member := teststorage.GenerateObject(cnr)
+	putToShard(t, e, 0, member)
+
+	var expAttr objectSDK.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue(strconv.Itoa(100))
+
+	lock := teststorage.GenerateObject(cnr)
+	lock.SetType(objectSDK.TypeLock)
+	lock.SetAttributes(expAttr)
+	putToShard(t, e, 1, lock)
+
+	idLock, _ := lock.ID()
+	idMember, _ := member.ID()
+	require.NoError(t, e.Lock(cnr, idLock, []oid.ID{idMember}))
+
+	addr := object.AddressOf(member)
+	tomb := oidtest.Address()
+	tomb.SetContainer(cnr)
+
+	inhume := func(exp uint64, force bool) error {
+		var prm engine.InhumePrm
+		prm.WithTarget(tomb, addr)
+		prm.WithTombstoneExpiration(exp)
+
+		if force {
+			prm.WithForceRemoval()
+		}
+
+		_, err := e.Inhume(prm)
+		return err
+	}
+
+	err := inhume(50, false)
+	require.ErrorIs(t, err, engine.ErrTombstoneExpiresBeforeLock)
+	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+
+	_, err = engine.Head(e.StorageEngine, addr)
+	require.NoError(t, err)
+
+	// the tombstone outlives the lock, the lock itself still prevents the removal
+	require.ErrorAs(t, inhume(100, false), new(apistatus.ObjectLocked))
+
+	// the check is disabled explicitly or by the force removal
+	require.ErrorAs(t, inhume(0, false), new(apistatus.ObjectLocked))
+	require.ErrorAs(t, inhume(50, true), new(apistatus.ObjectLocked))
+}
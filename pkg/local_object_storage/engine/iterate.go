@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ObjectLoader is a function which reads the object from the storage on demand.
+type ObjectLoader func() (*objectSDK.Object, error)
+
+// IterateObjectsPrm groups the parameters of IterateObjects operation.
+type IterateObjectsPrm struct {
+	handler     func(oid.Address, ObjectLoader) error
+	maintenance MaintenancePrm
+}
+
+// IterateObjectsRes groups the resulting values of IterateObjects operation.
+type IterateObjectsRes struct {
+	MaintenanceRes
+}
+
+// WithHandler sets a function to call for each object. Object is read from
+// the storage only if the handler calls the provided loader.
+func (p *IterateObjectsPrm) WithHandler(f func(oid.Address, ObjectLoader) error) {
+	p.handler = f
+}
+
+// WithMaintenance sets the error handling parameters. Only the shards
+// which objects can't be listed are skipped in IgnoreErrors mode.
+func (p *IterateObjectsPrm) WithMaintenance(prm MaintenancePrm) {
+	p.maintenance = prm
+}
+
+const defaultIterateBatchSize = 100
+
+// IterateObjects calls handler for every object stored in the engine.
+// Inhumed objects are skipped. Shards are processed one by one, the
+// objects stored in several shards are visited in the first one only,
+// so every stored object is visited once.
+//
+// Iteration is stopped on the first handler error or after the context
+// is done, the error is returned in both cases.
+func (e *StorageEngine) IterateObjects(ctx context.Context, prm IterateObjectsPrm) (IterateObjectsRes, error) {
+	var res IterateObjectsRes
+
+	uniqueMap := make(map[string]struct{})
+
+	for _, sh := range e.unsortedShards() {
+		err := e.iterateShardObjects(ctx, sh, prm, uniqueMap, &res)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+func (e *StorageEngine) iterateShardObjects(ctx context.Context, sh hashedShard, prm IterateObjectsPrm,
+	uniqueMap map[string]struct{}, res *IterateObjectsRes) error {
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(defaultIterateBatchSize)
+
+	var c *meta.Cursor
+	for {
+		listPrm.WithCursor(c)
+
+		listRes, err := sh.ListWithCursor(listPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				return nil
+			}
+			if err := res.handleError(prm.maintenance, fmt.Errorf("could not list objects in shard %s: %w", sh.ID(), err)); err != nil {
+				return err
+			}
+
+			e.log.Warn("could not list objects in shard, skip it",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Error(err))
+			return nil
+		}
+
+		lst := listRes.AddressList()
+		for i := range lst {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			addr := lst[i]
+
+			sAddr := addr.EncodeToString()
+			if _, ok := uniqueMap[sAddr]; ok {
+				continue
+			}
+			uniqueMap[sAddr] = struct{}{}
+
+			err = prm.handler(addr, func() (*objectSDK.Object, error) {
+				var getPrm shard.GetPrm
+				getPrm.SetAddress(addr)
+
+				res, err := sh.Get(getPrm)
+				if err != nil {
+					return nil, err
+				}
+				return res.Object(), nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		c = listRes.Cursor()
+	}
+}
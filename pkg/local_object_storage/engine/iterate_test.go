@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterateObjects(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	const total = 20
+
+	expected := make(map[string]int, total)
+	for i := 0; i < total; i++ {
+		obj := generateObjectWithCID(t, cidtest.ID())
+
+		var prm PutPrm
+		prm.WithObject(obj)
+
+		_, err := e.Put(prm)
+		require.NoError(t, err)
+
+		expected[object.AddressOf(obj).EncodeToString()] = 0
+	}
+
+	// the object stored in both shards is visited once too
+	obj := generateObjectWithCID(t, cidtest.ID())
+
+	var shPrm shard.PutPrm
+	shPrm.SetObject(obj)
+
+	_, err := s1.Put(shPrm)
+	require.NoError(t, err)
+	_, err = s2.Put(shPrm)
+	require.NoError(t, err)
+
+	expected[object.AddressOf(obj).EncodeToString()] = 0
+
+	t.Run("all objects", func(t *testing.T) {
+		visited := make(map[string]int, total)
+
+		var prm IterateObjectsPrm
+		prm.WithHandler(func(addr oid.Address, load ObjectLoader) error {
+			obj, err := load()
+			if err != nil {
+				return err
+			}
+
+			require.Equal(t, addr, object.AddressOf(obj))
+			visited[addr.EncodeToString()]++
+			return nil
+		})
+
+		_, err := e.IterateObjects(context.Background(), prm)
+		require.NoError(t, err)
+		require.Len(t, visited, len(expected))
+		for k := range expected {
+			require.Equal(t, 1, visited[k])
+		}
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		errTest := errors.New("test error")
+
+		var count int
+		var prm IterateObjectsPrm
+		prm.WithHandler(func(oid.Address, ObjectLoader) error {
+			count++
+			return errTest
+		})
+
+		_, err := e.IterateObjects(context.Background(), prm)
+		require.ErrorIs(t, err, errTest)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var count int
+		var prm IterateObjectsPrm
+		prm.WithHandler(func(oid.Address, ObjectLoader) error {
+			count++
+			cancel()
+			return nil
+		})
+
+		_, err := e.IterateObjects(ctx, prm)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("ignore errors", func(t *testing.T) {
+		require.NoError(t, s1.SetMode(mode.DegradedReadOnly))
+		t.Cleanup(func() { require.NoError(t, s1.SetMode(mode.ReadWrite)) })
+
+		var count int
+		var prm IterateObjectsPrm
+		prm.WithHandler(func(oid.Address, ObjectLoader) error {
+			count++
+			return nil
+		})
+
+		_, err := e.IterateObjects(context.Background(), prm)
+		require.ErrorIs(t, err, shard.ErrDegradedMode)
+
+		count = 0
+		prm.WithMaintenance(MaintenancePrm{IgnoreErrors: true})
+		res, err := e.IterateObjects(context.Background(), prm)
+		require.NoError(t, err)
+		require.Less(t, count, total)
+		require.Equal(t, 1, res.ErrorCount())
+		require.ErrorIs(t, res.Errors()[0], shard.ErrDegradedMode)
+
+		t.Run("error limit", func(t *testing.T) {
+			require.NoError(t, s2.SetMode(mode.DegradedReadOnly))
+			t.Cleanup(func() { require.NoError(t, s2.SetMode(mode.ReadWrite)) })
+
+			prm.WithMaintenance(MaintenancePrm{IgnoreErrors: true, ErrorLimit: 1})
+			res, err := e.IterateObjects(context.Background(), prm)
+			require.ErrorIs(t, err, ErrErrorLimitExceeded)
+			require.Equal(t, 2, res.ErrorCount())
+		})
+	})
+}
@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"encoding/binary"
+	"errors"
 	"sort"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -12,12 +14,47 @@ import (
 // cursor. Use nil cursor object to start listing again.
 var ErrEndOfListing = shard.ErrEndOfListing
 
+var errInvalidCursor = errors.New("invalid cursor")
+
 // Cursor is a type for continuous object listing.
 type Cursor struct {
 	shardID     string
 	shardCursor *shard.Cursor
 }
 
+// Marshal encodes the cursor into a binary form, so the listing
+// can be resumed later, see Unmarshal.
+func (c *Cursor) Marshal() []byte {
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(c.shardID))
+	n := binary.PutUvarint(data, uint64(len(c.shardID)))
+	data = append(data[:n], c.shardID...)
+	if c.shardCursor != nil {
+		data = append(data, c.shardCursor.Marshal()...)
+	}
+	return data
+}
+
+// Unmarshal decodes the cursor from the binary form produced by Marshal.
+func (c *Cursor) Unmarshal(data []byte) error {
+	ln, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < ln {
+		return errInvalidCursor
+	}
+
+	data = data[n:]
+	c.shardID = string(data[:ln])
+	c.shardCursor = nil
+
+	if data = data[ln:]; len(data) > 0 {
+		c.shardCursor = new(shard.Cursor)
+		if err := c.shardCursor.Unmarshal(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ListWithCursorPrm contains parameters for ListWithCursor operation.
 type ListWithCursorPrm struct {
 	count  uint32
@@ -1,8 +1,13 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -12,10 +17,73 @@ import (
 
 var errLockFailed = errors.New("lock operation failed")
 
+// MemberLockResult is the result of locking a single object by Lock.
+type MemberLockResult struct {
+	// Member is the locked object.
+	Member oid.ID
+	// Err is nil if the lock record is stored in at least one shard. It is
+	// apistatus.LockNonRegularObject if the object is not of the REGULAR type
+	// and apistatus.ObjectAlreadyRemoved if the object is already tombstoned.
+	Err error
+}
+
+// LockError is returned by Lock if some of the objects have not been locked.
+// It unwraps to the error of the first such object, so errors.As can be used
+// to check for the API statuses.
+type LockError struct {
+	// Results contains the results for all the objects in the requested order.
+	Results []MemberLockResult
+}
+
+func (x *LockError) first() *MemberLockResult {
+	for i := range x.Results {
+		if x.Results[i].Err != nil {
+			return &x.Results[i]
+		}
+	}
+
+	return nil
+}
+
+// Error implements error interface.
+func (x *LockError) Error() string {
+	var failed int
+	for i := range x.Results {
+		if x.Results[i].Err != nil {
+			failed++
+		}
+	}
+
+	first := x.first()
+	if first == nil {
+		return "lock error"
+	}
+
+	return fmt.Sprintf("%d of %d objects have not been locked, first %s: %v",
+		failed, len(x.Results), first.Member, first.Err)
+}
+
+// Unwrap returns the error of the first object which has not been locked.
+func (x *LockError) Unwrap() error {
+	if first := x.first(); first != nil {
+		return first.Err
+	}
+
+	return nil
+}
+
 // Lock marks objects as locked with another object. All objects from the
 // specified container.
 //
-// Allows locking regular objects only (otherwise returns apistatus.LockNonRegularObject).
+// The lock records are stored in all the writable shards, so the objects stay
+// locked regardless of the shard they are stored in or moved to later.
+// Objects which are not stored on the node yet are locked too.
+//
+// Allows locking regular objects only, objects which are already tombstoned
+// can not be locked either. If some objects have not been locked, *LockError
+// with the per-object results is returned, it unwraps to
+// apistatus.LockNonRegularObject, apistatus.ObjectAlreadyRemoved or another
+// error of the first such object. Other objects are locked anyway.
 //
 // Locked list should be unique. Panics if it is empty.
 func (e *StorageEngine) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
@@ -25,85 +93,270 @@ func (e *StorageEngine) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 }
 
 func (e *StorageEngine) lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
+	if len(locked) == 0 {
+		panic("empty locked list")
+	}
+
+	var (
+		failed bool
+		res    = make([]MemberLockResult, len(locked))
+	)
+
 	for i := range locked {
-		switch e.lockSingle(idCnr, locker, locked[i], true) {
-		case 1:
-			return apistatus.LockNonRegularObject{}
-		case 0:
-			switch e.lockSingle(idCnr, locker, locked[i], false) {
-			case 1:
-				return apistatus.LockNonRegularObject{}
-			case 0:
-				return errLockFailed
-			}
-		}
+		res[i].Member = locked[i]
+		res[i].Err = e.lockSingle(idCnr, locker, locked[i])
+		failed = failed || res[i].Err != nil
+	}
+
+	if failed {
+		return &LockError{Results: res}
 	}
 
 	return nil
 }
 
-// Returns:
-//   - 0: fail
-//   - 1: locking irregular object
-//   - 2: ok
-func (e *StorageEngine) lockSingle(idCnr cid.ID, locker, locked oid.ID, checkExists bool) (status uint8) {
-	// code is pretty similar to inhumeAddr, maybe unify?
-	root := false
-	var errIrregular apistatus.LockNonRegularObject
-
+func (e *StorageEngine) lockSingle(idCnr cid.ID, locker, locked oid.ID) error {
 	var addrLocked oid.Address
 	addrLocked.SetContainer(idCnr)
 	addrLocked.SetObject(locked)
 
+	// tombstoned and irregular objects must not be locked, so check all the
+	// shards first to not leave the lock records on some of them
+	var errCheck error
+
 	e.iterateOverSortedShards(addrLocked, func(_ int, sh hashedShard) (stop bool) {
-		defer func() {
-			// if object is root we continue since information about it
-			// can be presented in other shards
-			if checkExists && root {
-				stop = false
+		var headPrm shard.HeadPrm
+		headPrm.SetAddress(addrLocked)
+		headPrm.SetRaw(true)
+		headPrm.SetShortHeader(true)
+
+		res, err := sh.Head(headPrm)
+		if err != nil {
+			if shard.IsErrRemoved(err) {
+				errCheck = err
+				return true
 			}
-		}()
 
-		if checkExists {
-			var existsPrm shard.ExistsPrm
-			existsPrm.SetAddress(addrLocked)
+			// other errors do not matter: the object is locked even if
+			// it is not stored on the node
+			return false
+		}
 
-			exRes, err := sh.Exists(existsPrm)
-			if err != nil {
-				var siErr *objectSDK.SplitInfoError
-				if !errors.As(err, &siErr) {
-					if shard.IsErrObjectExpired(err) {
-						// object is already expired =>
-						// do not lock it
-						return true
-					}
-
-					e.reportShardError(sh, "could not check locked object for presence in shard", err)
-					return
-				}
-
-				root = true
-			} else if !exRes.Exists() {
-				return
-			}
+		if res.Object().Type() != objectSDK.TypeRegular {
+			errCheck = apistatus.LockNonRegularObject{}
+			return true
 		}
 
+		return false
+	})
+
+	if errCheck != nil {
+		return errCheck
+	}
+
+	var (
+		stored       int
+		lastErr      error
+		errIrregular apistatus.LockNonRegularObject
+	)
+
+	e.iterateOverSortedShards(addrLocked, func(_ int, sh hashedShard) (stop bool) {
 		err := sh.Lock(idCnr, locker, []oid.ID{locked})
-		if err != nil {
+		switch {
+		case err == nil:
+			stored++
+		case errors.As(err, &errIrregular):
+			lastErr = err
+			return true
+		case errors.Is(err, shard.ErrReadOnlyMode), errors.Is(err, shard.ErrDegradedMode):
+			// the object can not be put to such shard either
+		default:
 			e.reportShardError(sh, "could not lock object in shard", err)
+			lastErr = err
+		}
 
-			if errors.As(err, &errIrregular) {
-				status = 1
-				return true
-			}
+		return false
+	})
+
+	if errors.As(lastErr, &errIrregular) {
+		return errIrregular
+	}
+
+	if stored == 0 {
+		if lastErr == nil {
+			return errLockFailed
+		}
+
+		return fmt.Errorf("%w: %v", errLockFailed, lastErr)
+	}
+
+	return nil
+}
+
+// Locks returns the information about the lock records stored on the node.
+// Records of the same locker from different shards are merged: the number of
+// the members is the maximum one, the lock object is considered found if it
+// is stored in any shard. Shards which fail
+// to return the records are reported and skipped.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) Locks() (res []meta.LockInfo, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res = e.locks()
+		return nil
+	})
+
+	return
+}
 
+func (e *StorageEngine) locks() []meta.LockInfo {
+	var (
+		res   []meta.LockInfo
+		index = make(map[oid.Address]int)
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		locks, err := sh.Locks()
+		if err != nil {
+			e.reportShardError(sh, "could not list lock records of shard", err)
 			return false
 		}
 
-		status = 2
+		for i := range locks {
+			j, ok := index[locks[i].Locker]
+			if !ok {
+				index[locks[i].Locker] = len(res)
+				res = append(res, locks[i])
+				continue
+			}
+
+			// the same records are stored in all the shards, see Lock
+			if locks[i].Members > res[j].Members {
+				res[j].Members = locks[i].Members
+			}
+			res[j].LockerFound = res[j].LockerFound || locks[i].LockerFound
+		}
 
-		return true
+		return false
+	})
+
+	// lock object can be stored in the shard without the lock records
+	for i := range res {
+		if !res[i].LockerFound {
+			res[i].LockerFound, _ = e.exists(context.Background(), res[i].Locker)
+		}
+	}
+
+	return res
+}
+
+// FreeLockedBy removes the lock records of the lockers from all the shards,
+// so the objects locked by them can be removed. Lock objects themselves are
+// not removed. It is intended to drop the records of the lost lock objects.
+//
+// Returns the first error of the shards which failed to remove the records.
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) FreeLockedBy(lockers []oid.Address) error {
+	return e.execIfNotBlocked(func() error {
+		return e.freeLockedBy(lockers)
+	})
+}
+
+func (e *StorageEngine) freeLockedBy(lockers []oid.Address) error {
+	var firstErr error
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		err := sh.FreeLockedBy(lockers)
+		if err != nil {
+			e.reportShardError(sh, "could not remove lock records from shard", err)
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("shard %s: %w", sh.ID(), err)
+			}
+		}
+
+		return false
+	})
+
+	return firstErr
+}
+
+// LocksFor returns the locks of the object stored on the node. Locks of the
+// same locker from different shards are merged. Lock objects are looked up
+// in all the shards since they are not required to be stored together with
+// the locked objects. Shards which fail to return the locks are reported
+// and skipped.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) LocksFor(addr oid.Address) (res []meta.ObjectLock, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res = e.locksFor(addr)
+		return nil
 	})
 
 	return
 }
+
+func (e *StorageEngine) locksFor(addr oid.Address) []meta.ObjectLock {
+	var (
+		res   []meta.ObjectLock
+		index = make(map[oid.Address]int)
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		locks, err := sh.LocksFor(addr)
+		if err != nil {
+			e.reportShardError(sh, "could not get object locks from shard", err)
+			return false
+		}
+
+		for i := range locks {
+			j, ok := index[locks[i].Locker]
+			if !ok {
+				index[locks[i].Locker] = len(res)
+				res = append(res, locks[i])
+			} else if !res[j].LockerFound {
+				res[j] = locks[i]
+			}
+		}
+
+		return false
+	})
+
+	var headPrm HeadPrm
+	headPrm.WithRaw(true)
+
+	for i := range res {
+		if res[i].LockerFound {
+			continue
+		}
+
+		headPrm.WithAddress(res[i].Locker)
+
+		hRes, err := e.head(context.Background(), headPrm)
+		if err != nil {
+			continue
+		}
+
+		res[i].LockerFound = true
+		res[i].Expiration, res[i].Malformed = expirationEpoch(hRes.Header())
+	}
+
+	return res
+}
+
+// expirationEpoch returns the expiration epoch of the object, zero if the
+// object has no expiration epoch. Returns true if the epoch is malformed.
+func expirationEpoch(obj *objectSDK.Object) (uint64, bool) {
+	for _, attr := range obj.Attributes() {
+		if attr.Key() == objectV2.SysAttributeExpEpoch {
+			exp, err := strconv.ParseUint(attr.Value(), 10, 64)
+			if err != nil {
+				return 0, true
+			}
+			return exp, false
+		}
+	}
+
+	return 0, false
+}
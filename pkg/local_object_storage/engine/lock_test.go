@@ -281,3 +281,206 @@ func TestLockForceRemoval(t *testing.T) {
 	_, err = e.Inhume(inhumePrm)
 	require.NoError(t, err)
 }
+
+func TestOrphanedLockRemoval(t *testing.T) {
+	// Tested scenario:
+	//   1. some objects are stored
+	//   2. one object is locked by the stored lock object, another one
+	//      is locked by the lock object lost by the node
+	//   3. both lock records are listed, the lost lock object is not found
+	//   4. the object locked by the lost lock object cannot be removed
+	//   5. the orphaned lock record is removed
+	//   6. the object is not locked anymore
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 2)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+
+	// 1.
+	obj := generateObjectWithCID(t, cnr)
+	require.NoError(t, Put(e, obj))
+
+	orphanObj := generateObjectWithCID(t, cnr)
+	require.NoError(t, Put(e, orphanObj))
+
+	// 2.
+	lock := generateObjectWithCID(t, cnr)
+	lock.SetType(object.TypeLock)
+	require.NoError(t, Put(e, lock))
+
+	id, _ := obj.ID()
+	idLock, _ := lock.ID()
+	require.NoError(t, e.Lock(cnr, idLock, []oid.ID{id}))
+
+	orphanID, _ := orphanObj.ID()
+	orphanLock := oidtest.Address()
+	orphanLock.SetContainer(cnr)
+	require.NoError(t, e.Lock(cnr, orphanLock.Object(), []oid.ID{orphanID}))
+
+	// 3.
+	locks, err := e.Locks()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []meta.LockInfo{
+		{Locker: objectcore.AddressOf(lock), Members: 1, LockerFound: true},
+		{Locker: orphanLock, Members: 1, LockerFound: false},
+	}, locks)
+
+	// 4.
+	var inhumePrm InhumePrm
+	inhumePrm.MarkAsGarbage(objectcore.AddressOf(orphanObj))
+
+	_, err = e.Inhume(inhumePrm)
+	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+
+	// 5.
+	require.NoError(t, e.FreeLockedBy([]oid.Address{orphanLock}))
+
+	locks, err = e.Locks()
+	require.NoError(t, err)
+	require.Equal(t, []meta.LockInfo{
+		{Locker: objectcore.AddressOf(lock), Members: 1, LockerFound: true},
+	}, locks)
+
+	// 6.
+	_, err = e.Inhume(inhumePrm)
+	require.NoError(t, err)
+}
+
+func TestStorageEngine_LocksFor(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+
+	obj := generateObjectWithCID(t, cnr)
+	require.NoError(t, Put(e, obj))
+
+	addr := objectcore.AddressOf(obj)
+
+	locks, err := e.LocksFor(addr)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	var expAttr object.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue("100")
+
+	lock := generateObjectWithCID(t, cnr)
+	lock.SetType(object.TypeLock)
+	lock.SetAttributes(expAttr)
+	require.NoError(t, Put(e, lock))
+
+	idLock, _ := lock.ID()
+	require.NoError(t, e.Lock(cnr, idLock, []oid.ID{addr.Object()}))
+
+	orphanLock := oidtest.Address()
+	orphanLock.SetContainer(cnr)
+	require.NoError(t, e.Lock(cnr, orphanLock.Object(), []oid.ID{addr.Object()}))
+
+	locks, err = e.LocksFor(addr)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []meta.ObjectLock{
+		{Locker: objectcore.AddressOf(lock), Expiration: 100, LockerFound: true},
+		{Locker: orphanLock},
+	}, locks)
+}
+
+func TestStorageEngine_LockCrossShard(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	shards := e.unsortedShards()
+	cnr := cidtest.ID()
+
+	putToShard := func(sh hashedShard, obj *object.Object) oid.ID {
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+
+		id, _ := obj.ID()
+		return id
+	}
+
+	// members are stored in the different shards or not stored at all
+	members := []oid.ID{
+		putToShard(shards[0], generateObjectWithCID(t, cnr)),
+		putToShard(shards[1], generateObjectWithCID(t, cnr)),
+		oidtest.ID(),
+	}
+
+	locker := oidtest.ID()
+	require.NoError(t, e.Lock(cnr, locker, members))
+
+	// the lock is recorded in every shard, so any of them can receive the object
+	for _, sh := range shards {
+		for _, id := range members {
+			var addr oid.Address
+			addr.SetContainer(cnr)
+			addr.SetObject(id)
+
+			var prm shard.InhumePrm
+			prm.SetTarget(objecttest.Address(), addr)
+
+			_, err := sh.Inhume(prm)
+			require.ErrorAs(t, err, new(apistatus.ObjectLocked), "shard %s", sh.ID())
+		}
+	}
+
+	t.Run("tombstoned and irregular", func(t *testing.T) {
+		tombstoned := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, tombstoned))
+
+		tomb := oidtest.Address()
+		tomb.SetContainer(cnr)
+
+		var inhumePrm InhumePrm
+		inhumePrm.WithTarget(tomb, objectcore.AddressOf(tombstoned))
+
+		_, err := e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		irregular := generateObjectWithCID(t, cnr)
+		irregular.SetType(object.TypeStorageGroup)
+
+		idTombstoned, _ := tombstoned.ID()
+		members := []oid.ID{
+			oidtest.ID(),
+			idTombstoned,
+			putToShard(shards[2], irregular),
+		}
+
+		err = e.Lock(cnr, oidtest.ID(), members)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+
+		var lockErr *LockError
+		require.ErrorAs(t, err, &lockErr)
+		require.Len(t, lockErr.Results, len(members))
+
+		for i := range members {
+			require.Equal(t, members[i], lockErr.Results[i].Member)
+		}
+
+		require.NoError(t, lockErr.Results[0].Err)
+		require.ErrorAs(t, lockErr.Results[1].Err, new(apistatus.ObjectAlreadyRemoved))
+		require.ErrorAs(t, lockErr.Results[2].Err, new(apistatus.LockNonRegularObject))
+
+		// nothing is recorded for the failed members
+		for _, id := range members[1:] {
+			var addr oid.Address
+			addr.SetContainer(cnr)
+			addr.SetObject(id)
+
+			locks, err := e.LocksFor(addr)
+			require.NoError(t, err)
+			require.Empty(t, locks)
+		}
+	})
+}
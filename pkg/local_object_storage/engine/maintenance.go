@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaintenancePrm groups the error handling parameters common for the engine
+// maintenance operations: Evacuate, FlushWriteCache, ResyncMetabase and
+// IterateObjects.
+type MaintenancePrm struct {
+	// IgnoreErrors makes the operation skip the objects and shards it fails
+	// to process instead of aborting on the first error.
+	IgnoreErrors bool
+	// ErrorLimit is the maximum number of errors skipped in IgnoreErrors mode.
+	// The operation is aborted with ErrErrorLimitExceeded once it is exceeded.
+	// Zero means no limit.
+	ErrorLimit int
+}
+
+// maxMaintenanceErrors is the maximum number of the skipped errors collected
+// by a single maintenance operation.
+const maxMaintenanceErrors = 100
+
+// ErrErrorLimitExceeded is returned by the maintenance operations when the
+// number of skipped errors exceeds MaintenancePrm.ErrorLimit.
+var ErrErrorLimitExceeded = errors.New("error limit exceeded")
+
+// MaintenanceRes groups the errors skipped by a maintenance operation.
+type MaintenanceRes struct {
+	errCount int
+	errs     []error
+}
+
+// ErrorCount returns the number of errors skipped by the operation.
+func (r MaintenanceRes) ErrorCount() int {
+	return r.errCount
+}
+
+// Errors returns the first errors skipped by the operation, at most 100.
+func (r MaintenanceRes) Errors() []error {
+	return r.errs
+}
+
+// handleError returns nil if err can be skipped according to p and
+// registers it, otherwise it returns the error to abort the operation with.
+func (r *MaintenanceRes) handleError(p MaintenancePrm, err error) error {
+	if !p.IgnoreErrors {
+		return err
+	}
+
+	r.errCount++
+	if len(r.errs) < maxMaintenanceErrors {
+		r.errs = append(r.errs, err)
+	}
+
+	if p.ErrorLimit > 0 && r.errCount > p.ErrorLimit {
+		return fmt.Errorf("%w (%d): last error: %v", ErrErrorLimitExceeded, p.ErrorLimit, err)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package engine
 
 import (
 	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 )
 
 type MetricRegister interface {
@@ -19,6 +21,41 @@ type MetricRegister interface {
 
 	SetObjectCounter(shardID, objectType string, v uint64)
 	AddToObjectCounter(shardID, objectType string, delta int)
+
+	IncReadCacheCounter(shardID string, hit bool)
+	IncRangeReadCounter(shardID string, full bool)
+	AddMetabaseTxWaitDuration(shardID, priority string, d time.Duration)
+	AddWriteCacheFlushedObject(shardID string, size uint64)
+	IncWriteCacheFlushErrors(shardID string)
+	SetWriteCacheBacklog(shardID string, v uint64)
+	SetWriteCacheObjects(shardID, storage string, v uint64)
+	SetWriteCacheSize(shardID string, v uint64)
+	SetWriteCacheFlushQueue(shardID string, v uint64)
+	SetWriteCacheFlushedMarks(shardID string, v uint64)
+
+	SetShardsInMode(mode string, v int)
+	SetGCBacklog(v uint64)
+	SetExpiredBacklog(v uint64)
+	SetWriteCachePending(v uint64)
+	SetWriteCacheQuarantined(v uint64)
+	SetEvacuationProgress(shardID string, evacuated uint64, running bool)
+}
+
+// stateMetricsInterval is the interval between the storage state metrics updates.
+const stateMetricsInterval = 30 * time.Second
+
+// storages of the write-cache objects reported by the state metrics.
+const (
+	writeCacheDBLabel     = "db"
+	writeCacheFSTreeLabel = "fstree"
+)
+
+// shardModes lists the modes reported by the state metrics.
+var shardModes = []mode.Mode{
+	mode.ReadWrite,
+	mode.ReadOnly,
+	mode.Degraded,
+	mode.DegradedReadOnly,
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
@@ -28,3 +65,80 @@ func elapsed(addFunc func(d time.Duration)) func() {
 		addFunc(time.Since(t))
 	}
 }
+
+// requestStateMetrics schedules the storage state metrics update
+// without waiting for it.
+func (e *StorageEngine) requestStateMetrics() {
+	select {
+	case e.stateMetricsCh <- struct{}{}:
+	default:
+	}
+}
+
+// updateStateMetricsLoop periodically updates the storage state metrics
+// until the engine is closed. The update is also performed on request
+// (e.g. when the shard mode is changed).
+func (e *StorageEngine) updateStateMetricsLoop() {
+	t := time.NewTicker(stateMetricsInterval)
+	defer t.Stop()
+
+	for {
+		e.updateStateMetrics()
+
+		select {
+		case <-e.closeCh:
+			return
+		case <-t.C:
+		case <-e.stateMetricsCh:
+		}
+	}
+}
+
+// updateStateMetrics reports the number of shards in each mode, the
+// totals of the GC, expired objects and write-cache backlogs and of the
+// quarantined write-cache files, and the write-cache statistics of each
+// shard. Only the counters maintained by the shards are read, the storages
+// are not scanned.
+func (e *StorageEngine) updateStateMetrics() {
+	var (
+		modes       = make(map[mode.Mode]int, len(shardModes))
+		backlog     uint64
+		expired     uint64
+		pending     uint64
+		quarantined uint64
+	)
+
+	for _, sh := range e.unsortedShards() {
+		m := sh.GetMode()
+		modes[m]++
+
+		if !m.NoMetabase() {
+			if v, err := sh.GCBacklog(); err == nil {
+				backlog += v
+			}
+		}
+
+		expired += sh.ExpiredBacklog()
+
+		if st, ok := sh.WriteCacheStats(); ok {
+			pending += st.Objects
+			quarantined += st.Quarantined
+
+			id := sh.ID().String()
+			e.metrics.SetWriteCacheObjects(id, writeCacheDBLabel, st.DBObjects)
+			e.metrics.SetWriteCacheObjects(id, writeCacheFSTreeLabel, st.FSObjects)
+			e.metrics.SetWriteCacheSize(id, st.Size)
+			e.metrics.SetWriteCacheFlushQueue(id, st.FlushQueue)
+			e.metrics.SetWriteCacheFlushedMarks(id, st.FlushedMarks)
+		}
+	}
+
+	for _, m := range shardModes {
+		e.metrics.SetShardsInMode(m.String(), modes[m])
+	}
+
+	e.metrics.SetGCBacklog(backlog)
+	e.metrics.SetExpiredBacklog(expired)
+	e.metrics.SetWriteCachePending(pending)
+	e.metrics.SetWriteCacheQuarantined(quarantined)
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+// stateMetrics records the number of shards in each mode, other metrics are ignored.
+type stateMetrics struct {
+	MetricRegister
+
+	mtx   sync.Mutex
+	modes map[string]int
+}
+
+func (m *stateMetrics) SetObjectCounter(string, string, uint64)                 {}
+func (m *stateMetrics) AddToObjectCounter(string, string, int)                  {}
+func (m *stateMetrics) IncReadCacheCounter(string, bool)                        {}
+func (m *stateMetrics) IncRangeReadCounter(string, bool)                        {}
+func (m *stateMetrics) AddMetabaseTxWaitDuration(string, string, time.Duration) {}
+func (m *stateMetrics) AddWriteCacheFlushedObject(string, uint64)               {}
+func (m *stateMetrics) IncWriteCacheFlushErrors(string)                         {}
+func (m *stateMetrics) SetWriteCacheBacklog(string, uint64)                     {}
+func (m *stateMetrics) SetWriteCacheObjects(string, string, uint64)             {}
+func (m *stateMetrics) SetWriteCacheSize(string, uint64)                        {}
+func (m *stateMetrics) SetWriteCacheFlushQueue(string, uint64)                  {}
+func (m *stateMetrics) SetWriteCacheFlushedMarks(string, uint64)                {}
+func (m *stateMetrics) SetGCBacklog(uint64)                                     {}
+func (m *stateMetrics) SetExpiredBacklog(uint64)                                {}
+func (m *stateMetrics) SetWriteCachePending(uint64)                             {}
+func (m *stateMetrics) SetWriteCacheQuarantined(uint64)                         {}
+func (m *stateMetrics) SetEvacuationProgress(string, uint64, bool)              {}
+
+func (m *stateMetrics) SetShardsInMode(mode string, v int) {
+	m.mtx.Lock()
+	m.modes[mode] = v
+	m.mtx.Unlock()
+}
+
+func (m *stateMetrics) shardsInMode(mode mode.Mode) int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.modes[mode.String()]
+}
+
+func TestStorageEngine_StateMetrics(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	m := &stateMetrics{modes: make(map[string]int)}
+
+	e := New(WithMetrics(m))
+	t.Cleanup(func() { _ = e.Close() })
+
+	ids := make([]*shard.ID, 3)
+	for i := range ids {
+		var err error
+		ids[i], err = e.AddShard(
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(
+					newStorages(filepath.Join(t.Name(), fmt.Sprintf("blobstor%d", i)),
+						1<<20)),
+			),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(t.Name(), fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(t.Name(), fmt.Sprintf("pilorama%d", i)))))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	requireModes := func(rw, ro, degraded int) {
+		// the updates on mode change must not wait for the next period
+		require.Eventually(t, func() bool {
+			return m.shardsInMode(mode.ReadWrite) == rw &&
+				m.shardsInMode(mode.ReadOnly) == ro &&
+				m.shardsInMode(mode.DegradedReadOnly) == degraded
+		}, stateMetricsInterval/10, 10*time.Millisecond)
+	}
+
+	requireModes(3, 0, 0)
+
+	require.NoError(t, e.SetShardMode(ids[0], mode.ReadOnly, false))
+	requireModes(2, 1, 0)
+
+	require.NoError(t, e.SetShardMode(ids[1], mode.DegradedReadOnly, false))
+	requireModes(1, 1, 1)
+
+	require.NoError(t, e.SetShardMode(ids[0], mode.ReadWrite, false))
+	requireModes(2, 0, 1)
+	require.Zero(t, m.shardsInMode(mode.Degraded))
+}
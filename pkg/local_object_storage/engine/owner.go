@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// ObjectsByOwner returns the addresses of the available objects of the
+// container owned by the given user. Objects are looked up in the metabase
+// owner indexes of the shards, no object headers are read. Shards which fail
+// to return the objects are reported and skipped.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) ObjectsByOwner(cnr cid.ID, owner user.ID) (res []oid.Address, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res = e.objectsByOwner(cnr, owner)
+		return nil
+	})
+
+	return
+}
+
+func (e *StorageEngine) objectsByOwner(cnr cid.ID, owner user.ID) []oid.Address {
+	var (
+		res    []oid.Address
+		unique = make(map[oid.Address]struct{})
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		addrs, err := sh.ObjectsByOwner(cnr, owner)
+		if err != nil {
+			e.reportShardError(sh, "could not select objects by owner from shard", err)
+			return false
+		}
+
+		for i := range addrs {
+			if _, ok := unique[addrs[i]]; !ok {
+				unique[addrs[i]] = struct{}{}
+				res = append(res, addrs[i])
+			}
+		}
+
+		return false
+	})
+
+	return res
+}
+
+// RebuildOwnerIndex rebuilds the metabase owner index of the shard with
+// the provided identifier. Returns the number of the indexed objects.
+func (e *StorageEngine) RebuildOwnerIndex(id *shard.ID) (int, error) {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := sh.RebuildOwnerIndex()
+	if err != nil {
+		return 0, fmt.Errorf("could not rebuild owner index of the shard %s: %w", id, err)
+	}
+
+	return n, nil
+}
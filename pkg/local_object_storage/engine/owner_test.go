@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ObjectsByOwner(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+	owners := []struct {
+		id   *user.ID
+		objs []oid.Address
+	}{{id: usertest.ID()}, {id: usertest.ID()}}
+
+	// objects are distributed over the shards
+	for i := 0; i < 20; i++ {
+		o := &owners[i%len(owners)]
+
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetOwnerID(o.id)
+		require.NoError(t, Put(e, obj))
+
+		o.objs = append(o.objs, object.AddressOf(obj))
+	}
+
+	for i := range owners {
+		res, err := e.ObjectsByOwner(cnr, *owners[i].id)
+		require.NoError(t, err)
+		require.ElementsMatch(t, owners[i].objs, res)
+	}
+
+	t.Run("rebuild", func(t *testing.T) {
+		var total int
+		for id := range e.shards {
+			n, err := e.RebuildOwnerIndex(e.shards[id].ID())
+			require.NoError(t, err)
+			total += n
+		}
+		require.Equal(t, 20, total)
+
+		for i := range owners {
+			res, err := e.ObjectsByOwner(cnr, *owners[i].id)
+			require.NoError(t, err)
+			require.ElementsMatch(t, owners[i].objs, res)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ContainerPlacement(t *testing.T) {
+	e, paths := engineWithShards(t, t.TempDir(), 2)
+	t.Cleanup(func() { _ = e.Close() })
+
+	shardByPath := make(map[string]*shard.Shard, len(paths))
+	for _, sh := range e.shards {
+		shardByPath[sh.DumpInfo().MetaBaseInfo.Path] = sh.Shard
+	}
+
+	var (
+		pinned = cidtest.ID()
+		fast   = shardByPath[paths[0]]
+		slow   = shardByPath[paths[1]]
+	)
+
+	var rcfg ReConfiguration
+	for _, p := range paths {
+		rcfg.AddShard(p, nil)
+	}
+
+	rcfg.SetShardContainerPlacement(paths[0], shard.ContainerPlacement{Allow: []cid.ID{pinned}})
+	rcfg.SetShardContainerPlacement(paths[1], shard.ContainerPlacement{Deny: []cid.ID{pinned}})
+	require.NoError(t, e.Reload(rcfg))
+
+	requireStoredIn := func(t *testing.T, obj *objectSDK.Object, expected, other *shard.Shard) {
+		var prm shard.ExistsPrm
+		prm.SetAddress(object.AddressOf(obj))
+
+		res, err := expected.Exists(prm)
+		require.NoError(t, err)
+		require.True(t, res.Exists())
+
+		res, err = other.Exists(prm)
+		require.NoError(t, err)
+		require.False(t, res.Exists())
+
+		_, err = Get(e, object.AddressOf(obj))
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 10; i++ {
+		obj := generateObjectWithCID(t, pinned)
+		require.NoError(t, Put(e, obj))
+		requireStoredIn(t, obj, fast, slow)
+
+		obj = generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(e, obj))
+		requireStoredIn(t, obj, slow, fast)
+	}
+
+	t.Run("no eligible shard", func(t *testing.T) {
+		var rcfg ReConfiguration
+		for _, p := range paths {
+			rcfg.AddShard(p, nil)
+			rcfg.SetShardContainerPlacement(p, shard.ContainerPlacement{Deny: []cid.ID{pinned}})
+		}
+
+		require.Error(t, e.Reload(rcfg))
+
+		// placement is not changed
+		require.True(t, fast.AcceptsContainer(pinned))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		var rcfg ReConfiguration
+		for _, p := range paths {
+			rcfg.AddShard(p, nil)
+		}
+
+		// pin to another shard
+		rcfg.SetShardContainerPlacement(paths[0], shard.ContainerPlacement{Deny: []cid.ID{pinned}})
+		rcfg.SetShardContainerPlacement(paths[1], shard.ContainerPlacement{Allow: []cid.ID{pinned}})
+		require.NoError(t, e.Reload(rcfg))
+
+		obj := generateObjectWithCID(t, pinned)
+		require.NoError(t, Put(e, obj))
+		requireStoredIn(t, obj, slow, fast)
+
+		obj = generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(e, obj))
+		requireStoredIn(t, obj, fast, slow)
+	})
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -16,6 +17,8 @@ import (
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
 	obj *objectSDK.Object
+
+	reservation *ReservationID
 }
 
 // PutRes groups the resulting values of Put operation.
@@ -30,6 +33,12 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 	p.obj = obj
 }
 
+// WithReservation is a Put option to store the object using the capacity
+// reserved by Reserve. Reservation is decreased by the object payload size.
+func (p *PutPrm) WithReservation(id ReservationID) {
+	p.reservation = &id
+}
+
 // Put saves the object to local storage.
 //
 // Returns any error encountered that
@@ -52,39 +61,89 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 		defer elapsed(e.metrics.AddPutDuration)()
 	}
 
-	addr := object.AddressOf(prm.obj)
+	err := e.checkReservations(prm.reservation, prm.obj.PayloadSize())
+	if err != nil {
+		return PutRes{}, err
+	}
+
+	_, err = e.putObject(prm.obj)
+	if err == nil && prm.reservation != nil {
+		e.consumeReservation(*prm.reservation, prm.obj.PayloadSize())
+	}
+
+	return PutRes{}, err
+}
+
+// putObject saves the object to the first suitable shard. Returns the shard
+// the object has been saved to or nil if the object already exists.
+func (e *StorageEngine) putObject(obj *objectSDK.Object) (*shard.Shard, error) {
+	addr := object.AddressOf(obj)
 
 	// In #1146 this check was parallelized, however, it became
 	// much slower on fast machines for 4 shards.
-	_, err := e.exists(addr)
+	_, err := e.exists(context.Background(), addr)
 	if err != nil {
-		return PutRes{}, err
+		return nil, err
 	}
 
-	finished := false
+	var (
+		finished bool
+		target   *shard.Shard
+		rejected error
+	)
 
 	e.iterateOverSortedShards(addr, func(ind int, sh hashedShard) (stop bool) {
+		if !sh.AcceptsContainer(addr.Container()) {
+			return false
+		}
+
 		e.mtx.RLock()
 		pool := e.shardPools[sh.ID().String()]
 		e.mtx.RUnlock()
 
-		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		putDone, exists, err := e.putToShard(sh, ind, pool, addr, obj)
+		if err != nil {
+			// the other shards would reject it too
+			rejected = err
+			return true
+		}
+
+		if putDone {
+			target = sh.Shard
+		}
+
 		finished = putDone || exists
 		return finished
 	})
 
+	if rejected != nil {
+		return nil, rejected
+	}
+
 	if !finished {
-		err = errPutShard
+		return nil, errPutShard
 	}
 
-	return PutRes{}, err
+	if target != nil {
+		e.notify(StorageEvent{
+			Type:    EventObjectStored,
+			ShardID: target.ID(),
+			Address: addr,
+		})
+	}
+
+	return target, nil
 }
 
 // putToShard puts object to sh.
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
-func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
-	var putSuccess, alreadyExists bool
+// Non-nil error is returned iff the object is rejected as an invalid one.
+func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool, error) {
+	var (
+		putSuccess, alreadyExists bool
+		rejected                  error
+	)
 
 	exitCh := make(chan struct{})
 
@@ -128,6 +187,11 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 
 		_, err = sh.Put(putPrm)
 		if err != nil {
+			if shard.IsErrInvalidObject(err) {
+				rejected = err
+				return
+			}
+
 			if errors.Is(err, shard.ErrReadOnlyMode) || errors.Is(err, blobstor.ErrNoPlaceFound) ||
 				errors.Is(err, common.ErrReadOnly) || errors.Is(err, common.ErrNoSpace) {
 				e.log.Warn("could not put object to shard",
@@ -147,7 +211,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 
 	<-exitCh
 
-	return putSuccess, alreadyExists
+	return putSuccess, alreadyExists, rejected
 }
 
 // Put writes provided object to local storage.
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStorageEngine_PutWriteCacheDisabled(t *testing.T) {
+	const objCount = 20
+
+	dir := t.TempDir()
+
+	e := New(WithLogger(zaptest.NewLogger(t)))
+	t.Cleanup(func() { _ = e.Close() })
+
+	// the first shard works with the write-cache, the second one without it
+	cached := make(map[string]bool)
+
+	for i, useCache := range []bool{true, false} {
+		shDir := filepath.Join(dir, fmt.Sprintf("shard%d", i))
+
+		id, err := e.AddShard(
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(newStorages(filepath.Join(shDir, "blob"), 1<<20))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(shDir, "metabase")),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{})),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(shDir, "pilorama"))),
+			shard.WithWriteCache(useCache),
+			shard.WithWriteCacheOptions(
+				writecache.WithPath(filepath.Join(shDir, "writecache"))),
+		)
+		require.NoError(t, err)
+
+		cached[id.String()] = useCache
+	}
+
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	cnr := cidtest.ID()
+	perShard := make(map[bool]int)
+
+	for i := 0; i < objCount; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		addr := object.AddressOf(obj)
+
+		require.NoError(t, Put(e, obj))
+
+		sts, err := e.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.Len(t, sts, 1)
+
+		useCache := cached[sts[0].ID.String()]
+		perShard[useCache]++
+
+		st := sts[0].Status
+		if useCache {
+			require.NotNil(t, st.WriteCache)
+			require.True(t, st.WriteCache.InDB || st.WriteCache.InFSTree)
+		} else {
+			// the object is written straight to the blobstor
+			require.Nil(t, st.WriteCache)
+			require.NotEmpty(t, st.BlobStorage)
+			require.True(t, st.Metabase.Found)
+		}
+
+		// the object is readable right after the put
+		res, err := Get(e, addr)
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload(), res.Payload())
+	}
+
+	require.NotZero(t, perShard[true])
+	require.NotZero(t, perShard[false])
+}
@@ -1,7 +1,9 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
@@ -45,26 +47,29 @@ func (r RngRes) Object() *objectSDK.Object {
 	return r.obj
 }
 
-// GetRange reads part of an object from local storage.
+// GetRange reads part of an object from local storage. If the read from
+// a shard fails in the middle, the rest of the range is read from another
+// local copy of the object, if any.
 //
 // Returns any error encountered that
 // did not allow to completely read the object part.
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in local storage.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object is inhumed.
-// Returns ErrRangeOutOfBounds if the requested object range is out of bounds.
+// Returns an error of type apistatus.ObjectOutOfRange if the requested object range is out of bounds.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) GetRange(prm RngPrm) (res RngRes, err error) {
+// Returns the context error if the context is done before the range is read.
+func (e *StorageEngine) GetRange(ctx context.Context, prm RngPrm) (res RngRes, err error) {
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.getRange(prm)
+		res, err = e.getRange(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
+func (e *StorageEngine) getRange(ctx context.Context, prm RngPrm) (RngRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddRangeDuration)()
 	}
@@ -80,6 +85,12 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 
 		shardWithMeta hashedShard
 		metaError     error
+
+		ctxErr error
+
+		// leading bytes of the range read from the
+		// shards which failed in the middle of the read
+		delivered []byte
 	)
 
 	var hasDegraded bool
@@ -87,14 +98,27 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 	var shPrm shard.RngPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRange(prm.off, prm.ln)
+	shPrm.SetContext(ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		if ctxErr = ctxError(ctx, "get range"); ctxErr != nil {
+			return true
+		}
+
+		if !sh.MayContain(prm.addr) {
+			return false
+		}
+
 		noMeta := sh.GetMode().NoMetabase()
 		hasDegraded = hasDegraded || noMeta
 		shPrm.SetIgnoreMeta(noMeta)
 
 		res, err := sh.GetRange(shPrm)
 		if err != nil {
+			if ctxErr = ctxError(ctx, "get range"); ctxErr != nil {
+				return true
+			}
+
 			if res.HasMeta() {
 				shardWithMeta = sh
 				metaError = err
@@ -127,6 +151,12 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 
 				return true // stop, return it back
 			default:
+				if n := res.Delivered(); n > 0 {
+					// resume from another copy of the object at the failed offset
+					delivered = append(delivered, res.Object().Payload()...)
+					shPrm.SetRange(prm.off+uint64(len(delivered)), prm.ln-uint64(len(delivered)))
+				}
+
 				e.reportShardError(sh, "could not get object from shard", err)
 				return false
 			}
@@ -137,6 +167,10 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 		return true
 	})
 
+	if ctxErr != nil {
+		return RngRes{}, ctxErr
+	}
+
 	if outSI != nil {
 		return RngRes{}, objectSDK.NewSplitInfoError(outSI)
 	}
@@ -159,16 +193,30 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 				return false
 			}
 
+			if ctxErr = ctxError(ctx, "get range"); ctxErr != nil {
+				return true
+			}
+
 			res, err := sh.GetRange(shPrm)
+			if ctxErr = ctxError(ctx, "get range"); ctxErr != nil {
+				return true
+			}
+
 			if shard.IsErrOutOfRange(err) {
 				var errOutOfRange apistatus.ObjectOutOfRange
 
 				outError = errOutOfRange
 				return true
 			}
+			if err != nil {
+				return false
+			}
 			obj = res.Object()
-			return err == nil
+			return true
 		})
+		if ctxErr != nil {
+			return RngRes{}, ctxErr
+		}
 		if obj == nil {
 			return RngRes{}, outError
 		}
@@ -179,18 +227,187 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 		}
 	}
 
+	if len(delivered) > 0 {
+		obj.SetPayload(append(delivered, obj.Payload()...))
+	}
+
 	return RngRes{
 		obj: obj,
 	}, nil
 }
 
+// GetPayloadRange reads ln bytes of the object payload starting at off.
+// Unlike GetRange, the range of a virtual object is read from the locally
+// stored parts of its split chain overlapping the range, the whole object
+// is not assembled.
+//
+// Returns any error encountered that
+// did not allow to completely read the payload range.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object or
+// any part overlapping the range is missing in local storage.
+// Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object is inhumed.
+// Returns an error of type apistatus.ObjectOutOfRange if the requested range is out of bounds.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+// Returns the context error if the context is done before the range is read.
+func (e *StorageEngine) GetPayloadRange(ctx context.Context, addr oid.Address, off, ln uint64) (data []byte, err error) {
+	err = e.execIfNotBlocked(func() error {
+		data, err = e.getPayloadRange(ctx, addr, off, ln)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) getPayloadRange(ctx context.Context, addr oid.Address, off, ln uint64) ([]byte, error) {
+	var prm RngPrm
+	prm.addr = addr
+	prm.off, prm.ln = off, ln
+
+	res, err := e.getRange(ctx, prm)
+	if err == nil {
+		return res.Object().Payload(), nil
+	}
+
+	var siErr *objectSDK.SplitInfoError
+	if !errors.As(err, &siErr) {
+		return nil, err
+	}
+
+	parts, err := e.splitChain(ctx, addr, siErr.SplitInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	var size uint64
+	for i := range parts {
+		size += parts[i].PayloadSize()
+	}
+
+	to := off + ln
+	if to < off || size < to {
+		return nil, apistatus.ObjectOutOfRange{}
+	}
+
+	data := make([]byte, 0, ln)
+
+	var partFrom uint64
+
+	for i := 0; i < len(parts) && partFrom < to; i++ {
+		partTo := partFrom + parts[i].PayloadSize()
+
+		if partTo > off {
+			from, till := off, to
+			if from < partFrom {
+				from = partFrom
+			}
+			if till > partTo {
+				till = partTo
+			}
+
+			prm.addr.SetObject(parts[i].id)
+			prm.off, prm.ln = from-partFrom, till-from
+
+			res, err := e.getRange(ctx, prm)
+			if err != nil {
+				return nil, fmt.Errorf("could not read range of the part %s: %w", parts[i].id, err)
+			}
+
+			data = append(data, res.Object().Payload()...)
+		}
+
+		partFrom = partTo
+	}
+
+	return data, nil
+}
+
+// splitPart is a header of the object part along with its ID.
+type splitPart struct {
+	id oid.ID
+	*objectSDK.Object
+}
+
+// splitChain returns the headers of the parts of the virtual object in
+// the payload order. The chain is restored from the linking object if it
+// is stored locally, otherwise from the last part through the previous ones.
+func (e *StorageEngine) splitChain(ctx context.Context, addr oid.Address, si *objectSDK.SplitInfo) ([]splitPart, error) {
+	var headPrm HeadPrm
+	headPrm.WithAddress(addr)
+	headPrm.WithRaw(true)
+
+	head := func(id oid.ID) (*objectSDK.Object, error) {
+		headPrm.addr.SetObject(id)
+
+		res, err := e.head(ctx, headPrm)
+		if err != nil {
+			return nil, fmt.Errorf("could not read header of the part %s: %w", id, err)
+		}
+
+		return res.Header(), nil
+	}
+
+	if link, ok := si.Link(); ok {
+		linkHdr, err := head(link)
+		if err != nil {
+			return nil, err
+		}
+
+		children := linkHdr.Children()
+		parts := make([]splitPart, len(children))
+
+		for i := range children {
+			hdr, err := head(children[i])
+			if err != nil {
+				return nil, err
+			}
+
+			parts[i] = splitPart{id: children[i], Object: hdr}
+		}
+
+		return parts, nil
+	}
+
+	last, ok := si.LastPart()
+	if !ok {
+		return nil, fmt.Errorf("%w: split chain of the object is not stored locally", apistatus.ObjectNotFound{})
+	}
+
+	var (
+		parts   []splitPart
+		visited = make(map[oid.ID]struct{})
+	)
+
+	for id, ok := last, true; ok; id, ok = parts[len(parts)-1].PreviousID() {
+		if _, ok := visited[id]; ok {
+			return nil, fmt.Errorf("cycle in the split chain at the part %s", id)
+		}
+
+		visited[id] = struct{}{}
+
+		hdr, err := head(id)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, splitPart{id: id, Object: hdr})
+	}
+
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+
+	return parts, nil
+}
+
 // GetRange reads object payload range from local storage by provided address.
 func GetRange(storage *StorageEngine, addr oid.Address, rng *objectSDK.Range) ([]byte, error) {
 	var rangePrm RngPrm
 	rangePrm.WithAddress(addr)
 	rangePrm.WithPayloadRange(rng)
 
-	res, err := storage.GetRange(rangePrm)
+	res, err := storage.GetRange(context.Background(), rangePrm)
 	if err != nil {
 		return nil, err
 	}
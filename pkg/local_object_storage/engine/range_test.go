@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_GetPayloadRange(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	cnr := cidtest.ID()
+	splitID := objectSDK.NewSplitID()
+
+	payload := make([]byte, 25)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+
+	parent := generateObjectWithCID(t, cnr)
+	parent.SetPayload(payload)
+	parent.SetPayloadSize(uint64(len(payload)))
+
+	idParent, _ := parent.ID()
+
+	// parts of 10, 10 and 5 bytes
+	var (
+		parts    []*objectSDK.Object
+		children []oid.ID
+	)
+
+	for from := 0; from < len(payload); from += 10 {
+		till := from + 10
+		if till > len(payload) {
+			till = len(payload)
+		}
+
+		part := generateObjectWithCID(t, cnr)
+		part.SetPayload(payload[from:till])
+		part.SetPayloadSize(uint64(till - from))
+		part.SetSplitID(splitID)
+
+		if len(parts) > 0 {
+			part.SetPreviousID(children[len(children)-1])
+		}
+
+		id, _ := part.ID()
+		parts = append(parts, part)
+		children = append(children, id)
+	}
+
+	last := parts[len(parts)-1]
+	last.SetParent(parent)
+	last.SetParentID(idParent)
+
+	link := generateObjectWithCID(t, cnr)
+	link.SetParent(parent)
+	link.SetParentID(idParent)
+	link.SetChildren(children...)
+	link.SetSplitID(splitID)
+
+	testRanges := func(t *testing.T, e *StorageEngine) {
+		addr := object.AddressOf(parent)
+
+		for _, tc := range []struct {
+			name    string
+			off, ln uint64
+		}{
+			{name: "first part", off: 2, ln: 5},
+			{name: "middle part", off: 10, ln: 10},
+			{name: "last part", off: 22, ln: 3},
+			{name: "two parts", off: 8, ln: 4},
+			{name: "all parts", off: 5, ln: 17},
+			{name: "full payload", off: 0, ln: 25},
+			{name: "empty", off: 25, ln: 0},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				data, err := e.GetPayloadRange(context.Background(), addr, tc.off, tc.ln)
+				require.NoError(t, err)
+				require.Equal(t, payload[tc.off:tc.off+tc.ln], data)
+			})
+		}
+
+		t.Run("out of range", func(t *testing.T) {
+			_, err := e.GetPayloadRange(context.Background(), addr, 20, 6)
+			require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+
+			_, err = e.GetPayloadRange(context.Background(), addr, 26, 0)
+			require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+		})
+
+		t.Run("regular object", func(t *testing.T) {
+			data, err := e.GetPayloadRange(context.Background(), object.AddressOf(parts[1]), 3, 4)
+			require.NoError(t, err)
+			require.Equal(t, payload[13:17], data)
+		})
+	}
+
+	t.Run("with link", func(t *testing.T) {
+		e := testNewEngineWithShardNum(t, 3)
+		defer e.Close()
+
+		for _, obj := range append(parts, link) {
+			require.NoError(t, Put(e, obj))
+		}
+
+		testRanges(t, e)
+	})
+
+	t.Run("without link", func(t *testing.T) {
+		e := testNewEngineWithShardNum(t, 3)
+		defer e.Close()
+
+		for _, obj := range parts {
+			require.NoError(t, Put(e, obj))
+		}
+
+		testRanges(t, e)
+	})
+
+	t.Run("missing part", func(t *testing.T) {
+		e := testNewEngineWithShardNum(t, 3)
+		defer e.Close()
+
+		require.NoError(t, Put(e, parts[0]))
+		require.NoError(t, Put(e, parts[2]))
+		require.NoError(t, Put(e, link))
+
+		addr := object.AddressOf(parent)
+
+		// sizes of all the parts are needed to locate the range
+		data, err := e.GetPayloadRange(context.Background(), addr, 22, 3)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		require.Nil(t, data)
+	})
+}
+
+// partialReadStorage fails payload range reads after the first n bytes
+// if fail is set.
+type partialReadStorage struct {
+	common.Storage
+
+	fail bool
+	n    int
+
+	ranges []*objectSDK.Range
+}
+
+func (*partialReadStorage) Type() string {
+	return "partial"
+}
+
+func (s *partialReadStorage) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	rng := prm.Range
+	s.ranges = append(s.ranges, &rng)
+
+	res, err := s.Storage.GetRange(prm)
+	if err != nil || !s.fail {
+		return res, err
+	}
+
+	if s.n < len(res.Data) {
+		res.Data = res.Data[:s.n]
+	}
+
+	return common.GetRangeRes{Data: res.Data}, errors.New("bad sector")
+}
+
+func TestStorageEngine_GetRangeResume(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	var (
+		stors  [2]*partialReadStorage
+		shards [2]*shard.Shard
+	)
+
+	for i := range shards {
+		stors[i] = &partialReadStorage{
+			Storage: fstree.New(
+				fstree.WithPath(filepath.Join(t.Name(), fmt.Sprintf("%d.fstree", i))),
+				fstree.WithDepth(1)),
+			n: 30,
+		}
+		shards[i] = testNewShard(t, i, shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{{Storage: stors[i]}})))
+	}
+
+	e := testNewEngineWithShards(shards[:]...)
+	defer e.Close()
+
+	payload := make([]byte, 100)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	addr := object.AddressOf(obj)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	for i := range shards {
+		_, err := shards[i].Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	// the copy in the first shard to be read is on the bad sector
+	sorted := e.sortShardsByWeight(addr)
+
+	failing, healthy := stors[0], stors[1]
+	if sorted[0].Shard != shards[0] {
+		failing, healthy = healthy, failing
+	}
+
+	failing.fail = true
+
+	rng := objectSDK.NewRange()
+	rng.SetOffset(10)
+	rng.SetLength(60)
+
+	var prm RngPrm
+	prm.WithAddress(addr)
+	prm.WithPayloadRange(rng)
+
+	res, err := e.GetRange(context.Background(), prm)
+	require.NoError(t, err)
+	require.Equal(t, payload[10:70], res.Object().Payload())
+
+	require.Len(t, failing.ranges, 1)
+	require.Len(t, healthy.ranges, 1)
+	require.EqualValues(t, 40, healthy.ranges[0].GetOffset())
+	require.EqualValues(t, 30, healthy.ranges[0].GetLength())
+
+	t.Run("no other copies", func(t *testing.T) {
+		healthy.fail = true
+		healthy.n = 10
+
+		_, err := e.GetRange(context.Background(), prm)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package engine
+
+// ReadCacheStats groups the statistics of the shard read caches.
+type ReadCacheStats struct {
+	// Budget is the limit of the total size of the read caches,
+	// zero if the size is limited per shard only.
+	Budget uint64
+
+	// Size is the total size of the objects in the read caches.
+	Size uint64
+
+	// Shards contains the sizes of the read caches by shard ID.
+	Shards map[string]uint64
+}
+
+// ReadCacheStats returns the statistics of the read caches of all shards.
+func (e *StorageEngine) ReadCacheStats() ReadCacheStats {
+	var res ReadCacheStats
+
+	if e.readCacheBudget != nil {
+		res.Budget = e.readCacheBudget.Capacity()
+	}
+
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	res.Shards = make(map[string]uint64, len(e.shards))
+
+	for id, sh := range e.shards {
+		sz := sh.ReadCacheSize()
+
+		res.Shards[id] = sz
+		res.Size += sz
+	}
+
+	return res
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCacheBudget(t *testing.T) {
+	const objNum = 20
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	// every cache can hold all the objects, the budget is enough for 5 of them
+	budget := shard.NewReadCacheBudget(uint64(len(data))*5 + 1)
+
+	e := testEngineFromShardOpts(t, 3, []shard.Option{
+		shard.WithReadCache(1<<20, 1<<10),
+		shard.WithReadCacheBudget(budget),
+	})
+	e.readCacheBudget = budget
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	for i := 0; i < objNum; i++ {
+		obj := generateObjectWithCID(t, cidtest.ID())
+
+		require.NoError(t, Put(e, obj))
+
+		_, err := Get(e, objectCore.AddressOf(obj))
+		require.NoError(t, err)
+
+		stats := e.ReadCacheStats()
+		require.Equal(t, budget.Capacity(), stats.Budget)
+		require.LessOrEqual(t, stats.Size, stats.Budget)
+		require.Len(t, stats.Shards, 3)
+
+		var sum uint64
+		for _, sz := range stats.Shards {
+			sum += sz
+		}
+		require.Equal(t, stats.Size, sum)
+	}
+
+	require.EqualValues(t, len(data)*5, e.ReadCacheStats().Size)
+}
@@ -0,0 +1,152 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+var errShardReloading = errors.New("shard is being reloaded")
+
+// placeholderEpochState is the epoch state of the placeholder shard
+// replacing the reloaded one, its metabase is never opened.
+type placeholderEpochState struct{}
+
+func (placeholderEpochState) CurrentEpoch() uint64 { return 0 }
+
+// ReloadShard re-creates the components of a single shard with the new
+// options, e.g. to point the metabase to a replaced disk, without
+// interrupting the other shards. The shard keeps its identifier: it is
+// written to the new metabase if it is empty, and the reload fails if
+// the metabase belongs to another shard.
+//
+// For the reload the shard is replaced with a placeholder without any
+// storage in the degraded read-only mode, so the requests hitting it fail
+// with the degraded mode errors instead of reaching the closed components
+// until the new ones are initialized. If the new components can not be
+// initialized, the shard is restored with the previous options.
+//
+// Returns an error if the shard is already being reloaded.
+func (e *StorageEngine) ReloadShard(id *shard.ID, opts []shard.Option) error {
+	sid := id.String()
+
+	e.mtx.Lock()
+	sh, ok := e.shards[sid]
+	if !ok {
+		e.mtx.Unlock()
+		return errShardNotFound
+	}
+
+	if _, ok := e.reloading[sid]; ok {
+		e.mtx.Unlock()
+		return errShardReloading
+	}
+
+	e.reloading[sid] = struct{}{}
+	e.mtx.Unlock()
+
+	defer func() {
+		e.mtx.Lock()
+		delete(e.reloading, sid)
+		e.mtx.Unlock()
+	}()
+
+	prev := sh.Shard
+	e.swapShard(sid, shard.New(
+		shard.WithID(id),
+		shard.WithLogger(e.log),
+		shard.WithMode(mode.DegradedReadOnly),
+		shard.WithMetaBaseOptions(meta.WithEpochState(placeholderEpochState{}))))
+
+	// the requests already being served by the shard are finished
+	// by the mode switch, so the components can be closed
+	prevMode := prev.GetMode()
+	if !prevMode.NoMetabase() {
+		if err := prev.SetMode(mode.DegradedReadOnly); err != nil {
+			e.log.Warn("could not move shard to degraded mode before reload",
+				zap.String("id", sid),
+				zap.Error(err))
+		}
+	}
+
+	if err := prev.Close(); err != nil {
+		e.log.Warn("could not close shard before reload",
+			zap.String("id", sid),
+			zap.Error(err))
+	}
+
+	newSh, err := e.openShard(id, opts)
+	if err != nil {
+		if rErr := restoreShard(prev, prevMode); rErr != nil {
+			e.log.Error("could not restore shard after failed reload",
+				zap.String("id", sid),
+				zap.Error(rErr))
+		}
+
+		e.swapShard(sid, prev)
+
+		return fmt.Errorf("could not reload shard %s: %w", sid, err)
+	}
+
+	e.swapShard(sid, newSh)
+
+	e.log.Info("shard has been reloaded", zap.String("id", sid))
+
+	e.requestStateMetrics()
+
+	return nil
+}
+
+// swapShard replaces the shard with the given identifier and resets its
+// error counter.
+func (e *StorageEngine) swapShard(sid string, sh *shard.Shard) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	w := e.shards[sid]
+	w.Shard = sh
+	w.errorCount.Store(0)
+	e.shards[sid] = w
+}
+
+// openShard creates, opens and initializes the shard with the given
+// identifier.
+func (e *StorageEngine) openShard(id *shard.ID, opts []shard.Option) (*shard.Shard, error) {
+	sh, err := e.createShardWithID(id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.ID().String() != id.String() {
+		return nil, fmt.Errorf("metabase belongs to another shard %s", sh.ID())
+	}
+
+	err = sh.Open()
+	if err == nil {
+		err = sh.Init()
+	}
+	if err != nil {
+		_ = sh.Close()
+		return nil, err
+	}
+
+	return sh, nil
+}
+
+// restoreShard opens and initializes the closed shard again and returns
+// it to the mode m.
+func restoreShard(sh *shard.Shard, m mode.Mode) error {
+	err := sh.Open()
+	if err == nil {
+		err = sh.Init()
+	}
+	if err == nil && sh.GetMode() != m {
+		err = sh.SetMode(m)
+	}
+
+	return err
+}
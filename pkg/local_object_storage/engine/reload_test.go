@@ -0,0 +1,210 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newReloadTestEngine(t *testing.T) (*StorageEngine, []*shard.ID, [][]oid.Address) {
+	e := testEngineFromShardOpts(t, 2, nil)
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	// shard IDs in the order of their options, see testShardOpts
+	ids := make([]*shard.ID, len(e.shards))
+	for _, sh := range e.shards {
+		for i := range ids {
+			if sh.DumpInfo().MetaBaseInfo.Path == filepath.Join(t.Name(), fmt.Sprintf("metabase%d", i)) {
+				ids[i] = sh.ID()
+			}
+		}
+	}
+
+	// objects stored in each shard
+	stored := make([][]oid.Address, len(ids))
+	for i := 0; i < 10; i++ {
+		obj := generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(e, obj))
+
+		addr := objectCore.AddressOf(obj)
+		for j := range ids {
+			var existsPrm shard.ExistsPrm
+			existsPrm.SetAddress(addr)
+
+			res, err := e.shards[ids[j].String()].Exists(existsPrm)
+			require.NoError(t, err)
+			if res.Exists() {
+				stored[j] = append(stored[j], addr)
+			}
+		}
+	}
+
+	return e, ids, stored
+}
+
+func requireStored(t *testing.T, e *StorageEngine, addrs []oid.Address) {
+	for i := range addrs {
+		var prm GetPrm
+		prm.WithAddress(addrs[i])
+
+		_, err := e.Get(context.Background(), prm)
+		require.NoError(t, err)
+	}
+}
+
+func TestReloadShard(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	t.Run("same options", func(t *testing.T) {
+		e, ids, stored := newReloadTestEngine(t)
+
+		require.NoError(t, e.ReloadShard(ids[0], testShardOpts(t.Name(), 0)))
+		require.Len(t, e.shards, 2)
+
+		sh := e.shards[ids[0].String()]
+		require.Equal(t, ids[0], sh.ID())
+		require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+		requireStored(t, e, stored[0])
+		requireStored(t, e, stored[1])
+
+		obj := generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(e, obj))
+	})
+
+	t.Run("new disk", func(t *testing.T) {
+		e, ids, stored := newReloadTestEngine(t)
+		require.NotEmpty(t, stored[0])
+
+		require.NoError(t, e.ReloadShard(ids[0], testShardOpts(filepath.Join(t.Name(), "new"), 0)))
+
+		sh := e.shards[ids[0].String()]
+		require.Equal(t, ids[0], sh.ID())
+		require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+		res, err := sh.List()
+		require.NoError(t, err)
+		require.Empty(t, res.AddressList())
+
+		for i := range stored[0] {
+			var prm GetPrm
+			prm.WithAddress(stored[0][i])
+
+			_, err := e.Get(context.Background(), prm)
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		}
+		requireStored(t, e, stored[1])
+
+		// shard ID is kept in the new metabase
+		e.mtx.RLock()
+		metaPath := sh.DumpInfo().MetaBaseInfo.Path
+		e.mtx.RUnlock()
+		require.Equal(t, filepath.Join(t.Name(), "new", "metabase0"), metaPath)
+	})
+
+	t.Run("failed reload", func(t *testing.T) {
+		e, ids, stored := newReloadTestEngine(t)
+
+		// metabase directory can not be created under a regular file
+		invalid := filepath.Join(t.Name(), "file")
+		require.NoError(t, os.WriteFile(invalid, nil, 0600))
+
+		require.Error(t, e.ReloadShard(ids[0], testShardOpts(invalid, 0)))
+
+		sh := e.shards[ids[0].String()]
+		require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+		requireStored(t, e, stored[0])
+		requireStored(t, e, stored[1])
+	})
+
+	t.Run("requests during reload", func(t *testing.T) {
+		e, ids, stored := newReloadTestEngine(t)
+		require.NotEmpty(t, stored[0])
+
+		wc := &blockingWriteCache{
+			opened:  make(chan struct{}),
+			release: make(chan struct{}),
+		}
+
+		opts := append(testShardOpts(t.Name(), 0),
+			shard.WithWriteCache(true),
+			shard.WithWriteCacheConstructor(func(bs *blobstor.BlobStor, mb *meta.DB) writecache.Cache {
+				wc.Cache = writecache.New(
+					writecache.WithPath(filepath.Join(t.Name(), "writecache0")),
+					writecache.WithBlobstor(bs),
+					writecache.WithMetabase(mb))
+				return wc
+			}))
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- e.ReloadShard(ids[0], opts) }()
+		<-wc.opened
+
+		e.mtx.RLock()
+		sh := e.shards[ids[0].String()]
+		e.mtx.RUnlock()
+		require.Equal(t, ids[0], sh.ID())
+		require.Equal(t, mode.DegradedReadOnly, sh.GetMode())
+
+		var getPrm GetPrm
+		getPrm.WithAddress(stored[0][0])
+		_, err := e.Get(context.Background(), getPrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+		require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+
+		close(wc.release)
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("concurrent reload", func(t *testing.T) {
+		e, ids, _ := newReloadTestEngine(t)
+
+		e.mtx.Lock()
+		e.reloading[ids[0].String()] = struct{}{}
+		e.mtx.Unlock()
+
+		err := e.ReloadShard(ids[0], testShardOpts(t.Name(), 0))
+		require.ErrorIs(t, err, errShardReloading)
+	})
+
+	t.Run("unknown shard", func(t *testing.T) {
+		e, _, _ := newReloadTestEngine(t)
+
+		id, err := generateShardID()
+		require.NoError(t, err)
+
+		require.ErrorIs(t, e.ReloadShard(id, nil), errShardNotFound)
+	})
+}
+
+// blockingWriteCache is a write-cache which Open is blocked until release
+// is closed.
+type blockingWriteCache struct {
+	writecache.Cache
+	opened  chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingWriteCache) Open(readOnly bool) error {
+	close(c.opened)
+	<-c.release
+	return c.Cache.Open(readOnly)
+}
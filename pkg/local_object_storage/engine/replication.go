@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ReplicationPolicy provides the number of the object copies the local node
+// must store according to the container placement policy.
+type ReplicationPolicy interface {
+	// LocalReplicas returns the number of the object copies required to be
+	// stored by the local node. The node stores at most one copy, so it is
+	// 1 if the node is among the first replica count nodes of any placement
+	// vector of the object and 0 otherwise.
+	LocalReplicas(oid.Address) (uint32, error)
+}
+
+// ReplicationReport describes the local copies of the container objects.
+//
+// Only the local storage is checked against the container placement policy:
+// the number of the copies across the container nodes is maintained by the
+// policer.
+type ReplicationReport struct {
+	// Objects is the number of the container objects known to the shards.
+	Objects int
+	// UnderReplicated lists the objects the local node must store according
+	// to the placement policy which are registered in the shard metabases
+	// and stored in none of the shards.
+	UnderReplicated []oid.Address
+	// OverReplicated lists the objects stored in more copies than required
+	// by the placement policy: in more than one shard or by the node which
+	// is not required to store them at all.
+	OverReplicated []oid.Address
+	// Misplaced lists the objects stored only in the shards not accepting
+	// the container according to the shard container placement.
+	Misplaced []oid.Address
+}
+
+// CheckReplication checks the number of the local copies of every object of
+// the container known to the shards against the required one and their
+// placement. Shards which fail to list the objects or to report the object
+// status and objects with unknown required number of copies are logged and
+// skipped.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) CheckReplication(cnr cid.ID, policy ReplicationPolicy) (res ReplicationReport, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res = e.checkReplication(cnr, policy)
+		return nil
+	})
+
+	return
+}
+
+func (e *StorageEngine) checkReplication(cnr cid.ID, policy ReplicationPolicy) ReplicationReport {
+	var (
+		res    ReplicationReport
+		addrs  []oid.Address
+		unique = make(map[string]struct{})
+		shards = e.unsortedShards()
+		selPrm shard.SelectPrm
+	)
+
+	selPrm.SetContainerID(cnr)
+
+	for _, sh := range shards {
+		sRes, err := sh.Select(selPrm)
+		if err != nil {
+			e.log.Warn("could not select objects for replication check",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Stringer("container_id", cnr),
+				zap.String("error", err.Error()))
+			continue
+		}
+
+		for _, addr := range sRes.AddressList() {
+			if _, ok := unique[addr.EncodeToString()]; !ok {
+				unique[addr.EncodeToString()] = struct{}{}
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+
+	accepts := make(map[string]bool, len(shards))
+	for _, sh := range shards {
+		accepts[sh.ID().String()] = sh.AcceptsContainer(cnr)
+	}
+
+	res.Objects = len(addrs)
+
+	for _, addr := range addrs {
+		statuses, err := e.ObjectStatus(addr)
+		if err != nil {
+			continue
+		}
+
+		required, err := policy.LocalReplicas(addr)
+		if err != nil {
+			e.log.Warn("could not get required number of object copies",
+				zap.Stringer("address", addr),
+				zap.String("error", err.Error()))
+			continue
+		}
+
+		var copies, placed uint32
+
+		for i := range statuses {
+			if !storesObject(statuses[i].Status) {
+				continue
+			}
+
+			copies++
+			if accepts[statuses[i].ID.String()] {
+				placed++
+			}
+		}
+
+		switch {
+		case copies < required:
+			res.UnderReplicated = append(res.UnderReplicated, addr)
+		case copies > required:
+			res.OverReplicated = append(res.OverReplicated, addr)
+		}
+
+		if copies > 0 && placed == 0 {
+			res.Misplaced = append(res.Misplaced, addr)
+		}
+	}
+
+	return res
+}
+
+// storesObject checks whether the shard stores the object data.
+func storesObject(st shard.ObjectStatus) bool {
+	return st.BlobStorage != "" ||
+		st.WriteCache != nil && (st.WriteCache.InDB || st.WriteCache.InFSTree)
+}
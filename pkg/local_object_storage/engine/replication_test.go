@@ -0,0 +1,144 @@
+package engine
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_CheckReplication(t *testing.T) {
+	dir := t.TempDir()
+
+	e, paths := engineWithShards(t, dir, 2)
+	t.Cleanup(func() { _ = e.Close() })
+
+	shardByPath := make(map[string]*shard.Shard, len(paths))
+	for _, sh := range e.shards {
+		shardByPath[sh.DumpInfo().MetaBaseInfo.Path] = sh.Shard
+	}
+
+	var (
+		cnr   = cidtest.ID()
+		first = shardByPath[paths[0]]
+		other = shardByPath[paths[1]]
+	)
+
+	// objects of the container are placed to the first shard only
+	var rcfg ReConfiguration
+	for _, p := range paths {
+		rcfg.AddShard(p, nil)
+	}
+	rcfg.SetShardContainerPlacement(paths[0], shard.ContainerPlacement{Allow: []cid.ID{cnr}})
+	rcfg.SetShardContainerPlacement(paths[1], shard.ContainerPlacement{Deny: []cid.ID{cnr}})
+	require.NoError(t, e.Reload(rcfg))
+
+	putTo := func(t *testing.T, obj *objectSDK.Object, shards ...*shard.Shard) {
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		for _, sh := range shards {
+			_, err := sh.Put(prm)
+			require.NoError(t, err)
+		}
+	}
+
+	// regular objects
+	for i := 0; i < 3; i++ {
+		require.NoError(t, Put(e, generateObjectWithCID(t, cnr)))
+	}
+
+	over := generateObjectWithCID(t, cnr)
+	putTo(t, over, first, other)
+
+	misplaced := generateObjectWithCID(t, cnr)
+	putTo(t, misplaced, other)
+
+	under := generateObjectWithCID(t, cnr)
+	under.SetPayload(make([]byte, 2*errSmallSize))
+	putTo(t, under, first)
+	removeBlob(t, dir, object.AddressOf(under))
+
+	// the node is not in the object placement
+	redundant := generateObjectWithCID(t, cnr)
+	putTo(t, redundant, first)
+
+	notRequired := generateObjectWithCID(t, cnr)
+	notRequired.SetPayload(make([]byte, 2*errSmallSize))
+	putTo(t, notRequired, first)
+	removeBlob(t, dir, object.AddressOf(notRequired))
+
+	unknown := generateObjectWithCID(t, cnr)
+	putTo(t, unknown, first, other)
+
+	// objects of the other containers are not checked
+	require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+
+	checkPolicy := testReplicationPolicy(func(addr oid.Address) (uint32, error) {
+		switch addr {
+		case object.AddressOf(redundant), object.AddressOf(notRequired):
+			return 0, nil
+		case object.AddressOf(unknown):
+			return 0, errors.New("any error")
+		default:
+			return 1, nil
+		}
+	})
+
+	res, err := e.CheckReplication(cnr, checkPolicy)
+	require.NoError(t, err)
+	require.Equal(t, 9, res.Objects)
+	require.Equal(t, []oid.Address{object.AddressOf(under)}, res.UnderReplicated)
+	require.ElementsMatch(t, []oid.Address{object.AddressOf(over), object.AddressOf(redundant)}, res.OverReplicated)
+	require.Equal(t, []oid.Address{object.AddressOf(misplaced)}, res.Misplaced)
+
+	t.Run("empty container", func(t *testing.T) {
+		res, err := e.CheckReplication(cidtest.ID(), checkPolicy)
+		require.NoError(t, err)
+		require.Equal(t, ReplicationReport{}, res)
+	})
+
+	t.Run("closed engine", func(t *testing.T) {
+		require.NoError(t, e.Close())
+
+		_, err := e.CheckReplication(cnr, checkPolicy)
+		require.ErrorIs(t, err, errClosed)
+	})
+}
+
+type testReplicationPolicy func(oid.Address) (uint32, error)
+
+func (f testReplicationPolicy) LocalReplicas(addr oid.Address) (uint32, error) {
+	return f(addr)
+}
+
+// removeBlob removes the file of the object from the FSTree under the root.
+func removeBlob(t *testing.T, root string, addr oid.Address) {
+	name := addr.Object().EncodeToString() + "." + addr.Container().EncodeToString()
+
+	var removed bool
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		// FSTree directories are named by the address prefix
+		if len(d.Name()) > len(addr.Container().EncodeToString())+1 && strings.HasSuffix(name, d.Name()) {
+			removed = true
+			return os.Remove(path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, removed)
+}
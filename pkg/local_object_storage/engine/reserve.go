@@ -0,0 +1,188 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrNotEnoughSpace is returned when there is not enough free space
+// in the storage to make a reservation.
+var ErrNotEnoughSpace = errors.New("not enough free space")
+
+// defaultReservationTimeout is the time after which the unused
+// reservation is released.
+const defaultReservationTimeout = 10 * time.Minute
+
+// ReservationID is an identifier of the capacity reservation.
+type ReservationID uint64
+
+type reservation struct {
+	size    uint64
+	expires time.Time
+}
+
+// reservations tracks the storage capacity reserved for the future uploads.
+type reservations struct {
+	mtx sync.Mutex
+
+	last ReservationID
+	// total is the sum of all reservation sizes.
+	total uint64
+	m     map[ReservationID]*reservation
+}
+
+// dropExpired releases the expired reservations. Must be called under the lock.
+func (r *reservations) dropExpired(now time.Time) {
+	for id, rv := range r.m {
+		if now.After(rv.expires) {
+			r.total -= rv.size
+			delete(r.m, id)
+		}
+	}
+}
+
+// Reserve checks that the storage has enough free space to store the
+// specified amount of bytes and reserves it. Reserved capacity is not
+// available for other reservations until it is consumed by Put with the
+// returned reservation ID, released explicitly or expired.
+//
+// Returns ErrNotEnoughSpace if the total free space minus existing
+// reservations is less than requested.
+func (e *StorageEngine) Reserve(size uint64) (ReservationID, error) {
+	free := e.freeCapacity()
+
+	e.reserved.mtx.Lock()
+	defer e.reserved.mtx.Unlock()
+
+	now := time.Now()
+	e.reserved.dropExpired(now)
+
+	if free < e.reserved.total || free-e.reserved.total < size {
+		return 0, ErrNotEnoughSpace
+	}
+
+	if e.reserved.m == nil {
+		e.reserved.m = make(map[ReservationID]*reservation)
+	}
+
+	e.reserved.last++
+	e.reserved.total += size
+	e.reserved.m[e.reserved.last] = &reservation{
+		size:    size,
+		expires: now.Add(e.reservationTimeout),
+	}
+
+	return e.reserved.last, nil
+}
+
+// Release releases the reservation. Does nothing if the reservation
+// is already released or expired.
+func (e *StorageEngine) Release(id ReservationID) {
+	e.reserved.mtx.Lock()
+	defer e.reserved.mtx.Unlock()
+
+	if rv, ok := e.reserved.m[id]; ok {
+		e.reserved.total -= rv.size
+		delete(e.reserved.m, id)
+	}
+}
+
+// AvailableCapacity returns the total free space of the storage
+// excluding the reserved capacity.
+func (e *StorageEngine) AvailableCapacity() uint64 {
+	free := e.freeCapacity()
+
+	e.reserved.mtx.Lock()
+	defer e.reserved.mtx.Unlock()
+
+	e.reserved.dropExpired(time.Now())
+
+	if free < e.reserved.total {
+		return 0
+	}
+	return free - e.reserved.total
+}
+
+// consumeReservation decreases the reservation by the stored object size.
+// The reservation is released when it is fully consumed.
+func (e *StorageEngine) consumeReservation(id ReservationID, size uint64) {
+	e.reserved.mtx.Lock()
+	defer e.reserved.mtx.Unlock()
+
+	rv, ok := e.reserved.m[id]
+	if !ok {
+		return
+	}
+
+	if size >= rv.size {
+		e.reserved.total -= rv.size
+		delete(e.reserved.m, id)
+		return
+	}
+
+	rv.size -= size
+	e.reserved.total -= size
+}
+
+// checkReservations returns ErrNotEnoughSpace if the put of the object of the
+// specified size would consume the capacity reserved for the other uploads.
+// The capacity of the reservation the object is put with (if any) is available.
+func (e *StorageEngine) checkReservations(id *ReservationID, size uint64) error {
+	e.reserved.mtx.Lock()
+	e.reserved.dropExpired(time.Now())
+
+	other := e.reserved.total
+	if id != nil {
+		if rv, ok := e.reserved.m[*id]; ok {
+			other -= rv.size
+		}
+	}
+	e.reserved.mtx.Unlock()
+
+	if other == 0 {
+		return nil
+	}
+
+	free := e.freeCapacity()
+	if free < other || free-other < size {
+		return ErrNotEnoughSpace
+	}
+
+	return nil
+}
+
+// freeCapacity returns the total free space of the writable shards. The space
+// of the device storing several shards is counted once.
+func (e *StorageEngine) freeCapacity() uint64 {
+	var (
+		free    uint64
+		devices = make(map[uint64]struct{})
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		if sh.GetMode().ReadOnly() {
+			return false
+		}
+
+		ds, err := e.shardDiskSpace(sh.Shard)
+		if err != nil {
+			e.log.Warn("could not get free space of the shard",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.String("error", err.Error()))
+			return false
+		}
+
+		if _, ok := devices[ds.Device]; ok {
+			return false
+		}
+
+		devices[ds.Device] = struct{}{}
+		free += ds.Free
+		return false
+	})
+
+	return free
+}
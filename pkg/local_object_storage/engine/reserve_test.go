@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_Reserve(t *testing.T) {
+	const shardCapacity = 1000
+
+	newEngine := func(t *testing.T) *StorageEngine {
+		s1 := testNewShard(t, 1)
+		s2 := testNewShard(t, 2)
+
+		e := testNewEngineWithShards(s1, s2)
+		e.shardDiskSpace = func(sh *shard.Shard) (shard.DiskSpace, error) {
+			ds := shard.DiskSpace{Device: 1, Free: shardCapacity}
+			if sh == s2 {
+				ds.Device = 2
+			}
+			return ds, nil
+		}
+		t.Cleanup(func() {
+			_ = e.Close()
+			_ = os.RemoveAll(t.Name())
+		})
+		return e
+	}
+
+	t.Run("near capacity", func(t *testing.T) {
+		e := newEngine(t)
+		require.Equal(t, uint64(2*shardCapacity), e.AvailableCapacity())
+
+		id, err := e.Reserve(2*shardCapacity - 10)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), e.AvailableCapacity())
+
+		_, err = e.Reserve(11)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+
+		_, err = e.Reserve(10)
+		require.NoError(t, err)
+
+		_, err = e.Reserve(1)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+
+		e.Release(id)
+		require.Equal(t, uint64(2*shardCapacity-10), e.AvailableCapacity())
+
+		_, err = e.Reserve(shardCapacity)
+		require.NoError(t, err)
+	})
+
+	t.Run("consumed by put", func(t *testing.T) {
+		e := newEngine(t)
+
+		obj := generateObjectWithCID(t, cidtest.ID())
+		size := obj.PayloadSize()
+
+		id, err := e.Reserve(2 * size)
+		require.NoError(t, err)
+		require.Equal(t, 2*shardCapacity-2*size, e.AvailableCapacity())
+
+		var prm PutPrm
+		prm.WithObject(obj)
+		prm.WithReservation(id)
+
+		_, err = e.Put(prm)
+		require.NoError(t, err)
+		require.Equal(t, 2*shardCapacity-size, e.AvailableCapacity())
+
+		prm.WithObject(generateObjectWithCID(t, cidtest.ID()))
+
+		_, err = e.Put(prm)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2*shardCapacity), e.AvailableCapacity())
+	})
+
+	t.Run("put honours other reservations", func(t *testing.T) {
+		e := newEngine(t)
+
+		obj := generateObjectWithCID(t, cidtest.ID())
+		obj.SetPayloadSize(uint64(len(obj.Payload())))
+		size := obj.PayloadSize()
+
+		_, err := e.Reserve(2*shardCapacity - size)
+		require.NoError(t, err)
+
+		id, err := e.Reserve(size)
+		require.NoError(t, err)
+
+		var prm PutPrm
+		prm.WithObject(obj)
+
+		_, err = e.Put(prm)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+
+		// the own reservation is available
+		prm.WithReservation(id)
+
+		_, err = e.Put(prm)
+		require.NoError(t, err)
+	})
+
+	t.Run("shared device", func(t *testing.T) {
+		e := newEngine(t)
+		e.shardDiskSpace = func(*shard.Shard) (shard.DiskSpace, error) {
+			return shard.DiskSpace{Device: 1, Free: shardCapacity}, nil
+		}
+
+		require.Equal(t, uint64(shardCapacity), e.AvailableCapacity())
+
+		_, err := e.Reserve(shardCapacity + 1)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+	})
+
+	t.Run("expiration", func(t *testing.T) {
+		e := newEngine(t)
+		e.reservationTimeout = 10 * time.Millisecond
+
+		_, err := e.Reserve(2 * shardCapacity)
+		require.NoError(t, err)
+
+		_, err = e.Reserve(1)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+
+		require.Eventually(t, func() bool {
+			_, err := e.Reserve(1)
+			return err == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("read-only shards", func(t *testing.T) {
+		e := newEngine(t)
+
+		for _, sh := range e.unsortedShards() {
+			require.NoError(t, e.SetShardMode(sh.ID(), mode.ReadOnly, false))
+		}
+
+		require.Zero(t, e.AvailableCapacity())
+
+		_, err := e.Reserve(1)
+		require.ErrorIs(t, err, ErrNotEnoughSpace)
+	})
+}
+
+func TestShardFreeSpace(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	sh := testNewShard(t, 1)
+	defer sh.Close()
+
+	free, err := sh.FreeSpace()
+	require.NoError(t, err)
+	require.NotZero(t, free)
+}
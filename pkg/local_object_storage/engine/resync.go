@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ResyncMetabasePrm groups the parameters of ResyncMetabase operation.
+type ResyncMetabasePrm struct {
+	shardID     *shard.ID
+	maintenance MaintenancePrm
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *ResyncMetabasePrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// SetMaintenance sets the error handling parameters. Only the objects
+// which can't be read from the blobstor or decoded are skipped in
+// IgnoreErrors mode.
+func (p *ResyncMetabasePrm) SetMaintenance(prm MaintenancePrm) {
+	p.maintenance = prm
+}
+
+// ResyncMetabaseRes groups the resulting values of ResyncMetabase operation.
+type ResyncMetabaseRes struct {
+	MaintenanceRes
+}
+
+// ResyncMetabase refills the metabase of a single shard from its blobstor,
+// see shard.Shard.ResyncMetabase.
+//
+// Returns shard.ErrReadOnlyMode or shard.ErrDegradedMode if the shard is not
+// in the read-write mode.
+func (e *StorageEngine) ResyncMetabase(p ResyncMetabasePrm) (ResyncMetabaseRes, error) {
+	sh, err := e.shardByID(p.shardID)
+	if err != nil {
+		return ResyncMetabaseRes{}, err
+	}
+
+	var res ResyncMetabaseRes
+
+	var prm shard.ResyncMetabasePrm
+	prm.SetIgnoreErrors(p.maintenance.IgnoreErrors)
+	prm.SetErrorHandler(func(addr oid.Address, err error) error {
+		return res.handleError(p.maintenance, fmt.Errorf("could not resync object %s: %w", addr, err))
+	})
+
+	err = sh.ResyncMetabase(prm)
+	return res, err
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResyncMetabaseIgnoreErrors(t *testing.T) {
+	e, ids, objects := newEngineEvacuate(t, 1, 3)
+
+	// corrupt two objects of the shard
+	var corrupted int
+	root := e.shards[ids[0].String()].DumpInfo().BlobStorInfo.RootPath
+	require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || corrupted == 2 {
+			return err
+		}
+
+		corrupted++
+		return os.WriteFile(path, []byte("not an object"), 0600)
+	}))
+	require.Equal(t, 2, corrupted)
+
+	var prm ResyncMetabasePrm
+	prm.SetShardID(ids[0])
+
+	_, err := e.ResyncMetabase(prm)
+	require.Error(t, err)
+
+	prm.SetMaintenance(MaintenancePrm{IgnoreErrors: true, ErrorLimit: 1})
+
+	res, err := e.ResyncMetabase(prm)
+	require.ErrorIs(t, err, ErrErrorLimitExceeded)
+	require.Equal(t, 2, res.ErrorCount())
+	require.Len(t, res.Errors(), 2)
+
+	prm.SetMaintenance(MaintenancePrm{IgnoreErrors: true, ErrorLimit: 2})
+
+	res, err = e.ResyncMetabase(prm)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.ErrorCount())
+
+	var found int
+	for i := range objects {
+		var getPrm GetPrm
+		getPrm.WithAddress(objectCore.AddressOf(objects[i]))
+
+		if _, err := e.Get(context.Background(), getPrm); err == nil {
+			found++
+		}
+	}
+	require.Equal(t, 1, found)
+}
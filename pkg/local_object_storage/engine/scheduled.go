@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"errors"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ScheduleDeletionPrm groups the parameters of ScheduleDeletion operation.
+type ScheduleDeletionPrm struct {
+	addrs []oid.Address
+
+	epoch uint64
+}
+
+// ScheduleDeletionRes groups the resulting values of ScheduleDeletion operation.
+type ScheduleDeletionRes struct{}
+
+// WithAddresses sets the addresses of the objects to be deleted.
+func (p *ScheduleDeletionPrm) WithAddresses(addrs ...oid.Address) {
+	p.addrs = addrs
+}
+
+// WithEpoch sets the epoch the objects are deleted at.
+func (p *ScheduleDeletionPrm) WithEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+// ScheduleDeletion schedules the objects to be marked as garbage when the
+// specified epoch comes, after that they are removed by GC as usual. The objects
+// are fully available until then. Deletion of the locked objects takes effect
+// after they are unlocked.
+//
+// Returns an error of type apistatus.ObjectNotFound if some object is not
+// stored physically, apistatus.ObjectAlreadyRemoved if it has already been
+// removed. Returns meta.ErrLockObjectRemoval if some object is a lock object.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) ScheduleDeletion(prm ScheduleDeletionPrm) (res ScheduleDeletionRes, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.scheduleDeletion(prm)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) scheduleDeletion(prm ScheduleDeletionPrm) (ScheduleDeletionRes, error) {
+	var shPrm shard.ScheduleDeletionPrm
+	shPrm.SetEpoch(prm.epoch)
+
+	for i := range prm.addrs {
+		shPrm.SetAddresses(prm.addrs[i])
+
+		var (
+			scheduled bool
+			outErr    error
+			lastErr   error
+		)
+
+		// object may be stored in several shards, schedule its deletion in all of them
+		e.iterateOverSortedShards(prm.addrs[i], func(_ int, sh hashedShard) (stop bool) {
+			_, err := sh.ScheduleDeletion(shPrm)
+			switch {
+			case err == nil:
+				scheduled = true
+			case shard.IsErrNotFound(err) || errors.As(err, new(*objectSDK.SplitInfoError)):
+			case shard.IsErrRemoved(err) || errors.Is(err, meta.ErrLockObjectRemoval):
+				outErr = err
+				return true
+			case errors.Is(err, shard.ErrReadOnlyMode) || errors.Is(err, shard.ErrDegradedMode):
+				lastErr = err
+			default:
+				lastErr = err
+
+				e.reportShardError(sh, "could not schedule object deletion", err,
+					zap.Stringer("address", prm.addrs[i]))
+			}
+
+			return false
+		})
+
+		if outErr != nil {
+			return ScheduleDeletionRes{}, outErr
+		}
+
+		if !scheduled {
+			if lastErr != nil {
+				return ScheduleDeletionRes{}, lastErr
+			}
+
+			var errNotFound apistatus.ObjectNotFound
+
+			return ScheduleDeletionRes{}, errNotFound
+		}
+	}
+
+	return ScheduleDeletionRes{}, nil
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleDeletion(t *testing.T) {
+	e := testEngineFromShardOpts(t, 2, []shard.Option{
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+
+			return pool
+		}),
+	})
+
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	const deleteAt = 10
+
+	cnr := cidtest.ID()
+
+	obj := generateObjectWithCID(t, cnr)
+	require.NoError(t, Put(e, obj))
+
+	locked := generateObjectWithCID(t, cnr)
+	require.NoError(t, Put(e, locked))
+
+	lock := generateObjectWithCID(t, cnr)
+	lock.SetType(object.TypeLock)
+	require.NoError(t, Put(e, lock))
+
+	lockedID, _ := locked.ID()
+	lockID, _ := lock.ID()
+	require.NoError(t, e.Lock(cnr, lockID, []oid.ID{lockedID}))
+
+	addr := objectcore.AddressOf(obj)
+	lockedAddr := objectcore.AddressOf(locked)
+
+	var prm ScheduleDeletionPrm
+	prm.WithEpoch(deleteAt)
+
+	t.Run("missing object", func(t *testing.T) {
+		prm.WithAddresses(oidtest.Address())
+
+		_, err := e.ScheduleDeletion(prm)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	t.Run("lock object", func(t *testing.T) {
+		prm.WithAddresses(objectcore.AddressOf(lock))
+
+		_, err := e.ScheduleDeletion(prm)
+		require.ErrorIs(t, err, meta.ErrLockObjectRemoval)
+	})
+
+	prm.WithAddresses(addr, lockedAddr)
+
+	_, err := e.ScheduleDeletion(prm)
+	require.NoError(t, err)
+
+	for epoch := uint64(1); epoch < deleteAt; epoch++ {
+		e.HandleNewEpoch(epoch)
+	}
+
+	// delay for GC
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = Get(e, addr)
+	require.NoError(t, err, "object must be available before the scheduled epoch")
+
+	e.HandleNewEpoch(deleteAt)
+
+	require.Eventually(t, func() bool {
+		_, err := Get(e, addr)
+		return err != nil
+	}, 3*time.Second, 10*time.Millisecond)
+
+	_, err = Get(e, lockedAddr)
+	require.NoError(t, err, "locked object must not be deleted")
+
+	// deletion takes effect after the object is unlocked
+	var inhumePrm InhumePrm
+	inhumePrm.MarkAsGarbage(objectcore.AddressOf(lock))
+	inhumePrm.WithForceRemoval()
+
+	_, err = e.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	e.HandleNewEpoch(deleteAt + 1)
+
+	require.Eventually(t, func() bool {
+		_, err := Get(e, lockedAddr)
+		return err != nil
+	}, 3*time.Second, 10*time.Millisecond)
+}
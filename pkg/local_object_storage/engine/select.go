@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"context"
+	"sort"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -11,11 +14,17 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	orderAttr, orderPrefix string
+	ordered                bool
+
+	identity AccessIdentity
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	values   []string
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -28,31 +37,62 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// WithPrefixOrder is a Select option to select only the objects with
+// the attribute value starting with the prefix and to sort them by
+// closeness of the value to the prefix: the exact match goes first,
+// others follow in lexicographic order.
+func (p *SelectPrm) WithPrefixOrder(attr, prefix string) {
+	p.orderAttr, p.orderPrefix, p.ordered = attr, prefix, true
+}
+
+// WithIdentity is a Select option to set the requester identity
+// recorded in the access log.
+func (p *SelectPrm) WithIdentity(id AccessIdentity) {
+	p.identity = id
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Values returns the attribute values of the selected objects in the order
+// of AddressList. Set only if WithPrefixOrder option was used.
+func (r SelectRes) Values() []string {
+	return r.values
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Select(prm SelectPrm) (res SelectRes, err error) {
+// Returns the context error if the context is done before all the shards are processed.
+func (e *StorageEngine) Select(ctx context.Context, prm SelectPrm) (res SelectRes, err error) {
 	err = e.execIfNotBlocked(func() error {
-		res, err = e._select(prm)
+		res, err = e._select(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
+func (e *StorageEngine) _select(ctx context.Context, prm SelectPrm) (SelectRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddSearchDuration)()
 	}
 
+	if e.accessLog != nil {
+		e.logAccess(AccessEntry{
+			Operation: AccessSelect,
+			Identity:  prm.identity,
+			Container: prm.cnr,
+			Filters:   prm.filters,
+		})
+	}
+
 	addrList := make([]oid.Address, 0)
+	values := make([]string, 0)
 	uniqueMap := make(map[string]struct{})
 
 	var outError error
@@ -60,26 +100,53 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	if prm.ordered {
+		shPrm.SetPrefixOrder(prm.orderAttr, prm.orderPrefix)
+	}
+
+	var ctxErr error
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		if ctxErr = ctxError(ctx, "select"); ctxErr != nil {
+			return true
+		}
+
 		res, err := sh.Select(shPrm)
 		if err != nil {
 			e.reportShardError(sh, "could not select objects from shard", err)
 			return false
 		}
 
-		for _, addr := range res.AddressList() { // save only unique values
+		shValues := res.Values()
+		for i, addr := range res.AddressList() { // save only unique values
 			if _, ok := uniqueMap[addr.EncodeToString()]; !ok {
 				uniqueMap[addr.EncodeToString()] = struct{}{}
 				addrList = append(addrList, addr)
+				if prm.ordered {
+					values = append(values, shValues[i])
+				}
 			}
 		}
 
 		return false
 	})
 
+	if ctxErr != nil {
+		return SelectRes{}, ctxErr
+	}
+
+	if !prm.ordered {
+		return SelectRes{
+			addrList: addrList,
+		}, outError
+	}
+
+	// every shard result is sorted, but they are to be merged
+	sort.Sort(byValue{addrs: addrList, values: values})
+
 	return SelectRes{
 		addrList: addrList,
+		values:   values,
 	}, outError
 }
 
@@ -138,7 +205,7 @@ func Select(storage *StorageEngine, cnr cid.ID, fs object.SearchFilters) ([]oid.
 	selectPrm.WithContainerID(cnr)
 	selectPrm.WithFilters(fs)
 
-	res, err := storage.Select(selectPrm)
+	res, err := storage.Select(context.Background(), selectPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -156,3 +223,23 @@ func List(storage *StorageEngine, limit uint64) ([]oid.Address, error) {
 
 	return res.AddressList(), nil
 }
+
+// byValue sorts addresses by the corresponding values and then by themselves.
+type byValue struct {
+	addrs  []oid.Address
+	values []string
+}
+
+func (x byValue) Len() int { return len(x.addrs) }
+
+func (x byValue) Less(i, j int) bool {
+	if x.values[i] != x.values[j] {
+		return x.values[i] < x.values[j]
+	}
+	return x.addrs[i].EncodeToString() < x.addrs[j].EncodeToString()
+}
+
+func (x byValue) Swap(i, j int) {
+	x.addrs[i], x.addrs[j] = x.addrs[j], x.addrs[i]
+	x.values[i], x.values[j] = x.values[j], x.values[i]
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_SelectPrefixOrder(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+
+	e := testNewEngineWithShards(s1, s2)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+
+	// Values are distributed between shards, so the shard results are merged.
+	values := []string{"docs/b", "docs", "doc", "docs/a", "img"}
+	addrs := make(map[string]oid.Address)
+	for i, v := range values {
+		obj := generateObjectWithCID(t, cnr)
+		addAttribute(obj, "path", v)
+		addrs[v] = objectCore.AddressOf(obj)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		sh := s1
+		if i%2 == 1 {
+			sh = s2
+		}
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	var prm SelectPrm
+	prm.WithContainerID(cnr)
+	prm.WithPrefixOrder("path", "docs")
+
+	res, err := e.Select(context.Background(), prm)
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs", "docs/a", "docs/b"}, res.Values())
+	require.Equal(t, []oid.Address{addrs["docs"], addrs["docs/a"], addrs["docs/b"]}, res.AddressList())
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/hrw"
@@ -21,6 +22,9 @@ type hashedShard shardWrapper
 type metricsWithID struct {
 	id string
 	mw MetricRegister
+
+	// modeChanged is called after the shard mode is changed.
+	modeChanged func()
 }
 
 func (m metricsWithID) SetObjectCounter(objectType string, v uint64) {
@@ -39,6 +43,36 @@ func (m metricsWithID) DecObjectCounter(objectType string) {
 	m.mw.AddToObjectCounter(m.id, objectType, -1)
 }
 
+func (m metricsWithID) IncReadCacheCounter(hit bool) {
+	m.mw.IncReadCacheCounter(m.id, hit)
+}
+
+func (m metricsWithID) IncRangeReadCounter(full bool) {
+	m.mw.IncRangeReadCounter(m.id, full)
+}
+
+func (m metricsWithID) AddMetabaseTxWait(priority string, d time.Duration) {
+	m.mw.AddMetabaseTxWaitDuration(m.id, priority, d)
+}
+
+func (m metricsWithID) AddWriteCacheFlushedObject(size uint64) {
+	m.mw.AddWriteCacheFlushedObject(m.id, size)
+}
+
+func (m metricsWithID) IncWriteCacheFlushErrors() {
+	m.mw.IncWriteCacheFlushErrors(m.id)
+}
+
+func (m metricsWithID) SetWriteCacheBacklog(v uint64) {
+	m.mw.SetWriteCacheBacklog(m.id, v)
+}
+
+func (m metricsWithID) SetMode(mode.Mode) {
+	if m.modeChanged != nil {
+		m.modeChanged()
+	}
+}
+
 // AddShard adds a new shard to the storage engine.
 //
 // Returns any error encountered that did not allow adding a shard.
@@ -63,31 +97,55 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 		return nil, fmt.Errorf("could not generate shard ID: %w", err)
 	}
 
+	return e.createShardWithID(id, opts)
+}
+
+// createShardWithID creates a shard with the default identifier id, the
+// identifier stored in the metabase takes precedence.
+func (e *StorageEngine) createShardWithID(id *shard.ID, opts []shard.Option) (*shard.Shard, error) {
 	e.mtx.RLock()
 
 	if e.metrics != nil {
 		opts = append(opts, shard.WithMetricsWriter(
 			metricsWithID{
-				id: id.String(),
-				mw: e.metrics,
+				id:          id.String(),
+				mw:          e.metrics,
+				modeChanged: e.requestStateMetrics,
 			},
 		))
 	}
 
 	e.mtx.RUnlock()
 
+	if e.readCacheBudget != nil {
+		opts = append(opts, shard.WithReadCacheBudget(e.readCacheBudget))
+	}
+
+	if e.readOnlyAll {
+		opts = append(opts, shard.WithForcedReadOnly(true))
+	}
+
+	if e.shardPanicRecovery {
+		opts = append(opts, shard.WithStoragePanicCallback(func(err error) {
+			// handle the panic asynchronously to not block the failed operation
+			go e.handleShardPanic(id.String(), err)
+		}))
+	}
+
 	sh := shard.New(append(opts,
 		shard.WithID(id),
 		shard.WithExpiredTombstonesCallback(e.processExpiredTombstones),
 		shard.WithExpiredLocksCallback(e.processExpiredLocks),
 		shard.WithDeletedLockCallback(e.processDeletedLocks),
+		shard.WithObjectFlushedCallback(e.processFlushedObject),
+		shard.WithModeChangedCallback(e.processModeChange),
 	)...)
 
 	if err := sh.UpdateID(); err != nil {
 		return nil, fmt.Errorf("could not update shard ID: %w", err)
 	}
 
-	return sh, err
+	return sh, nil
 }
 
 func (e *StorageEngine) addShard(sh *shard.Shard) error {
@@ -106,6 +164,7 @@ func (e *StorageEngine) addShard(sh *shard.Shard) error {
 
 	e.shards[strID] = shardWrapper{
 		errorCount: atomic.NewUint32(0),
+		lastErrors: newErrorList(),
 		Shard:      sh,
 	}
 
@@ -114,6 +173,34 @@ func (e *StorageEngine) addShard(sh *shard.Shard) error {
 	return nil
 }
 
+// handleShardPanic reports the recovered panic of the shard storage
+// and moves the shard to the degraded mode.
+func (e *StorageEngine) handleShardPanic(id string, err error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	e.reportShardError(hashedShard(sh), "shard storage panic", err)
+
+	if sh.GetMode().NoMetabase() {
+		return
+	}
+
+	err = sh.SetMode(mode.DegradedReadOnly)
+	if err != nil {
+		e.log.Error("failed to move shard in degraded mode after storage panic",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+	} else {
+		e.log.Info("shard is moved in degraded mode due to storage panic",
+			zap.Stringer("shard_id", sh.ID()))
+	}
+}
+
 // removeShards removes specified shards. Skips non-existent shards.
 // Logs errors about shards that it could not Close after the removal.
 func (e *StorageEngine) removeShards(ids ...string) {
@@ -224,6 +311,8 @@ func (e *StorageEngine) iterateOverUnsortedShards(handler func(hashedShard) (sto
 // SetShardMode sets mode of the shard with provided identifier.
 //
 // Returns an error if shard mode was not set, or shard was not found in storage engine.
+// Returns shard.ErrForcedReadOnly if the storage engine keeps all the shards
+// read-only (see WithReadOnlyAll) and m allows writes.
 func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounter bool) error {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
@@ -232,6 +321,7 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounte
 		if id.String() == shID {
 			if resetErrorCounter {
 				sh.errorCount.Store(0)
+				sh.lastErrors.reset()
 			}
 			return sh.SetMode(m)
 		}
@@ -240,6 +330,25 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounte
 	return errShardNotFound
 }
 
+// ResetShardErrors sets error counter of the shard with provided identifier
+// to zero and drops all the errors recorded for it.
+//
+// Returns an error if shard was not found in storage engine.
+func (e *StorageEngine) ResetShardErrors(id *shard.ID) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return errShardNotFound
+	}
+
+	sh.errorCount.Store(0)
+	sh.lastErrors.reset()
+
+	return nil
+}
+
 // HandleNewEpoch notifies every shard about NewEpoch event.
 func (e *StorageEngine) HandleNewEpoch(epoch uint64) {
 	ev := shard.EventNewEpoch(epoch)
@@ -257,3 +366,18 @@ func (s hashedShard) Hash() uint64 {
 		[]byte(s.Shard.ID().String()),
 	)
 }
+
+// shardByID returns the shard with the given identifier.
+//
+// Returns errShardNotFound if there is no such shard.
+func (e *StorageEngine) shardByID(id *shard.ID) (shardWrapper, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return shardWrapper{}, errShardNotFound
+	}
+
+	return sh, nil
+}
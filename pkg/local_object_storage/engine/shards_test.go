@@ -1,9 +1,19 @@
 package engine
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +54,61 @@ func TestRemoveShard(t *testing.T) {
 		require.True(t, ok != removed)
 	}
 }
+
+func TestStorageEngine_ReadOnlyAll(t *testing.T) {
+	const numOfShards = 2
+
+	dir := t.TempDir()
+
+	newEngine := func(opts ...Option) *StorageEngine {
+		e := New(opts...)
+		for i := 0; i < numOfShards; i++ {
+			_, err := e.AddShard(
+				shard.WithBlobStorOptions(
+					blobstor.WithStorages(
+						newStorages(filepath.Join(dir, fmt.Sprintf("blobstor%d", i)), 1<<20))),
+				shard.WithMetaBaseOptions(
+					meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+					meta.WithPermissions(0700),
+					meta.WithEpochState(epochState{})),
+				shard.WithPiloramaOptions(
+					pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("pilorama%d", i)))),
+			)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, e.Open())
+		require.NoError(t, e.Init())
+
+		return e
+	}
+
+	e := newEngine()
+	obj := generateObjectWithCID(t, cidtest.ID())
+	require.NoError(t, Put(e, obj))
+	require.NoError(t, e.Close())
+
+	e = newEngine(WithReadOnlyAll(true))
+	t.Cleanup(func() { _ = e.Close() })
+
+	_, err := Head(e, objectCore.AddressOf(obj))
+	require.NoError(t, err)
+	require.Error(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+
+	res := e.HealthSummary()
+	require.Len(t, res.Shards, numOfShards)
+
+	for _, sh := range res.Shards {
+		require.Equal(t, mode.ReadOnly, sh.Mode)
+		require.True(t, sh.ForcedReadOnly)
+		require.Equal(t, HealthDegraded, sh.Status)
+
+		err := e.SetShardMode(sh.ID, mode.ReadWrite, false)
+		require.True(t, errors.Is(err, shard.ErrForcedReadOnly), err)
+		require.NoError(t, e.SetShardMode(sh.ID, mode.DegradedReadOnly, false))
+	}
+
+	for _, info := range e.DumpInfo().Shards {
+		require.True(t, info.ForcedReadOnly)
+	}
+}
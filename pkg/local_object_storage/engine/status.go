@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ShardObjectStatus represents the status of the object in a single shard.
+type ShardObjectStatus struct {
+	// ID is the shard identifier.
+	ID *shard.ID
+	// Status is the status of the object in the shard.
+	Status shard.ObjectStatus
+}
+
+// ObjectStatus returns the status of the object in every shard that knows
+// anything about it. Shards which fail to report the status are logged and skipped.
+func (e *StorageEngine) ObjectStatus(addr oid.Address) ([]ShardObjectStatus, error) {
+	var res []ShardObjectStatus
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		st, err := sh.ObjectStatus(addr)
+		if err != nil {
+			e.log.Warn("could not get object status",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Stringer("address", addr),
+				zap.String("error", err.Error()))
+			return false
+		}
+
+		if st.Found() {
+			res = append(res, ShardObjectStatus{
+				ID:     sh.ID(),
+				Status: st,
+			})
+		}
+		return false
+	})
+
+	return res, nil
+}
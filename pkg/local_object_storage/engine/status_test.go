@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ObjectStatus(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+
+	e := testNewEngineWithShards(s1, s2)
+	defer e.Close()
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := objectCore.AddressOf(obj)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := s2.Put(putPrm)
+	require.NoError(t, err)
+
+	res, err := e.ObjectStatus(addr)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, s2.ID(), res[0].ID)
+	require.True(t, res[0].Status.Metabase.Found)
+	require.NotEmpty(t, res[0].Status.BlobStorage)
+
+	res, err = e.ObjectStatus(oidtest.Address())
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
@@ -50,7 +51,7 @@ func benchmarkTreeVsSearch(b *testing.B, objCount int) {
 		prm.WithFilters(fs)
 
 		for i := 0; i < b.N; i++ {
-			res, err := e.Select(prm)
+			res, err := e.Select(context.Background(), prm)
 			if err != nil {
 				b.Fatal(err)
 			}
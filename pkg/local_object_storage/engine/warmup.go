@@ -0,0 +1,136 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// WarmUp groups the parameters of the warm-up of the shard storages.
+type WarmUp struct {
+	// Enabled enables the warm-up.
+	Enabled bool
+
+	// Buckets are the names of the metabase buckets to read
+	// (see meta.WarmUpPrm.SetBuckets). Empty list means the default ones.
+	Buckets []string
+
+	// ByteLimit is the maximum number of bytes read from each shard.
+	// Zero means no limit.
+	ByteLimit uint64
+
+	// TimeLimit is the maximum duration of the warm-up.
+	// Non-positive value means no limit.
+	TimeLimit time.Duration
+
+	// WriteCache enables the warm-up of the write-cache databases.
+	WriteCache bool
+}
+
+// warmUpResult describes the warm-up of the single shard.
+type warmUpResult struct {
+	id *shard.ID
+
+	res shard.WarmUpRes
+
+	err error
+}
+
+// RunWarmUp sequentially reads the metabases (and, optionally, the write-caches)
+// of all the shards in parallel according to the parameters set via WithWarmUp,
+// so that the first requests after the start do not wait for the disk. Blocks
+// until the warm-up is done, the time limit is exceeded, the context is done or
+// the engine is closed. Returns immediately if the warm-up is disabled.
+//
+// Must be called after Init.
+func (e *StorageEngine) RunWarmUp(ctx context.Context) {
+	if !e.warmUp.Enabled {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if e.warmUp.TimeLimit > 0 {
+		ctx, cancel = context.WithTimeout(ctx, e.warmUp.TimeLimit)
+		defer cancel()
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-e.closeCh:
+			cancel()
+		}
+	}()
+
+	_ = e.execIfNotBlocked(func() error {
+		e.warmUpShards(ctx)
+		return nil
+	})
+}
+
+// warmUpShards warms up all the shards in parallel and logs the results.
+func (e *StorageEngine) warmUpShards(ctx context.Context) []warmUpResult {
+	var prm shard.WarmUpPrm
+	prm.SetBuckets(e.warmUp.Buckets...)
+	prm.SetByteLimit(e.warmUp.ByteLimit)
+	prm.SetWriteCache(e.warmUp.WriteCache)
+
+	var (
+		start  = time.Now()
+		shards = e.unsortedShards()
+		res    = make([]warmUpResult, len(shards))
+		wg     sync.WaitGroup
+	)
+
+	e.log.Info("warming up shards",
+		zap.Int("shards", len(shards)),
+		zap.Uint64("byte limit", e.warmUp.ByteLimit),
+		zap.Stringer("time limit", e.warmUp.TimeLimit))
+
+	for i := range shards {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			sh := shards[i]
+			r := warmUpResult{id: sh.ID()}
+			shStart := time.Now()
+
+			r.res, r.err = sh.WarmUp(ctx, prm)
+
+			fields := []zap.Field{
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Uint64("metabase bytes", r.res.MetabaseBytes()),
+				zap.Uint64("write-cache bytes", r.res.WriteCacheBytes()),
+				zap.Stringer("duration", time.Since(shStart)),
+			}
+
+			if r.err != nil {
+				e.log.Warn("shard warm-up interrupted", append(fields, zap.Error(r.err))...)
+			} else {
+				e.log.Info("shard warmed up", fields...)
+			}
+
+			res[i] = r
+		}(i)
+	}
+
+	wg.Wait()
+
+	var total uint64
+	for i := range res {
+		total += res[i].res.MetabaseBytes() + res[i].res.WriteCacheBytes()
+	}
+
+	e.log.Info("shards warm-up finished",
+		zap.Uint64("bytes", total),
+		zap.Stringer("duration", time.Since(start)))
+
+	return res
+}
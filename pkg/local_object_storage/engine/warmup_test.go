@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_RunWarmUp(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	e := testNewEngineWithShardNum(t, 3)
+	defer e.Close()
+
+	cnr := cidtest.ID()
+	for i := 0; i < 30; i++ {
+		var prm PutPrm
+		prm.WithObject(generateObjectWithCID(t, cnr))
+
+		_, err := e.Put(prm)
+		require.NoError(t, err)
+	}
+
+	e.warmUp = WarmUp{
+		Enabled: true,
+		Buckets: []string{meta.WarmUpPrimary},
+	}
+
+	t.Run("all shards", func(t *testing.T) {
+		res := e.warmUpShards(context.Background())
+		require.Len(t, res, 3)
+
+		var total uint64
+		for i := range res {
+			require.NoError(t, res[i].err)
+			require.Zero(t, res[i].res.WriteCacheBytes())
+			total += res[i].res.MetabaseBytes()
+		}
+		require.NotZero(t, total)
+	})
+
+	t.Run("byte limit", func(t *testing.T) {
+		e.warmUp.ByteLimit = 1
+		defer func() { e.warmUp.ByteLimit = 0 }()
+
+		for _, r := range e.warmUpShards(context.Background()) {
+			require.NoError(t, r.err)
+			require.LessOrEqual(t, r.res.MetabaseBytes(), uint64(1024))
+		}
+	})
+
+	t.Run("degraded shard", func(t *testing.T) {
+		sh := e.unsortedShards()[0]
+		require.NoError(t, sh.SetMode(mode.DegradedReadOnly))
+		defer func() { require.NoError(t, sh.SetMode(mode.ReadWrite)) }()
+
+		for _, r := range e.warmUpShards(context.Background()) {
+			require.NoError(t, r.err)
+			if r.id.String() == sh.ID().String() {
+				require.Zero(t, r.res.MetabaseBytes())
+			}
+		}
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for _, r := range e.warmUpShards(ctx) {
+			require.ErrorIs(t, r.err, context.Canceled)
+		}
+	})
+
+	t.Run("closed engine", func(t *testing.T) {
+		require.NoError(t, e.Close())
+
+		done := make(chan struct{})
+		go func() {
+			e.RunWarmUp(context.Background())
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("warm-up is not aborted by the engine close")
+		}
+	})
+}
@@ -1,13 +1,19 @@
 package engine
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // FlushWriteCachePrm groups the parameters of FlushWriteCache operation.
 type FlushWriteCachePrm struct {
-	shardID      *shard.ID
-	ignoreErrors bool
+	shardID     *shard.ID
+	maintenance MaintenancePrm
+	force       bool
 }
 
 // SetShardID is an option to set shard ID.
@@ -17,26 +23,73 @@ func (p *FlushWriteCachePrm) SetShardID(id *shard.ID) {
 	p.shardID = id
 }
 
-// SetIgnoreErrors sets errors ignore flag..
-func (p *FlushWriteCachePrm) SetIgnoreErrors(ignore bool) {
-	p.ignoreErrors = ignore
+// SetMaintenance sets the error handling parameters. Only the objects
+// which can't be read from the write-cache are skipped in IgnoreErrors mode.
+func (p *FlushWriteCachePrm) SetMaintenance(prm MaintenancePrm) {
+	p.maintenance = prm
+}
+
+// SetForce sets the flag to flush the write-cache concurrently with the writes,
+// see shard.FlushWriteCachePrm.SetForce.
+func (p *FlushWriteCachePrm) SetForce(force bool) {
+	p.force = force
 }
 
 // FlushWriteCacheRes groups the resulting values of FlushWriteCache operation.
-type FlushWriteCacheRes struct{}
+type FlushWriteCacheRes struct {
+	MaintenanceRes
+}
 
 // FlushWriteCache flushes write-cache on a single shard.
+//
+// Returns shard.ErrReadOnlyMode or shard.ErrDegradedMode if the shard is not
+// in the read-write mode.
 func (e *StorageEngine) FlushWriteCache(p FlushWriteCachePrm) (FlushWriteCacheRes, error) {
-	e.mtx.RLock()
-	sh, ok := e.shards[p.shardID.String()]
-	e.mtx.RUnlock()
-
-	if !ok {
-		return FlushWriteCacheRes{}, errShardNotFound
+	sh, err := e.shardByID(p.shardID)
+	if err != nil {
+		return FlushWriteCacheRes{}, err
 	}
 
+	var res FlushWriteCacheRes
+
 	var prm shard.FlushWriteCachePrm
-	prm.SetIgnoreErrors(p.ignoreErrors)
+	prm.SetIgnoreErrors(p.maintenance.IgnoreErrors)
+	prm.SetForce(p.force)
+	prm.SetErrorHandler(func(addr oid.Address, err error) error {
+		return res.handleError(p.maintenance, fmt.Errorf("could not flush object %s: %w", addr, err))
+	})
+
+	err = sh.FlushWriteCache(prm)
+	return res, err
+}
+
+// DisableWriteCache detaches the write-cache from the shard with the provided
+// identifier after flushing it in timeout, the shard operates without the
+// write-cache until EnableWriteCache is called or the node is restarted.
+// The timeout must be positive.
+//
+// Returns context.DeadlineExceeded if the flush is not finished in timeout
+// and the context error if ctx is done. Returns shard.ErrReadOnlyMode or
+// shard.ErrDegradedMode if the shard is not in the read-write mode.
+func (e *StorageEngine) DisableWriteCache(ctx context.Context, id *shard.ID, timeout time.Duration) error {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return err
+	}
+
+	return sh.DisableWriteCache(ctx, timeout)
+}
+
+// EnableWriteCache attaches the write-cache detached by DisableWriteCache
+// back to the shard with the provided identifier.
+//
+// Returns shard.ErrReadOnlyMode or shard.ErrDegradedMode if the shard is not
+// in the read-write mode.
+func (e *StorageEngine) EnableWriteCache(id *shard.ID) error {
+	sh, err := e.shardByID(id)
+	if err != nil {
+		return err
+	}
 
-	return FlushWriteCacheRes{}, sh.FlushWriteCache(prm)
+	return sh.EnableWriteCache()
 }
@@ -0,0 +1,12 @@
+// Package teststorage provides in-memory storage components with the injectable
+// errors and latency and the storage engine built from them for the tests of
+// the local object storage packages.
+//
+// Storage is a map-backed blobstor sub-storage and WriteCache is a map-backed
+// write-cache. The metabase of the shard is the regular bbolt database since
+// the shard depends on its implementation, but on Linux its file is kept in
+// memory (see memfd_create(2)), the path in the temporary directory of the
+// test is used as a name only. On the other platforms the file is created in
+// the temporary directory and is not synced to the disk. The metabase
+// compaction and the trees are not supported by the shards of the engine.
+package teststorage
@@ -0,0 +1,150 @@
+package teststorage
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"go.etcd.io/bbolt"
+)
+
+// Engine is the storage engine with the shards built from the in-memory
+// blobstor sub-storages, write-caches and metabases.
+type Engine struct {
+	*engine.StorageEngine
+
+	// Shards contains the components of the engine shards
+	// in the order of their creation.
+	Shards []Shard
+}
+
+// Shard groups the components of the engine shard.
+type Shard struct {
+	// ID is the identifier of the shard in the engine.
+	ID *shard.ID
+
+	// Storage is the only sub-storage of the shard blobstor.
+	Storage *Storage
+
+	// WriteCache is the write-cache of the shard,
+	// nil if the write-cache is disabled.
+	WriteCache *WriteCache
+}
+
+// Option is an option of NewEngine.
+type Option func(*cfg)
+
+type cfg struct {
+	engineOpts []engine.Option
+	shardOpts  []shard.Option
+	writeCache bool
+	epochState meta.EpochState
+}
+
+// WithEngineOptions returns option to pass additional options to the engine.
+func WithEngineOptions(opts ...engine.Option) Option {
+	return func(c *cfg) {
+		c.engineOpts = append(c.engineOpts, opts...)
+	}
+}
+
+// WithShardOptions returns option to pass additional options to every shard.
+// The options of the in-memory components must not be overridden.
+func WithShardOptions(opts ...shard.Option) Option {
+	return func(c *cfg) {
+		c.shardOpts = append(c.shardOpts, opts...)
+	}
+}
+
+// WithWriteCache returns option to enable the in-memory write-cache in every shard.
+func WithWriteCache(enabled bool) Option {
+	return func(c *cfg) {
+		c.writeCache = enabled
+	}
+}
+
+// WithEpochState returns option to set the source of the current epoch
+// of the metabases. The current epoch is always 0 by default.
+func WithEpochState(s meta.EpochState) Option {
+	return func(c *cfg) {
+		c.epochState = s
+	}
+}
+
+type zeroEpochState struct{}
+
+func (zeroEpochState) CurrentEpoch() uint64 {
+	return 0
+}
+
+// NewEngine returns opened and initialized storage engine with num shards
+// built from the in-memory blobstor sub-storages, write-caches and metabases.
+// The engine is closed on the test cleanup.
+func NewEngine(t testing.TB, num int, opts ...Option) *Engine {
+	c := cfg{
+		epochState: zeroEpochState{},
+	}
+
+	for i := range opts {
+		opts[i](&c)
+	}
+
+	dir := t.TempDir()
+	openFile := newMemFileOpener(t)
+	e := &Engine{
+		StorageEngine: engine.New(c.engineOpts...),
+		Shards:        make([]Shard, num),
+	}
+
+	for i := range e.Shards {
+		sh := &e.Shards[i]
+		sh.Storage = NewStorage()
+
+		shardOpts := []shard.Option{
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages([]blobstor.SubStorage{{Storage: sh.Storage}})),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(c.epochState),
+				meta.WithBoltDBOptions(&bbolt.Options{
+					NoSync:         true,
+					NoFreelistSync: true,
+					OpenFile:       openFile,
+				})),
+		}
+
+		if c.writeCache {
+			shardOpts = append(shardOpts,
+				shard.WithWriteCache(true),
+				shard.WithWriteCacheConstructor(func(bs *blobstor.BlobStor, mb *meta.DB) writecache.Cache {
+					sh.WriteCache = NewWriteCache(bs, mb)
+					return sh.WriteCache
+				}))
+		}
+
+		var err error
+
+		sh.ID, err = e.AddShard(append(shardOpts, c.shardOpts...)...)
+		if err != nil {
+			t.Fatalf("could not add shard: %v", err)
+		}
+	}
+
+	if err := e.Open(); err != nil {
+		t.Fatalf("could not open engine: %v", err)
+	}
+
+	if err := e.Init(); err != nil {
+		t.Fatalf("could not init engine: %v", err)
+	}
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	return e
+}
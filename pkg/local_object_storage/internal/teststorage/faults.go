@@ -0,0 +1,84 @@
+package teststorage
+
+import (
+	"sync"
+	"time"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Op is an operation of the in-memory storage component.
+type Op string
+
+const (
+	OpGet      Op = "GET"
+	OpGetRange Op = "GET_RANGE"
+	OpExists   Op = "EXISTS"
+	OpPut      Op = "PUT"
+	OpDelete   Op = "DELETE"
+	OpIterate  Op = "ITERATE"
+	OpFlush    Op = "FLUSH"
+)
+
+// ErrorHook is called before every operation of the in-memory storage
+// component. Non-nil error fails the operation: it is returned from the
+// single object operations and is handled as a read error of the object
+// during iteration and flush.
+type ErrorHook func(op Op, addr oid.Address) error
+
+// FailOn returns ErrorHook failing the listed operations with err.
+// All operations fail if no operations are listed.
+func FailOn(err error, ops ...Op) ErrorHook {
+	return func(op Op, _ oid.Address) error {
+		if len(ops) == 0 {
+			return err
+		}
+
+		for i := range ops {
+			if ops[i] == op {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// faults injects errors and latency into the operations of the component.
+type faults struct {
+	mtx sync.RWMutex
+
+	hook    ErrorHook
+	latency time.Duration
+}
+
+// SetErrorHook sets the hook called before every operation.
+// Nil hook disables error injection.
+func (f *faults) SetErrorHook(h ErrorHook) {
+	f.mtx.Lock()
+	f.hook = h
+	f.mtx.Unlock()
+}
+
+// SetLatency sets the delay of every operation.
+func (f *faults) SetLatency(d time.Duration) {
+	f.mtx.Lock()
+	f.latency = d
+	f.mtx.Unlock()
+}
+
+func (f *faults) inject(op Op, addr oid.Address) error {
+	f.mtx.RLock()
+	h, d := f.hook, f.latency
+	f.mtx.RUnlock()
+
+	if d > 0 {
+		time.Sleep(d)
+	}
+
+	if h != nil {
+		return h(op, addr)
+	}
+
+	return nil
+}
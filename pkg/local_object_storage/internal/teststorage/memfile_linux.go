@@ -0,0 +1,67 @@
+//go:build linux
+// +build linux
+
+package teststorage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// memFiles is a set of the anonymous in-memory files opened by their paths.
+// The content of the file is kept until the test cleanup, so the metabase
+// can be closed and opened again.
+type memFiles struct {
+	mtx   sync.Mutex
+	files map[string]*os.File
+}
+
+// newMemFileOpener returns the function opening the in-memory file instead
+// of the file with the specified path, it is used as bbolt.Options.OpenFile.
+// The files are released on the test cleanup.
+func newMemFileOpener(t testing.TB) func(string, int, os.FileMode) (*os.File, error) {
+	m := &memFiles{
+		files: make(map[string]*os.File),
+	}
+
+	t.Cleanup(func() {
+		m.mtx.Lock()
+		defer m.mtx.Unlock()
+
+		for _, f := range m.files {
+			_ = f.Close()
+		}
+	})
+
+	return m.open
+}
+
+func (m *memFiles) open(name string, _ int, _ os.FileMode) (*os.File, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	f, ok := m.files[name]
+	if !ok {
+		fd, err := unix.MemfdCreate(filepath.Base(name), 0)
+		if err != nil {
+			return nil, fmt.Errorf("could not create memory file: %w", err)
+		}
+
+		f = os.NewFile(uintptr(fd), name)
+		m.files[name] = f
+	}
+
+	// the caller closes the file, so the descriptor is duplicated
+	// to keep the content
+	fd, err := unix.Dup(int(f.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("could not duplicate memory file descriptor: %w", err)
+	}
+
+	return os.NewFile(uintptr(fd), name), nil
+}
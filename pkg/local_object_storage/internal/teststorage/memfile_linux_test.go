@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+package teststorage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestMemFileOpener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metabase")
+
+	db := meta.New(
+		meta.WithPath(path),
+		meta.WithEpochState(zeroEpochState{}),
+		meta.WithBoltDBOptions(&bbolt.Options{
+			OpenFile: newMemFileOpener(t),
+		}))
+
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	obj := GenerateObject(cidtest.ID())
+	var putPrm meta.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := db.Put(putPrm)
+	require.NoError(t, err)
+
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the content is kept after the metabase is closed
+	require.NoError(t, db.Close())
+	require.NoError(t, db.Open(false))
+	t.Cleanup(func() { _ = db.Close() })
+
+	var existsPrm meta.ExistsPrm
+	existsPrm.SetAddress(object.AddressOf(obj))
+
+	res, err := db.Exists(existsPrm)
+	require.NoError(t, err)
+	require.True(t, res.Exists())
+}
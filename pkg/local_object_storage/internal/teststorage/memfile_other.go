@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package teststorage
+
+import (
+	"os"
+	"testing"
+)
+
+// newMemFileOpener returns nil since the in-memory files are not supported
+// on this platform, so the metabase file is created in the temporary
+// directory of the test.
+func newMemFileOpener(testing.TB) func(string, int, os.FileMode) (*os.File, error) {
+	return nil
+}
@@ -0,0 +1,273 @@
+package teststorage
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// StorageType is the type of the in-memory storage.
+const StorageType = "memory"
+
+// Storage is an in-memory implementation of common.Storage.
+// Stored objects survive Close and can be read after the next Open.
+type Storage struct {
+	faults
+
+	mtx sync.RWMutex
+
+	readOnly bool
+	cc       *compression.Config
+	objects  map[oid.Address][]byte
+}
+
+var _ common.Storage = (*Storage)(nil)
+
+// NewStorage returns new empty in-memory storage.
+func NewStorage() *Storage {
+	return &Storage{
+		objects: make(map[oid.Address][]byte),
+	}
+}
+
+// Open implements common.Storage.
+func (s *Storage) Open(readOnly bool) error {
+	s.mtx.Lock()
+	s.readOnly = readOnly
+	s.mtx.Unlock()
+
+	return nil
+}
+
+// Init implements common.Storage.
+func (s *Storage) Init() error {
+	return nil
+}
+
+// Close implements common.Storage.
+func (s *Storage) Close() error {
+	return nil
+}
+
+// Type implements common.Storage.
+func (s *Storage) Type() string {
+	return StorageType
+}
+
+// SetCompressor implements common.Storage.
+func (s *Storage) SetCompressor(cc *compression.Config) {
+	s.mtx.Lock()
+	s.cc = cc
+	s.mtx.Unlock()
+}
+
+// Len returns the number of the stored objects.
+func (s *Storage) Len() int {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return len(s.objects)
+}
+
+// Get implements common.Storage.
+func (s *Storage) Get(prm common.GetPrm) (common.GetRes, error) {
+	if err := s.inject(OpGet, prm.Address); err != nil {
+		return common.GetRes{}, err
+	}
+
+	return s.get(prm.Address)
+}
+
+func (s *Storage) get(addr oid.Address) (common.GetRes, error) {
+	s.mtx.RLock()
+	data, ok := s.objects[addr]
+	cc := s.cc
+	s.mtx.RUnlock()
+
+	if !ok {
+		return common.GetRes{}, apistatus.ObjectNotFound{}
+	}
+
+	data, err := cc.Decompress(data)
+	if err != nil {
+		return common.GetRes{}, err
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return common.GetRes{}, err
+	}
+
+	return common.GetRes{Object: obj, RawData: data}, nil
+}
+
+// GetRange implements common.Storage.
+func (s *Storage) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	if err := s.inject(OpGetRange, prm.Address); err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	res, err := s.get(prm.Address)
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	payload := res.Object.Payload()
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return common.GetRangeRes{}, apistatus.ObjectOutOfRange{}
+	}
+
+	return common.GetRangeRes{
+		Data:     payload[from:to],
+		FullRead: true,
+	}, nil
+}
+
+// Exists implements common.Storage.
+func (s *Storage) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	if err := s.inject(OpExists, prm.Address); err != nil {
+		return common.ExistsRes{}, err
+	}
+
+	s.mtx.RLock()
+	_, ok := s.objects[prm.Address]
+	s.mtx.RUnlock()
+
+	return common.ExistsRes{Exists: ok}, nil
+}
+
+// Put implements common.Storage.
+func (s *Storage) Put(prm common.PutPrm) (common.PutRes, error) {
+	if err := s.inject(OpPut, prm.Address); err != nil {
+		return common.PutRes{}, err
+	}
+
+	data := prm.RawData
+	if data == nil {
+		var err error
+		if data, err = prm.Object.Marshal(); err != nil {
+			return common.PutRes{}, err
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.readOnly {
+		return common.PutRes{}, common.ErrReadOnly
+	}
+
+	if !prm.DontCompress {
+		data = s.cc.Compress(data)
+	}
+
+	s.objects[prm.Address] = slice.Copy(data)
+
+	return common.PutRes{StorageID: []byte{}}, nil
+}
+
+// Delete implements common.Storage.
+func (s *Storage) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	if err := s.inject(OpDelete, prm.Address); err != nil {
+		return common.DeleteRes{}, err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.readOnly {
+		return common.DeleteRes{}, common.ErrReadOnly
+	}
+
+	if _, ok := s.objects[prm.Address]; !ok {
+		return common.DeleteRes{}, apistatus.ObjectNotFound{}
+	}
+
+	delete(s.objects, prm.Address)
+
+	return common.DeleteRes{}, nil
+}
+
+// Iterate implements common.Storage. Objects are iterated in the order
+// of their stringified addresses, the objects put during the iteration
+// are not visited.
+func (s *Storage) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
+	s.mtx.RLock()
+	addrs := make([]oid.Address, 0, len(s.objects))
+	for addr := range s.objects {
+		addrs = append(addrs, addr)
+	}
+	s.mtx.RUnlock()
+
+	sortAddresses(addrs)
+
+	for i := range addrs {
+		err := s.iterateObject(addrs[i], prm)
+		if err != nil {
+			return common.IterateRes{}, err
+		}
+	}
+
+	return common.IterateRes{}, nil
+}
+
+func (s *Storage) iterateObject(addr oid.Address, prm common.IteratePrm) error {
+	read := func() ([]byte, error) {
+		if err := s.inject(OpIterate, addr); err != nil {
+			return nil, err
+		}
+
+		s.mtx.RLock()
+		data, ok := s.objects[addr]
+		s.mtx.RUnlock()
+
+		if !ok {
+			return nil, apistatus.ObjectNotFound{}
+		}
+
+		return data, nil
+	}
+
+	if prm.LazyHandler != nil {
+		return prm.LazyHandler(addr, read)
+	}
+
+	s.mtx.RLock()
+	cc := s.cc
+	s.mtx.RUnlock()
+
+	data, err := read()
+	if err == nil {
+		data, err = cc.Decompress(data)
+	}
+	if err != nil {
+		if prm.IgnoreErrors {
+			if prm.ErrorHandler != nil {
+				return prm.ErrorHandler(addr, err)
+			}
+			return nil
+		}
+		return err
+	}
+
+	return prm.Handler(common.IterationElement{
+		Address:    addr,
+		ObjectData: data,
+		StorageID:  []byte{},
+	})
+}
+
+func sortAddresses(addrs []oid.Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].EncodeToString() < addrs[j].EncodeToString()
+	})
+}
@@ -0,0 +1,144 @@
+package teststorage_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/teststorage"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	s := teststorage.NewStorage()
+	require.NoError(t, s.Open(false))
+	require.NoError(t, s.Init())
+
+	obj := teststorage.GenerateObject(cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	_, err := s.Get(common.GetPrm{Address: addr})
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	_, err = s.Put(common.PutPrm{Address: addr, Object: obj})
+	require.NoError(t, err)
+
+	res, err := s.Get(common.GetPrm{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, obj, res.Object)
+
+	var rng objectSDK.Range
+	rng.SetOffset(1)
+	rng.SetLength(3)
+
+	rngRes, err := s.GetRange(common.GetRangePrm{Address: addr, Range: rng})
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload()[1:4], rngRes.Data)
+
+	t.Run("error hook", func(t *testing.T) {
+		errTest := errors.New("test error")
+		s.SetErrorHook(teststorage.FailOn(errTest, teststorage.OpGet, teststorage.OpIterate))
+		t.Cleanup(func() { s.SetErrorHook(nil) })
+
+		_, err := s.Get(common.GetPrm{Address: addr})
+		require.ErrorIs(t, err, errTest)
+
+		eRes, err := s.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		require.True(t, eRes.Exists)
+
+		var failed []oid.Address
+		_, err = s.Iterate(common.IteratePrm{
+			IgnoreErrors: true,
+			ErrorHandler: func(addr oid.Address, err error) error {
+				require.ErrorIs(t, err, errTest)
+				failed = append(failed, addr)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{addr}, failed)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		s.SetLatency(10 * time.Millisecond)
+		t.Cleanup(func() { s.SetLatency(0) })
+
+		start := time.Now()
+		_, err := s.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, s.Close())
+		require.NoError(t, s.Open(true))
+		t.Cleanup(func() { require.NoError(t, s.Open(false)) })
+
+		_, err := s.Delete(common.DeletePrm{Address: addr})
+		require.ErrorIs(t, err, common.ErrReadOnly)
+
+		// objects survive reopening
+		require.Equal(t, 1, s.Len())
+	})
+
+	_, err = s.Delete(common.DeletePrm{Address: addr})
+	require.NoError(t, err)
+
+	_, err = s.Delete(common.DeletePrm{Address: addr})
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+}
+
+func TestNewEngine(t *testing.T) {
+	e := teststorage.NewEngine(t, 2, teststorage.WithWriteCache(true))
+	require.Len(t, e.Shards, 2)
+
+	obj := teststorage.GenerateObject(cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	require.NoError(t, engine.Put(e.StorageEngine, obj))
+
+	var sh teststorage.Shard
+	for i := range e.Shards {
+		if e.Shards[i].WriteCache.Len() != 0 {
+			sh = e.Shards[i]
+		}
+	}
+	require.NotNil(t, sh.WriteCache)
+	require.Zero(t, sh.Storage.Len())
+
+	var flushPrm engine.FlushWriteCachePrm
+	flushPrm.SetShardID(sh.ID)
+
+	_, err := e.FlushWriteCache(flushPrm)
+	require.NoError(t, err)
+	require.Zero(t, sh.WriteCache.Len())
+	require.Equal(t, 1, sh.Storage.Len())
+	require.EqualValues(t, 1, sh.WriteCache.Stats().Flushed)
+
+	require.NoError(t, e.SetShardMode(sh.ID, mode.ReadWrite, false))
+
+	res, err := engine.Get(e.StorageEngine, addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, res)
+
+	// the failures of the blobstor are reported by the engine
+	errTest := errors.New("test error")
+	sh.Storage.SetErrorHook(teststorage.FailOn(errTest))
+
+	_, err = engine.Get(e.StorageEngine, addr)
+	require.Error(t, err)
+
+	sh.Storage.SetErrorHook(nil)
+
+	_, err = engine.Get(e.StorageEngine, addr)
+	require.NoError(t, err)
+}
@@ -0,0 +1,464 @@
+package teststorage
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// DefaultWriteCacheCapacity is the default maximum size
+// of the objects stored in WriteCache.
+const DefaultWriteCacheCapacity = 1 << 30
+
+var errMustBeReadOnly = errors.New("write-cache must be in read-only mode")
+
+// WriteCache is an in-memory implementation of writecache.Cache.
+//
+// There are no background flush workers: the objects reach the main storage
+// only on Flush and FlushTo calls and when the write-cache is switched to the
+// degraded mode, so the tests control the moment of the flush. Stored objects
+// survive Close and can be read after the next Open.
+type WriteCache struct {
+	faults
+
+	blobstor *blobstor.BlobStor
+	metabase *meta.DB
+
+	mtx sync.RWMutex
+
+	log      *zap.Logger
+	mode     mode.Mode
+	capacity uint64
+	size     uint64
+	objects  map[oid.Address][]byte
+
+	flushed   uint64
+	lastFlush time.Time
+}
+
+var _ writecache.Cache = (*WriteCache)(nil)
+
+// NewWriteCache returns new empty in-memory write-cache flushing
+// objects to the blobstor and the metabase.
+func NewWriteCache(bs *blobstor.BlobStor, mb *meta.DB) *WriteCache {
+	return &WriteCache{
+		blobstor: bs,
+		metabase: mb,
+		log:      zap.NewNop(),
+		capacity: DefaultWriteCacheCapacity,
+		objects:  make(map[oid.Address][]byte),
+	}
+}
+
+// SetCapacity sets the maximum size of the stored objects. Put fails with
+// writecache.ErrOutOfSpace if the capacity is exceeded.
+func (c *WriteCache) SetCapacity(sz uint64) {
+	c.mtx.Lock()
+	c.capacity = sz
+	c.mtx.Unlock()
+}
+
+// Len returns the number of the objects which are not flushed yet.
+func (c *WriteCache) Len() int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return len(c.objects)
+}
+
+// Open implements writecache.Cache.
+func (c *WriteCache) Open(readOnly bool) error {
+	c.mtx.Lock()
+	if readOnly {
+		c.mode = mode.ReadOnly
+	} else {
+		c.mode = mode.ReadWrite
+	}
+	c.mtx.Unlock()
+
+	return nil
+}
+
+// Init implements writecache.Cache.
+func (c *WriteCache) Init() error {
+	c.mtx.Lock()
+	c.lastFlush = time.Now()
+	c.mtx.Unlock()
+
+	return nil
+}
+
+// Close implements writecache.Cache.
+func (c *WriteCache) Close() error {
+	return nil
+}
+
+// SetLogger implements writecache.Cache.
+func (c *WriteCache) SetLogger(l *zap.Logger) {
+	c.mtx.Lock()
+	c.log = l
+	c.mtx.Unlock()
+}
+
+// SetMode implements writecache.Cache. The objects are flushed to the main
+// storage when the write-cache is switched to the degraded mode.
+func (c *WriteCache) SetMode(m mode.Mode) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if m.NoMetabase() && !c.mode.NoMetabase() {
+		err := c.flush(c.blobstor, writecache.FlushPrm{IgnoreErrors: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	c.mode = m
+	return nil
+}
+
+// DumpInfo implements writecache.Cache.
+func (c *WriteCache) DumpInfo() writecache.Info {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return writecache.Info{
+		DBBacklog: uint64(len(c.objects)),
+	}
+}
+
+// Stats implements writecache.Cache.
+func (c *WriteCache) Stats() writecache.Stats {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return writecache.Stats{
+		Flushed:   c.flushed,
+		LastFlush: c.lastFlush,
+		Objects:   uint64(len(c.objects)),
+		DBObjects: uint64(len(c.objects)),
+		Size:      c.size,
+		Capacity:  c.capacity,
+	}
+}
+
+// Put implements writecache.Cache.
+func (c *WriteCache) Put(prm common.PutPrm) (common.PutRes, error) {
+	if err := c.inject(OpPut, prm.Address); err != nil {
+		return common.PutRes{}, err
+	}
+
+	if prm.RawData == nil {
+		var err error
+		if prm.RawData, err = prm.Object.Marshal(); err != nil {
+			return common.PutRes{}, err
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.mode.ReadOnly() {
+		return common.PutRes{}, writecache.ErrReadOnly
+	}
+
+	if !c.blobstor.CanPut(prm.Object, prm.RawData) {
+		return common.PutRes{}, fmt.Errorf("%w: blobstor has no place for it", writecache.ErrBigObject)
+	}
+
+	if _, ok := c.objects[prm.Address]; ok {
+		return common.PutRes{}, nil
+	}
+
+	sz := uint64(len(prm.RawData))
+	if c.capacity < c.size+sz {
+		return common.PutRes{}, writecache.ErrOutOfSpace
+	}
+
+	c.objects[prm.Address] = slice.Copy(prm.RawData)
+	c.size += sz
+
+	return common.PutRes{}, nil
+}
+
+// Get implements writecache.Cache.
+func (c *WriteCache) Get(addr oid.Address) (*objectSDK.Object, error) {
+	if err := c.inject(OpGet, addr); err != nil {
+		return nil, err
+	}
+
+	c.mtx.RLock()
+	data, ok := c.objects[addr]
+	c.mtx.RUnlock()
+
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	obj := objectSDK.New()
+	return obj, obj.Unmarshal(data)
+}
+
+// Head implements writecache.Cache.
+func (c *WriteCache) Head(addr oid.Address) (*objectSDK.Object, error) {
+	obj, err := c.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+// Delete implements writecache.Cache.
+func (c *WriteCache) Delete(addr oid.Address) error {
+	if err := c.inject(OpDelete, addr); err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.mode.ReadOnly() {
+		return writecache.ErrReadOnly
+	}
+
+	data, ok := c.objects[addr]
+	if !ok {
+		return apistatus.ObjectNotFound{}
+	}
+
+	delete(c.objects, addr)
+	c.size -= uint64(len(data))
+
+	return nil
+}
+
+// ObjectStatus implements writecache.Cache. Stored objects are
+// reported as the objects of the small object database.
+func (c *WriteCache) ObjectStatus(addr oid.Address) (writecache.ObjectStatus, error) {
+	c.mtx.RLock()
+	_, ok := c.objects[addr]
+	c.mtx.RUnlock()
+
+	return writecache.ObjectStatus{InDB: ok}, nil
+}
+
+// Iterate implements writecache.Cache. Like the regular write-cache,
+// it does nothing unless the write-cache is in read-only mode.
+func (c *WriteCache) Iterate(prm writecache.IterationPrm) error {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	if !c.mode.ReadOnly() {
+		return nil
+	}
+
+	return c.iterate(func(_ oid.Address, data []byte, err error) error {
+		if err != nil {
+			if prm.IgnoreErrors() {
+				return nil
+			}
+			return err
+		}
+
+		return prm.Handler()(data)
+	})
+}
+
+// iterate passes the stored objects to f in the order of their stringified
+// addresses along with the injected read errors. Must be called with the
+// mutex held.
+func (c *WriteCache) iterate(f func(oid.Address, []byte, error) error) error {
+	addrs := make([]oid.Address, 0, len(c.objects))
+	for addr := range c.objects {
+		addrs = append(addrs, addr)
+	}
+
+	sortAddresses(addrs)
+
+	for i := range addrs {
+		err := f(addrs[i], c.objects[addrs[i]], c.inject(OpIterate, addrs[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush implements writecache.Cache.
+func (c *WriteCache) Flush(prm writecache.FlushPrm) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.mode.ReadOnly() && !prm.Force {
+		return errMustBeReadOnly
+	}
+
+	return c.flush(c.blobstor, prm)
+}
+
+// FlushTo implements writecache.Cache.
+func (c *WriteCache) FlushTo(target common.Storage, prm writecache.FlushPrm) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.mode.ReadOnly() {
+		return errMustBeReadOnly
+	}
+
+	return c.flush(target, prm)
+}
+
+// Drain implements writecache.Cache. The context is checked before
+// every object.
+func (c *WriteCache) Drain(ctx context.Context) (uint64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.mode = mode.ReadOnly
+
+	err := c.flushCtx(ctx, c.blobstor, writecache.FlushPrm{})
+	if err != nil {
+		return uint64(len(c.objects)), err
+	}
+
+	return 0, nil
+}
+
+type flushTarget interface {
+	Put(common.PutPrm) (common.PutRes, error)
+}
+
+// flush writes all the objects to dst and removes them from the write-cache.
+// Must be called with the mutex held.
+func (c *WriteCache) flush(dst flushTarget, prm writecache.FlushPrm) error {
+	return c.flushCtx(context.Background(), dst, prm)
+}
+
+// flushCtx is like flush, but is interrupted when ctx is done.
+func (c *WriteCache) flushCtx(ctx context.Context, dst flushTarget, prm writecache.FlushPrm) error {
+	skip := func(addr oid.Address, err error) error {
+		if !prm.IgnoreErrors {
+			return err
+		}
+		if prm.ErrorHandler != nil {
+			return prm.ErrorHandler(addr, err)
+		}
+		return nil
+	}
+
+	return c.iterate(func(addr oid.Address, data []byte, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err == nil {
+			err = c.inject(OpFlush, addr)
+		}
+		if err != nil {
+			return skip(addr, err)
+		}
+
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(data); err != nil {
+			return skip(addr, err)
+		}
+
+		res, err := dst.Put(common.PutPrm{
+			Address: objectCore.AddressOf(obj),
+			Object:  obj,
+			RawData: data,
+		})
+		if err != nil {
+			return err
+		}
+
+		var pPrm meta.PutPrm
+		pPrm.SetObject(obj)
+		pPrm.SetStorageID(res.StorageID)
+
+		if _, err := c.metabase.Put(pPrm); err != nil {
+			return err
+		}
+
+		delete(c.objects, addr)
+		c.size -= uint64(len(data))
+		c.flushed++
+		c.lastFlush = time.Now()
+
+		c.log.Debug("object is flushed", zap.Stringer("address", addr))
+
+		return nil
+	})
+}
+
+// WarmUp implements writecache.Cache. The stored objects are
+// always in memory, so WarmUp only counts their size.
+func (c *WriteCache) WarmUp(ctx context.Context, byteLimit uint64) (uint64, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	var read uint64
+
+	for addr, data := range c.objects {
+		if err := ctx.Err(); err != nil {
+			return read, err
+		}
+
+		read += uint64(len(addr.EncodeToString()) + len(data))
+		if byteLimit > 0 && read >= byteLimit {
+			break
+		}
+	}
+
+	return read, nil
+}
+
+// Dump implements writecache.Cache. The records have the same format
+// as the ones of the regular write-cache.
+func (c *WriteCache) Dump(w io.Writer) error {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	if c.mode.NoMetabase() {
+		return writecache.ErrDegraded
+	}
+
+	var size [4]byte
+
+	return c.iterate(func(addr oid.Address, data []byte, err error) error {
+		if err != nil {
+			return err
+		}
+
+		for _, field := range [][]byte{[]byte(addr.EncodeToString()), data} {
+			binary.LittleEndian.PutUint32(size[:], uint32(len(field)))
+			if _, err := w.Write(size[:]); err != nil {
+				return err
+			}
+
+			if _, err := w.Write(field); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
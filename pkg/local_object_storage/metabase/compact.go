@@ -0,0 +1,217 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// compactSuffix is a suffix of the temporary database file
+// the metabase is compacted to.
+const compactSuffix = ".compact"
+
+// defaultCompactTxMaxSize is the default maximum size of the data copied
+// in a single transaction during compaction.
+const defaultCompactTxMaxSize = 64 << 20
+
+var errNotOpened = errors.New("metabase is not opened")
+
+// ErrCompactReopen is returned by Compact if the metabase can't be reopened
+// after compaction. The metabase is switched to mode.DegradedReadOnly then.
+var ErrCompactReopen = errors.New("can't reopen metabase after compaction")
+
+// CompactRes groups the resulting values of Compact operation.
+type CompactRes struct {
+	sizeBefore, sizeAfter int64
+}
+
+// SizeBefore returns the size of the database file before compaction.
+func (r CompactRes) SizeBefore() int64 {
+	return r.sizeBefore
+}
+
+// SizeAfter returns the size of the database file after compaction.
+func (r CompactRes) SizeAfter() int64 {
+	return r.sizeAfter
+}
+
+// FreeRatio returns the ratio of the free pages to all the pages
+// of the database file. The space of the free pages is reused by
+// the database, but is returned to the file system by Compact only.
+func (db *DB) FreeRatio() (float64, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.boltDB == nil {
+		return 0, errNotOpened
+	}
+
+	var ratio float64
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		total := tx.Size() / int64(db.boltDB.Info().PageSize)
+		if total == 0 {
+			return nil
+		}
+
+		if !db.boltDB.IsReadOnly() {
+			stats := db.boltDB.Stats()
+			ratio = float64(stats.FreePageN+stats.PendingPageN) / float64(total)
+			return nil
+		}
+
+		// free list is not loaded in read-only mode, so count the used pages
+		used := int64(2) // meta pages
+		err := tx.ForEach(func(_ []byte, b *bbolt.Bucket) error {
+			st := b.Stats()
+			used += int64(st.BranchPageN + st.BranchOverflowN + st.LeafPageN + st.LeafOverflowN)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if used < total {
+			ratio = float64(total-used) / float64(total)
+		}
+
+		return nil
+	})
+
+	return ratio, err
+}
+
+// Compact copies all the buckets of the metabase to the new database file
+// and replaces the original file with it, so the space of the free pages is
+// returned to the file system. The metabase is reopened in the same mode.
+//
+// The new database is written to a temporary file which atomically replaces
+// the original one when it is completely written, so the metabase is consistent
+// if the process is interrupted. The remaining temporary file is removed on the
+// next Open.
+//
+// Operations are blocked during compaction. Returns wrapped ErrCompactReopen
+// if the metabase can't be reopened, the metabase works in
+// mode.DegradedReadOnly after that.
+func (db *DB) Compact() (CompactRes, error) {
+	db.modeMtx.Lock()
+	defer db.modeMtx.Unlock()
+
+	var res CompactRes
+
+	if db.boltDB == nil {
+		return res, errNotOpened
+	}
+
+	st, err := os.Stat(db.info.Path)
+	if err != nil {
+		return res, fmt.Errorf("can't stat metabase file: %w", err)
+	}
+
+	res.sizeBefore = st.Size()
+
+	tmpPath := db.info.Path + compactSuffix
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return res, fmt.Errorf("can't remove stale compacted metabase: %w", err)
+	}
+
+	dstOpts := *db.boltOptions
+	dstOpts.ReadOnly = false
+
+	dst, err := bbolt.Open(tmpPath, db.info.Permission, &dstOpts)
+	if err != nil {
+		return res, fmt.Errorf("can't open compacted metabase: %w", err)
+	}
+
+	err = bbolt.Compact(dst, db.boltDB, db.compactTxMaxSize)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return res, fmt.Errorf("can't compact metabase: %w", err)
+	}
+
+	readOnly := db.boltDB.IsReadOnly()
+
+	if err := db.boltDB.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return res, fmt.Errorf("can't close metabase: %w", err)
+	}
+
+	db.boltDB = nil
+
+	if err := os.Rename(tmpPath, db.info.Path); err != nil {
+		_ = os.Remove(tmpPath)
+		if openErr := db.reopenAfterCompaction(readOnly); openErr != nil {
+			return res, fmt.Errorf("can't replace metabase file: %v: %w", err, openErr)
+		}
+		return res, fmt.Errorf("can't replace metabase file: %w", err)
+	}
+
+	syncDir(filepath.Dir(db.info.Path))
+
+	if err := db.reopenAfterCompaction(readOnly); err != nil {
+		return res, err
+	}
+
+	st, err = os.Stat(db.info.Path)
+	if err != nil {
+		return res, fmt.Errorf("can't stat compacted metabase file: %w", err)
+	}
+
+	res.sizeAfter = st.Size()
+
+	return res, nil
+}
+
+// reopenAfterCompaction opens the database closed for compaction. If it
+// fails, the metabase is switched to mode.DegradedReadOnly, so it is not
+// accessed until the next successful SetMode call.
+func (db *DB) reopenAfterCompaction(readOnly bool) error {
+	err := db.Open(readOnly)
+	if err == nil {
+		return nil
+	}
+
+	if db.boltDB != nil {
+		_ = db.boltDB.Close()
+		db.boltDB = nil
+	}
+
+	db.mode = mode.DegradedReadOnly
+
+	return fmt.Errorf("%w: %v", ErrCompactReopen, err)
+}
+
+// removeCompactLeftover removes the temporary file of the interrupted compaction.
+func (db *DB) removeCompactLeftover() {
+	tmpPath := db.info.Path + compactSuffix
+
+	err := os.Remove(tmpPath)
+	switch {
+	case err == nil:
+		db.log.Info("removed leftover of the interrupted metabase compaction",
+			zap.String("path", tmpPath))
+	case !errors.Is(err, os.ErrNotExist):
+		db.log.Warn("can't remove leftover of the interrupted metabase compaction",
+			zap.String("path", tmpPath),
+			zap.Error(err))
+	}
+}
+
+// syncDir flushes the directory entries so the renamed file is persisted.
+func syncDir(path string) {
+	d, err := os.Open(path)
+	if err != nil {
+		return
+	}
+
+	_ = d.Sync()
+	_ = d.Close()
+}
@@ -0,0 +1,37 @@
+package meta
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ReopenAfterCompaction(t *testing.T) {
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	require.NoError(t, db.boltDB.Close())
+	db.boltDB = nil
+
+	// compacted file is corrupted
+	require.NoError(t, os.WriteFile(db.info.Path, []byte("not a database"), 0600))
+
+	err := db.reopenAfterCompaction(false)
+	require.ErrorIs(t, err, ErrCompactReopen)
+	require.Nil(t, db.boltDB)
+	require.Equal(t, mode.DegradedReadOnly, db.mode)
+
+	// the metabase is not accessed in degraded mode
+	_, err = db.FreeRatio()
+	require.ErrorIs(t, err, errNotOpened)
+	_, err = db.Compact()
+	require.ErrorIs(t, err, errNotOpened)
+
+	require.NoError(t, os.Remove(db.info.Path))
+	require.NoError(t, db.SetMode(mode.ReadWrite))
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,97 @@
+package meta_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestDB_Compact(t *testing.T) {
+	db := newDB(t, meta.WithBoltDBOptions(&bbolt.Options{NoSync: true}), meta.WithMaxBatchSize(1),
+		meta.WithCompactTxMaxSize(4<<10)) // several transactions
+
+	cnr := cidtest.ID()
+	addrs := make([]oid.Address, 0, 2000)
+	for i := 0; i < cap(addrs); i++ {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, putBig(db, obj))
+		addrs = append(addrs, object.AddressOf(obj))
+	}
+
+	kept := addrs[:10]
+	require.NoError(t, metaDelete(db, addrs[len(kept):]...))
+
+	ratio, err := db.FreeRatio()
+	require.NoError(t, err)
+	require.Greater(t, ratio, 0.5)
+
+	check := func(t *testing.T) {
+		for _, addr := range kept {
+			exists, err := metaExists(db, addr)
+			require.NoError(t, err)
+			require.True(t, exists)
+		}
+
+		_, err := os.Stat(db.DumpInfo().Path + ".compact")
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+
+	res, err := db.Compact()
+	require.NoError(t, err)
+	require.Less(t, res.SizeAfter(), res.SizeBefore())
+	check(t)
+
+	st, err := os.Stat(db.DumpInfo().Path)
+	require.NoError(t, err)
+	require.Equal(t, res.SizeAfter(), st.Size())
+
+	ratio, err = db.FreeRatio()
+	require.NoError(t, err)
+	require.Less(t, ratio, 0.5)
+
+	// metabase is writable after compaction
+	require.NoError(t, putBig(db, generateObjectWithCID(t, cnr)))
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, db.SetMode(mode.ReadOnly))
+
+		_, err := db.FreeRatio()
+		require.NoError(t, err)
+
+		_, err = db.Compact()
+		require.NoError(t, err)
+		check(t)
+
+		require.Error(t, putBig(db, generateObjectWithCID(t, cnr)))
+	})
+}
+
+func TestDB_CompactLeftover(t *testing.T) {
+	db := newDB(t)
+
+	obj := generateObject(t)
+	require.NoError(t, putBig(db, obj))
+
+	path := db.DumpInfo().Path
+	require.NoError(t, db.Close())
+
+	// simulate the compaction interrupted before the file swap
+	require.NoError(t, os.WriteFile(path+".compact", []byte("garbage"), 0600))
+
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	_, err := os.Stat(path + ".compact")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	exists, err := metaExists(db, object.AddressOf(obj))
+	require.NoError(t, err)
+	require.True(t, exists)
+}
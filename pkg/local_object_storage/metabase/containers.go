@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"encoding/binary"
 
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -38,7 +39,7 @@ func (db *DB) containers(tx *bbolt.Tx) ([]cid.ID, error) {
 }
 
 func (db *DB) ContainerSize(id cid.ID) (size uint64, err error) {
-	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+	err = db.update(context.Background(), PriorityHigh, func(tx *bbolt.Tx) error {
 		size, err = db.containerSize(tx, id)
 
 		return err
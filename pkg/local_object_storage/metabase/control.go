@@ -18,6 +18,10 @@ func (db *DB) Open(readOnly bool) error {
 
 	db.log.Debug("created directory for Metabase", zap.String("path", db.info.Path))
 
+	if !readOnly {
+		db.removeCompactLeftover()
+	}
+
 	if db.boltOptions == nil {
 		opts := *bbolt.DefaultOptions
 		db.boltOptions = &opts
@@ -78,11 +82,12 @@ func (db *DB) init(reset bool) error {
 	}
 
 	mStaticBuckets := map[string]struct{}{
-		string(containerVolumeBucketName): {},
-		string(graveyardBucketName):       {},
-		string(toMoveItBucketName):        {},
-		string(garbageBucketName):         {},
-		string(shardInfoBucket):           {},
+		string(containerVolumeBucketName):   {},
+		string(graveyardBucketName):         {},
+		string(toMoveItBucketName):          {},
+		string(garbageBucketName):           {},
+		string(shardInfoBucket):             {},
+		string(scheduledDeletionBucketName): {},
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
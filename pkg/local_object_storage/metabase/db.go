@@ -43,6 +43,8 @@ type DB struct {
 
 	boltDB *bbolt.DB
 
+	txLimiter *txLimiter
+
 	initialized bool
 }
 
@@ -60,6 +62,14 @@ type cfg struct {
 	log *logger.Logger
 
 	epochState EpochState
+
+	expirationGrace uint64
+
+	txLimit     int
+	txQueueSize int
+	txWait      func(Priority, time.Duration)
+
+	compactTxMaxSize int64
 }
 
 func defaultCfg() *cfg {
@@ -67,9 +77,10 @@ func defaultCfg() *cfg {
 		info: Info{
 			Permission: os.ModePerm, // 0777
 		},
-		boltBatchDelay: bbolt.DefaultMaxBatchDelay,
-		boltBatchSize:  bbolt.DefaultMaxBatchSize,
-		log:            zap.L(),
+		boltBatchDelay:   bbolt.DefaultMaxBatchDelay,
+		boltBatchSize:    bbolt.DefaultMaxBatchSize,
+		log:              zap.L(),
+		compactTxMaxSize: defaultCompactTxMaxSize,
 	}
 }
 
@@ -86,7 +97,8 @@ func New(opts ...Option) *DB {
 	}
 
 	return &DB{
-		cfg: c,
+		cfg:       c,
+		txLimiter: newTxLimiter(c.txLimit, c.txQueueSize, c.txWait),
 		matchers: map[object.SearchMatchType]matcher{
 			object.MatchUnknown: {
 				matchSlow:   unknownMatcher,
@@ -108,6 +120,21 @@ func New(opts ...Option) *DB {
 	}
 }
 
+// currentEpoch returns the epoch relative to which the objects are checked
+// for expiration: the current epoch decreased by the expiration grace period.
+func (db *DB) currentEpoch() uint64 {
+	return db.expirationEpoch(db.epochState.CurrentEpoch())
+}
+
+// expirationEpoch decreases epoch by the expiration grace period.
+func (db *DB) expirationEpoch(epoch uint64) uint64 {
+	if epoch < db.expirationGrace {
+		return 0
+	}
+
+	return epoch - db.expirationGrace
+}
+
 func stringifyValue(key string, objVal []byte) string {
 	switch key {
 	default:
@@ -349,3 +376,42 @@ func WithEpochState(s EpochState) Option {
 		c.epochState = s
 	}
 }
+
+// WithExpirationGracePeriod returns option to specify the number of epochs
+// the objects stay available after their expiration. Zero (default) means
+// the objects expire right at the declared epoch.
+func WithExpirationGracePeriod(epochs uint64) Option {
+	return func(c *cfg) {
+		c.expirationGrace = epochs
+	}
+}
+
+// WithTxLimit returns option to limit the number of the concurrent write
+// transactions. The operations exceeding the limit wait in the queue of
+// the specified size, higher priority operations are served first. If
+// the queue is full, the operations fail with ErrTxQueueFull.
+//
+// Zero limit (default) means no limit.
+func WithTxLimit(limit, queueSize int) Option {
+	return func(c *cfg) {
+		c.txLimit = limit
+		c.txQueueSize = queueSize
+	}
+}
+
+// WithCompactTxMaxSize returns option to specify the maximum size of the
+// data copied in a single transaction during Compact. Non-positive value
+// means the whole metabase is copied in a single transaction.
+func WithCompactTxMaxSize(size int64) Option {
+	return func(c *cfg) {
+		c.compactTxMaxSize = size
+	}
+}
+
+// WithTxWaitCallback returns option to specify the callback which is called
+// with the time spent by the write operation waiting for the transaction limit.
+func WithTxWaitCallback(f func(Priority, time.Duration)) Option {
+	return func(c *cfg) {
+		c.txWait = f
+	}
+}
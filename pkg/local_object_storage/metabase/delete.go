@@ -2,6 +2,7 @@ package meta
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 
@@ -16,12 +17,16 @@ import (
 // DeletePrm groups the parameters of Delete operation.
 type DeletePrm struct {
 	addrs []oid.Address
+
+	priority Priority
+	ctx      context.Context
 }
 
 // DeleteRes groups the resulting values of Delete operation.
 type DeleteRes struct {
 	rawRemoved       uint64
 	availableRemoved uint64
+	sizeRemoved      uint64
 }
 
 // AvailableObjectsRemoved returns the number of removed available
@@ -35,6 +40,12 @@ func (d DeleteRes) RawObjectsRemoved() uint64 {
 	return d.rawRemoved
 }
 
+// RemovedPayloadSize returns the total payload size
+// of the removed raw objects.
+func (d DeleteRes) RemovedPayloadSize() uint64 {
+	return d.sizeRemoved
+}
+
 // SetAddresses is a Delete option to set the addresses of the objects to delete.
 //
 // Option is required.
@@ -42,6 +53,18 @@ func (p *DeletePrm) SetAddresses(addrs ...oid.Address) {
 	p.addrs = addrs
 }
 
+// SetPriority is a Delete option to set the priority of the operation in the
+// queue of the write transactions. PriorityHigh is used by default.
+func (p *DeletePrm) SetPriority(priority Priority) {
+	p.priority = priority
+}
+
+// SetContext is a Delete option to set the context which aborts waiting for
+// the transaction limit (see WithTxLimit) when done.
+func (p *DeletePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 type referenceNumber struct {
 	all, cur int
 
@@ -59,10 +82,11 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 
 	var rawRemoved uint64
 	var availableRemoved uint64
+	var sizeRemoved uint64
 	var err error
 
-	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
-		rawRemoved, availableRemoved, err = db.deleteGroup(tx, prm.addrs)
+	err = db.update(prm.ctx, prm.priority, func(tx *bbolt.Tx) error {
+		rawRemoved, availableRemoved, sizeRemoved, err = db.deleteGroup(tx, prm.addrs)
 		return err
 	})
 	if err == nil {
@@ -75,6 +99,7 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 	return DeleteRes{
 		rawRemoved:       rawRemoved,
 		availableRemoved: availableRemoved,
+		sizeRemoved:      sizeRemoved,
 	}, err
 }
 
@@ -83,22 +108,25 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 // The first return value is a physical objects removed number: physical
 // objects that were stored. The second return value is a logical objects
 // removed number: objects that were available (without Tombstones, GCMarks
-// non-expired, etc.)
-func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) (uint64, uint64, error) {
+// non-expired, etc.) The third return value is a total payload size of the
+// removed physical objects.
+func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) (uint64, uint64, uint64, error) {
 	refCounter := make(referenceCounter, len(addrs))
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
 
 	var rawDeleted uint64
 	var availableDeleted uint64
+	var sizeDeleted uint64
 
 	for i := range addrs {
-		removed, available, err := db.delete(tx, addrs[i], refCounter, currEpoch)
+		removed, available, size, err := db.delete(tx, addrs[i], refCounter, currEpoch)
 		if err != nil {
-			return 0, 0, err // maybe log and continue?
+			return 0, 0, 0, err // maybe log and continue?
 		}
 
 		if removed {
 			rawDeleted++
+			sizeDeleted += size
 		}
 
 		if available {
@@ -109,14 +137,14 @@ func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) (uint64, uint64, er
 	if rawDeleted > 0 {
 		err := db.updateCounter(tx, phy, rawDeleted, false)
 		if err != nil {
-			return 0, 0, fmt.Errorf("could not decrease phy object counter: %w", err)
+			return 0, 0, 0, fmt.Errorf("could not decrease phy object counter: %w", err)
 		}
 	}
 
 	if availableDeleted > 0 {
 		err := db.updateCounter(tx, logical, availableDeleted, false)
 		if err != nil {
-			return 0, 0, fmt.Errorf("could not decrease logical object counter: %w", err)
+			return 0, 0, 0, fmt.Errorf("could not decrease logical object counter: %w", err)
 		}
 	}
 
@@ -124,12 +152,12 @@ func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) (uint64, uint64, er
 		if refNum.cur == refNum.all {
 			err := db.deleteObject(tx, refNum.obj, true)
 			if err != nil {
-				return rawDeleted, availableDeleted, err // maybe log and continue?
+				return rawDeleted, availableDeleted, sizeDeleted, err // maybe log and continue?
 			}
 		}
 	}
 
-	return rawDeleted, availableDeleted, nil
+	return rawDeleted, availableDeleted, sizeDeleted, nil
 }
 
 // delete removes object indexes from the metabase. Counts the references
@@ -137,8 +165,8 @@ func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) (uint64, uint64, er
 // The first return value indicates if an object has been removed. (removing a
 // non-exist object is error-free). The second return value indicates if an
 // object was available before the removal (for calculating the logical object
-// counter).
-func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter, currEpoch uint64) (bool, bool, error) {
+// counter). The third return value is a payload size of the removed object.
+func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter, currEpoch uint64) (bool, bool, uint64, error) {
 	key := make([]byte, addressKeySize)
 	addrKey := addressKey(addr, key)
 	garbageBKT := tx.Bucket(garbageBucketName)
@@ -150,7 +178,7 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 	if garbageBKT != nil {
 		err := garbageBKT.Delete(addrKey)
 		if err != nil {
-			return false, false, fmt.Errorf("could not remove from garbage bucket: %w", err)
+			return false, false, 0, fmt.Errorf("could not remove from garbage bucket: %w", err)
 		}
 	}
 
@@ -158,10 +186,10 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 	obj, err := db.get(tx, addr, key, false, true, currEpoch)
 	if err != nil {
 		if errors.As(err, new(apistatus.ObjectNotFound)) {
-			return false, false, nil
+			return false, false, 0, nil
 		}
 
-		return false, false, err
+		return false, false, 0, err
 	}
 
 	// if object is an only link to a parent, then remove parent
@@ -187,10 +215,10 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 	// remove object
 	err = db.deleteObject(tx, obj, false)
 	if err != nil {
-		return false, false, fmt.Errorf("could not remove object: %w", err)
+		return false, false, 0, fmt.Errorf("could not remove object: %w", err)
 	}
 
-	return true, removeAvailableObject, nil
+	return true, removeAvailableObject, obj.PayloadSize(), nil
 }
 
 func (db *DB) deleteObject(
@@ -319,6 +347,10 @@ func delUniqueIndexes(tx *bbolt.Tx, obj *objectSDK.Object, isParent bool) error
 			name: bucketName,
 			key:  objKey,
 		})
+		delUniqueIndexItem(tx, namedBucketItem{
+			name: shortHeaderBucketName(cnr, bucketName),
+			key:  objKey,
+		})
 	} else {
 		delUniqueIndexItem(tx, namedBucketItem{
 			name: parentBucketName(cnr, bucketName),
@@ -129,6 +129,24 @@ func TestExpiredObject(t *testing.T) {
 	})
 }
 
+func TestDB_DeleteRemovedSize(t *testing.T) {
+	db := newDB(t)
+
+	obj1 := generateObject(t)
+	obj2 := generateObject(t)
+
+	require.NoError(t, putBig(db, obj1))
+	require.NoError(t, putBig(db, obj2))
+
+	var prm meta.DeletePrm
+	prm.SetAddresses(object.AddressOf(obj1), object.AddressOf(obj2), oidtest.Address())
+
+	res, err := db.Delete(prm)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.RawObjectsRemoved())
+	require.Equal(t, obj1.PayloadSize()+obj2.PayloadSize(), res.RemovedPayloadSize())
+}
+
 func metaDelete(db *meta.DB, addrs ...oid.Address) error {
 	var deletePrm meta.DeletePrm
 	deletePrm.SetAddresses(addrs...)
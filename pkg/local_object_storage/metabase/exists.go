@@ -16,7 +16,8 @@ import (
 
 // ExistsPrm groups the parameters of Exists operation.
 type ExistsPrm struct {
-	addr oid.Address
+	addr         oid.Address
+	ignoreGCMark bool
 }
 
 // ExistsRes groups the resulting values of Exists operation.
@@ -31,6 +32,12 @@ func (p *ExistsPrm) SetAddress(addr oid.Address) {
 	p.addr = addr
 }
 
+// SetIgnoreGCMark is an Exists option to report the object removed with
+// a tombstone or marked with GC as existing one.
+func (p *ExistsPrm) SetIgnoreGCMark(ignore bool) {
+	p.ignoreGCMark = ignore
+}
+
 // Exists returns the fact that the object is in the metabase.
 func (p ExistsRes) Exists() bool {
 	return p.exists
@@ -45,10 +52,18 @@ func (db *DB) Exists(prm ExistsPrm) (res ExistsRes, err error) {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
 
 	err = db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.exists, err = db.exists(tx, prm.addr, currEpoch)
+		if prm.ignoreGCMark {
+			if isExpired(tx, prm.addr, currEpoch) {
+				return object.ErrObjectIsExpired
+			}
+
+			res.exists, err = db.objectExists(tx, prm.addr)
+		} else {
+			res.exists, err = db.exists(tx, prm.addr, currEpoch)
+		}
 
 		return err
 	})
@@ -71,6 +86,12 @@ func (db *DB) exists(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) (exists b
 		return false, object.ErrObjectIsExpired
 	}
 
+	return db.objectExists(tx, addr)
+}
+
+// objectExists checks whether the object is in the metabase
+// regardless of its status.
+func (db *DB) objectExists(tx *bbolt.Tx, addr oid.Address) (bool, error) {
 	objKey := objectKey(addr.Object(), make([]byte, objectKeySize))
 
 	cnr := addr.Container()
@@ -101,13 +122,23 @@ func (db *DB) exists(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) (exists b
 //   - 2 if object is covered with tombstone;
 //   - 3 if object is expired.
 func objectStatus(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) uint8 {
+	if isExpired(tx, addr, currEpoch) {
+		return 3
+	}
+
+	graveyardBkt := tx.Bucket(graveyardBucketName)
+	garbageBkt := tx.Bucket(garbageBucketName)
+	addrKey := addressKey(addr, make([]byte, addressKeySize))
+	return inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt)
+}
+
+// isExpired checks whether the object expires in the current epoch.
+func isExpired(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) bool {
 	// we check only if the object is expired in the current
 	// epoch since it is considered the only corner case: the
 	// GC is expected to collect all the objects that have
 	// expired previously for less than the one epoch duration
 
-	var expired bool
-
 	// bucket with objects that have expiration attr
 	attrKey := make([]byte, bucketKeySize+len(objectV2.SysAttributeExpEpoch))
 	expirationBucket := tx.Bucket(attributeBucketName(addr.Container(), objectV2.SysAttributeExpEpoch, attrKey))
@@ -117,19 +148,12 @@ func objectStatus(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) uint8 {
 		if prevEpochBkt != nil {
 			rawOID := objectKey(addr.Object(), make([]byte, objectKeySize))
 			if prevEpochBkt.Get(rawOID) != nil {
-				expired = true
+				return true
 			}
 		}
 	}
 
-	if expired {
-		return 3
-	}
-
-	graveyardBkt := tx.Bucket(graveyardBucketName)
-	garbageBkt := tx.Bucket(garbageBucketName)
-	addrKey := addressKey(addr, make([]byte, addressKeySize))
-	return inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt)
+	return false
 }
 
 func inGraveyardWithKey(addrKey []byte, graveyard, garbageBCK *bbolt.Bucket) uint8 {
@@ -0,0 +1,105 @@
+package meta
+
+import (
+	"context"
+	"errors"
+
+	"go.etcd.io/bbolt"
+)
+
+// ExpiredCursor is a position in the expiration index of the DB.
+// See IterateExpiredAfter.
+type ExpiredCursor struct {
+	bucket []byte
+	expKey []byte
+	id     []byte
+}
+
+var (
+	expiredCursorKey = []byte("expired_objects_cursor")
+
+	errInvalidExpiredCursor = errors.New("invalid expired objects cursor")
+)
+
+// Marshal encodes the cursor into a binary form.
+func (c ExpiredCursor) Marshal() []byte {
+	res := make([]byte, 0, 3+len(c.bucket)+len(c.expKey)+len(c.id))
+
+	for _, field := range [][]byte{c.bucket, c.expKey, c.id} {
+		res = append(res, byte(len(field)))
+		res = append(res, field...)
+	}
+
+	return res
+}
+
+// Unmarshal decodes the cursor from the binary form produced by Marshal.
+func (c *ExpiredCursor) Unmarshal(data []byte) error {
+	var fields [3][]byte
+
+	for i := range fields {
+		if len(data) == 0 || len(data) < 1+int(data[0]) {
+			return errInvalidExpiredCursor
+		}
+
+		fields[i] = append([]byte(nil), data[1:1+data[0]]...)
+		data = data[1+data[0]:]
+	}
+
+	if len(data) != 0 || len(fields[0]) == 0 || fields[0][0] != userAttributePrefix {
+		return errInvalidExpiredCursor
+	}
+
+	c.bucket, c.expKey, c.id = fields[0], fields[1], fields[2]
+
+	return nil
+}
+
+// ReadExpiredCursor reads the position in the expiration index the GC
+// stopped at. If the cursor is missing, returns nil, nil.
+func (db *DB) ReadExpiredCursor() (*ExpiredCursor, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var c *ExpiredCursor
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		v := b.Get(expiredCursorKey)
+		if v == nil {
+			return nil
+		}
+
+		c = new(ExpiredCursor)
+		return c.Unmarshal(v)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// WriteExpiredCursor writes the position in the expiration index the GC
+// stopped at. Nil cursor removes it.
+func (db *DB) WriteExpiredCursor(c *ExpiredCursor) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	return db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return err
+		}
+
+		if c == nil {
+			return b.Delete(expiredCursorKey)
+		}
+
+		return b.Put(expiredCursorKey, c.Marshal())
+	})
+}
@@ -0,0 +1,45 @@
+package meta_test
+
+import (
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ExpiredCursor(t *testing.T) {
+	db := newDB(t)
+
+	c, err := db.ReadExpiredCursor()
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	putWithExpiration(t, db, object.TypeRegular, 1)
+
+	expected, err := db.IterateExpiredAfter(10, nil, func(*meta.ExpiredObject) error {
+		return meta.ErrInterruptIterator
+	})
+	require.NoError(t, err)
+	require.NotNil(t, expected)
+
+	require.NoError(t, db.WriteExpiredCursor(expected))
+
+	c, err = db.ReadExpiredCursor()
+	require.NoError(t, err)
+	require.Equal(t, expected, c)
+
+	var decoded meta.ExpiredCursor
+	require.NoError(t, decoded.Unmarshal(expected.Marshal()))
+	require.Equal(t, *expected, decoded)
+
+	for _, data := range [][]byte{nil, {1}, {0, 0, 0}, append(expected.Marshal(), 0)} {
+		require.Error(t, new(meta.ExpiredCursor).Unmarshal(data))
+	}
+
+	require.NoError(t, db.WriteExpiredCursor(nil))
+
+	c, err = db.ReadExpiredCursor()
+	require.NoError(t, err)
+	require.Nil(t, c)
+}
@@ -13,8 +13,10 @@ import (
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
-	addr oid.Address
-	raw  bool
+	addr         oid.Address
+	raw          bool
+	short        bool
+	ignoreGCMark bool
 }
 
 // GetRes groups the resulting values of Get operation.
@@ -36,6 +38,19 @@ func (p *GetPrm) SetRaw(raw bool) {
 	p.raw = raw
 }
 
+// SetShortHeader is a Get option to request only the fields of the short
+// object header: version, owner, type, creation epoch, payload length
+// and payload hashes. Other header fields can be missing in the result.
+func (p *GetPrm) SetShortHeader(short bool) {
+	p.short = short
+}
+
+// SetIgnoreGCMark is a Get option to return the header of the object
+// removed with a tombstone or marked with GC.
+func (p *GetPrm) SetIgnoreGCMark(ignore bool) {
+	p.ignoreGCMark = ignore
+}
+
 // Header returns the requested object header.
 func (r GetRes) Header() *objectSDK.Object {
 	return r.hdr
@@ -50,11 +65,23 @@ func (db *DB) Get(prm GetPrm) (res GetRes, err error) {
 	db.modeMtx.Lock()
 	defer db.modeMtx.Unlock()
 
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
 
 	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		if prm.ignoreGCMark && isExpired(tx, prm.addr, currEpoch) {
+			return object.ErrObjectIsExpired
+		}
+
 		key := make([]byte, addressKeySize)
-		res.hdr, err = db.get(tx, prm.addr, key, true, prm.raw, currEpoch)
+		if prm.short {
+			res.hdr, err = db.getShortHeader(tx, prm.addr, key, !prm.ignoreGCMark, prm.raw, currEpoch)
+		} else {
+			res.hdr, err = db.get(tx, prm.addr, key, !prm.ignoreGCMark, prm.raw, currEpoch)
+			if err == nil && len(res.hdr.Payload()) != 0 {
+				// object is stored inline
+				res.hdr = res.hdr.CutPayload()
+			}
+		}
 
 		return err
 	})
@@ -64,17 +91,8 @@ func (db *DB) Get(prm GetPrm) (res GetRes, err error) {
 
 func (db *DB) get(tx *bbolt.Tx, addr oid.Address, key []byte, checkStatus, raw bool, currEpoch uint64) (*objectSDK.Object, error) {
 	if checkStatus {
-		switch objectStatus(tx, addr, currEpoch) {
-		case 1:
-			var errNotFound apistatus.ObjectNotFound
-
-			return nil, errNotFound
-		case 2:
-			var errRemoved apistatus.ObjectAlreadyRemoved
-
-			return nil, errRemoved
-		case 3:
-			return nil, object.ErrObjectIsExpired
+		if err := checkObjectStatus(tx, addr, currEpoch); err != nil {
+			return nil, err
 		}
 	}
 
@@ -111,6 +129,24 @@ func (db *DB) get(tx *bbolt.Tx, addr oid.Address, key []byte, checkStatus, raw b
 	return getVirtualObject(tx, cnr, key, raw)
 }
 
+// checkObjectStatus returns an error if the object is not available.
+func checkObjectStatus(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) error {
+	switch objectStatus(tx, addr, currEpoch) {
+	case 1:
+		var errNotFound apistatus.ObjectNotFound
+
+		return errNotFound
+	case 2:
+		var errRemoved apistatus.ObjectAlreadyRemoved
+
+		return errRemoved
+	case 3:
+		return object.ErrObjectIsExpired
+	}
+
+	return nil
+}
+
 func getFromBucket(tx *bbolt.Tx, name, key []byte) []byte {
 	bkt := tx.Bucket(name)
 	if bkt == nil {
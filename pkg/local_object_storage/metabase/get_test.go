@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -136,6 +137,45 @@ func TestDB_Get(t *testing.T) {
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
+	t.Run("ignore GC mark", func(t *testing.T) {
+		removed := generateObject(t)
+		garbage := generateObject(t)
+
+		require.NoError(t, putBig(db, removed))
+		require.NoError(t, putBig(db, garbage))
+
+		require.NoError(t, metaInhume(db, object.AddressOf(removed), oidtest.Address()))
+
+		var inhumePrm meta.InhumePrm
+		inhumePrm.SetAddresses(object.AddressOf(garbage))
+		inhumePrm.SetGCMark()
+
+		_, err := db.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		for _, obj := range []*objectSDK.Object{removed, garbage} {
+			var prm meta.GetPrm
+			prm.SetAddress(object.AddressOf(obj))
+
+			_, err = db.Get(prm)
+			require.Error(t, err)
+
+			prm.SetIgnoreGCMark(true)
+
+			res, err := db.Get(prm)
+			require.NoError(t, err)
+			require.True(t, binaryEqual(obj.CutPayload(), res.Header()))
+
+			var existsPrm meta.ExistsPrm
+			existsPrm.SetAddress(object.AddressOf(obj))
+			existsPrm.SetIgnoreGCMark(true)
+
+			exRes, err := db.Exists(existsPrm)
+			require.NoError(t, err)
+			require.True(t, exRes.Exists())
+		}
+	})
+
 	t.Run("expired object", func(t *testing.T) {
 		checkExpiredObjects(t, db, func(exp, nonExp *objectSDK.Object) {
 			gotExp, err := metaGet(db, object.AddressOf(exp), false)
@@ -149,6 +189,90 @@ func TestDB_Get(t *testing.T) {
 	})
 }
 
+func TestDB_GetShortHeader(t *testing.T) {
+	db := newDB(t, meta.WithEpochState(epochState{currEpoch}))
+
+	checkShort := func(t *testing.T, exp, got *objectSDK.Object) {
+		require.Equal(t, object.AddressOf(exp), object.AddressOf(got))
+		require.Equal(t, exp.Version(), got.Version())
+		require.Equal(t, exp.OwnerID(), got.OwnerID())
+		require.Equal(t, exp.Type(), got.Type())
+		require.Equal(t, exp.CreationEpoch(), got.CreationEpoch())
+		require.Equal(t, exp.PayloadSize(), got.PayloadSize())
+
+		expCS, _ := exp.PayloadChecksum()
+		gotCS, _ := got.PayloadChecksum()
+		require.Equal(t, expCS, gotCS)
+
+		expCS, _ = exp.PayloadHomomorphicHash()
+		gotCS, _ = got.PayloadHomomorphicHash()
+		require.Equal(t, expCS, gotCS)
+	}
+
+	t.Run("regular object", func(t *testing.T) {
+		raw := generateObject(t)
+		raw.SetCreationEpoch(currEpoch - 1)
+		addAttribute(raw, "foo", "bar")
+
+		require.NoError(t, putBig(db, raw))
+
+		newObj, err := metaGetShort(db, object.AddressOf(raw), false)
+		require.NoError(t, err)
+		checkShort(t, raw, newObj)
+		require.Empty(t, newObj.Attributes())
+
+		require.NoError(t, metaDelete(db, object.AddressOf(raw)))
+
+		_, err = metaGetShort(db, object.AddressOf(raw), false)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	t.Run("virtual object", func(t *testing.T) {
+		cnr := cidtest.ID()
+
+		parent := generateObjectWithCID(t, cnr)
+		addAttribute(parent, "foo", "bar")
+
+		child := generateObjectWithCID(t, cnr)
+		child.SetParent(parent)
+		idParent, _ := parent.ID()
+		child.SetParentID(idParent)
+		child.SetSplitID(objectSDK.NewSplitID())
+
+		require.NoError(t, putBig(db, child))
+
+		_, err := metaGetShort(db, object.AddressOf(parent), true)
+		require.ErrorAs(t, err, new(*objectSDK.SplitInfoError))
+
+		newParent, err := metaGetShort(db, object.AddressOf(parent), false)
+		require.NoError(t, err)
+		checkShort(t, parent, newParent)
+
+		newChild, err := metaGetShort(db, object.AddressOf(child), true)
+		require.NoError(t, err)
+		checkShort(t, child, newChild)
+	})
+
+	t.Run("removed object", func(t *testing.T) {
+		obj := oidtest.Address()
+
+		require.NoError(t, metaInhume(db, obj, oidtest.Address()))
+		_, err := metaGetShort(db, obj, false)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("expired object", func(t *testing.T) {
+		checkExpiredObjects(t, db, func(exp, nonExp *objectSDK.Object) {
+			_, err := metaGetShort(db, object.AddressOf(exp), false)
+			require.ErrorIs(t, err, object.ErrObjectIsExpired)
+
+			gotNonExp, err := metaGetShort(db, object.AddressOf(nonExp), false)
+			require.NoError(t, err)
+			checkShort(t, nonExp, gotNonExp)
+		})
+	})
+}
+
 // binary equal is used when object contains empty lists in the structure and
 // requre.Equal fails on comparing <nil> and []{} lists.
 func binaryEqual(a, b *objectSDK.Object) bool {
@@ -174,19 +298,25 @@ func BenchmarkGet(b *testing.B) {
 
 	for _, num := range numOfObjects {
 		b.Run(fmt.Sprintf("%d objects", num), func(b *testing.B) {
-			benchmarkGet(b, num)
+			benchmarkGet(b, num, false)
+		})
+		b.Run(fmt.Sprintf("%d objects, short header", num), func(b *testing.B) {
+			benchmarkGet(b, num, true)
 		})
 	}
 }
 
 var obj *objectSDK.Object
 
-func benchmarkGet(b *testing.B, numOfObj int) {
+func benchmarkGet(b *testing.B, numOfObj int, short bool) {
 	db := newDB(b)
 	addrs := make([]oid.Address, 0, numOfObj)
 
 	for i := 0; i < numOfObj; i++ {
 		raw := generateObject(b)
+		for j := 0; j < 10; j++ {
+			addAttribute(raw, "key"+strconv.Itoa(j), "value"+strconv.Itoa(j))
+		}
 		addrs = append(addrs, object.AddressOf(raw))
 
 		err := putBig(db, raw)
@@ -202,6 +332,7 @@ func benchmarkGet(b *testing.B, numOfObj int) {
 
 	var getPrm meta.GetPrm
 	getPrm.SetAddress(addrs[len(addrs)/2])
+	getPrm.SetShortHeader(short)
 
 	for i := 0; i < b.N; i++ {
 		for _, addr := range addrs {
@@ -224,3 +355,13 @@ func metaGet(db *meta.DB, addr oid.Address, raw bool) (*objectSDK.Object, error)
 	res, err := db.Get(prm)
 	return res.Header(), err
 }
+
+func metaGetShort(db *meta.DB, addr oid.Address, raw bool) (*objectSDK.Object, error) {
+	var prm meta.GetPrm
+	prm.SetAddress(addr)
+	prm.SetRaw(raw)
+	prm.SetShortHeader(true)
+
+	res, err := db.Get(prm)
+	return res.Header(), err
+}
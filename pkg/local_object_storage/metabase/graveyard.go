@@ -2,6 +2,7 @@ package meta
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 
@@ -224,7 +225,7 @@ func graveFromKV(k, v []byte) (res TombstonedObject, err error) {
 func (db *DB) DropGraves(tss []TombstonedObject) error {
 	buf := make([]byte, addressKeySize)
 
-	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+	return db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
 		bkt := tx.Bucket(graveyardBucketName)
 		if bkt == nil {
 			return nil
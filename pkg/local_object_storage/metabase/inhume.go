@@ -2,6 +2,7 @@ package meta
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 
@@ -20,6 +21,9 @@ type InhumePrm struct {
 	lockObjectHandling bool
 
 	forceRemoval bool
+
+	priority Priority
+	ctx      context.Context
 }
 
 // InhumeRes encapsulates results of Inhume operation.
@@ -74,6 +78,18 @@ func (p *InhumePrm) SetForceGCMark() {
 	p.forceRemoval = true
 }
 
+// SetPriority is an Inhume option to set the priority of the operation in the
+// queue of the write transactions. PriorityHigh is used by default.
+func (p *InhumePrm) SetPriority(priority Priority) {
+	p.priority = priority
+}
+
+// SetContext is an Inhume option to set the context which aborts waiting for
+// the transaction limit (see WithTxLimit) when done.
+func (p *InhumePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 var errBreakBucketForEach = errors.New("bucket ForEach break")
 
 // ErrLockObjectRemoval is returned when inhume operation is being
@@ -92,10 +108,10 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
 	var inhumed uint64
 
-	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+	err = db.update(prm.ctx, prm.priority, func(tx *bbolt.Tx) error {
 		garbageBKT := tx.Bucket(garbageBucketName)
 		graveyardBKT := tx.Bucket(graveyardBucketName)
 
@@ -0,0 +1,319 @@
+package meta
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// inlineStorageID is a storage ID of the objects stored inline, i.e. with
+// the payload embedded into the metabase record.
+var inlineStorageID = []byte("inline")
+
+// IsInlineStorageID checks whether the storage ID belongs to the object
+// stored inline in the metabase (see PutPrm.SetInline).
+func IsInlineStorageID(id []byte) bool {
+	return bytes.Equal(id, inlineStorageID)
+}
+
+// GetInlinedPrm groups the parameters of GetInlined operation.
+type GetInlinedPrm struct {
+	addr oid.Address
+}
+
+// GetInlinedRes groups the resulting values of GetInlined operation.
+type GetInlinedRes struct {
+	obj *objectSDK.Object
+	id  []byte
+}
+
+// SetAddress is a GetInlined option to set the address of the requested object.
+//
+// Option is required.
+func (p *GetInlinedPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// Object returns the object stored inline. Nil if the object is not stored
+// inline, e.g. it has been moved to the blobstor.
+func (r GetInlinedRes) Object() *objectSDK.Object {
+	return r.obj
+}
+
+// StorageID returns the storage ID of the object if it is not stored inline.
+func (r GetInlinedRes) StorageID() []byte {
+	return r.id
+}
+
+// GetInlined reads the object stored inline in the metabase together with
+// its payload. The status of the object is not checked.
+//
+// If the object is not stored inline, only its storage ID is returned.
+func (db *DB) GetInlined(prm GetInlinedPrm) (res GetInlinedRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return res, errNotOpened
+	}
+
+	currEpoch := db.currentEpoch()
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.id, err = db.storageID(tx, prm.addr)
+		if err != nil || !IsInlineStorageID(res.id) {
+			return err
+		}
+
+		res.id = nil
+		res.obj, err = db.get(tx, prm.addr, make([]byte, addressKeySize), false, true, currEpoch)
+
+		return err
+	})
+
+	return
+}
+
+// DeinlinePrm groups the parameters of Deinline operation.
+type DeinlinePrm struct {
+	addr     oid.Address
+	id       []byte
+	priority Priority
+	ctx      context.Context
+}
+
+// DeinlineRes groups the resulting values of Deinline operation.
+type DeinlineRes struct{}
+
+// SetAddress is a Deinline option to set the address of the object.
+//
+// Option is required.
+func (p *DeinlinePrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// SetStorageID is a Deinline option to set the storage ID of the object
+// written to the blobstor.
+func (p *DeinlinePrm) SetStorageID(id []byte) {
+	p.id = id
+}
+
+// SetPriority is a Deinline option to set the priority of the operation in the
+// queue of the write transactions. PriorityHigh is used by default.
+func (p *DeinlinePrm) SetPriority(priority Priority) {
+	p.priority = priority
+}
+
+// SetContext is a Deinline option to set the context which aborts waiting for
+// the transaction limit (see WithTxLimit) when done.
+func (p *DeinlinePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
+// Deinline drops the payload from the record of the object stored inline
+// and saves the storage ID of the object written to the blobstor. Does
+// nothing if the object is not stored inline.
+func (db *DB) Deinline(prm DeinlinePrm) (res DeinlineRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return res, errNotOpened
+	}
+
+	err = db.batch(prm.ctx, prm.priority, func(tx *bbolt.Tx) error {
+		id, err := db.storageID(tx, prm.addr)
+		if err != nil || !IsInlineStorageID(id) {
+			return err
+		}
+
+		bkt, key := objectRecordBucket(tx, prm.addr)
+		if bkt != nil {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(bkt.Get(key)); err != nil {
+				return fmt.Errorf("can't unmarshal inline object: %w", err)
+			}
+
+			data, err := obj.CutPayload().Marshal()
+			if err != nil {
+				return fmt.Errorf("can't marshal object header: %w", err)
+			}
+
+			if err := bkt.Put(key, data); err != nil {
+				return err
+			}
+		}
+
+		return updateStorageID(tx, prm.addr, prm.id)
+	})
+
+	return
+}
+
+// inlineExisting stores the payload of the object saved without it, e.g.
+// put to the write-cache, in the metabase record. The objects written to
+// the blobstor are left as is.
+func (db *DB) inlineExisting(tx *bbolt.Tx, obj *objectSDK.Object) error {
+	addr := object.AddressOf(obj)
+
+	id, err := db.storageID(tx, addr)
+	if err != nil {
+		return err
+	}
+	if id != nil && !IsInlineStorageID(id) {
+		return nil
+	}
+
+	bkt, key := objectRecordBucket(tx, addr)
+	if bkt == nil {
+		return nil
+	}
+
+	data, err := obj.Marshal()
+	if err != nil {
+		return fmt.Errorf("can't marshal object: %w", err)
+	}
+
+	if err := bkt.Put(key, data); err != nil {
+		return err
+	}
+
+	return updateStorageID(tx, addr, inlineStorageID)
+}
+
+// objectRecordBucket returns the bucket holding the record of the object
+// and the key of the record. Nil bucket is returned if there is no record.
+func objectRecordBucket(tx *bbolt.Tx, addr oid.Address) (*bbolt.Bucket, []byte) {
+	cnr := addr.Container()
+	key := objectKey(addr.Object(), make([]byte, objectKeySize))
+
+	for _, bucketName := range [][]byte{
+		primaryBucketName(cnr, make([]byte, bucketKeySize)),
+		tombstoneBucketName(cnr, make([]byte, bucketKeySize)),
+		storageGroupBucketName(cnr, make([]byte, bucketKeySize)),
+		bucketNameLockers(cnr, make([]byte, bucketKeySize)),
+	} {
+		bkt := tx.Bucket(bucketName)
+		if bkt != nil && bkt.Get(key) != nil {
+			return bkt, key
+		}
+	}
+
+	return nil, nil
+}
+
+// ListInlined returns the addresses of at most count objects stored inline
+// in the metabase. Zero count means no limit.
+func (db *DB) ListInlined(count int) ([]oid.Address, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return nil, errNotOpened
+	}
+
+	var res []oid.Address
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var err error
+		res, err = listInlined(tx, count)
+		return err
+	})
+
+	return res, err
+}
+
+// HasInlined checks whether the metabase has been storing the objects inline
+// since the last ResetInlineVersion call.
+func (db *DB) HasInlined() (bool, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return false, errNotOpened
+	}
+
+	var res bool
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		res = storedVersion(tx) == inlineVersion
+		return nil
+	})
+
+	return res, err
+}
+
+// ResetInlineVersion sets the metabase version back to the one not supporting
+// the inline objects if none of the objects are stored inline anymore, so the
+// metabase can be opened by the code not supporting them again. Does nothing
+// if the metabase is opened in read-only mode.
+func (db *DB) ResetInlineVersion() error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return errNotOpened
+	}
+
+	if db.boltDB.IsReadOnly() {
+		return nil
+	}
+
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if storedVersion(tx) != inlineVersion {
+			return nil
+		}
+
+		addrs, err := listInlined(tx, 1)
+		if err != nil || len(addrs) != 0 {
+			return err
+		}
+
+		return updateVersion(tx, version)
+	})
+}
+
+func listInlined(tx *bbolt.Tx, count int) ([]oid.Address, error) {
+	var (
+		res  []oid.Address
+		cnr  cid.ID
+		obj  oid.ID
+		addr oid.Address
+	)
+
+	err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+		rawCID, prefix := parseContainerIDWithPrefix(&cnr, name)
+		if len(rawCID) == 0 || prefix != smallPrefix {
+			return nil
+		}
+
+		addr.SetContainer(cnr)
+
+		return b.ForEach(func(k, v []byte) error {
+			if !IsInlineStorageID(v) || obj.Decode(k) != nil {
+				return nil
+			}
+
+			addr.SetObject(obj)
+			res = append(res, addr)
+
+			if count > 0 && len(res) == count {
+				return ErrInterruptIterator
+			}
+
+			return nil
+		})
+	})
+	if err != nil && !errors.Is(err, ErrInterruptIterator) {
+		return nil, err
+	}
+
+	return res, nil
+}
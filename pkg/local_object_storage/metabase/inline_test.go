@@ -0,0 +1,199 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Inline(t *testing.T) {
+	db := newDB(t)
+
+	obj := generateObject(t)
+	obj.SetPayload([]byte("inline payload"))
+	addr := object.AddressOf(obj)
+
+	require.NoError(t, metaPutInline(db, obj))
+
+	exists, err := metaExists(db, addr)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	id, err := metaStorageID(db, addr)
+	require.NoError(t, err)
+	require.True(t, meta.IsInlineStorageID(id))
+
+	hdr, err := metaGet(db, addr, false)
+	require.NoError(t, err)
+	require.Empty(t, hdr.Payload())
+	require.Equal(t, obj.CutPayload(), hdr)
+
+	inlined, err := metaGetInlined(db, addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, inlined.Object())
+
+	list, err := db.ListInlined(0)
+	require.NoError(t, err)
+	require.Equal(t, []oid.Address{addr}, list)
+
+	t.Run("repeated put", func(t *testing.T) {
+		require.NoError(t, metaPutInline(db, obj))
+
+		inlined, err := metaGetInlined(db, addr)
+		require.NoError(t, err)
+		require.Equal(t, obj, inlined.Object())
+	})
+
+	t.Run("deinline", func(t *testing.T) {
+		other := generateObject(t)
+		require.NoError(t, metaPutInline(db, other))
+
+		otherAddr := object.AddressOf(other)
+		storageID := []byte{1, 2, 3}
+
+		var prm meta.DeinlinePrm
+		prm.SetAddress(otherAddr)
+		prm.SetStorageID(storageID)
+
+		_, err := db.Deinline(prm)
+		require.NoError(t, err)
+
+		id, err := metaStorageID(db, otherAddr)
+		require.NoError(t, err)
+		require.Equal(t, storageID, id)
+
+		inlined, err := metaGetInlined(db, otherAddr)
+		require.NoError(t, err)
+		require.Nil(t, inlined.Object())
+		require.Equal(t, storageID, inlined.StorageID())
+
+		hdr, err := metaGet(db, otherAddr, false)
+		require.NoError(t, err)
+		require.Equal(t, other.CutPayload(), hdr)
+
+		list, err := db.ListInlined(0)
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{addr}, list)
+
+		// nothing to do for the object stored in the blobstor
+		_, err = db.Deinline(prm)
+		require.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, metaDelete(db, addr))
+
+		_, err := metaGet(db, addr, false)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+		list, err := db.ListInlined(0)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
+
+func TestDB_InlineExisting(t *testing.T) {
+	db := newDB(t)
+
+	cached := generateObject(t)
+	cachedAddr := object.AddressOf(cached)
+	require.NoError(t, putBig(db, cached))
+
+	stored := generateObject(t)
+	storedAddr := object.AddressOf(stored)
+	storageID := []byte{1, 2, 3}
+	require.NoError(t, metaPut(db, stored, storageID))
+
+	require.NoError(t, metaPutInline(db, cached))
+	require.NoError(t, metaPutInline(db, stored))
+
+	inlined, err := metaGetInlined(db, cachedAddr)
+	require.NoError(t, err)
+	require.Equal(t, cached, inlined.Object())
+
+	inlined, err = metaGetInlined(db, storedAddr)
+	require.NoError(t, err)
+	require.Nil(t, inlined.Object())
+	require.Equal(t, storageID, inlined.StorageID())
+}
+
+func TestDB_InlineVersion(t *testing.T) {
+	db := newDB(t)
+
+	has, err := db.HasInlined()
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, putBig(db, generateObject(t)))
+
+	has, err = db.HasInlined()
+	require.NoError(t, err)
+	require.False(t, has)
+
+	obj := generateObject(t)
+	require.NoError(t, metaPutInline(db, obj))
+
+	has, err = db.HasInlined()
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the object is still stored inline
+	require.NoError(t, db.ResetInlineVersion())
+
+	has, err = db.HasInlined()
+	require.NoError(t, err)
+	require.True(t, has)
+
+	var prm meta.DeinlinePrm
+	prm.SetAddress(object.AddressOf(obj))
+	prm.SetStorageID([]byte{1, 2, 3})
+
+	_, err = db.Deinline(prm)
+	require.NoError(t, err)
+
+	require.NoError(t, db.ResetInlineVersion())
+
+	has, err = db.HasInlined()
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDB_ListInlined(t *testing.T) {
+	db := newDB(t)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, metaPutInline(db, generateObject(t)))
+	}
+
+	require.NoError(t, putBig(db, generateObject(t)))
+
+	list, err := db.ListInlined(0)
+	require.NoError(t, err)
+	require.Len(t, list, 5)
+
+	list, err = db.ListInlined(3)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+}
+
+func metaPutInline(db *meta.DB, obj *objectSDK.Object) error {
+	var putPrm meta.PutPrm
+	putPrm.SetObject(obj)
+	putPrm.SetInline(true)
+
+	_, err := db.Put(putPrm)
+
+	return err
+}
+
+func metaGetInlined(db *meta.DB, addr oid.Address) (meta.GetInlinedRes, error) {
+	var prm meta.GetInlinedPrm
+	prm.SetAddress(addr)
+
+	return db.GetInlined(prm)
+}
@@ -1,10 +1,12 @@
 package meta
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -40,46 +42,112 @@ var ErrInterruptIterator = errors.New("iterator is interrupted")
 // relative to epoch. Locked objects are not included (do not confuse
 // with objects of type LOCK).
 //
+// The epoch is decreased by the expiration grace period, so the objects
+// are considered out of date the same way as on reading.
+//
 // If h returns ErrInterruptIterator, nil returns immediately.
 // Returns other errors of h directly.
 func (db *DB) IterateExpired(epoch uint64, h ExpiredObjectHandler) error {
-	return db.boltDB.View(func(tx *bbolt.Tx) error {
-		return db.iterateExpired(tx, epoch, h)
+	_, err := db.IterateExpiredAfter(epoch, nil, h)
+	return err
+}
+
+// IterateExpiredAfter is like IterateExpired, but starts the iteration right
+// after the position c in the expiration index. Nil c means iteration from
+// the beginning.
+//
+// Returns the position of the last object passed to h if h returns
+// ErrInterruptIterator, so the iteration can be continued later from it.
+// Nil position is returned if the index is exhausted.
+func (db *DB) IterateExpiredAfter(epoch uint64, c *ExpiredCursor, h ExpiredObjectHandler) (*ExpiredCursor, error) {
+	epoch = db.expirationEpoch(epoch)
+
+	var res *ExpiredCursor
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var err error
+		res, err = db.iterateExpired(tx, epoch, c, h)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
-func (db *DB) iterateExpired(tx *bbolt.Tx, epoch uint64, h ExpiredObjectHandler) error {
-	err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+func (db *DB) iterateExpired(tx *bbolt.Tx, epoch uint64, c *ExpiredCursor, h ExpiredObjectHandler) (*ExpiredCursor, error) {
+	var after ExpiredCursor
+	if c != nil {
+		after = *c
+	}
+
+	// the iteration is on the path to the start position until it leaves it
+	onPath := c != nil
+
+	rootCursor := tx.Cursor()
+	name, _ := rootCursor.Seek([]byte{userAttributePrefix})
+	if onPath {
+		name, _ = rootCursor.Seek(after.bucket)
+	}
+
+	for ; name != nil && name[0] == userAttributePrefix; name, _ = rootCursor.Next() {
+		onPath = onPath && bytes.Equal(name, after.bucket)
+
 		cidBytes := cidFromAttributeBucket(name, objectV2.SysAttributeExpEpoch)
 		if cidBytes == nil {
-			return nil
+			continue
+		}
+
+		b := tx.Bucket(name)
+		if b == nil {
+			continue
 		}
 
 		var cnrID cid.ID
 		err := cnrID.Decode(cidBytes)
 		if err != nil {
-			return fmt.Errorf("could not parse container ID of expired bucket: %w", err)
+			return nil, fmt.Errorf("could not parse container ID of expired bucket: %w", err)
 		}
 
-		return b.ForEach(func(expKey, _ []byte) error {
+		expCursor := b.Cursor()
+		expKey, _ := expCursor.First()
+		if onPath {
+			expKey, _ = expCursor.Seek(after.expKey)
+		}
+
+		for ; expKey != nil; expKey, _ = expCursor.Next() {
+			onPath = onPath && bytes.Equal(expKey, after.expKey)
+
 			bktExpired := b.Bucket(expKey)
 			if bktExpired == nil {
-				return nil
+				continue
 			}
 
 			expiresAfter, err := strconv.ParseUint(string(expKey), 10, 64)
 			if err != nil {
-				return fmt.Errorf("could not parse expiration epoch: %w", err)
+				return nil, fmt.Errorf("could not parse expiration epoch: %w", err)
 			} else if expiresAfter >= epoch {
-				return nil
+				continue
+			}
+
+			idCursor := bktExpired.Cursor()
+			idKey, _ := idCursor.First()
+			if onPath {
+				idKey, _ = idCursor.Seek(after.id)
+				if bytes.Equal(idKey, after.id) {
+					idKey, _ = idCursor.Next()
+				}
+
+				onPath = false
 			}
 
-			return bktExpired.ForEach(func(idKey, _ []byte) error {
+			for ; idKey != nil; idKey, _ = idCursor.Next() {
 				var id oid.ID
 
 				err = id.Decode(idKey)
 				if err != nil {
-					return fmt.Errorf("could not parse ID of expired object: %w", err)
+					return nil, fmt.Errorf("could not parse ID of expired object: %w", err)
 				}
 
 				// Ignore locked objects.
@@ -87,26 +155,33 @@ func (db *DB) iterateExpired(tx *bbolt.Tx, epoch uint64, h ExpiredObjectHandler)
 				// To slightly optimize performance we can check only REGULAR objects
 				// (only they can be locked), but it's more reliable.
 				if objectLocked(tx, cnrID, id) {
-					return nil
+					continue
 				}
 
 				var addr oid.Address
 				addr.SetContainer(cnrID)
 				addr.SetObject(id)
 
-				return h(&ExpiredObject{
+				err = h(&ExpiredObject{
 					typ:  firstIrregularObjectType(tx, cnrID, idKey),
 					addr: addr,
 				})
-			})
-		})
-	})
-
-	if errors.Is(err, ErrInterruptIterator) {
-		err = nil
+				if err != nil {
+					if errors.Is(err, ErrInterruptIterator) {
+						return &ExpiredCursor{
+							bucket: slice.Copy(name),
+							expKey: slice.Copy(expKey),
+							id:     slice.Copy(idKey),
+						}, nil
+					}
+
+					return nil, err
+				}
+			}
+		}
 	}
 
-	return err
+	return nil, nil
 }
 
 // IterateCoveredByTombstones iterates over all objects in DB which are covered
@@ -187,3 +262,69 @@ func iteratePhyObjects(tx *bbolt.Tx, f func(cid.ID, oid.ID) error) error {
 		})
 	})
 }
+
+// IterateAddresses passes to h the addresses of all the objects the DB has
+// information about: stored objects of all types, parents of the stored
+// child objects and objects in the graveyard. An address can be passed
+// more than once.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+func (db *DB) IterateAddresses(h func(oid.Address) error) error {
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var addr oid.Address
+		var cnr cid.ID
+		var obj oid.ID
+
+		err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			rawCID, prefix := parseContainerIDWithPrefix(&cnr, name)
+			if len(rawCID) == 0 {
+				return nil
+			}
+
+			switch prefix {
+			case primaryPrefix,
+				storageGroupPrefix,
+				lockersPrefix,
+				tombstonePrefix,
+				parentPrefix:
+			default:
+				return nil
+			}
+
+			addr.SetContainer(cnr)
+
+			return b.ForEach(func(k, _ []byte) error {
+				if obj.Decode(k) != nil {
+					return nil
+				}
+
+				addr.SetObject(obj)
+
+				return h(addr)
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		bktGraveyard := tx.Bucket(graveyardBucketName)
+		if bktGraveyard == nil {
+			return nil
+		}
+
+		return bktGraveyard.ForEach(func(k, _ []byte) error {
+			if decodeAddressFromKey(&addr, k) != nil {
+				return nil
+			}
+
+			return h(addr)
+		})
+	})
+
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return err
+}
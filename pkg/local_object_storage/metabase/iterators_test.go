@@ -55,6 +55,127 @@ func TestDB_IterateExpired(t *testing.T) {
 	require.Empty(t, mExpired)
 }
 
+func TestDB_IterateExpiredAfter(t *testing.T) {
+	db := newDB(t)
+
+	const (
+		epoch = 13
+		batch = 3
+	)
+
+	var expired []oid.Address
+
+	for i := 0; i < 10; i++ {
+		expired = append(expired, putWithExpiration(t, db, object.TypeRegular, uint64(epoch-1-i%3)))
+		putWithExpiration(t, db, object.TypeRegular, epoch)
+	}
+
+	var (
+		c         *meta.ExpiredCursor
+		collected []oid.Address
+		steps     int
+	)
+
+	for {
+		var n int
+
+		res, err := db.IterateExpiredAfter(epoch, c, func(exp *meta.ExpiredObject) error {
+			collected = append(collected, exp.Address())
+
+			n++
+			if n == batch {
+				return meta.ErrInterruptIterator
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		steps++
+
+		// the cursor survives the persisting
+		require.NoError(t, db.WriteExpiredCursor(res))
+
+		c, err = db.ReadExpiredCursor()
+		require.NoError(t, err)
+		require.Equal(t, res, c)
+
+		if c == nil {
+			break
+		}
+
+		require.Equal(t, batch, n)
+	}
+
+	require.Equal(t, (len(expired)+batch-1)/batch, steps)
+	require.Len(t, collected, len(expired))
+	require.ElementsMatch(t, expired, collected)
+
+	t.Run("removed object", func(t *testing.T) {
+		var first *meta.ExpiredObject
+
+		c, err := db.IterateExpiredAfter(epoch, nil, func(exp *meta.ExpiredObject) error {
+			first = exp
+			return meta.ErrInterruptIterator
+		})
+		require.NoError(t, err)
+		require.NotNil(t, c)
+
+		var prm meta.DeletePrm
+		prm.SetAddresses(first.Address())
+
+		_, err = db.Delete(prm)
+		require.NoError(t, err)
+
+		var rest []oid.Address
+
+		c, err = db.IterateExpiredAfter(epoch, c, func(exp *meta.ExpiredObject) error {
+			rest = append(rest, exp.Address())
+			return nil
+		})
+		require.NoError(t, err)
+		require.Nil(t, c)
+		require.Len(t, rest, len(expired)-1)
+		require.NotContains(t, rest, first.Address())
+	})
+}
+
+func TestDB_ExpirationGracePeriod(t *testing.T) {
+	const (
+		epoch = 15
+		grace = 2
+	)
+
+	db := newDB(t,
+		meta.WithEpochState(epochState{e: epoch}),
+		meta.WithExpirationGracePeriod(grace))
+
+	expired := putWithExpiration(t, db, object.TypeRegular, epoch-grace-1)
+	inGrace := putWithExpiration(t, db, object.TypeRegular, epoch-grace)
+	alive := putWithExpiration(t, db, object.TypeRegular, epoch)
+
+	_, err := metaGet(db, expired, false)
+	require.ErrorIs(t, err, object2.ErrObjectIsExpired)
+
+	for _, addr := range []oid.Address{inGrace, alive} {
+		_, err = metaGet(db, addr, false)
+		require.NoError(t, err)
+
+		exists, err := metaExists(db, addr)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+
+	var collected []oid.Address
+
+	err = db.IterateExpired(epoch, func(exp *meta.ExpiredObject) error {
+		collected = append(collected, exp.Address())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []oid.Address{expired}, collected)
+}
+
 func putWithExpiration(t *testing.T, db *meta.DB, typ object.Type, expiresAt uint64) oid.Address {
 	obj := generateObject(t)
 	obj.SetType(typ)
@@ -120,3 +241,50 @@ func TestDB_IterateCoveredByTombstones(t *testing.T) {
 	require.Len(t, handled, 2)
 	require.NotContains(t, handled, protectedLocked)
 }
+
+func TestDB_IterateAddresses(t *testing.T) {
+	db := newDB(t)
+
+	regular := generateObject(t)
+	lock := generateObject(t)
+	lock.SetType(object.TypeLock)
+
+	parent := generateObject(t)
+	child := generateObjectWithCID(t, object2.AddressOf(parent).Container())
+	child.SetParent(parent)
+	idParent, _ := parent.ID()
+	child.SetParentID(idParent)
+
+	for _, obj := range []*object.Object{regular, lock, child} {
+		require.NoError(t, putBig(db, obj))
+	}
+
+	removed := oidtest.Address()
+	require.NoError(t, metaInhume(db, removed, oidtest.Address()))
+
+	exp := map[oid.Address]struct{}{
+		object2.AddressOf(regular): {},
+		object2.AddressOf(lock):    {},
+		object2.AddressOf(child):   {},
+		object2.AddressOf(parent):  {},
+		removed:                    {},
+	}
+
+	got := make(map[oid.Address]struct{})
+	err := db.IterateAddresses(func(addr oid.Address) error {
+		got[addr] = struct{}{}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, exp, got)
+
+	t.Run("interrupt", func(t *testing.T) {
+		var n int
+		err := db.IterateAddresses(func(oid.Address) error {
+			n++
+			return meta.ErrInterruptIterator
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+}
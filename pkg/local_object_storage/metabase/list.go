@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"encoding/binary"
 	"errors"
 
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -13,16 +14,42 @@ import (
 // cursor. Use nil cursor object to start listing again.
 var ErrEndOfListing = errors.New("end of object listing")
 
+var errInvalidCursor = errors.New("invalid cursor")
+
 // Cursor is a type for continuous object listing.
 type Cursor struct {
 	bucketName     []byte
 	inBucketOffset []byte
 }
 
+// Marshal encodes the cursor into a binary form, so the listing
+// can be resumed later, see Unmarshal.
+func (c *Cursor) Marshal() []byte {
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(c.bucketName)+len(c.inBucketOffset))
+	n := binary.PutUvarint(data, uint64(len(c.bucketName)))
+	data = append(data[:n], c.bucketName...)
+	return append(data, c.inBucketOffset...)
+}
+
+// Unmarshal decodes the cursor from the binary form produced by Marshal.
+func (c *Cursor) Unmarshal(data []byte) error {
+	ln, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < ln {
+		return errInvalidCursor
+	}
+
+	data = data[n:]
+	c.bucketName = append([]byte{}, data[:ln]...)
+	c.inBucketOffset = append([]byte{}, data[ln:]...)
+
+	return nil
+}
+
 // ListPrm contains parameters for ListWithCursor operation.
 type ListPrm struct {
 	count  int
 	cursor *Cursor
+	cnr    *cid.ID
 }
 
 // SetCount sets maximum amount of addresses that ListWithCursor should return.
@@ -37,6 +64,11 @@ func (l *ListPrm) SetCursor(cursor *Cursor) {
 	l.cursor = cursor
 }
 
+// SetContainerID limits ListWithCursor to the objects of the container.
+func (l *ListPrm) SetContainerID(cnr cid.ID) {
+	l.cnr = &cnr
+}
+
 // ListRes contains values returned from ListWithCursor operation.
 type ListRes struct {
 	addrList []oid.Address
@@ -66,15 +98,21 @@ func (db *DB) ListWithCursor(prm ListPrm) (res ListRes, err error) {
 	result := make([]oid.Address, 0, prm.count)
 
 	err = db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, res.cursor, err = db.listWithCursor(tx, result, prm.count, prm.cursor)
+		res.addrList, res.cursor, err = db.listWithCursor(tx, result, prm.count, prm.cursor, prm.cnr)
 		return err
 	})
 
 	return res, err
 }
 
-func (db *DB) listWithCursor(tx *bbolt.Tx, result []oid.Address, count int, cursor *Cursor) ([]oid.Address, *Cursor, error) {
+func (db *DB) listWithCursor(tx *bbolt.Tx, result []oid.Address, count int, cursor *Cursor, cnr *cid.ID) ([]oid.Address, *Cursor, error) {
 	threshold := cursor == nil // threshold is a flag to ignore cursor
+	if !threshold {
+		// do not modify the cursor of the caller, it can be reused
+		c := *cursor
+		cursor = &c
+	}
+
 	var bucketName []byte
 
 	c := tx.Cursor()
@@ -94,7 +132,7 @@ func (db *DB) listWithCursor(tx *bbolt.Tx, result []oid.Address, count int, curs
 loop:
 	for ; name != nil; name, _ = c.Next() {
 		cidRaw, prefix := parseContainerIDWithPrefix(&containerID, name)
-		if cidRaw == nil {
+		if cidRaw == nil || cnr != nil && !containerID.Equals(*cnr) {
 			continue
 		}
 
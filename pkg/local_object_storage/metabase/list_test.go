@@ -216,6 +216,53 @@ func TestAddObjectDuringListingWithCursor(t *testing.T) {
 
 }
 
+func TestListWithCursorContainer(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	var expected []oid.Address
+	for i := 0; i < 5; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		if i%2 == 0 {
+			obj.SetType(objectSDK.TypeTombstone)
+		}
+
+		require.NoError(t, putBig(db, obj))
+		expected = append(expected, object.AddressOf(obj))
+
+		// objects of the other containers are not listed
+		require.NoError(t, putBig(db, generateObject(t)))
+	}
+
+	var (
+		got    []oid.Address
+		cursor *meta.Cursor
+	)
+
+	for {
+		var listPrm meta.ListPrm
+		listPrm.SetCount(1)
+		listPrm.SetCursor(cursor)
+		listPrm.SetContainerID(cnr)
+
+		res, err := db.ListWithCursor(listPrm)
+		if errors.Is(err, meta.ErrEndOfListing) {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, res.AddressList()...)
+
+		// the listing can be resumed from the encoded cursor
+		cursor = new(meta.Cursor)
+		require.NoError(t, cursor.Unmarshal(res.Cursor().Marshal()))
+	}
+
+	require.ElementsMatch(t, expected, got)
+
+	require.Error(t, new(meta.Cursor).Unmarshal([]byte{10, 1}))
+}
+
 func sortAddresses(addr []oid.Address) []oid.Address {
 	sort.Slice(addr, func(i, j int) bool {
 		return addr[i].EncodeToString() < addr[j].EncodeToString()
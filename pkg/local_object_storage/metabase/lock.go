@@ -2,8 +2,11 @@ package meta
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"strconv"
 
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -39,7 +42,7 @@ func (db *DB) Lock(cnr cid.ID, locker oid.ID, locked []oid.ID) error {
 	}
 	key := make([]byte, cidSize)
 
-	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+	return db.update(context.Background(), PriorityHigh, func(tx *bbolt.Tx) error {
 		if firstIrregularObjectType(tx, cnr, bucketKeysLocked...) != object.TypeRegular {
 			return apistatus.LockNonRegularObject{}
 		}
@@ -93,7 +96,7 @@ func (db *DB) Lock(cnr cid.ID, locker oid.ID, locked []oid.ID) error {
 
 // FreeLockedBy unlocks all objects in DB which are locked by lockers.
 func (db *DB) FreeLockedBy(lockers []oid.Address) error {
-	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+	return db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
 		var err error
 
 		for i := range lockers {
@@ -107,6 +110,177 @@ func (db *DB) FreeLockedBy(lockers []oid.Address) error {
 	})
 }
 
+// LockInfo represents the lock record in the metabase.
+type LockInfo struct {
+	// Locker is the address of the LOCK object.
+	Locker oid.Address
+	// Members is the number of the objects locked by the locker.
+	Members int
+	// LockerFound is true if the LOCK object header is stored in the metabase.
+	LockerFound bool
+}
+
+// Locks returns the information about all the lockers which lock
+// at least one object. Lockers are not required to be stored in the
+// metabase, such lock records can be removed with FreeLockedBy.
+func (db *DB) Locks() ([]LockInfo, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var res []LockInfo
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		bucketLocked := tx.Bucket(bucketNameLocked)
+		if bucketLocked == nil {
+			return nil
+		}
+
+		return bucketLocked.ForEach(func(cnrKey, _ []byte) error {
+			bucketLockedContainer := bucketLocked.Bucket(cnrKey)
+			if bucketLockedContainer == nil {
+				return nil
+			}
+
+			var cnr cid.ID
+			if err := cnr.Decode(cnrKey); err != nil {
+				return fmt.Errorf("decode container ID of locked bucket: %w", err)
+			}
+
+			members := make(map[string]int)
+
+			err := bucketLockedContainer.ForEach(func(_, v []byte) error {
+				keyLockers, err := decodeList(v)
+				if err != nil {
+					return fmt.Errorf("decode list of lockers in locked bucket: %w", err)
+				}
+
+				for i := range keyLockers {
+					members[string(keyLockers[i])]++
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			bucketLockers := tx.Bucket(bucketNameLockers(cnr, make([]byte, bucketKeySize)))
+
+			for keyLocker, n := range members {
+				var locker oid.ID
+				if err := locker.Decode([]byte(keyLocker)); err != nil {
+					return fmt.Errorf("decode locker ID: %w", err)
+				}
+
+				info := LockInfo{Members: n}
+				info.Locker.SetContainer(cnr)
+				info.Locker.SetObject(locker)
+
+				if bucketLockers != nil {
+					info.LockerFound = bucketLockers.Get([]byte(keyLocker)) != nil
+				}
+
+				res = append(res, info)
+			}
+
+			return nil
+		})
+	})
+
+	return res, err
+}
+
+// ObjectLock represents the lock of the object in the metabase.
+type ObjectLock struct {
+	// Locker is the address of the LOCK object.
+	Locker oid.Address
+	// Expiration is the last epoch of the lock, zero if the LOCK object
+	// is not stored in the metabase or has no expiration epoch.
+	Expiration uint64
+	// LockerFound is true if the LOCK object header is stored in the metabase.
+	LockerFound bool
+	// Malformed is true if the expiration epoch of the found LOCK object
+	// can't be read, Expiration is zero then.
+	Malformed bool
+}
+
+// LocksFor returns the locks of the object. Returns no locks if the
+// object is not locked. Locks with the malformed LOCK object header are
+// returned with ObjectLock.Malformed set.
+func (db *DB) LocksFor(addr oid.Address) ([]ObjectLock, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var res []ObjectLock
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		bucketLocked := tx.Bucket(bucketNameLocked)
+		if bucketLocked == nil {
+			return nil
+		}
+
+		key := make([]byte, cidSize)
+		addr.Container().Encode(key)
+
+		bucketLockedContainer := bucketLocked.Bucket(key)
+		if bucketLockedContainer == nil {
+			return nil
+		}
+
+		keyLockers, err := decodeList(bucketLockedContainer.Get(objectKey(addr.Object(), key)))
+		if err != nil {
+			return fmt.Errorf("decode list of object lockers: %w", err)
+		}
+
+		bucketLockers := tx.Bucket(bucketNameLockers(addr.Container(), make([]byte, bucketKeySize)))
+
+		for i := range keyLockers {
+			var locker oid.ID
+			if err := locker.Decode(keyLockers[i]); err != nil {
+				return fmt.Errorf("decode locker ID: %w", err)
+			}
+
+			var lock ObjectLock
+			lock.Locker.SetContainer(addr.Container())
+			lock.Locker.SetObject(locker)
+
+			if bucketLockers != nil {
+				if data := bucketLockers.Get(keyLockers[i]); data != nil {
+					lock.LockerFound = true
+
+					if lock.Expiration, err = expirationEpoch(data); err != nil {
+						lock.Expiration = 0
+						lock.Malformed = true
+					}
+				}
+			}
+
+			res = append(res, lock)
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+// expirationEpoch returns the expiration epoch of the binary object header,
+// zero if the header has no expiration epoch.
+func expirationEpoch(data []byte) (uint64, error) {
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return 0, fmt.Errorf("unmarshal object header: %w", err)
+	}
+
+	for _, attr := range obj.Attributes() {
+		if attr.Key() == objectV2.SysAttributeExpEpoch {
+			return strconv.ParseUint(attr.Value(), 10, 64)
+		}
+	}
+
+	return 0, nil
+}
+
 // checks if specified object is locked in the specified container.
 func objectLocked(tx *bbolt.Tx, idCnr cid.ID, idObj oid.ID) bool {
 	bucketLocked := tx.Bucket(bucketNameLocked)
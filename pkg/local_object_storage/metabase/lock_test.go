@@ -3,6 +3,7 @@ package meta_test
 import (
 	"testing"
 
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -199,3 +200,97 @@ func putAndLockObj(t *testing.T, db *meta.DB, numOfLockedObjs int) ([]*object.Ob
 
 	return lockedObjs, lockObj
 }
+
+func TestDB_Locks(t *testing.T) {
+	db := newDB(t)
+
+	locks, err := db.Locks()
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	objs, lockObj := putAndLockObj(t, db, 3)
+	lockAddr := objectcore.AddressOf(lockObj)
+
+	// the lock object is not stored in the metabase, e.g. after the shard wipe
+	orphanObj := generateObjectWithCID(t, lockAddr.Container())
+	require.NoError(t, putBig(db, orphanObj))
+
+	orphanObjID, _ := orphanObj.ID()
+	orphanLocker := oidtest.ID()
+	require.NoError(t, db.Lock(lockAddr.Container(), orphanLocker, []oid.ID{orphanObjID}))
+
+	var orphanAddr oid.Address
+	orphanAddr.SetContainer(lockAddr.Container())
+	orphanAddr.SetObject(orphanLocker)
+
+	locks, err = db.Locks()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []meta.LockInfo{
+		{Locker: lockAddr, Members: len(objs), LockerFound: true},
+		{Locker: orphanAddr, Members: 1, LockerFound: false},
+	}, locks)
+
+	// the orphaned lock record is removed, so the object can be removed
+	require.NoError(t, db.FreeLockedBy([]oid.Address{orphanAddr}))
+
+	locks, err = db.Locks()
+	require.NoError(t, err)
+	require.Equal(t, []meta.LockInfo{{Locker: lockAddr, Members: len(objs), LockerFound: true}}, locks)
+
+	var inhumePrm meta.InhumePrm
+	inhumePrm.SetGCMark()
+	inhumePrm.SetAddresses(objectcore.AddressOf(orphanObj))
+
+	_, err = db.Inhume(inhumePrm)
+	require.NoError(t, err)
+}
+
+func TestDB_LocksFor(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	obj := generateObjectWithCID(t, cnr)
+	require.NoError(t, putBig(db, obj))
+
+	addr := objectcore.AddressOf(obj)
+
+	locks, err := db.LocksFor(addr)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	lockObj := generateObjectWithCID(t, cnr)
+	lockObj.SetType(object.TypeLock)
+	addAttribute(lockObj, objectV2.SysAttributeExpEpoch, "100")
+	require.NoError(t, putBig(db, lockObj))
+
+	lockID, _ := lockObj.ID()
+	require.NoError(t, db.Lock(cnr, lockID, []oid.ID{addr.Object()}))
+
+	// the lock object is not stored in the metabase
+	orphanLock := oidtest.Address()
+	orphanLock.SetContainer(cnr)
+	require.NoError(t, db.Lock(cnr, orphanLock.Object(), []oid.ID{addr.Object()}))
+
+	// the lock object has the malformed expiration epoch
+	malformedLock := generateObjectWithCID(t, cnr)
+	malformedLock.SetType(object.TypeLock)
+	addAttribute(malformedLock, objectV2.SysAttributeExpEpoch, "not a number")
+	require.NoError(t, putBig(db, malformedLock))
+
+	malformedID, _ := malformedLock.ID()
+	require.NoError(t, db.Lock(cnr, malformedID, []oid.ID{addr.Object()}))
+
+	locks, err = db.LocksFor(addr)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []meta.ObjectLock{
+		{Locker: objectcore.AddressOf(lockObj), Expiration: 100, LockerFound: true},
+		{Locker: orphanLock},
+		{Locker: objectcore.AddressOf(malformedLock), LockerFound: true, Malformed: true},
+	}, locks)
+
+	// other object is not locked
+	locks, err = db.LocksFor(oidtest.Address())
+	require.NoError(t, err)
+	require.Empty(t, locks)
+}
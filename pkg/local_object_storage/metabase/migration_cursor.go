@@ -0,0 +1,58 @@
+package meta
+
+import (
+	"context"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+var blobMigrationCursorKey = []byte("blob_migration_cursor")
+
+// ReadBlobMigrationCursor reads the address of the last object processed by
+// the blobstor migration. If the cursor is missing, returns nil, nil.
+func (db *DB) ReadBlobMigrationCursor() (*oid.Address, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var addr *oid.Address
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		v := b.Get(blobMigrationCursorKey)
+		if v == nil {
+			return nil
+		}
+
+		addr = new(oid.Address)
+		return decodeAddressFromKey(addr, v)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
+// WriteBlobMigrationCursor writes the address of the last object processed
+// by the blobstor migration. Nil address removes the cursor.
+func (db *DB) WriteBlobMigrationCursor(addr *oid.Address) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	return db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return err
+		}
+
+		if addr == nil {
+			return b.Delete(blobMigrationCursorKey)
+		}
+
+		return b.Put(blobMigrationCursorKey, addressKey(*addr, make([]byte, addressKeySize)))
+	})
+}
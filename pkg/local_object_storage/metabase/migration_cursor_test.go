@@ -0,0 +1,29 @@
+package meta_test
+
+import (
+	"testing"
+
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_BlobMigrationCursor(t *testing.T) {
+	db := newDB(t)
+
+	addr, err := db.ReadBlobMigrationCursor()
+	require.NoError(t, err)
+	require.Nil(t, addr)
+
+	expected := oidtest.Address()
+	require.NoError(t, db.WriteBlobMigrationCursor(&expected))
+
+	addr, err = db.ReadBlobMigrationCursor()
+	require.NoError(t, err)
+	require.Equal(t, &expected, addr)
+
+	require.NoError(t, db.WriteBlobMigrationCursor(nil))
+
+	addr, err = db.ReadBlobMigrationCursor()
+	require.NoError(t, err)
+	require.Nil(t, addr)
+}
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"fmt"
 
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -55,7 +56,7 @@ func (db *DB) ToMoveIt(prm ToMoveItPrm) (res ToMoveItRes, err error) {
 	key := make([]byte, addressKeySize)
 	key = addressKey(prm.addr, key)
 
-	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+	err = db.update(context.Background(), PriorityHigh, func(tx *bbolt.Tx) error {
 		toMoveIt, err := tx.CreateBucketIfNotExists(toMoveItBucketName)
 		if err != nil {
 			return err
@@ -75,7 +76,7 @@ func (db *DB) DoNotMove(prm DoNotMovePrm) (res DoNotMoveRes, err error) {
 	key := make([]byte, addressKeySize)
 	key = addressKey(prm.addr, key)
 
-	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+	err = db.update(context.Background(), PriorityHigh, func(tx *bbolt.Tx) error {
 		toMoveIt := tx.Bucket(toMoveItBucketName)
 		if toMoveIt == nil {
 			return nil
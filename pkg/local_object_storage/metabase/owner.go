@@ -0,0 +1,141 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.etcd.io/bbolt"
+)
+
+// ObjectsByOwner returns the addresses of the available objects of the
+// container owned by the given user. Objects are looked up in the owner
+// index filled on Put, object headers are not read.
+//
+// Removed and expired objects are not returned.
+func (db *DB) ObjectsByOwner(cnr cid.ID, owner user.ID) ([]oid.Address, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var res []oid.Address
+
+	currEpoch := db.currentEpoch()
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		fkbtRoot := tx.Bucket(ownerBucketName(cnr, make([]byte, bucketKeySize)))
+		if fkbtRoot == nil {
+			return nil
+		}
+
+		fkbtLeaf := fkbtRoot.Bucket([]byte(owner.EncodeToString()))
+		if fkbtLeaf == nil {
+			return nil
+		}
+
+		return fkbtLeaf.ForEach(func(k, _ []byte) error {
+			var addr oid.Address
+			addr.SetContainer(cnr)
+
+			var id oid.ID
+			if err := id.Decode(k); err != nil {
+				return fmt.Errorf("could not decode object ID: %w", err)
+			}
+
+			addr.SetObject(id)
+
+			if objectStatus(tx, addr, currEpoch) == 0 {
+				res = append(res, addr)
+			}
+
+			return nil
+		})
+	})
+
+	return res, err
+}
+
+// headerBucketPrefixes are the prefixes of the buckets containing
+// the object headers.
+var headerBucketPrefixes = []byte{primaryPrefix, lockersPrefix, storageGroupPrefix, tombstonePrefix}
+
+// RebuildOwnerIndex drops the owner index and fills it again from the object
+// headers stored in the metabase. Returns the number of the indexed objects.
+//
+// The index is also rebuilt along with the other indexes on the metabase
+// resynchronization with the blobstor.
+func (db *DB) RebuildOwnerIndex() (int, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var count int
+
+	err := db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
+		var (
+			ownerBuckets  [][]byte
+			headerBuckets [][]byte
+		)
+
+		err := tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if len(name) != bucketKeySize {
+				return nil
+			}
+
+			if name[0] == ownerPrefix {
+				ownerBuckets = append(ownerBuckets, append([]byte(nil), name...))
+				return nil
+			}
+
+			for i := range headerBucketPrefixes {
+				if name[0] == headerBucketPrefixes[i] {
+					headerBuckets = append(headerBuckets, append([]byte(nil), name...))
+					break
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range ownerBuckets {
+			if err := tx.DeleteBucket(ownerBuckets[i]); err != nil {
+				return fmt.Errorf("could not drop owner index bucket: %w", err)
+			}
+		}
+
+		for i := range headerBuckets {
+			var cnr cid.ID
+			if err := cnr.Decode(headerBuckets[i][1:]); err != nil {
+				return fmt.Errorf("could not decode container ID: %w", err)
+			}
+
+			ownerBucket := ownerBucketName(cnr, make([]byte, bucketKeySize))
+
+			err := tx.Bucket(headerBuckets[i]).ForEach(func(k, v []byte) error {
+				obj := objectSDK.New()
+				if err := obj.Unmarshal(v); err != nil {
+					return fmt.Errorf("could not unmarshal object header: %w", err)
+				}
+
+				count++
+
+				return putFKBTIndexItem(tx, namedBucketItem{
+					name: ownerBucket,
+					key:  []byte(obj.OwnerID().EncodeToString()),
+					val:  append([]byte(nil), k...),
+				})
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return count, err
+}
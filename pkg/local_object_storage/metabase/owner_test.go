@@ -0,0 +1,89 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ObjectsByOwner(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+	owner1, owner2 := usertest.ID(), usertest.ID()
+
+	var objs1, objs2 []oid.Address
+
+	for i := 0; i < 6; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		if i%2 == 0 {
+			obj.SetOwnerID(owner1)
+			objs1 = append(objs1, objectcore.AddressOf(obj))
+		} else {
+			obj.SetOwnerID(owner2)
+			objs2 = append(objs2, objectcore.AddressOf(obj))
+		}
+
+		require.NoError(t, putBig(db, obj))
+	}
+
+	// the object of the same owner in another container is not returned
+	other := generateObject(t)
+	other.SetOwnerID(owner1)
+	require.NoError(t, putBig(db, other))
+
+	requireObjects := func(t *testing.T, expected1, expected2 []oid.Address) {
+		res, err := db.ObjectsByOwner(cnr, *owner1)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected1, res)
+
+		res, err = db.ObjectsByOwner(cnr, *owner2)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected2, res)
+	}
+
+	requireObjects(t, objs1, objs2)
+
+	res, err := db.ObjectsByOwner(cnr, *usertest.ID())
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	t.Run("removed object", func(t *testing.T) {
+		require.NoError(t, metaInhume(db, objs1[0], oidtest.Address()))
+		objs1 = objs1[1:]
+
+		requireObjects(t, objs1, objs2)
+	})
+
+	t.Run("rebuild", func(t *testing.T) {
+		n, err := db.RebuildOwnerIndex()
+		require.NoError(t, err)
+		require.Equal(t, 7, n)
+
+		requireObjects(t, objs1, objs2)
+	})
+
+	t.Run("virtual object", func(t *testing.T) {
+		parent := generateObjectWithCID(t, cnr)
+		parent.SetOwnerID(owner2)
+
+		child := generateObjectWithCID(t, cnr)
+		child.SetOwnerID(owner2)
+		child.SetParent(parent)
+		idParent, _ := parent.ID()
+		child.SetParentID(idParent)
+		child.SetSplitID(objectSDK.NewSplitID())
+
+		require.NoError(t, putBig(db, child))
+
+		objs2 = append(objs2, objectcore.AddressOf(child), objectcore.AddressOf(parent))
+
+		requireObjects(t, objs1, objs2)
+	})
+}
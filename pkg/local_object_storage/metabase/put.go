@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -27,6 +28,11 @@ type PutPrm struct {
 	obj *objectSDK.Object
 
 	id []byte
+
+	inline bool
+
+	priority Priority
+	ctx      context.Context
 }
 
 // PutRes groups the resulting values of Put operation.
@@ -42,13 +48,34 @@ func (p *PutPrm) SetStorageID(id []byte) {
 	p.id = id
 }
 
+// SetInline is a Put option to store the object inline, i.e. with the
+// payload embedded into the metabase record instead of the blobstor. The
+// storage ID set with SetStorageID is ignored. Inline objects can be read
+// with GetInlined.
+func (p *PutPrm) SetInline(inline bool) {
+	p.inline = inline
+}
+
+// SetPriority is a Put option to set the priority of the operation in the
+// queue of the write transactions. PriorityHigh is used by default.
+func (p *PutPrm) SetPriority(priority Priority) {
+	p.priority = priority
+}
+
+// SetContext is a Put option to set the context which aborts waiting for
+// the transaction limit (see WithTxLimit) when done.
+func (p *PutPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 var (
 	ErrUnknownObjectType        = errors.New("unknown object type")
 	ErrIncorrectSplitInfoUpdate = errors.New("updating split info on object without it")
 	ErrIncorrectRootObject      = errors.New("invalid root object")
 )
 
-// Put saves object header in metabase. Object payload expected to be cut.
+// Put saves object header in metabase. Object payload is not saved unless
+// the object is stored inline (see PutPrm.SetInline).
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been placed in graveyard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
@@ -56,10 +83,21 @@ func (db *DB) Put(prm PutPrm) (res PutRes, err error) {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
+
+	id := prm.id
+	if prm.inline {
+		id = inlineStorageID
+	}
+
+	err = db.batch(prm.ctx, prm.priority, func(tx *bbolt.Tx) error {
+		if prm.inline && storedVersion(tx) != inlineVersion {
+			if err := updateVersion(tx, inlineVersion); err != nil {
+				return fmt.Errorf("could not update version: %w", err)
+			}
+		}
 
-	err = db.boltDB.Batch(func(tx *bbolt.Tx) error {
-		return db.put(tx, prm.obj, prm.id, nil, currEpoch)
+		return db.put(tx, prm.obj, id, nil, currEpoch)
 	})
 	if err == nil {
 		storagelog.Write(db.log,
@@ -94,6 +132,10 @@ func (db *DB) put(
 		// When storage engine moves objects between different sub-storages,
 		// it calls metabase.Put method with new storage ID, thus triggering this code.
 		if !isParent && id != nil {
+			if IsInlineStorageID(id) {
+				return db.inlineExisting(tx, obj)
+			}
+
 			return updateStorageID(tx, object.AddressOf(obj), id)
 		}
 
@@ -186,7 +228,12 @@ func putUniqueIndexes(
 			return ErrUnknownObjectType
 		}
 
-		rawObject, err := obj.CutPayload().Marshal()
+		hdr := obj
+		if !IsInlineStorageID(id) {
+			hdr = obj.CutPayload()
+		}
+
+		rawObject, err := hdr.Marshal()
 		if err != nil {
 			return fmt.Errorf("can't marshal object header: %w", err)
 		}
@@ -200,6 +247,15 @@ func putUniqueIndexes(
 			return err
 		}
 
+		err = putUniqueIndexItem(tx, namedBucketItem{
+			name: shortHeaderBucketName(cnr, bucketName),
+			key:  objKey,
+			val:  marshalShortHeader(obj),
+		})
+		if err != nil {
+			return err
+		}
+
 		// index storageID if it is present
 		if id != nil {
 			err = putUniqueIndexItem(tx, namedBucketItem{
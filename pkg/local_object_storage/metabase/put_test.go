@@ -2,7 +2,9 @@ package meta_test
 
 import (
 	"runtime"
+	"sort"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -75,6 +77,84 @@ func BenchmarkPut(b *testing.B) {
 	})
 }
 
+// BenchmarkPutMixedLoad measures the latency of the object puts
+// made in parallel with the background inhume operations.
+func BenchmarkPutMixedLoad(b *testing.B) {
+	const background = 8
+
+	for _, tc := range []struct {
+		name string
+		opts []meta.Option
+	}{
+		{name: "no limit"},
+		{name: "limit", opts: []meta.Option{meta.WithTxLimit(4, 1024)}},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			db := newDB(b, append(tc.opts, meta.WithMaxBatchDelay(time.Millisecond))...)
+
+			garbage := prepareObjects(b, background)
+			for i := range garbage {
+				require.NoError(b, metaPut(db, garbage[i], nil))
+			}
+
+			stop := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < background; i++ {
+				wg.Add(1)
+				go func(obj *objectSDK.Object) {
+					defer wg.Done()
+
+					var prm meta.InhumePrm
+					prm.SetAddresses(object.AddressOf(obj))
+					prm.SetGCMark()
+					prm.SetPriority(meta.PriorityLow)
+
+					for {
+						select {
+						case <-stop:
+							return
+						default:
+						}
+
+						if _, err := db.Inhume(prm); err != nil {
+							b.Error(err)
+							return
+						}
+					}
+				}(garbage[i])
+			}
+
+			objs := prepareObjects(b, b.N)
+			latencies := make([]time.Duration, b.N)
+			index := atomic.NewInt64(-1)
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := index.Inc()
+
+					var prm meta.PutPrm
+					prm.SetObject(objs[i])
+
+					start := time.Now()
+					if _, err := db.Put(prm); err != nil {
+						b.Error(err)
+						return
+					}
+					latencies[i] = time.Since(start)
+				}
+			})
+			b.StopTimer()
+
+			close(stop)
+			wg.Wait()
+
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+			b.ReportMetric(float64(latencies[len(latencies)*99/100].Microseconds()), "p99-us")
+		})
+	}
+}
+
 func TestDB_PutBlobovnicaUpdate(t *testing.T) {
 	db := newDB(t)
 
@@ -0,0 +1,180 @@
+package meta
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// scheduledDeletionKeySize is a size of the key in the scheduled deletions
+// bucket: big-endian epoch followed by the object address.
+const scheduledDeletionKeySize = 8 + addressKeySize
+
+// ScheduleDeletionPrm groups the parameters of ScheduleDeletion operation.
+type ScheduleDeletionPrm struct {
+	addrs []oid.Address
+
+	epoch uint64
+}
+
+// ScheduleDeletionRes groups the resulting values of ScheduleDeletion operation.
+type ScheduleDeletionRes struct{}
+
+// SetAddresses sets the addresses of the objects to be deleted.
+func (p *ScheduleDeletionPrm) SetAddresses(addrs ...oid.Address) {
+	p.addrs = addrs
+}
+
+// SetEpoch sets the epoch the objects are deleted at.
+func (p *ScheduleDeletionPrm) SetEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+// ScheduleDeletion records that the objects must be marked as garbage
+// when the specified epoch comes. The objects stay available until then.
+// If the deletion of the object is scheduled several times, the earliest
+// epoch takes effect.
+//
+// Returns an error of type apistatus.ObjectNotFound if some object is not stored,
+// apistatus.ObjectAlreadyRemoved if it has already been removed. Returns
+// ErrLockObjectRemoval if some object is a lock object.
+func (db *DB) ScheduleDeletion(prm ScheduleDeletionPrm) (ScheduleDeletionRes, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	currEpoch := db.currentEpoch()
+
+	err := db.update(context.Background(), PriorityHigh, func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(scheduledDeletionBucketName)
+		if err != nil {
+			return fmt.Errorf("could not create scheduled deletions bucket: %w", err)
+		}
+
+		key := make([]byte, scheduledDeletionKeySize)
+		binary.BigEndian.PutUint64(key, prm.epoch)
+
+		for i := range prm.addrs {
+			exists, err := db.exists(tx, prm.addrs[i], currEpoch)
+			if err != nil {
+				return err
+			} else if !exists {
+				var errNotFound apistatus.ObjectNotFound
+
+				return errNotFound
+			}
+
+			if isLockObject(tx, prm.addrs[i].Container(), prm.addrs[i].Object()) {
+				return ErrLockObjectRemoval
+			}
+
+			addressKey(prm.addrs[i], key[8:])
+
+			if err := b.Put(key, zeroValue); err != nil {
+				return fmt.Errorf("could not schedule deletion of %s: %w", prm.addrs[i], err)
+			}
+		}
+
+		return nil
+	})
+
+	return ScheduleDeletionRes{}, err
+}
+
+// ScheduledDeletion is a descriptor of the object deletion scheduled at some epoch.
+type ScheduledDeletion struct {
+	addr oid.Address
+
+	epoch uint64
+}
+
+// Address returns the address of the object to be deleted.
+func (d ScheduledDeletion) Address() oid.Address {
+	return d.addr
+}
+
+// Epoch returns the epoch the object is deleted at.
+func (d ScheduledDeletion) Epoch() uint64 {
+	return d.epoch
+}
+
+// IterateScheduledDeletions iterates over the deletions scheduled at the epochs
+// not greater than the given one in the ascending order of the epochs. Deletions
+// of the locked objects are skipped, so they take effect after unlocking.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+func (db *DB) IterateScheduledDeletions(epoch uint64, h func(ScheduledDeletion) error) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(scheduledDeletionBucketName)
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if len(k) != scheduledDeletionKeySize {
+				return fmt.Errorf("invalid scheduled deletion key length: %d", len(k))
+			}
+
+			d := ScheduledDeletion{epoch: binary.BigEndian.Uint64(k)}
+			if d.epoch > epoch {
+				return nil
+			}
+
+			if err := decodeAddressFromKey(&d.addr, k[8:]); err != nil {
+				return fmt.Errorf("could not parse address of scheduled deletion: %w", err)
+			}
+
+			if objectLocked(tx, d.addr.Container(), d.addr.Object()) {
+				continue
+			}
+
+			if err := h(d); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return err
+}
+
+// DropScheduledDeletions removes the records of the scheduled deletions,
+// e.g. after they have taken effect.
+func (db *DB) DropScheduledDeletions(ds []ScheduledDeletion) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	return db.update(context.Background(), PriorityLow, func(tx *bbolt.Tx) error {
+		b := tx.Bucket(scheduledDeletionBucketName)
+		if b == nil {
+			return nil
+		}
+
+		key := make([]byte, scheduledDeletionKeySize)
+
+		for i := range ds {
+			binary.BigEndian.PutUint64(key, ds[i].epoch)
+			addressKey(ds[i].addr, key[8:])
+
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
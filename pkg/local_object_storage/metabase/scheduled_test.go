@@ -0,0 +1,81 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ScheduleDeletion(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	addrs := make([]oid.Address, 3)
+	for i := range addrs {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, putBig(db, obj))
+		addrs[i] = object.AddressOf(obj)
+	}
+
+	lock := generateObjectWithCID(t, cnr)
+	lock.SetType(objectSDK.TypeLock)
+	require.NoError(t, putBig(db, lock))
+
+	require.ErrorAs(t, metaScheduleDeletion(db, 10, oidtest.Address()), new(apistatus.ObjectNotFound))
+	require.ErrorIs(t, metaScheduleDeletion(db, 10, object.AddressOf(lock)), meta.ErrLockObjectRemoval)
+
+	require.NoError(t, metaScheduleDeletion(db, 20, addrs[0]))
+	require.NoError(t, metaScheduleDeletion(db, 10, addrs[1], addrs[2]))
+
+	lockID, _ := lock.ID()
+	require.NoError(t, db.Lock(cnr, lockID, []oid.ID{addrs[2].Object()}))
+
+	require.Empty(t, metaScheduledDeletions(t, db, 9))
+	require.Equal(t, []oid.Address{addrs[1]}, metaScheduledDeletions(t, db, 10))
+	require.Equal(t, []oid.Address{addrs[1], addrs[0]}, metaScheduledDeletions(t, db, 20))
+
+	// objects are available until the deletion is activated
+	for i := range addrs {
+		exists, err := metaExists(db, addrs[i])
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+
+	var ds []meta.ScheduledDeletion
+	require.NoError(t, db.IterateScheduledDeletions(10, func(d meta.ScheduledDeletion) error {
+		require.EqualValues(t, 10, d.Epoch())
+		ds = append(ds, d)
+		return nil
+	}))
+
+	require.NoError(t, db.DropScheduledDeletions(ds))
+	require.Equal(t, []oid.Address{addrs[0]}, metaScheduledDeletions(t, db, 20))
+}
+
+func metaScheduleDeletion(db *meta.DB, epoch uint64, addrs ...oid.Address) error {
+	var prm meta.ScheduleDeletionPrm
+	prm.SetAddresses(addrs...)
+	prm.SetEpoch(epoch)
+
+	_, err := db.ScheduleDeletion(prm)
+	return err
+}
+
+func metaScheduledDeletions(t *testing.T, db *meta.DB, epoch uint64) []oid.Address {
+	var res []oid.Address
+
+	require.NoError(t, db.IterateScheduledDeletions(epoch, func(d meta.ScheduledDeletion) error {
+		res = append(res, d.Address())
+		return nil
+	}))
+
+	return res
+}
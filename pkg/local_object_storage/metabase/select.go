@@ -32,11 +32,13 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	order   *prefixOrder
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	values   []string
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -49,11 +51,31 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetPrefixOrder is a Select option to select only the objects with
+// the attribute value starting with the prefix and to sort them by
+// closeness of the value to the prefix: the exact match goes first,
+// others follow in lexicographic order.
+//
+// If the attribute is not indexed, object headers are read and
+// filtered one by one, which is much slower.
+func (p *SelectPrm) SetPrefixOrder(attr, prefix string) {
+	p.order = &prefixOrder{
+		attr:   attr,
+		prefix: prefix,
+	}
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Values returns the attribute values of the selected objects in the order
+// of AddressList. Set only if SetPrefixOrder option was used.
+func (r SelectRes) Values() []string {
+	return r.values
+}
+
 // Select returns list of addresses of objects that match search filters.
 func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	db.modeMtx.RLock()
@@ -63,12 +85,25 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 		return res, nil
 	}
 
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
+
+	fs := prm.filters
+	if prm.order != nil && isIndexedAttribute(prm.order.attr) {
+		// narrow the selection with the index, values are checked anyway
+		fs = make(object.SearchFilters, len(prm.filters), len(prm.filters)+1)
+		copy(fs, prm.filters)
+		fs.AddFilter(prm.order.attr, prm.order.prefix, object.MatchCommonPrefix)
+	}
 
 	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		res.addrList, err = db.selectObjects(tx, prm.cnr, fs, currEpoch)
+		if err != nil || prm.order == nil {
+			return err
+		}
 
-		return err
+		res.addrList, res.values = db.orderByPrefix(tx, prm.cnr, res.addrList, *prm.order, currEpoch)
+
+		return nil
 	})
 }
 
@@ -165,7 +200,7 @@ func (db *DB) selectFastFilter(
 	to map[string]int, // resulting cache
 	fNum int, // index of filter
 ) {
-	currEpoch := db.epochState.CurrentEpoch()
+	currEpoch := db.currentEpoch()
 	bucketName := make([]byte, bucketKeySize)
 	switch f.Header() {
 	case v2object.FilterHeaderObjectID:
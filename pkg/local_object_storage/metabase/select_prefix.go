@@ -0,0 +1,170 @@
+package meta
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// prefixOrder describes the ordering of the Select results
+// by closeness of the attribute value to the prefix.
+type prefixOrder struct {
+	attr   string
+	prefix string
+}
+
+// isIndexedAttribute returns true if values of the attribute are stored
+// in <fkbt> index and can be iterated in lexicographic order.
+func isIndexedAttribute(attr string) bool {
+	return attr == v2object.FilterHeaderOwnerID || !isSystemKey(attr)
+}
+
+// fkbtBucketName returns the name of <fkbt> index of the indexed attribute.
+func fkbtBucketName(cnr cid.ID, attr string) []byte {
+	key := make([]byte, bucketKeySize)
+	if attr == v2object.FilterHeaderOwnerID {
+		return ownerBucketName(cnr, key)
+	}
+	return attributeBucketName(cnr, attr, key)
+}
+
+// orderByPrefix leaves only the objects with the attribute value starting
+// with the prefix and sorts them by closeness of the value to the prefix:
+// the exact match goes first, others follow in lexicographic order of values.
+// Objects with the same value are sorted by their addresses.
+//
+// Indexed attributes are read from <fkbt> index, for other ones
+// every object header is read from the storage.
+func (db *DB) orderByPrefix(tx *bbolt.Tx, cnr cid.ID, addrs []oid.Address, o prefixOrder, currEpoch uint64) ([]oid.Address, []string) {
+	var (
+		res    = addrs[:0]
+		values = make([]string, 0, len(addrs))
+	)
+
+	if isIndexedAttribute(o.attr) {
+		mValues := make(map[string]string)
+
+		if fkbtRoot := tx.Bucket(fkbtBucketName(cnr, o.attr)); fkbtRoot != nil {
+			_ = stringCommonPrefixMatcherBucket(fkbtRoot, o.attr, o.prefix, func(k, _ []byte) error {
+				fkbtLeaf := fkbtRoot.Bucket(k)
+				if fkbtLeaf == nil {
+					return nil
+				}
+
+				val := stringifyValue(o.attr, k)
+
+				return fkbtLeaf.ForEach(func(objKey, _ []byte) error {
+					mValues[string(objKey)] = val
+					return nil
+				})
+			})
+		}
+
+		key := make([]byte, objectKeySize)
+		for i := range addrs {
+			if val, ok := mValues[string(objectKey(addrs[i].Object(), key))]; ok {
+				res = append(res, addrs[i])
+				values = append(values, val)
+			}
+		}
+	} else {
+		buf := make([]byte, addressKeySize)
+		for i := range addrs {
+			obj, err := db.get(tx, addrs[i], buf, true, false, currEpoch)
+			if err != nil {
+				continue
+			}
+
+			if val, ok := headerValue(obj, o.attr); ok && strings.HasPrefix(val, o.prefix) {
+				res = append(res, addrs[i])
+				values = append(values, val)
+			}
+		}
+	}
+
+	sort.Sort(byPrefixRelevance{addrs: res, values: values})
+
+	return res, values
+}
+
+// byPrefixRelevance sorts addresses by the corresponding values. All the values
+// have the same prefix, so the lexicographic order puts the exact match first.
+type byPrefixRelevance struct {
+	addrs  []oid.Address
+	values []string
+}
+
+func (x byPrefixRelevance) Len() int { return len(x.addrs) }
+
+func (x byPrefixRelevance) Less(i, j int) bool {
+	if x.values[i] != x.values[j] {
+		return x.values[i] < x.values[j]
+	}
+	return x.addrs[i].EncodeToString() < x.addrs[j].EncodeToString()
+}
+
+func (x byPrefixRelevance) Swap(i, j int) {
+	x.addrs[i], x.addrs[j] = x.addrs[j], x.addrs[i]
+	x.values[i], x.values[j] = x.values[j], x.values[i]
+}
+
+// headerValue returns the string value of the object header or attribute
+// in the same format it is used in search filters.
+func headerValue(obj *object.Object, attr string) (string, bool) {
+	switch attr {
+	case v2object.FilterHeaderVersion:
+		if v := obj.Version(); v != nil {
+			return v.String(), true
+		}
+	case v2object.FilterHeaderObjectID:
+		if id, ok := obj.ID(); ok {
+			return id.EncodeToString(), true
+		}
+	case v2object.FilterHeaderContainerID:
+		if cnr, ok := obj.ContainerID(); ok {
+			return cnr.EncodeToString(), true
+		}
+	case v2object.FilterHeaderOwnerID:
+		if owner := obj.OwnerID(); owner != nil {
+			return owner.EncodeToString(), true
+		}
+	case v2object.FilterHeaderCreationEpoch:
+		return strconv.FormatUint(obj.CreationEpoch(), 10), true
+	case v2object.FilterHeaderPayloadLength:
+		return strconv.FormatUint(obj.PayloadSize(), 10), true
+	case v2object.FilterHeaderPayloadHash:
+		if cs, ok := obj.PayloadChecksum(); ok {
+			return hex.EncodeToString(cs.Value()), true
+		}
+	case v2object.FilterHeaderHomomorphicHash:
+		if cs, ok := obj.PayloadHomomorphicHash(); ok {
+			return hex.EncodeToString(cs.Value()), true
+		}
+	case v2object.FilterHeaderObjectType:
+		return obj.Type().String(), true
+	case v2object.FilterHeaderParent:
+		if id, ok := obj.ParentID(); ok {
+			return id.EncodeToString(), true
+		}
+	case v2object.FilterHeaderSplitID:
+		if id := obj.SplitID(); id != nil {
+			return id.String(), true
+		}
+	default:
+		attrs := obj.Attributes()
+		for i := range attrs {
+			if attrs[i].Key() == attr {
+				return attrs[i].Value(), true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,91 @@
+package meta_test
+
+import (
+	"strconv"
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_SelectPrefixOrder(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	values := []string{"cab", "ca", "c", "cat", "dog", "ab", "car", "ca"}
+	addrs := make(map[string][]oid.Address)
+	for i, v := range values {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetPayloadSize(uint64(100 + i))
+		addAttribute(obj, "name", v)
+		if i%2 == 0 {
+			addAttribute(obj, "even", "true")
+		}
+		require.NoError(t, putBig(db, obj))
+
+		addrs[v] = append(addrs[v], objectCore.AddressOf(obj))
+	}
+
+	selectOrdered := func(fs objectSDK.SearchFilters, attr, prefix string) meta.SelectRes {
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetPrefixOrder(attr, prefix)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.Equal(t, len(res.AddressList()), len(res.Values()))
+		return res
+	}
+
+	t.Run("indexed attribute", func(t *testing.T) {
+		res := selectOrdered(nil, "name", "ca")
+		require.Equal(t, []string{"ca", "ca", "cab", "car", "cat"}, res.Values())
+
+		caAddrs := res.AddressList()[:2]
+		require.ElementsMatch(t, addrs["ca"], caAddrs)
+		require.True(t, caAddrs[0].EncodeToString() < caAddrs[1].EncodeToString())
+		require.Equal(t, addrs["cab"][0], res.AddressList()[2])
+		require.Equal(t, addrs["car"][0], res.AddressList()[3])
+		require.Equal(t, addrs["cat"][0], res.AddressList()[4])
+	})
+
+	t.Run("with other filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("even", "true", objectSDK.MatchStringEqual)
+
+		res := selectOrdered(fs, "name", "c")
+		require.Equal(t, []string{"c", "cab", "car"}, res.Values())
+		require.Equal(t, []oid.Address{addrs["c"][0], addrs["cab"][0], addrs["car"][0]}, res.AddressList())
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		res := selectOrdered(nil, "name", "")
+		require.Equal(t, []string{"ab", "c", "ca", "ca", "cab", "car", "cat", "dog"}, res.Values())
+	})
+
+	t.Run("missing attribute", func(t *testing.T) {
+		res := selectOrdered(nil, "unknown", "")
+		require.Empty(t, res.AddressList())
+		require.Empty(t, res.Values())
+	})
+
+	t.Run("not indexed attribute", func(t *testing.T) {
+		res := selectOrdered(nil, v2object.FilterHeaderPayloadLength, "10")
+		require.Len(t, res.Values(), len(values))
+		for i := range values {
+			require.Equal(t, strconv.Itoa(100+i), res.Values()[i])
+		}
+		require.Equal(t, addrs["cab"][0], res.AddressList()[0])
+
+		res = selectOrdered(nil, v2object.FilterHeaderPayloadLength, "103")
+		require.Equal(t, []string{"103"}, res.Values())
+		require.Equal(t, addrs["cat"], res.AddressList())
+	})
+}
@@ -0,0 +1,75 @@
+package meta
+
+import (
+	"fmt"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// marshalShortHeader returns binary representation of the short header
+// of the object: version, owner, type, creation epoch, payload length and hashes.
+func marshalShortHeader(obj *objectSDK.Object) []byte {
+	hdr := obj.ToV2().GetHeader()
+
+	var sh objectV2.ShortHeader
+	sh.SetVersion(hdr.GetVersion())
+	sh.SetCreationEpoch(hdr.GetCreationEpoch())
+	sh.SetOwnerID(hdr.GetOwnerID())
+	sh.SetObjectType(hdr.GetObjectType())
+	sh.SetPayloadLength(hdr.GetPayloadLength())
+	sh.SetPayloadHash(hdr.GetPayloadHash())
+	sh.SetHomomorphicHash(hdr.GetHomomorphicHash())
+
+	return sh.StableMarshal(nil)
+}
+
+// unmarshalShortHeader decodes the short header and returns an object
+// which header contains only the short header fields and the address.
+func unmarshalShortHeader(addr oid.Address, data []byte) (*objectSDK.Object, error) {
+	var sh objectV2.ShortHeader
+	if err := sh.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("can't unmarshal short header: %w", err)
+	}
+
+	var hdr objectV2.Header
+	hdr.SetVersion(sh.GetVersion())
+	hdr.SetCreationEpoch(sh.GetCreationEpoch())
+	hdr.SetOwnerID(sh.GetOwnerID())
+	hdr.SetObjectType(sh.GetObjectType())
+	hdr.SetPayloadLength(sh.GetPayloadLength())
+	hdr.SetPayloadHash(sh.GetPayloadHash())
+	hdr.SetHomomorphicHash(sh.GetHomomorphicHash())
+
+	var objV2 objectV2.Object
+	objV2.SetHeader(&hdr)
+
+	obj := objectSDK.NewFromV2(&objV2)
+	obj.SetContainerID(addr.Container())
+	obj.SetID(addr.Object())
+
+	return obj, nil
+}
+
+// getShortHeader returns the short header of the physically stored object.
+// Falls back to the full header if the short one is missing, e.g. the object
+// is virtual or was stored before short headers were introduced.
+func (db *DB) getShortHeader(tx *bbolt.Tx, addr oid.Address, key []byte, checkStatus, raw bool, currEpoch uint64) (*objectSDK.Object, error) {
+	if checkStatus {
+		if err := checkObjectStatus(tx, addr, currEpoch); err != nil {
+			return nil, err
+		}
+	}
+
+	bucketName := make([]byte, bucketKeySize)
+
+	data := getFromBucket(tx, shortHeaderBucketName(addr.Container(), bucketName),
+		objectKey(addr.Object(), key))
+	if len(data) != 0 {
+		return unmarshalShortHeader(addr, data)
+	}
+
+	return db.get(tx, addr, key, false, raw, currEpoch)
+}
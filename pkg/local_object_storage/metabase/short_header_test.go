@@ -0,0 +1,47 @@
+package meta
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestDB_GetShortHeaderFallback(t *testing.T) {
+	db := New(WithPath(filepath.Join(t.TempDir(), "metabase")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	obj := objecttest.Raw()
+	obj.SetType(0)
+	obj.SetCreationEpoch(0)
+	obj.SetAttributes(*objecttest.Attribute())
+
+	var putPrm PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := db.Put(putPrm)
+	require.NoError(t, err)
+
+	addr := object.AddressOf(obj)
+
+	// drop the short header to emulate objects stored before it was introduced
+	require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+		bkt := tx.Bucket(shortHeaderBucketName(addr.Container(), make([]byte, bucketKeySize)))
+		require.NotNil(t, bkt)
+		return bkt.Delete(objectKey(addr.Object(), make([]byte, objectKeySize)))
+	}))
+
+	var getPrm GetPrm
+	getPrm.SetAddress(addr)
+	getPrm.SetShortHeader(true)
+
+	res, err := db.Get(getPrm)
+	require.NoError(t, err)
+	require.Equal(t, obj.CutPayload(), res.Header())
+}
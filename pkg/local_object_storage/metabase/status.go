@@ -0,0 +1,70 @@
+package meta
+
+import (
+	"errors"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// ObjectStatus represents the status of the object in the metabase.
+type ObjectStatus struct {
+	// Found is true if the object header is stored in the metabase.
+	Found bool
+	// StorageID is the blobstor storage descriptor of the object.
+	StorageID []byte
+	// GCMarked is true if the object is marked to be removed by GC.
+	GCMarked bool
+	// Tombstone is the address of the tombstone covering the object, if any.
+	Tombstone *oid.Address
+	// Locked is true if the object is protected by a lock.
+	Locked bool
+}
+
+// ObjectStatus returns the information about the object stored
+// in different metabase indexes. Object status (removed, expired)
+// is not checked, so it is safe to use it for any object.
+func (db *DB) ObjectStatus(addr oid.Address) (ObjectStatus, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var res ObjectStatus
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		key := make([]byte, addressKeySize)
+
+		_, err := db.get(tx, addr, key, false, true, 0)
+		if err == nil {
+			res.Found = true
+		} else if !errors.As(err, new(apistatus.ObjectNotFound)) && !errors.As(err, new(*objectSDK.SplitInfoError)) {
+			return err
+		}
+
+		res.StorageID, err = db.storageID(tx, addr)
+		if err != nil {
+			return err
+		}
+
+		addrKey := addressKey(addr, key)
+		if garbageBkt := tx.Bucket(garbageBucketName); garbageBkt != nil {
+			res.GCMarked = garbageBkt.Get(addrKey) != nil
+		}
+
+		if graveyardBkt := tx.Bucket(graveyardBucketName); graveyardBkt != nil {
+			if val := graveyardBkt.Get(addrKey); val != nil {
+				var tomb oid.Address
+				if err := decodeAddressFromKey(&tomb, val); err != nil {
+					return err
+				}
+				res.Tombstone = &tomb
+			}
+		}
+
+		res.Locked = objectLocked(tx, addr.Container(), addr.Object())
+		return nil
+	})
+
+	return res, err
+}
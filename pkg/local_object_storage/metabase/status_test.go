@@ -0,0 +1,72 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ObjectStatus(t *testing.T) {
+	db := newDB(t)
+
+	t.Run("missing", func(t *testing.T) {
+		st, err := db.ObjectStatus(oidtest.Address())
+		require.NoError(t, err)
+		require.Equal(t, meta.ObjectStatus{}, st)
+	})
+
+	t.Run("stored", func(t *testing.T) {
+		obj := generateObject(t)
+		addr := object.AddressOf(obj)
+		sid := []byte{1, 2, 3}
+
+		require.NoError(t, metaPut(db, obj, sid))
+		require.NoError(t, db.Lock(addr.Container(), oidtest.ID(), []oid.ID{addr.Object()}))
+
+		st, err := db.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.Equal(t, meta.ObjectStatus{
+			Found:     true,
+			StorageID: sid,
+			Locked:    true,
+		}, st)
+	})
+
+	t.Run("GC marked", func(t *testing.T) {
+		obj := generateObject(t)
+		addr := object.AddressOf(obj)
+		require.NoError(t, putBig(db, obj))
+
+		var prm meta.InhumePrm
+		prm.SetAddresses(addr)
+		prm.SetGCMark()
+
+		_, err := db.Inhume(prm)
+		require.NoError(t, err)
+
+		st, err := db.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.True(t, st.Found)
+		require.True(t, st.GCMarked)
+		require.Nil(t, st.Tombstone)
+	})
+
+	t.Run("tombstoned", func(t *testing.T) {
+		obj := generateObject(t)
+		addr := object.AddressOf(obj)
+		require.NoError(t, putBig(db, obj))
+
+		tomb := oidtest.Address()
+		tomb.SetContainer(addr.Container())
+		require.NoError(t, metaInhume(db, addr, tomb))
+
+		st, err := db.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.True(t, st.Found)
+		require.Equal(t, &tomb, st.Tombstone)
+	})
+}
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
@@ -52,3 +53,49 @@ func (db *DB) storageID(tx *bbolt.Tx, addr oid.Address) ([]byte, error) {
 
 	return slice.Copy(storageID), nil
 }
+
+// UpdateStorageIDPrm groups the parameters of UpdateStorageID operation.
+type UpdateStorageIDPrm struct {
+	addr     oid.Address
+	id       []byte
+	priority Priority
+	ctx      context.Context
+}
+
+// UpdateStorageIDRes groups the resulting values of UpdateStorageID operation.
+type UpdateStorageIDRes struct{}
+
+// SetAddress is an UpdateStorageID option to set the object address to update.
+func (p *UpdateStorageIDPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// SetStorageID is an UpdateStorageID option to set the new storage ID.
+func (p *UpdateStorageIDPrm) SetStorageID(id []byte) {
+	p.id = id
+}
+
+// SetPriority is an UpdateStorageID option to set the priority of the operation in the
+// queue of the write transactions. PriorityHigh is used by default.
+func (p *UpdateStorageIDPrm) SetPriority(priority Priority) {
+	p.priority = priority
+}
+
+// SetContext is an UpdateStorageID option to set the context which aborts waiting for
+// the transaction limit (see WithTxLimit) when done.
+func (p *UpdateStorageIDPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
+// UpdateStorageID updates storage descriptor of the object moved
+// to another location inside the blobstor.
+func (db *DB) UpdateStorageID(prm UpdateStorageIDPrm) (res UpdateStorageIDRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	err = db.batch(prm.ctx, prm.priority, func(tx *bbolt.Tx) error {
+		return updateStorageID(tx, prm.addr, prm.id)
+	})
+
+	return
+}
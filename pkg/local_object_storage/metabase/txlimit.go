@@ -0,0 +1,254 @@
+package meta
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// Priority is a priority of the write operation in the queue of the
+// operations waiting for the transaction limit.
+type Priority uint8
+
+const (
+	// PriorityHigh is a priority of the foreground operations, e.g.
+	// object PUT requests. It is used by default.
+	PriorityHigh Priority = iota
+
+	// PriorityLow is a priority of the background operations, e.g. GC
+	// and write-cache flush. Low priority operations wait until there are
+	// no high priority ones in the queue.
+	PriorityLow
+
+	priorityNum = iota
+)
+
+// String implements fmt.Stringer.
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrTxQueueFull is returned by the write operations if the limit of the
+// concurrent write transactions is reached and the queue of the waiting
+// operations is full.
+var ErrTxQueueFull = errors.New("metabase transaction queue is full")
+
+// txLimiter limits the number of the concurrent write transactions. The
+// operations exceeding the limit wait in the FIFO queues of their priorities,
+// the queue of the higher priority is served first. Nil txLimiter does not
+// limit the transactions.
+type txLimiter struct {
+	mtx sync.Mutex
+
+	limit     int
+	queueSize int
+
+	active int
+	queued int
+	queues [priorityNum][]chan struct{}
+
+	// batch is the group of the batch callers new ones can join
+	batch *batchGroup
+
+	// wait is called with the time spent by the operation in the queue.
+	wait func(Priority, time.Duration)
+}
+
+func newTxLimiter(limit, queueSize int, wait func(Priority, time.Duration)) *txLimiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &txLimiter{
+		limit:     limit,
+		queueSize: queueSize,
+		wait:      wait,
+	}
+}
+
+// batchGroup is a group of the batch callers sharing the single transaction
+// slot, since their calls are executed in the common batch transactions.
+type batchGroup struct {
+	callers int
+}
+
+// acquire waits until the operation of the specified priority is allowed to
+// start the transaction. Returns ErrTxQueueFull if the operation can not be
+// queued and the context error if ctx is done while waiting. Nil ctx is
+// never done.
+func (l *txLimiter) acquire(ctx context.Context, p Priority) error {
+	if l == nil {
+		return nil
+	}
+
+	if p >= priorityNum {
+		p = PriorityLow
+	}
+
+	l.mtx.Lock()
+	if l.active < l.limit && l.queued == 0 {
+		l.active++
+		l.mtx.Unlock()
+		return nil
+	}
+
+	if l.queued >= l.queueSize {
+		l.mtx.Unlock()
+		return ErrTxQueueFull
+	}
+
+	ch := make(chan struct{})
+	l.queues[p] = append(l.queues[p], ch)
+	l.queued++
+	l.mtx.Unlock()
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+
+	start := time.Now()
+
+	select {
+	case <-ch:
+	case <-done:
+		if !l.dequeue(p, ch) {
+			// the slot has been passed concurrently
+			l.release()
+		}
+
+		return ctx.Err()
+	}
+
+	if l.wait != nil {
+		l.wait(p, time.Since(start))
+	}
+
+	return nil
+}
+
+// dequeue removes the waiting operation from the queue. Returns false if it
+// is not queued anymore, i.e. the transaction slot has been passed to it.
+func (l *txLimiter) dequeue(p Priority, ch chan struct{}) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for i := range l.queues[p] {
+		if l.queues[p][i] == ch {
+			l.queues[p] = append(l.queues[p][:i], l.queues[p][i+1:]...)
+			l.queued--
+			return true
+		}
+	}
+
+	return false
+}
+
+// acquireBatch is similar to acquire, but the batch callers join the group
+// holding the transaction slot already unless other operations are waiting
+// for the slot. The slot is released by the last caller of the group, see
+// releaseBatch.
+func (l *txLimiter) acquireBatch(ctx context.Context, p Priority) (*batchGroup, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	l.mtx.Lock()
+	if g := l.batch; g != nil && l.queued == 0 {
+		g.callers++
+		l.mtx.Unlock()
+		return g, nil
+	}
+	l.mtx.Unlock()
+
+	if err := l.acquire(ctx, p); err != nil {
+		return nil, err
+	}
+
+	g := &batchGroup{callers: 1}
+
+	l.mtx.Lock()
+	l.batch = g
+	l.mtx.Unlock()
+
+	return g, nil
+}
+
+// releaseBatch releases the transaction slot of the group after its last caller.
+func (l *txLimiter) releaseBatch(g *batchGroup) {
+	if l == nil {
+		return
+	}
+
+	l.mtx.Lock()
+	g.callers--
+	last := g.callers == 0
+	if last && l.batch == g {
+		l.batch = nil
+	}
+	l.mtx.Unlock()
+
+	if last {
+		l.release()
+	}
+}
+
+// release passes the transaction slot to the first queued operation
+// of the highest priority or frees it.
+func (l *txLimiter) release() {
+	if l == nil {
+		return
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for p := range l.queues {
+		if len(l.queues[p]) > 0 {
+			ch := l.queues[p][0]
+			l.queues[p][0] = nil
+			l.queues[p] = l.queues[p][1:]
+			l.queued--
+
+			close(ch)
+			return
+		}
+	}
+
+	l.active--
+}
+
+// update executes f in the read-write transaction after the operation
+// of the specified priority is allowed by the transaction limit. Waiting
+// for the limit is aborted when ctx is done.
+func (db *DB) update(ctx context.Context, p Priority, f func(*bbolt.Tx) error) error {
+	if err := db.txLimiter.acquire(ctx, p); err != nil {
+		return err
+	}
+	defer db.txLimiter.release()
+
+	return db.boltDB.Update(f)
+}
+
+// batch is the same as update, but executes f as a part of the batch
+// transaction. The concurrent batch callers take a single transaction
+// slot, so the limit does not prevent them from being batched.
+func (db *DB) batch(ctx context.Context, p Priority, f func(*bbolt.Tx) error) error {
+	g, err := db.txLimiter.acquireBatch(ctx, p)
+	if err != nil {
+		return err
+	}
+	defer db.txLimiter.releaseBatch(g)
+
+	return db.boltDB.Batch(f)
+}
@@ -0,0 +1,159 @@
+package meta
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// waitQueued waits until n operations are queued by the limiter.
+func waitQueued(t *testing.T, l *txLimiter, n int) {
+	require.Eventually(t, func() bool {
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		return l.queued == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestTxLimiter(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		l := newTxLimiter(0, 0, nil)
+		require.Nil(t, l)
+		require.NoError(t, l.acquire(context.Background(), PriorityLow))
+		l.release()
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		var waited []Priority
+
+		l := newTxLimiter(1, 10, func(p Priority, _ time.Duration) {
+			waited = append(waited, p)
+		})
+
+		require.NoError(t, l.acquire(context.Background(), PriorityLow))
+
+		order := make(chan Priority, 4)
+		acquire := func(p Priority) {
+			go func() {
+				if l.acquire(context.Background(), p) == nil {
+					order <- p
+					l.release()
+				}
+			}()
+		}
+
+		acquire(PriorityLow)
+		waitQueued(t, l, 1)
+		acquire(PriorityHigh)
+		waitQueued(t, l, 2)
+		acquire(PriorityLow)
+		waitQueued(t, l, 3)
+		acquire(PriorityHigh)
+		waitQueued(t, l, 4)
+
+		l.release()
+
+		var res []Priority
+		for i := 0; i < 4; i++ {
+			res = append(res, <-order)
+		}
+
+		require.Equal(t, []Priority{PriorityHigh, PriorityHigh, PriorityLow, PriorityLow}, res)
+		require.Len(t, waited, 4)
+
+		l.mtx.Lock()
+		require.Zero(t, l.active)
+		require.Zero(t, l.queued)
+		l.mtx.Unlock()
+	})
+
+	t.Run("queue is full", func(t *testing.T) {
+		l := newTxLimiter(1, 1, nil)
+
+		require.NoError(t, l.acquire(context.Background(), PriorityHigh))
+
+		done := make(chan error)
+		go func() { done <- l.acquire(context.Background(), PriorityLow) }()
+		waitQueued(t, l, 1)
+
+		require.ErrorIs(t, l.acquire(context.Background(), PriorityHigh), ErrTxQueueFull)
+
+		l.release()
+		require.NoError(t, <-done)
+		l.release()
+
+		require.NoError(t, l.acquire(context.Background(), PriorityHigh))
+		l.release()
+	})
+	t.Run("cancel", func(t *testing.T) {
+		l := newTxLimiter(1, 10, nil)
+
+		require.NoError(t, l.acquire(context.Background(), PriorityHigh))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- l.acquire(ctx, PriorityLow) }()
+		waitQueued(t, l, 1)
+
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+
+		l.mtx.Lock()
+		require.Zero(t, l.queued)
+		l.mtx.Unlock()
+
+		l.release()
+
+		l.mtx.Lock()
+		require.Zero(t, l.active)
+		l.mtx.Unlock()
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		l := newTxLimiter(1, 10, nil)
+
+		g1, err := l.acquireBatch(context.Background(), PriorityHigh)
+		require.NoError(t, err)
+
+		g2, err := l.acquireBatch(context.Background(), PriorityLow)
+		require.NoError(t, err)
+		require.Same(t, g1, g2)
+
+		// waiting operations are not overtaken by the batch callers
+		done := make(chan error)
+		go func() { done <- l.acquire(context.Background(), PriorityHigh) }()
+		waitQueued(t, l, 1)
+
+		batchDone := make(chan *batchGroup)
+		go func() {
+			g, err := l.acquireBatch(context.Background(), PriorityHigh)
+			if err == nil {
+				batchDone <- g
+			}
+		}()
+		waitQueued(t, l, 2)
+
+		l.releaseBatch(g1)
+		select {
+		case <-done:
+			t.Fatal("slot is released before the last batch caller")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		l.releaseBatch(g2)
+		require.NoError(t, <-done)
+		l.release()
+
+		g3 := <-batchDone
+		require.NotSame(t, g1, g3)
+		l.releaseBatch(g3)
+
+		l.mtx.Lock()
+		require.Zero(t, l.active)
+		require.Zero(t, l.queued)
+		require.Nil(t, l.batch)
+		l.mtx.Unlock()
+	})
+}
@@ -22,6 +22,9 @@ var (
 	garbageBucketName         = []byte{garbagePrefix}
 	toMoveItBucketName        = []byte{toMoveItPrefix}
 	containerVolumeBucketName = []byte{containerVolumePrefix}
+	// scheduledDeletionBucketName stores rows with the objects that should be
+	// marked as garbage at some epoch.
+	scheduledDeletionBucketName = []byte{scheduledDeletionPrefix}
 
 	zeroValue = []byte{0xFF}
 )
@@ -109,6 +112,16 @@ const (
 	//  Key: split ID
 	//  Value: list of object IDs
 	splitPrefix
+
+	// shortHeaderPrefix is used for prefixing buckets containing short headers of the objects.
+	//  Key: object ID
+	//  Value: marshaled short header
+	shortHeaderPrefix
+
+	// scheduledDeletionPrefix is used for the bucket of the scheduled object deletions.
+	//  Key: big-endian epoch and object address
+	//  Value: dummy value
+	scheduledDeletionPrefix
 )
 
 const (
@@ -146,6 +159,11 @@ func smallBucketName(cnr cid.ID, key []byte) []byte {
 	return bucketName(cnr, smallPrefix, key)
 }
 
+// shortHeaderBucketName returns <CID>_short.
+func shortHeaderBucketName(cnr cid.ID, key []byte) []byte {
+	return bucketName(cnr, shortHeaderPrefix, key)
+}
+
 // attributeBucketName returns <CID>_attr_<attributeKey>.
 func attributeBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
 	key[0] = userAttributePrefix
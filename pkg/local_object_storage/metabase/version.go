@@ -11,6 +11,13 @@ import (
 // version contains current metabase version.
 const version = 2
 
+// inlineVersion is the version of the metabase storing the objects inline
+// (see PutPrm.SetInline). It is set on the first inline object put, so the
+// code not supporting the inline objects refuses to open the metabase, and
+// is set back to version by ResetInlineVersion when the inline objects are
+// moved out.
+const inlineVersion = version + 1
+
 var versionKey = []byte("version")
 
 // ErrOutdatedVersion is returned on initializing
@@ -24,7 +31,7 @@ func checkVersion(tx *bbolt.Tx, initialized bool) error {
 		data := b.Get(versionKey)
 		if len(data) == 8 {
 			stored := binary.LittleEndian.Uint64(data)
-			if stored != version {
+			if stored != version && stored != inlineVersion {
 				return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
 			}
 		}
@@ -45,3 +52,18 @@ func updateVersion(tx *bbolt.Tx, version uint64) error {
 	}
 	return b.Put(versionKey, data)
 }
+
+// storedVersion returns the version of the metabase, zero if it is not written.
+func storedVersion(tx *bbolt.Tx) uint64 {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return 0
+	}
+
+	data := b.Get(versionKey)
+	if len(data) != 8 {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(data)
+}
@@ -65,11 +65,23 @@ func TestVersion(t *testing.T) {
 		check(t, db)
 		require.NoError(t, db.Close())
 	})
+	t.Run("inline version", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return updateVersion(tx, inlineVersion)
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.Close())
+	})
 	t.Run("invalid version", func(t *testing.T) {
 		db := newDB(t)
 		require.NoError(t, db.Open(false))
 		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
-			return updateVersion(tx, version+1)
+			return updateVersion(tx, inlineVersion+1)
 		}))
 		require.NoError(t, db.Close())
 
@@ -85,3 +97,30 @@ func TestVersion(t *testing.T) {
 		})
 	})
 }
+
+func TestBucketPrefixes(t *testing.T) {
+	// Prefixes are persisted, new ones must be appended to the end.
+	for i, p := range []byte{
+		graveyardPrefix,
+		garbagePrefix,
+		toMoveItPrefix,
+		containerVolumePrefix,
+		lockedPrefix,
+		shardInfoPrefix,
+		primaryPrefix,
+		lockersPrefix,
+		storageGroupPrefix,
+		tombstonePrefix,
+		smallPrefix,
+		rootPrefix,
+		ownerPrefix,
+		userAttributePrefix,
+		payloadHashPrefix,
+		parentPrefix,
+		splitPrefix,
+		shortHeaderPrefix,
+		scheduledDeletionPrefix,
+	} {
+		require.Equal(t, byte(i), p)
+	}
+}
@@ -0,0 +1,167 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+// Names of the metabase buckets which can be warmed up.
+const (
+	WarmUpPrimary     = "primary"
+	WarmUpGraveyard   = "graveyard"
+	WarmUpGarbage     = "garbage"
+	WarmUpTombstone   = "tombstone"
+	WarmUpLock        = "lock"
+	WarmUpLocked      = "locked"
+	WarmUpSmall       = "small"
+	WarmUpRoot        = "root"
+	WarmUpParent      = "parent"
+	WarmUpShortHeader = "short_header"
+)
+
+var warmUpPrefixes = map[string]byte{
+	WarmUpPrimary:     primaryPrefix,
+	WarmUpGraveyard:   graveyardPrefix,
+	WarmUpGarbage:     garbagePrefix,
+	WarmUpTombstone:   tombstonePrefix,
+	WarmUpLock:        lockersPrefix,
+	WarmUpLocked:      lockedPrefix,
+	WarmUpSmall:       smallPrefix,
+	WarmUpRoot:        rootPrefix,
+	WarmUpParent:      parentPrefix,
+	WarmUpShortHeader: shortHeaderPrefix,
+}
+
+// warmUpCheckInterval is the number of the records read between
+// the checks of the context.
+const warmUpCheckInterval = 1024
+
+// WarmUpPrm groups the parameters of WarmUp operation.
+type WarmUpPrm struct {
+	buckets []string
+
+	byteLimit uint64
+}
+
+// WarmUpRes groups the resulting values of WarmUp operation.
+type WarmUpRes struct {
+	bytes uint64
+}
+
+// SetBuckets sets the names of the buckets to read. Per-container buckets
+// are read for all the containers. Defaults to WarmUpPrimary and WarmUpGraveyard.
+func (p *WarmUpPrm) SetBuckets(names ...string) {
+	p.buckets = names
+}
+
+// SetByteLimit sets the maximum number of bytes to read.
+// Zero means no limit.
+func (p *WarmUpPrm) SetByteLimit(limit uint64) {
+	p.byteLimit = limit
+}
+
+// Bytes returns the number of keys and values bytes read.
+func (r WarmUpRes) Bytes() uint64 {
+	return r.bytes
+}
+
+// CheckWarmUpBuckets returns an error if some bucket name is not supported by WarmUp.
+func CheckWarmUpBuckets(names ...string) error {
+	for i := range names {
+		if _, ok := warmUpPrefixes[names[i]]; !ok {
+			return fmt.Errorf("unknown metabase bucket %q", names[i])
+		}
+	}
+
+	return nil
+}
+
+// WarmUp sequentially reads the records of the specified buckets, so the
+// database pages are loaded to the OS page cache and the first requests after
+// the start do not wait for the disk.
+//
+// Reading stops when the byte limit is reached or the context is done,
+// the number of bytes read is returned in both cases.
+func (db *DB) WarmUp(ctx context.Context, prm WarmUpPrm) (WarmUpRes, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var res WarmUpRes
+
+	if db.mode.NoMetabase() || db.boltDB == nil {
+		return res, errNotOpened
+	}
+
+	names := prm.buckets
+	if len(names) == 0 {
+		names = []string{WarmUpPrimary, WarmUpGraveyard}
+	}
+
+	if err := CheckWarmUpBuckets(names...); err != nil {
+		return res, err
+	}
+
+	var prefixes [256]bool
+	for i := range names {
+		prefixes[warmUpPrefixes[names[i]]] = true
+	}
+
+	var (
+		read uint64
+		done bool
+	)
+
+	var walk func(b *bbolt.Bucket) error
+	walk = func(b *bbolt.Bucket) error {
+		c := b.Cursor()
+		for k, v := c.First(); k != nil && !done; k, v = c.Next() {
+			if v == nil {
+				if nested := b.Bucket(k); nested != nil {
+					if err := walk(nested); err != nil {
+						return err
+					}
+				}
+			}
+
+			res.bytes += uint64(len(k) + len(v))
+
+			if prm.byteLimit > 0 && res.bytes >= prm.byteLimit {
+				done = true
+				break
+			}
+
+			if read++; read%warmUpCheckInterval == 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		c := tx.Cursor()
+		for name, _ := c.First(); name != nil && !done; name, _ = c.Next() {
+			if !prefixes[name[0]] {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := walk(tx.Bucket(name)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return res, err
+}
@@ -0,0 +1,76 @@
+package meta_test
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"golang.org/x/sys/unix"
+)
+
+// BenchmarkDB_WarmUp measures the latency of the first requests after the
+// metabase is opened with the file evicted from the OS page cache.
+func BenchmarkDB_WarmUp(b *testing.B) {
+	const (
+		objNum = 10000
+		reqNum = 200
+	)
+
+	db := newDB(b, meta.WithBoltDBOptions(&bbolt.Options{NoSync: true}), meta.WithMaxBatchSize(1))
+
+	addrs := make([]oid.Address, objNum)
+	for i := range addrs {
+		obj := generateObjectWithCID(b, cidtest.ID())
+		require.NoError(b, putBig(db, obj))
+		addrs[i] = object.AddressOf(obj)
+	}
+
+	reopen := func(b *testing.B, warmUp bool) {
+		require.NoError(b, db.Close())
+		dropPageCache(b, db.DumpInfo().Path)
+		require.NoError(b, db.Open(false))
+		require.NoError(b, db.Init())
+
+		if warmUp {
+			_, err := db.WarmUp(context.Background(), meta.WarmUpPrm{})
+			require.NoError(b, err)
+		}
+	}
+
+	for _, warmUp := range []bool{false, true} {
+		name := "cold"
+		if warmUp {
+			name = "warm"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				reopen(b, warmUp)
+				b.StartTimer()
+
+				for j := 0; j < reqNum; j++ {
+					_, err := metaExists(db, addrs[rand.Intn(objNum)])
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func dropPageCache(tb testing.TB, path string) {
+	f, err := os.Open(path)
+	require.NoError(tb, err)
+	defer f.Close()
+
+	require.NoError(tb, unix.Fadvise(int(f.Fd()), 0, 0, unix.FADV_DONTNEED))
+}
@@ -0,0 +1,78 @@
+package meta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestDB_WarmUp(t *testing.T) {
+	db := newDB(t, meta.WithBoltDBOptions(&bbolt.Options{NoSync: true}), meta.WithMaxBatchSize(1))
+
+	cnr := cidtest.ID()
+	for i := 0; i < 300; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, putBig(db, obj))
+
+		if i%2 == 0 {
+			require.NoError(t, metaInhume(db, object.AddressOf(obj), oidtest.Address()))
+		}
+	}
+
+	warmUp := func(ctx context.Context, limit uint64, buckets ...string) (uint64, error) {
+		var prm meta.WarmUpPrm
+		prm.SetBuckets(buckets...)
+		prm.SetByteLimit(limit)
+
+		res, err := db.WarmUp(ctx, prm)
+		return res.Bytes(), err
+	}
+
+	primary, err := warmUp(context.Background(), 0, meta.WarmUpPrimary)
+	require.NoError(t, err)
+	require.NotZero(t, primary)
+
+	graveyard, err := warmUp(context.Background(), 0, meta.WarmUpGraveyard)
+	require.NoError(t, err)
+	require.NotZero(t, graveyard)
+
+	all, err := warmUp(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, primary+graveyard, all)
+
+	t.Run("byte limit", func(t *testing.T) {
+		limit := primary / 2
+
+		n, err := warmUp(context.Background(), limit, meta.WarmUpPrimary)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, n, limit)
+		require.Less(t, n, primary)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := warmUp(ctx, 0, meta.WarmUpPrimary, meta.WarmUpGraveyard, meta.WarmUpShortHeader, meta.WarmUpSmall)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("unknown bucket", func(t *testing.T) {
+		_, err := warmUp(context.Background(), 0, "unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		require.NoError(t, db.SetMode(mode.Degraded))
+
+		_, err := warmUp(context.Background(), 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,359 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// ErrCheckInProgress is returned when consistency check is requested
+// while another one is being performed on the same shard.
+var ErrCheckInProgress = errors.New("consistency check is already in progress")
+
+// defaultCheckBatchSize is the number of metabase records listed at once
+// during consistency check.
+const defaultCheckBatchSize = 100
+
+// CheckPrm groups the parameters of CheckConsistency operation.
+type CheckPrm struct {
+	repairOrphans bool
+	markDangling  bool
+	resume        bool
+	rateLimit     uint32
+}
+
+// WithRepairOrphans is a CheckConsistency option to register blobs
+// without a metabase record in the metabase.
+func (p *CheckPrm) WithRepairOrphans(v bool) {
+	p.repairOrphans = v
+}
+
+// WithMarkDangling is a CheckConsistency option to mark metabase records
+// without a blob with GC mark, so that the object is re-replicated
+// from other nodes by the policer.
+func (p *CheckPrm) WithMarkDangling(v bool) {
+	p.markDangling = v
+}
+
+// WithResume is a CheckConsistency option to continue the previous
+// interrupted check instead of starting a new one.
+func (p *CheckPrm) WithResume(v bool) {
+	p.resume = v
+}
+
+// WithRateLimit is a CheckConsistency option to set the maximum number
+// of objects processed per second. Zero value means no limit.
+func (p *CheckPrm) WithRateLimit(objPerSec uint32) {
+	p.rateLimit = objPerSec
+}
+
+// CheckSummary groups the results of the shard consistency check.
+type CheckSummary struct {
+	// Records is the number of checked metabase records.
+	Records uint64
+	// Blobs is the number of checked blobs.
+	Blobs uint64
+	// Dangling is the number of metabase records without a blob.
+	Dangling uint64
+	// Orphans is the number of blobs without a metabase record.
+	Orphans uint64
+	// MarkedDangling is the number of dangling records marked with GC mark.
+	MarkedDangling uint64
+	// RepairedOrphans is the number of orphan blobs registered in the metabase.
+	RepairedOrphans uint64
+	// Completed is true if both sides have been fully scanned.
+	Completed bool
+}
+
+// CheckRes groups the resulting values of CheckConsistency operation.
+type CheckRes struct {
+	summary CheckSummary
+}
+
+// Summary returns the summary of the check.
+func (r CheckRes) Summary() CheckSummary {
+	return r.summary
+}
+
+// checkState contains the progress of the consistency check
+// to be able to resume it.
+type checkState struct {
+	// running is true while the check is being performed.
+	running atomic.Bool
+
+	mtx sync.Mutex
+	// blobsPhase is true if the metabase has been scanned.
+	blobsPhase bool
+	// cursor is the metabase listing cursor.
+	cursor *meta.Cursor
+	// summary is the summary of the latest check.
+	summary CheckSummary
+}
+
+// LastCheckSummary returns the summary of the latest consistency check.
+func (s *Shard) LastCheckSummary() CheckSummary {
+	s.check.mtx.Lock()
+	defer s.check.mtx.Unlock()
+
+	return s.check.summary
+}
+
+// CheckConsistency checks that every metabase record has a corresponding blob
+// and that every blob is registered in the metabase. The inconsistencies are
+// reported to the log and, optionally, repaired.
+//
+// Check is interrupted when the context is done, the progress is kept and can be
+// continued with WithResume option.
+//
+// Returns ErrDegradedMode if the shard is in degraded mode.
+// Returns ErrReadOnlyMode if any repair option is set and the shard is in read-only mode.
+// Returns ErrCheckInProgress if the shard is being checked at the moment.
+func (s *Shard) CheckConsistency(ctx context.Context, prm CheckPrm) (CheckRes, error) {
+	m := s.GetMode()
+	if m.NoMetabase() {
+		return CheckRes{}, ErrDegradedMode
+	} else if m.ReadOnly() && (prm.repairOrphans || prm.markDangling) {
+		return CheckRes{}, ErrReadOnlyMode
+	}
+
+	if !s.check.running.CAS(false, true) {
+		return CheckRes{}, ErrCheckInProgress
+	}
+	defer s.check.running.Store(false)
+
+	s.check.mtx.Lock()
+	if !prm.resume || s.check.summary.Completed {
+		s.check.blobsPhase = false
+		s.check.cursor = nil
+		s.check.summary = CheckSummary{}
+	}
+	s.check.mtx.Unlock()
+
+	c := &checker{
+		s:       s,
+		prm:     prm,
+		limiter: newRateLimiter(prm.rateLimit),
+	}
+
+	err := c.checkRecords(ctx)
+	if err == nil {
+		err = c.checkBlobs(ctx)
+	}
+
+	summary := s.LastCheckSummary()
+	s.log.Info("shard consistency check finished",
+		zap.Bool("completed", summary.Completed),
+		zap.Uint64("records", summary.Records),
+		zap.Uint64("blobs", summary.Blobs),
+		zap.Uint64("dangling records", summary.Dangling),
+		zap.Uint64("orphan blobs", summary.Orphans),
+		zap.Uint64("marked records", summary.MarkedDangling),
+		zap.Uint64("repaired blobs", summary.RepairedOrphans))
+
+	return CheckRes{summary: summary}, err
+}
+
+type checker struct {
+	s   *Shard
+	prm CheckPrm
+
+	limiter *rateLimiter
+}
+
+// update applies f to the check state under the lock.
+func (c *checker) update(f func(st *checkState)) {
+	c.s.check.mtx.Lock()
+	f(c.s.check)
+	c.s.check.mtx.Unlock()
+}
+
+// checkRecords looks for the metabase records without a blob.
+func (c *checker) checkRecords(ctx context.Context) error {
+	c.s.check.mtx.Lock()
+	done, cursor := c.s.check.blobsPhase, c.s.check.cursor
+	c.s.check.mtx.Unlock()
+
+	if done {
+		return nil
+	}
+
+	var listPrm meta.ListPrm
+	listPrm.SetCount(defaultCheckBatchSize)
+
+	for {
+		listPrm.SetCursor(cursor)
+
+		res, err := c.s.metaBase.ListWithCursor(listPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				c.update(func(st *checkState) { st.blobsPhase = true })
+				return nil
+			}
+			return fmt.Errorf("could not list metabase objects: %w", err)
+		}
+
+		for _, addr := range res.AddressList() {
+			if err := c.limiter.wait(ctx); err != nil {
+				return err
+			}
+
+			var sidPrm meta.StorageIDPrm
+			sidPrm.SetAddress(addr)
+
+			sidRes, err := c.s.metaBase.StorageID(sidPrm)
+			if err != nil {
+				return fmt.Errorf("could not get storage ID of %s: %w", addr, err)
+			}
+
+			if meta.IsInlineStorageID(sidRes.StorageID()) {
+				c.update(func(st *checkState) { st.summary.Records++ })
+				continue
+			}
+
+			if c.s.hasWriteCache() {
+				if _, err := c.s.writeCache.Head(addr); err == nil {
+					c.update(func(st *checkState) { st.summary.Records++ })
+					continue
+				}
+			}
+
+			var exPrm common.ExistsPrm
+			exPrm.Address = addr
+
+			exRes, err := c.s.blobStor.Exists(exPrm)
+			if err != nil {
+				return fmt.Errorf("could not check blob existence of %s: %w", addr, err)
+			}
+
+			if exRes.Exists {
+				c.update(func(st *checkState) { st.summary.Records++ })
+				continue
+			}
+
+			c.s.log.Warn("metabase record without a blob",
+				zap.Stringer("address", addr),
+				zap.Binary("storage ID", sidRes.StorageID()))
+
+			marked := false
+			if c.prm.markDangling {
+				var inhumePrm meta.InhumePrm
+				inhumePrm.SetAddresses(addr)
+				inhumePrm.SetGCMark()
+
+				inhumeRes, err := c.s.metaBase.Inhume(inhumePrm)
+				if err != nil {
+					c.s.log.Warn("could not mark dangling record with GC mark",
+						zap.Stringer("address", addr),
+						zap.String("error", err.Error()))
+				} else {
+					c.s.decObjectCounterBy(logical, inhumeRes.AvailableInhumed())
+					marked = true
+				}
+			}
+
+			c.update(func(st *checkState) {
+				st.summary.Records++
+				st.summary.Dangling++
+				if marked {
+					st.summary.MarkedDangling++
+				}
+			})
+		}
+
+		cursor = res.Cursor()
+		c.update(func(st *checkState) { st.cursor = cursor })
+	}
+}
+
+// checkBlobs looks for the blobs without a metabase record.
+//
+// Blobstor iteration can't be started from an arbitrary position,
+// so the blobs checked before the interruption are skipped.
+func (c *checker) checkBlobs(ctx context.Context) error {
+	c.s.check.mtx.Lock()
+	skip := c.s.check.summary.Blobs
+	c.s.check.mtx.Unlock()
+
+	var iterPrm common.IteratePrm
+	iterPrm.IgnoreErrors = true
+	iterPrm.Handler = func(elem common.IterationElement) error {
+		if skip > 0 {
+			skip--
+			return nil
+		}
+
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		var exPrm meta.ExistsPrm
+		exPrm.SetAddress(elem.Address)
+
+		// Any error means that there is some record about the object
+		// in the metabase (e.g. it is removed or expired).
+		exRes, err := c.s.metaBase.Exists(exPrm)
+		if err != nil || exRes.Exists() {
+			c.update(func(st *checkState) { st.summary.Blobs++ })
+			return nil
+		}
+
+		c.s.log.Warn("blob without a metabase record",
+			zap.Stringer("address", elem.Address),
+			zap.Binary("storage ID", elem.StorageID))
+
+		repaired := false
+		if c.prm.repairOrphans {
+			repaired = c.repairOrphan(elem)
+		}
+
+		c.update(func(st *checkState) {
+			st.summary.Blobs++
+			st.summary.Orphans++
+			if repaired {
+				st.summary.RepairedOrphans++
+			}
+		})
+		return nil
+	}
+
+	_, err := c.s.blobStor.Iterate(iterPrm)
+	if err != nil {
+		return fmt.Errorf("could not iterate over blobs: %w", err)
+	}
+
+	c.update(func(st *checkState) { st.summary.Completed = true })
+	return nil
+}
+
+// repairOrphan registers the blob in the metabase.
+func (c *checker) repairOrphan(elem common.IterationElement) bool {
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(elem.ObjectData); err != nil {
+		c.s.log.Warn("could not unmarshal orphan blob",
+			zap.Stringer("address", elem.Address),
+			zap.String("error", err.Error()))
+		return false
+	}
+
+	var putPrm meta.PutPrm
+	putPrm.SetObject(obj)
+	putPrm.SetStorageID(elem.StorageID)
+
+	if _, err := c.s.metaBase.Put(putPrm); err != nil {
+		c.s.log.Warn("could not register orphan blob in the metabase",
+			zap.Stringer("address", elem.Address),
+			zap.String("error", err.Error()))
+		return false
+	}
+
+	c.s.existenceFilter.addObject(obj)
+	c.s.incObjectCounter()
+	return true
+}
@@ -0,0 +1,127 @@
+package shard_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_CheckConsistency(t *testing.T) {
+	const objCount = 10
+
+	dir := t.TempDir()
+	sh := newCustomShard(t, dir, false, nil, nil)
+
+	addrs := make([]oid.Address, objCount)
+	for i := range addrs {
+		obj := generateObject(t)
+		addrs[i] = objectCore.AddressOf(obj)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+	require.NoError(t, sh.Close())
+
+	// Break the consistency: remove 2 records and add 1 record without a blob.
+	mb := meta.New(
+		meta.WithPath(filepath.Join(dir, "nowc", "meta")),
+		meta.WithEpochState(epochState{}))
+	require.NoError(t, mb.Open(false))
+	require.NoError(t, mb.Init())
+
+	var delPrm meta.DeletePrm
+	delPrm.SetAddresses(addrs[0], addrs[1])
+	_, err := mb.Delete(delPrm)
+	require.NoError(t, err)
+
+	dangling := generateObject(t)
+	var putPrm meta.PutPrm
+	putPrm.SetObject(dangling)
+	_, err = mb.Put(putPrm)
+	require.NoError(t, err)
+	require.NoError(t, mb.Close())
+
+	sh = newCustomShard(t, dir, false, nil, nil)
+	defer releaseShard(sh, t)
+
+	t.Run("report only", func(t *testing.T) {
+		res, err := sh.CheckConsistency(context.Background(), shard.CheckPrm{})
+		require.NoError(t, err)
+		require.Equal(t, shard.CheckSummary{
+			Records:   objCount - 2 + 1,
+			Blobs:     objCount,
+			Dangling:  1,
+			Orphans:   2,
+			Completed: true,
+		}, res.Summary())
+		require.Equal(t, res.Summary(), sh.LastCheckSummary())
+	})
+
+	t.Run("interrupt and resume", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := sh.CheckConsistency(ctx, shard.CheckPrm{})
+		require.ErrorIs(t, err, context.Canceled)
+		require.False(t, res.Summary().Completed)
+
+		var prm shard.CheckPrm
+		prm.WithResume(true)
+
+		res, err = sh.CheckConsistency(context.Background(), prm)
+		require.NoError(t, err)
+		require.True(t, res.Summary().Completed)
+		require.Equal(t, uint64(objCount-2+1), res.Summary().Records)
+		require.Equal(t, uint64(objCount), res.Summary().Blobs)
+	})
+
+	t.Run("repair", func(t *testing.T) {
+		var prm shard.CheckPrm
+		prm.WithRepairOrphans(true)
+		prm.WithMarkDangling(true)
+		prm.WithRateLimit(1000)
+
+		res, err := sh.CheckConsistency(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, shard.CheckSummary{
+			Records:         objCount - 2 + 1,
+			Blobs:           objCount,
+			Dangling:        1,
+			Orphans:         2,
+			MarkedDangling:  1,
+			RepairedOrphans: 2,
+			Completed:       true,
+		}, res.Summary())
+
+		for i := range addrs[:2] {
+			var getPrm shard.GetPrm
+			getPrm.SetAddress(addrs[i])
+
+			_, err := sh.Get(getPrm)
+			require.NoError(t, err)
+		}
+
+		var exPrm shard.ExistsPrm
+		exPrm.SetAddress(objectCore.AddressOf(dangling))
+
+		_, err = sh.Exists(exPrm)
+		require.True(t, shard.IsErrNotFound(err), err)
+
+		res, err = sh.CheckConsistency(context.Background(), shard.CheckPrm{})
+		require.NoError(t, err)
+		require.Equal(t, shard.CheckSummary{
+			Records:   objCount,
+			Blobs:     objCount,
+			Completed: true,
+		}, res.Summary())
+	})
+}
@@ -0,0 +1,55 @@
+package shard
+
+import (
+	"errors"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+// MetabaseFreeRatio returns the ratio of the free pages of the metabase file.
+//
+// Returns ErrDegradedMode if the shard works without the metabase.
+func (s *Shard) MetabaseFreeRatio() (float64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	return s.metaBase.FreeRatio()
+}
+
+// CompactMetabase compacts the metabase file returning the free pages to the
+// file system. All the shard operations are blocked until compaction is done.
+// If the metabase can't be reopened after compaction, the shard is switched
+// to mode.DegradedReadOnly.
+//
+// Returns ErrDegradedMode if the shard works without the metabase.
+func (s *Shard) CompactMetabase() (meta.CompactRes, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.info.Mode.NoMetabase() {
+		return meta.CompactRes{}, ErrDegradedMode
+	} else if s.forcedReadOnly {
+		return meta.CompactRes{}, ErrForcedReadOnly
+	}
+
+	res, err := s.metaBase.Compact()
+	if errors.Is(err, meta.ErrCompactReopen) {
+		s.log.Error("metabase is not reopened after compaction, switching to degraded mode",
+			zap.Stringer("mode", mode.DegradedReadOnly),
+			zap.Error(err))
+
+		if mErr := s.setMode(mode.DegradedReadOnly); mErr != nil {
+			s.log.Error("could not switch to degraded mode",
+				zap.Stringer("mode", mode.DegradedReadOnly),
+				zap.Error(mErr))
+		}
+	}
+
+	return res, err
+}
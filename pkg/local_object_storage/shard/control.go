@@ -1,11 +1,12 @@
 package shard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -39,7 +40,12 @@ func (s *Shard) handleMetabaseFailure(stage string, err error) error {
 // Open opens all Shard's components.
 func (s *Shard) Open() error {
 	components := []interface{ Open(bool) error }{
-		s.blobStor, s.metaBase,
+		s.blobStor,
+	}
+
+	// metabase is opened and initialized on the switch to a mode using it
+	if !s.GetMode().NoMetabase() {
+		components = append(components, s.metaBase)
 	}
 
 	if s.hasWriteCache() {
@@ -51,12 +57,12 @@ func (s *Shard) Open() error {
 	}
 
 	for i, component := range components {
-		if err := component.Open(false); err != nil {
+		if err := component.Open(s.forcedReadOnly); err != nil {
 			if component == s.metaBase {
 				// We must first open all other components to avoid
 				// opening non-existent DB in read-only mode.
 				for j := i + 1; j < len(components); j++ {
-					if err := components[j].Open(false); err != nil {
+					if err := components[j].Open(s.forcedReadOnly); err != nil {
 						// Other components must be opened, fail.
 						return fmt.Errorf("could not open %T: %w", components[j], err)
 					}
@@ -72,13 +78,31 @@ func (s *Shard) Open() error {
 			return fmt.Errorf("could not open %T: %w", component, err)
 		}
 	}
+
+	if s.forcedReadOnly {
+		// storages are opened read-only, but their modes
+		// must be set to not run the modifying routines
+		if err := s.SetMode(s.GetMode()); err != nil {
+			return fmt.Errorf("could not set read-only mode: %w", err)
+		}
+	}
+
 	return nil
 }
 
 type metabaseSynchronizer Shard
 
 func (x *metabaseSynchronizer) Init() error {
-	return (*Shard)(x).refillMetabase()
+	var prm ResyncMetabasePrm
+	prm.SetIgnoreErrors(true)
+	prm.SetErrorHandler(func(addr oid.Address, err error) error {
+		x.log.Warn("could not restore object in metabase",
+			zap.Stringer("address", addr),
+			zap.String("err", err.Error()))
+		return nil
+	})
+
+	return (*Shard)(x).refillMetabase(prm)
 }
 
 // Init initializes all Shard's components.
@@ -134,114 +158,219 @@ func (s *Shard) Init() error {
 
 	s.updateObjectCounter()
 
+	if !s.GetMode().NoMetabase() {
+		s.rebuildExistenceFilter()
+	}
+
 	s.gc = &gc{
-		gcCfg:       s.gcCfg,
-		remover:     s.removeGarbage,
-		stopChannel: make(chan struct{}),
-		eventChan:   make(chan Event),
+		gcCfg:            s.gcCfg,
+		remover:          s.removeGarbage,
+		expiredCollector: s.continueExpiredCollection,
+		triggerChannel:   make(chan chan GCTickStat),
+		history:          newGCHistory(s.gcCfg.historySize),
+		stopChannel:      make(chan struct{}),
+		eventChan:        make(chan Event),
+		listenerStopped:  make(chan struct{}),
 		mEventHandler: map[eventType]*eventHandlers{
 			eventNewEpoch: {
 				cancelFunc: func() {},
 				handlers: []eventHandler{
-					s.collectExpiredObjects,
-					s.collectExpiredTombstones,
-					s.collectExpiredLocks,
+					s.safeEventHandler("collect expired objects", s.collectExpiredObjects),
+					s.safeEventHandler("collect expired tombstones", s.collectExpiredTombstones),
+					s.safeEventHandler("collect expired locks", s.collectExpiredLocks),
+					s.safeEventHandler("collect scheduled deletions", s.collectScheduledDeletions),
 				},
 			},
 		},
 	}
 
+	if s.orphanSampleSize > 0 {
+		h := s.gc.mEventHandler[eventNewEpoch]
+		h.handlers = append(h.handlers, s.safeEventHandler("collect orphaned children", s.collectOrphans))
+	}
+
+	if s.forcedReadOnly {
+		// nothing is collected since nothing can be removed
+		s.gc.mEventHandler[eventNewEpoch].handlers = nil
+	}
+
 	s.gc.init()
 
+	if s.inlineThreshold == 0 && !s.GetMode().ReadOnly() {
+		s.startDeinliner()
+	}
+
 	return nil
 }
 
-func (s *Shard) refillMetabase() error {
-	err := s.metaBase.Reset()
+// ResyncMetabasePrm groups the parameters of ResyncMetabase operation.
+type ResyncMetabasePrm struct {
+	ignoreErrors bool
+	errorHandler func(oid.Address, error) error
+}
+
+// SetIgnoreErrors sets the flag to skip the objects which can't be read or
+// decoded instead of aborting the resynchronization.
+func (p *ResyncMetabasePrm) SetIgnoreErrors(ignore bool) {
+	p.ignoreErrors = ignore
+}
+
+// SetErrorHandler sets a function to call for every skipped object, the
+// resynchronization is aborted if it returns an error. Address is zero
+// if it is unknown.
+func (p *ResyncMetabasePrm) SetErrorHandler(f func(oid.Address, error) error) {
+	p.errorHandler = f
+}
+
+// skip returns nil if the object failed with err can be skipped.
+func (p ResyncMetabasePrm) skip(addr oid.Address, err error) error {
+	if !p.ignoreErrors {
+		return err
+	}
+	if p.errorHandler != nil {
+		return p.errorHandler(addr, err)
+	}
+	return nil
+}
+
+// ResyncMetabase drops the metabase and fills it again with the objects
+// stored in the blobstor, like the shard initialization with the enabled
+// metabase refill does. The shard is unavailable until the operation is
+// finished.
+//
+// Returns ErrReadOnlyMode or ErrDegradedMode if the shard is not in the
+// read-write mode.
+func (s *Shard) ResyncMetabase(p ResyncMetabasePrm) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	return s.refillMetabase(p)
+}
+
+func (s *Shard) refillMetabase(p ResyncMetabasePrm) error {
+	// the objects stored inline are not in the blobstor,
+	// so they must not be lost with the metabase reset
+	_, err := s.deinlineAll(context.Background(), meta.PriorityHigh, nil)
 	if err != nil {
-		return fmt.Errorf("could not reset metabase: %w", err)
+		return fmt.Errorf("could not move inline objects to blobstor: %w", err)
 	}
 
-	obj := objectSDK.New()
+	err = s.metaBase.Reset()
+	if err != nil {
+		return fmt.Errorf("could not reset metabase: %w", err)
+	}
 
-	err = blobstor.IterateBinaryObjects(s.blobStor, func(addr oid.Address, data []byte, descriptor []byte) error {
-		if err := obj.Unmarshal(data); err != nil {
-			s.log.Warn("could not unmarshal object",
-				zap.Stringer("address", addr),
-				zap.String("err", err.Error()))
-			return nil
+	var (
+		obj     = objectSDK.New()
+		prm     common.IteratePrm
+		iterErr error
+	)
+
+	// BlobStor.Iterate doesn't return the errors of the particular sub-storage
+	// in IgnoreErrors mode, so the abort reason is kept here
+	prm.IgnoreErrors = p.ignoreErrors
+	prm.ErrorHandler = func(addr oid.Address, err error) error {
+		if iterErr == nil {
+			iterErr = p.skip(addr, err)
 		}
+		return iterErr
+	}
+	prm.Handler = func(elem common.IterationElement) error {
+		if iterErr == nil {
+			iterErr = s.refillObject(obj, elem, p)
+		}
+		return iterErr
+	}
 
-		//nolint: exhaustive
-		switch obj.Type() {
-		case objectSDK.TypeTombstone:
-			tombstone := objectSDK.NewTombstone()
-
-			if err := tombstone.Unmarshal(obj.Payload()); err != nil {
-				return fmt.Errorf("could not unmarshal tombstone content: %w", err)
-			}
+	_, err = s.blobStor.Iterate(prm)
+	if err == nil {
+		err = iterErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not put objects to the meta: %w", err)
+	}
 
-			tombAddr := object.AddressOf(obj)
-			memberIDs := tombstone.Members()
-			tombMembers := make([]oid.Address, 0, len(memberIDs))
+	err = s.metaBase.SyncCounters()
+	if err != nil {
+		return fmt.Errorf("could not sync object counters: %w", err)
+	}
 
-			for i := range memberIDs {
-				a := tombAddr
-				a.SetObject(memberIDs[i])
+	return nil
+}
 
-				tombMembers = append(tombMembers, a)
-			}
+// refillObject registers the object read from the blobstor in the metabase.
+func (s *Shard) refillObject(obj *objectSDK.Object, elem common.IterationElement, p ResyncMetabasePrm) error {
+	if err := obj.Unmarshal(elem.ObjectData); err != nil {
+		return p.skip(elem.Address, fmt.Errorf("could not unmarshal object: %w", err))
+	}
 
-			var inhumePrm meta.InhumePrm
+	//nolint: exhaustive
+	switch obj.Type() {
+	case objectSDK.TypeTombstone:
+		tombstone := objectSDK.NewTombstone()
 
-			inhumePrm.SetTombstoneAddress(tombAddr)
-			inhumePrm.SetAddresses(tombMembers...)
+		if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+			return p.skip(elem.Address, fmt.Errorf("could not unmarshal tombstone content: %w", err))
+		}
 
-			_, err = s.metaBase.Inhume(inhumePrm)
-			if err != nil {
-				return fmt.Errorf("could not inhume objects: %w", err)
-			}
-		case objectSDK.TypeLock:
-			var lock objectSDK.Lock
-			if err := lock.Unmarshal(obj.Payload()); err != nil {
-				return fmt.Errorf("could not unmarshal lock content: %w", err)
-			}
+		tombAddr := object.AddressOf(obj)
+		memberIDs := tombstone.Members()
+		tombMembers := make([]oid.Address, 0, len(memberIDs))
 
-			locked := make([]oid.ID, lock.NumberOfMembers())
-			lock.ReadMembers(locked)
+		for i := range memberIDs {
+			a := tombAddr
+			a.SetObject(memberIDs[i])
 
-			cnr, _ := obj.ContainerID()
-			id, _ := obj.ID()
-			err = s.metaBase.Lock(cnr, id, locked)
-			if err != nil {
-				return fmt.Errorf("could not lock objects: %w", err)
-			}
+			tombMembers = append(tombMembers, a)
 		}
 
-		var mPrm meta.PutPrm
-		mPrm.SetObject(obj)
-		mPrm.SetStorageID(descriptor)
+		var inhumePrm meta.InhumePrm
+
+		inhumePrm.SetTombstoneAddress(tombAddr)
+		inhumePrm.SetAddresses(tombMembers...)
 
-		_, err := s.metaBase.Put(mPrm)
-		if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, object.ErrObjectIsExpired) {
-			return err
+		_, err := s.metaBase.Inhume(inhumePrm)
+		if err != nil {
+			return fmt.Errorf("could not inhume objects: %w", err)
+		}
+	case objectSDK.TypeLock:
+		var lock objectSDK.Lock
+		if err := lock.Unmarshal(obj.Payload()); err != nil {
+			return p.skip(elem.Address, fmt.Errorf("could not unmarshal lock content: %w", err))
 		}
 
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("could not put objects to the meta: %w", err)
+		locked := make([]oid.ID, lock.NumberOfMembers())
+		lock.ReadMembers(locked)
+
+		cnr, _ := obj.ContainerID()
+		id, _ := obj.ID()
+		err := s.metaBase.Lock(cnr, id, locked)
+		if err != nil {
+			return fmt.Errorf("could not lock objects: %w", err)
+		}
 	}
 
-	err = s.metaBase.SyncCounters()
-	if err != nil {
-		return fmt.Errorf("could not sync object counters: %w", err)
+	var mPrm meta.PutPrm
+	mPrm.SetObject(obj)
+	mPrm.SetStorageID(elem.StorageID)
+
+	_, err := s.metaBase.Put(mPrm)
+	if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, object.ErrObjectIsExpired) {
+		return err
 	}
 
 	return nil
 }
 
-// Close releases all Shard's components.
+// Close releases all Shard's components. The write-cache is drained
+// before if configured, see WithWriteCacheDrainTimeout.
 func (s *Shard) Close() error {
 	components := []interface{ Close() error }{}
 
@@ -255,13 +384,42 @@ func (s *Shard) Close() error {
 
 	components = append(components, s.blobStor, s.metaBase)
 
+	// stop the background work first, so it does not access the closed storage
+	s.StopFSTreeMigration()
+	s.stopDeinliner()
+	if s.gc != nil { // nil if the shard has not been initialized
+		s.gc.stop()
+	}
+
+	if s.hasWriteCache() {
+		s.drainWriteCache()
+	}
+
 	for _, component := range components {
 		if err := component.Close(); err != nil {
 			return fmt.Errorf("could not close %s: %w", component, err)
 		}
 	}
 
-	s.gc.stop()
+	s.readCache.purge()
 
 	return nil
 }
+
+// drainWriteCache flushes all the write-cache objects to the blobstor
+// before the shutdown within the configured timeout, failures are logged.
+func (s *Shard) drainWriteCache() {
+	if s.writeCacheDrainTimeout == 0 || s.GetMode() != mode.ReadWrite {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.writeCacheDrainTimeout)
+	defer cancel()
+
+	pending, err := s.writeCache.Drain(ctx)
+	if err != nil {
+		s.log.Warn("could not drain write-cache on close, the rest objects are flushed after the restart",
+			zap.Uint64("objects left", pending),
+			zap.Error(err))
+	}
+}
@@ -1,12 +1,17 @@
 package shard
 
 import (
+	"context"
+	"crypto/sha256"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
@@ -30,6 +35,31 @@ func (s epochState) CurrentEpoch() uint64 {
 	return 0
 }
 
+// newTestShard returns opened and initialized shard with the FSTree blobstor,
+// the metabase and the pilorama in dir. Options are applied after the default
+// ones.
+func newTestShard(t testing.TB, dir string, opts ...Option) *Shard {
+	sh := New(append([]Option{
+		WithLogger(zaptest.NewLogger(t)),
+		WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: fstree.New(
+						fstree.WithDirNameLen(2),
+						fstree.WithPath(filepath.Join(dir, "blob")),
+						fstree.WithDepth(1)),
+				},
+			}),
+		),
+		WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+		WithMetaBaseOptions(meta.WithPath(filepath.Join(dir, "meta")), meta.WithEpochState(epochState{})),
+	}, opts...)...)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+
+	return sh
+}
+
 func TestShardOpen(t *testing.T) {
 	dir := t.TempDir()
 	metaPath := filepath.Join(dir, "meta")
@@ -343,7 +373,7 @@ func TestRefillMetabase(t *testing.T) {
 	checkObj(object.AddressOf(tombObj), nil)
 	checkTombMembers(false)
 
-	err = sh.refillMetabase()
+	err = sh.refillMetabase(ResyncMetabasePrm{})
 	require.NoError(t, err)
 
 	c, err = sh.metaBase.ObjectCounters()
@@ -357,3 +387,172 @@ func TestRefillMetabase(t *testing.T) {
 	checkTombMembers(true)
 	checkLocked(t, cnrLocked, locked)
 }
+
+func TestShardForcedReadOnly(t *testing.T) {
+	dir := t.TempDir()
+
+	newShard := func(opts ...Option) *Shard {
+		sh := New(append([]Option{
+			WithLogger(zaptest.NewLogger(t)),
+			WithBlobStorOptions(
+				blobstor.WithStorages([]blobstor.SubStorage{
+					{
+						Storage: blobovniczatree.NewBlobovniczaTree(
+							blobovniczatree.WithRootPath(filepath.Join(dir, "blob", "blobovnicza")),
+							blobovniczatree.WithBlobovniczaShallowDepth(1),
+							blobovniczatree.WithBlobovniczaShallowWidth(1)),
+						Policy: func(_ *objectSDK.Object, data []byte) bool {
+							return len(data) <= 1<<10
+						},
+					},
+					{
+						Storage: fstree.New(
+							fstree.WithPath(filepath.Join(dir, "blob")),
+							fstree.WithDepth(1)),
+					},
+				})),
+			WithMetaBaseOptions(meta.WithPath(filepath.Join(dir, "meta")), meta.WithEpochState(epochState{})),
+			WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+			WithWriteCache(true),
+			WithWriteCacheOptions(writecache.WithPath(filepath.Join(dir, "wc"))),
+			WithGCRemoverSleepInterval(10 * time.Millisecond),
+		}, opts...)...)
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+
+		return sh
+	}
+
+	sh := newShard()
+
+	cnr := cidtest.ID()
+	small := putGCTestObject(t, sh, cnr)
+
+	big := newInlineTestObject(1 << 11)
+	big.SetContainerID(cnr)
+
+	var putPrm PutPrm
+	putPrm.SetObject(big)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	var expAttr objectSDK.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue("1")
+
+	expired := putGCTestObject(t, sh, cnr, expAttr)
+	garbage := putGCTestObject(t, sh, cnr)
+	markGarbage(t, sh, garbage)
+
+	require.NoError(t, sh.Close())
+
+	before := dirSnapshot(t, dir)
+
+	sh = newShard(WithForcedReadOnly(true), WithRefillMetabase(true))
+	require.Equal(t, mode.ReadOnly, sh.GetMode())
+	require.True(t, sh.DumpInfo().ForcedReadOnly)
+
+	for _, addr := range []oid.Address{small, object.AddressOf(big), expired} {
+		var getPrm GetPrm
+		getPrm.SetAddress(addr)
+
+		_, err := sh.Get(getPrm)
+		require.NoError(t, err)
+	}
+
+	_, err = sh.Put(putPrm)
+	require.ErrorIs(t, err, ErrReadOnlyMode)
+
+	require.ErrorIs(t, sh.SetMode(mode.ReadWrite), ErrForcedReadOnly)
+	require.ErrorIs(t, sh.SetMode(mode.Degraded), ErrForcedReadOnly)
+	require.Equal(t, mode.ReadOnly, sh.GetMode())
+
+	_, err = sh.CompactMetabase()
+	require.ErrorIs(t, err, ErrForcedReadOnly)
+
+	require.ErrorIs(t, sh.FlushWriteCache(FlushWriteCachePrm{}), ErrReadOnlyMode)
+
+	_, err = sh.TriggerGC(context.Background())
+	require.ErrorIs(t, err, ErrReadOnlyMode)
+
+	sh.NotificationChannel() <- EventNewEpoch(10)
+
+	// let the GC and the write-cache flush work if they are going to
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, sh.Close())
+	require.Equal(t, before, dirSnapshot(t, dir))
+}
+
+// dirSnapshot returns the checksums of all the files in the directory tree.
+func dirSnapshot(t *testing.T, dir string) map[string][sha256.Size]byte {
+	res := make(map[string][sha256.Size]byte)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		res[path] = sha256.Sum256(data)
+		return nil
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestShard_DrainWriteCacheOnClose(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		timeout time.Duration
+		flushed bool
+	}{
+		{name: "disabled", timeout: 0, flushed: false},
+		{name: "enabled", timeout: time.Minute, flushed: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			sh := newTestShard(t, dir,
+				WithWriteCache(true),
+				WithWriteCacheDrainTimeout(tc.timeout),
+				WithWriteCacheOptions(
+					writecache.WithPath(filepath.Join(dir, "wc")),
+					// the objects are not flushed in background
+					writecache.WithFlushInterval(time.Hour)))
+
+			obj := newInlineTestObject(1 << 10)
+			addr := object.AddressOf(obj)
+
+			var putPrm PutPrm
+			putPrm.SetObject(obj)
+
+			_, err := sh.Put(putPrm)
+			require.NoError(t, err)
+
+			require.NoError(t, sh.Close())
+
+			// the shard without the write-cache reads the blobstor only
+			sh = newTestShard(t, dir)
+			t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+			res, err := sh.blobStor.Exists(common.ExistsPrm{Address: addr})
+			require.NoError(t, err)
+			require.Equal(t, tc.flushed, res.Exists)
+		})
+	}
+}
+
+func TestShard_CloseNotInitialized(t *testing.T) {
+	// the placeholder shards of the engine are never opened
+	sh := New(
+		WithMode(mode.DegradedReadOnly),
+		WithMetaBaseOptions(meta.WithEpochState(epochState{})))
+
+	require.NoError(t, sh.Close())
+}
@@ -10,10 +10,17 @@ import (
 // DeletePrm groups the parameters of Delete operation.
 type DeletePrm struct {
 	addr []oid.Address
+
+	// priority is the priority of the metabase transaction,
+	// it is lowered for the GC.
+	priority meta.Priority
 }
 
 // DeleteRes groups the resulting values of Delete operation.
-type DeleteRes struct{}
+type DeleteRes struct {
+	removed uint64
+	size    uint64
+}
 
 // SetAddresses is a Delete option to set the addresses of the objects to delete.
 //
@@ -24,7 +31,9 @@ func (p *DeletePrm) SetAddresses(addr ...oid.Address) {
 
 // Delete removes data from the shard's writeCache, metaBase and
 // blobStor.
-func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
+func (s *Shard) Delete(prm DeletePrm) (_ DeleteRes, err error) {
+	defer s.catchStoragePanic("delete", &err)()
+
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return DeleteRes{}, ErrReadOnlyMode
@@ -32,9 +41,12 @@ func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
 		return DeleteRes{}, ErrDegradedMode
 	}
 
+	s.readCache.remove(prm.addr...)
+
 	ln := len(prm.addr)
 
 	smalls := make(map[oid.Address][]byte, ln)
+	inlined := make(map[oid.Address]struct{})
 
 	for i := range prm.addr {
 		if s.hasWriteCache() {
@@ -56,23 +68,37 @@ func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
 			continue
 		}
 
-		if res.StorageID() != nil {
+		if meta.IsInlineStorageID(res.StorageID()) {
+			// removal of the metabase record is enough
+			inlined[prm.addr[i]] = struct{}{}
+		} else if res.StorageID() != nil {
 			smalls[prm.addr[i]] = res.StorageID()
 		}
 	}
 
 	var delPrm meta.DeletePrm
 	delPrm.SetAddresses(prm.addr...)
+	delPrm.SetPriority(prm.priority)
 
 	res, err := s.metaBase.Delete(delPrm)
 	if err != nil {
 		return DeleteRes{}, err // stop on metabase error ?
 	}
 
+	removed := DeleteRes{
+		removed: res.RawObjectsRemoved(),
+		size:    res.RemovedPayloadSize(),
+	}
+
 	s.decObjectCounterBy(physical, res.RawObjectsRemoved())
 	s.decObjectCounterBy(logical, res.AvailableObjectsRemoved())
+	s.existenceFilter.remove(res.RawObjectsRemoved())
 
 	for i := range prm.addr { // delete small object
+		if _, ok := inlined[prm.addr[i]]; ok {
+			continue
+		}
+
 		var delPrm common.DeletePrm
 		delPrm.Address = prm.addr[i]
 		id := smalls[prm.addr[i]]
@@ -86,5 +112,5 @@ func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
 		}
 	}
 
-	return DeleteRes{}, nil
+	return removed, nil
 }
@@ -3,10 +3,12 @@ package shard
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 )
 
@@ -70,7 +72,7 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
+	err := WriteDumpHeader(w)
 	if err != nil {
 		return DumpRes{}, err
 	}
@@ -82,13 +84,7 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 
 		iterPrm.WithIgnoreErrors(prm.ignoreErrors)
 		iterPrm.WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
-				return err
-			}
-
-			if _, err := w.Write(data); err != nil {
+			if err := WriteDumpObject(w, data); err != nil {
 				return err
 			}
 
@@ -105,15 +101,7 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 	var pi common.IteratePrm
 	pi.IgnoreErrors = prm.ignoreErrors
 	pi.Handler = func(elem common.IterationElement) error {
-		data := elem.ObjectData
-
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
-			return err
-		}
-
-		if _, err := w.Write(data); err != nil {
+		if err := WriteDumpObject(w, elem.ObjectData); err != nil {
 			return err
 		}
 
@@ -125,5 +113,78 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		return DumpRes{}, err
 	}
 
+	if !s.info.Mode.NoMetabase() {
+		n, err := s.dumpInline(w, prm.ignoreErrors)
+		count += n
+		if err != nil {
+			return DumpRes{}, err
+		}
+	}
+
 	return DumpRes{count: count}, nil
 }
+
+// dumpInline writes the objects stored inline in the metabase to the dump.
+func (s *Shard) dumpInline(w io.Writer, ignoreErrors bool) (int, error) {
+	addrs, err := s.metaBase.ListInlined(0)
+	if err != nil {
+		return 0, fmt.Errorf("could not list inline objects: %w", err)
+	}
+
+	var count int
+
+	for i := range addrs {
+		var gPrm meta.GetInlinedPrm
+		gPrm.SetAddress(addrs[i])
+
+		res, err := s.metaBase.GetInlined(gPrm)
+		if err != nil {
+			if ignoreErrors {
+				continue
+			}
+
+			return count, fmt.Errorf("could not read inline object %s: %w", addrs[i], err)
+		}
+
+		if res.Object() == nil {
+			continue
+		}
+
+		data, err := res.Object().Marshal()
+		if err != nil {
+			if ignoreErrors {
+				continue
+			}
+
+			return count, fmt.Errorf("could not marshal inline object %s: %w", addrs[i], err)
+		}
+
+		if err := WriteDumpObject(w, data); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// WriteDumpHeader writes the header of the dump produced by Dump. The header
+// must be followed by the objects written with WriteDumpObject, such stream
+// can be restored with Restore.
+func WriteDumpHeader(w io.Writer) error {
+	_, err := w.Write(dumpMagic)
+	return err
+}
+
+// WriteDumpObject writes the binary object to the dump, see WriteDumpHeader.
+func WriteDumpObject(w io.Writer, data []byte) error {
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+	if _, err := w.Write(size[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(data)
+	return err
+}
@@ -3,7 +3,11 @@ package shard
 import (
 	"errors"
 
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 )
 
@@ -30,3 +34,41 @@ func IsErrOutOfRange(err error) bool {
 func IsErrObjectExpired(err error) bool {
 	return errors.Is(err, object.ErrObjectIsExpired)
 }
+
+// IsErrNoSpace checks if an error returned by Shard Put method corresponds
+// to the exhausted storage space: full write-cache, no space in the
+// sub-storages or on the device.
+func IsErrNoSpace(err error) bool {
+	return errors.Is(err, writecache.ErrOutOfSpace) || errors.Is(err, common.ErrNoSpace) ||
+		errors.Is(err, blobstor.ErrNoPlaceFound)
+}
+
+// IsErrInvalidObject checks if an error returned by Shard Put method
+// corresponds to the object rejected by the validation.
+func IsErrInvalidObject(err error) bool {
+	return errors.Is(err, writecache.ErrInvalidObject)
+}
+
+// invalidObjectError is returned by Put when the object is rejected by the
+// write-cache validation. There is no dedicated status for the invalid
+// objects, so it is transmitted as apistatus.ServerInternal with the
+// message describing the cause.
+type invalidObjectError struct {
+	cause error
+}
+
+func (e invalidObjectError) Error() string {
+	return e.cause.Error()
+}
+
+func (e invalidObjectError) Unwrap() error {
+	return e.cause
+}
+
+// ToStatusV2 implements apistatus.StatusV2 interface.
+func (e invalidObjectError) ToStatusV2() *status.Status {
+	var st apistatus.ServerInternal
+	st.SetMessage(e.cause.Error())
+
+	return st.ToStatusV2()
+}
@@ -0,0 +1,278 @@
+package shard
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// maxFilterHashes is the maximum number of the hash functions
+// of the existence filter.
+const maxFilterHashes = 16
+
+// bloomFilter is a Bloom filter over the object addresses. Object and
+// container IDs are SHA-256 hashes, so their bits are used as the hash
+// values directly.
+type bloomFilter struct {
+	bits   []uint64
+	hashes uint64
+}
+
+// newBloomFilter creates a filter of the given size in bytes with the number
+// of hashes optimal for n addresses.
+func newBloomFilter(size, n uint64) *bloomFilter {
+	words := size / 8
+	if words == 0 {
+		words = 1
+	}
+
+	if n == 0 {
+		n = 1
+	}
+
+	k := uint64(math.Round(float64(words*64) / float64(n) * math.Ln2))
+	if k == 0 {
+		k = 1
+	} else if k > maxFilterHashes {
+		k = maxFilterHashes
+	}
+
+	return &bloomFilter{
+		bits:   make([]uint64, words),
+		hashes: k,
+	}
+}
+
+func addressHashes(addr oid.Address) (uint64, uint64) {
+	cnr := addr.Container()
+	obj := addr.Object()
+
+	h1 := binary.LittleEndian.Uint64(obj[:8]) ^ binary.LittleEndian.Uint64(cnr[:8])
+	h2 := binary.LittleEndian.Uint64(obj[8:16]) ^ binary.LittleEndian.Uint64(cnr[8:16])
+
+	// odd step visits different bits for all the hashes
+	return h1, h2 | 1
+}
+
+func (f *bloomFilter) add(addr oid.Address) {
+	h1, h2 := addressHashes(addr)
+	m := uint64(len(f.bits)) * 64
+
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (f *bloomFilter) mayContain(addr oid.Address) bool {
+	h1, h2 := addressHashes(addr)
+	m := uint64(len(f.bits)) * 64
+
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// existenceFilter tells whether the shard may have any information about
+// the object: the object itself, its children or its tombstone. False
+// positives are possible, false negatives are not. Since the addresses can
+// not be removed from the filter, the removals are counted, and the filter
+// is rebuilt by the GC when it becomes stale.
+//
+// Nil existenceFilter and the filter which is not built yet may contain
+// any address.
+type existenceFilter struct {
+	mtx sync.RWMutex
+
+	size uint64
+
+	// cur is nil until the filter is built.
+	cur *bloomFilter
+	// next is the filter being built, it receives the new addresses too.
+	next *bloomFilter
+
+	// built is the number of the addresses cur has been built with.
+	built   uint64
+	added   uint64
+	removed uint64
+}
+
+func newExistenceFilter(size uint64) *existenceFilter {
+	return &existenceFilter{size: size}
+}
+
+// mayContain returns false if the address is definitely unknown to the shard.
+func (f *existenceFilter) mayContain(addr oid.Address) bool {
+	if f == nil {
+		return true
+	}
+
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	return f.cur == nil || f.cur.mayContain(addr)
+}
+
+// add puts the addresses to the filter. It must be called after the
+// information about the objects is stored, so that the rebuilding
+// filter does not miss it.
+func (f *existenceFilter) add(addrs ...oid.Address) {
+	if f == nil {
+		return
+	}
+
+	f.mtx.Lock()
+	for i := range addrs {
+		if f.cur != nil {
+			f.cur.add(addrs[i])
+		}
+		if f.next != nil {
+			f.next.add(addrs[i])
+		}
+	}
+	f.added += uint64(len(addrs))
+	f.mtx.Unlock()
+}
+
+// addObject puts the object address and the address of its parent to
+// the filter.
+func (f *existenceFilter) addObject(obj *objectSDK.Object) {
+	if f == nil {
+		return
+	}
+
+	if par := obj.Parent(); par != nil {
+		if _, ok := par.ID(); ok {
+			f.add(objectCore.AddressOf(obj), objectCore.AddressOf(par))
+			return
+		}
+	}
+
+	f.add(objectCore.AddressOf(obj))
+}
+
+// remove registers n removed objects.
+func (f *existenceFilter) remove(n uint64) {
+	if f == nil {
+		return
+	}
+
+	f.mtx.Lock()
+	f.removed += n
+	f.mtx.Unlock()
+}
+
+// stale checks whether the filter must be rebuilt: at least half of the
+// added addresses have been removed, or the number of the addresses has doubled
+// since the filter was built, so the false positive rate is high.
+func (f *existenceFilter) stale() bool {
+	if f == nil {
+		return false
+	}
+
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	return f.cur != nil && f.next == nil && f.added > 0 &&
+		(2*f.removed >= f.added || f.added > 2*f.built)
+}
+
+// startRebuild starts building the filter for n addresses, it receives
+// all the addresses added until finishRebuild.
+func (f *existenceFilter) startRebuild(n uint64) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.next = newBloomFilter(f.size, n)
+	f.added = 0
+	f.removed = 0
+}
+
+// addNext puts the address to the filter being built.
+func (f *existenceFilter) addNext(addr oid.Address) {
+	f.mtx.Lock()
+	if f.next != nil {
+		f.next.add(addr)
+		f.added++
+	}
+	f.mtx.Unlock()
+}
+
+// finishRebuild replaces the filter with the built one. If the build has
+// failed, the current filter is kept.
+func (f *existenceFilter) finishRebuild(ok bool) {
+	f.mtx.Lock()
+	if ok {
+		f.cur = f.next
+		f.built = f.added
+	}
+	f.next = nil
+	f.mtx.Unlock()
+}
+
+// MayContain checks whether the shard may have any information about the
+// object: it may be stored in the shard, be a parent of the stored object or
+// be removed with a tombstone stored in the shard. If false is returned, the
+// shard definitely responds to the object requests with the not found error,
+// so it can be skipped. Always returns true if the existence filter is
+// disabled (see WithExistenceFilter) or is not built yet, and in the
+// degraded mode.
+func (s *Shard) MayContain(addr oid.Address) bool {
+	// objects can be put to the blobstor only
+	if s.GetMode().NoMetabase() {
+		return true
+	}
+
+	return s.existenceFilter.mayContain(addr)
+}
+
+// buildExistenceFilter fills the existence filter with the addresses from
+// the metabase. The filter keeps answering with the
+// previous data until the build is finished.
+func (s *Shard) buildExistenceFilter() error {
+	if s.existenceFilter == nil {
+		return nil
+	}
+
+	if s.GetMode().NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	cc, err := s.metaBase.ObjectCounters()
+	if err != nil {
+		return fmt.Errorf("could not read object counters: %w", err)
+	}
+
+	s.existenceFilter.startRebuild(cc.Phy())
+
+	err = s.metaBase.IterateAddresses(func(addr oid.Address) error {
+		s.existenceFilter.addNext(addr)
+		return nil
+	})
+
+	s.existenceFilter.finishRebuild(err == nil)
+	if err != nil {
+		return fmt.Errorf("could not iterate over metabase: %w", err)
+	}
+
+	return nil
+}
+
+// rebuildExistenceFilter rebuilds the existence filter logging the failure.
+func (s *Shard) rebuildExistenceFilter() {
+	if err := s.buildExistenceFilter(); err != nil {
+		s.log.Warn("could not build existence filter",
+			zap.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,154 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const n = 10000
+
+	// 10 bits per address
+	f := newBloomFilter(n*10/8, n)
+	require.EqualValues(t, 7, f.hashes)
+
+	for i := 0; i < n; i++ {
+		addr := oidtest.Address()
+		f.add(addr)
+		require.True(t, f.mayContain(addr))
+	}
+
+	var positives int
+	for i := 0; i < n; i++ {
+		if f.mayContain(oidtest.Address()) {
+			positives++
+		}
+	}
+
+	// ~0.8% expected
+	require.Less(t, positives, n/50)
+}
+
+// existenceFilterTestOpts returns the options of the test shard with the
+// write-cache and the existence filter of the given size.
+func existenceFilterTestOpts(dir string, filterSize uint64) []Option {
+	return []Option{
+		WithWriteCache(true),
+		WithWriteCacheOptions(writecache.WithPath(filepath.Join(dir, "wc"))),
+		// the remover is called manually
+		WithGCRemoverSleepInterval(time.Hour),
+		WithExistenceFilter(filterSize),
+	}
+}
+
+func TestShard_ExistenceFilter(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		sh := newTestShard(t, t.TempDir(), existenceFilterTestOpts(t.TempDir(), 0)...)
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		require.True(t, sh.MayContain(oidtest.Address()))
+	})
+
+	dir := t.TempDir()
+	sh := newTestShard(t, dir, existenceFilterTestOpts(dir, 1<<10)...)
+
+	cnr := cidtest.ID()
+
+	stored := putGCTestObject(t, sh, cnr)
+
+	var csum checksum.Checksum
+	csum.SetSHA256(sha256.Sum256(nil))
+
+	parent := objectSDK.New()
+	parent.SetID(oidtest.ID())
+	parent.SetContainerID(cnr)
+	parent.SetOwnerID(usertest.ID())
+	parent.SetPayloadChecksum(csum)
+
+	parentAddr := object.AddressOf(parent)
+	parentID, _ := parent.ID()
+
+	child := objectSDK.New()
+	child.SetID(oidtest.ID())
+	child.SetContainerID(cnr)
+	child.SetOwnerID(usertest.ID())
+	child.SetPayloadChecksum(csum)
+	child.SetParent(parent)
+	child.SetParentID(parentID)
+
+	var putPrm PutPrm
+	putPrm.SetObject(child)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	childAddr := object.AddressOf(child)
+
+	// the object is removed with a tombstone stored in another shard
+	removed := oidtest.Address()
+	removed.SetContainer(cnr)
+
+	var inhumePrm InhumePrm
+	inhumePrm.SetTarget(oidtest.Address(), removed)
+
+	_, err = sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	known := []oid.Address{stored, parentAddr, childAddr, removed}
+
+	requireKnown := func(sh *Shard) {
+		for i := range known {
+			require.True(t, sh.MayContain(known[i]), i)
+		}
+
+		require.False(t, sh.MayContain(oidtest.Address()))
+	}
+
+	requireKnown(sh)
+
+	t.Run("rebuild on init", func(t *testing.T) {
+		require.NoError(t, sh.Close())
+
+		sh = newTestShard(t, dir, existenceFilterTestOpts(dir, 1<<10)...)
+		requireKnown(sh)
+	})
+
+	t.Run("rebuild on removal", func(t *testing.T) {
+		var delPrm DeletePrm
+		delPrm.SetAddresses(stored, childAddr)
+
+		_, err := sh.Delete(delPrm)
+		require.NoError(t, err)
+
+		require.True(t, sh.existenceFilter.stale())
+		require.True(t, sh.MayContain(stored))
+		require.True(t, sh.MayContain(childAddr))
+
+		sh.removeGarbage()
+
+		require.False(t, sh.existenceFilter.stale())
+		require.False(t, sh.MayContain(stored))
+		require.False(t, sh.MayContain(childAddr))
+		require.True(t, sh.MayContain(removed))
+	})
+
+	t.Run("degraded mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.DegradedReadOnly))
+		require.True(t, sh.MayContain(oidtest.Address()))
+	})
+
+	require.NoError(t, sh.Close())
+}
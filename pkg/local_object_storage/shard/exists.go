@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -9,6 +10,7 @@ import (
 // ExistsPrm groups the parameters of Exists operation.
 type ExistsPrm struct {
 	addr oid.Address
+	ctx  context.Context
 }
 
 // ExistsRes groups the resulting values of Exists operation.
@@ -21,6 +23,12 @@ func (p *ExistsPrm) SetAddress(addr oid.Address) {
 	p.addr = addr
 }
 
+// SetContext is an Exists option to set the context which interrupts the
+// operation before reading the object from the blobstor when done.
+func (p *ExistsPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Exists returns the fact that the object is in the shard.
 func (p ExistsRes) Exists() bool {
 	return p.ex
@@ -33,11 +41,17 @@ func (p ExistsRes) Exists() bool {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been marked as removed.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Exists(prm ExistsPrm) (ExistsRes, error) {
+// Returns the context error if the context is done before the blobstor read.
+func (s *Shard) Exists(prm ExistsPrm) (_ ExistsRes, err error) {
+	defer s.catchStoragePanic("exists", &err)()
+
 	var exists bool
-	var err error
 
 	if s.GetMode().NoMetabase() {
+		if err := ctxError(prm.ctx); err != nil {
+			return ExistsRes{}, err
+		}
+
 		var p common.ExistsPrm
 		p.Address = prm.addr
 
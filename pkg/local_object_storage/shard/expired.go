@@ -0,0 +1,316 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// expiredState contains the state of the expired objects collection.
+//
+// The expired objects are collected in the order of the metabase expiration
+// index. If the number of the objects collected per run is limited (see
+// WithExpiredObjectsLimit), the position in the index is persisted in the
+// metabase and the next runs continue from it: the new epoch handlers and
+// the GC remover ticks until the index is passed completely.
+type expiredState struct {
+	// sem is a semaphore serializing the collection runs, the remover
+	// ticks skip the run if it is held.
+	sem chan struct{}
+
+	// the fields below are accessed with sem held
+
+	// loaded is true if the cursor has been read from the metabase.
+	loaded bool
+	// cursor is the position in the expiration index the next run starts after.
+	cursor *meta.ExpiredCursor
+	// pending is true if the expired objects of the latest epoch
+	// have not been collected completely.
+	pending bool
+	// epoch is the latest handled epoch.
+	epoch uint64
+	// passEpoch is the epoch the current pass over the index has been
+	// started at. The objects expired later may be missed by the pass
+	// before the cursor, so the index is passed once more in this case.
+	passEpoch uint64
+	// backlogKnown is true if the backlog has been counted in the current pass.
+	backlogKnown bool
+	// countCursor is the position in the expiration index the backlog
+	// has been counted up to in the current pass, nil if the counting
+	// has not been started yet.
+	countCursor *meta.ExpiredCursor
+
+	// backlog is the number of the expired objects waiting for the collection.
+	backlog atomic.Uint64
+}
+
+// expiredCountBatch is the maximum number of the expired objects counted
+// in the backlog per collection run if the collection limit is lower.
+const expiredCountBatch = 1000
+
+func newExpiredState() *expiredState {
+	return &expiredState{
+		sem: make(chan struct{}, 1),
+	}
+}
+
+// ExpiredBacklog returns the number of the expired objects which have not
+// been marked as garbage yet because of the per-run limit (see
+// WithExpiredObjectsLimit). The value is counted once per pass over the
+// metabase expiration index and decreases as the objects are collected.
+// The counting is spread over the collection runs, so the value may be
+// lower than the actual backlog at the beginning of the pass.
+func (s *Shard) ExpiredBacklog() uint64 {
+	return s.expired.backlog.Load()
+}
+
+// collectExpiredObjects marks the objects expired at the epoch as garbage.
+// At most the configured number of objects is marked, the rest is carried
+// over to the GC remover ticks (see continueExpiredCollection).
+func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
+	select {
+	case s.expired.sem <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-s.expired.sem }()
+
+	s.loadExpiredCursor()
+
+	epoch := e.(newEpoch).epoch
+
+	s.expired.epoch = epoch
+	if !s.expired.pending {
+		s.expired.pending = true
+		s.expired.passEpoch = epoch
+	}
+
+	s.collectExpiredBatch(ctx)
+}
+
+// continueExpiredCollection continues the collection of the expired objects
+// carried over from the previous runs. Does nothing if the collection is
+// running already or there are no objects left.
+func (s *Shard) continueExpiredCollection() {
+	var err error
+	defer s.catchStoragePanic("continue expired objects collection", &err)()
+
+	if s.GetMode() != mode.ReadWrite {
+		return
+	}
+
+	select {
+	case s.expired.sem <- struct{}{}:
+	default:
+		return
+	}
+	defer func() { <-s.expired.sem }()
+
+	if s.expired.pending {
+		s.collectExpiredBatch(context.Background())
+	}
+}
+
+// loadExpiredCursor reads the cursor persisted by the previous shard runs.
+// Must be called with the semaphore held.
+func (s *Shard) loadExpiredCursor() {
+	if s.expired.loaded {
+		return
+	}
+
+	c, err := s.metaBase.ReadExpiredCursor()
+	if err != nil {
+		s.log.Warn("could not read expired objects cursor, start from the beginning", zap.Error(err))
+	}
+
+	s.expired.loaded = true
+	s.expired.cursor = c
+
+	if c != nil {
+		// the epoch of the interrupted pass is unknown,
+		// so the index is passed once more after it
+		s.expired.pending = true
+		s.expired.passEpoch = 0
+	}
+}
+
+// collectExpiredBatch marks as garbage at most the configured number of the
+// objects expired at the latest epoch starting after the cursor. Must be
+// called with the semaphore held.
+func (s *Shard) collectExpiredBatch(ctx context.Context) {
+	st := s.expired
+
+	stat := GCTickStat{
+		Type:  GCRunExpired,
+		Time:  time.Now(),
+		Epoch: st.epoch,
+	}
+
+	defer func() {
+		stat.Duration = time.Since(stat.Time)
+		s.gc.history.add(stat)
+	}()
+
+	limit := int(s.expiredObjectsLimit)
+
+	var expired []oid.Address
+
+	next, err := s.metaBase.IterateExpiredAfter(st.epoch, st.cursor, func(expiredObject *meta.ExpiredObject) error {
+		select {
+		case <-ctx.Done():
+			return meta.ErrInterruptIterator
+		default:
+		}
+
+		if isCollectedOnExpiration(expiredObject.Type()) {
+			expired = append(expired, expiredObject.Address())
+			if limit > 0 && len(expired) == limit {
+				return meta.ErrInterruptIterator
+			}
+		}
+
+		return nil
+	})
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	stat.Candidates = uint64(len(expired))
+	if err != nil {
+		s.log.Warn("iterator over expired objects failed", zap.String("error", err.Error()))
+
+		stat.Errors++
+		stat.Deferred = stat.Candidates
+		return
+	}
+
+	if len(expired) > 0 {
+		var inhumePrm meta.InhumePrm
+
+		inhumePrm.SetAddresses(expired...)
+		inhumePrm.SetGCMark()
+		inhumePrm.SetPriority(s.gcPriority)
+		inhumePrm.SetContext(ctx)
+
+		// inhume the collected objects, the cursor is not moved
+		// on failure to retry the same objects on the next run
+		res, err := s.metaBase.Inhume(inhumePrm)
+		if err != nil {
+			s.log.Warn("could not inhume the objects",
+				zap.String("error", err.Error()),
+			)
+
+			// the objects locked after the iteration fail the whole batch
+			if errors.As(err, new(apistatus.ObjectLocked)) {
+				stat.Locked = stat.Candidates
+			} else {
+				stat.Errors++
+				stat.Deferred = stat.Candidates
+			}
+
+			return
+		}
+
+		stat.Removed = stat.Candidates
+		s.decObjectCounterBy(logical, res.AvailableInhumed())
+	}
+
+	s.moveExpiredCursor(next)
+
+	if next == nil {
+		// the index is passed, pass it once more if the epoch
+		// has changed since the pass was started
+		if st.passEpoch < st.epoch {
+			st.passEpoch = st.epoch
+		} else {
+			st.pending = false
+		}
+
+		st.backlogKnown = false
+		st.countCursor = nil
+		st.backlog.Store(0)
+
+		return
+	}
+
+	if b := st.backlog.Load(); b > stat.Removed {
+		st.backlog.Store(b - stat.Removed)
+	} else {
+		st.backlog.Store(0)
+	}
+
+	if !st.backlogKnown {
+		s.countExpiredBacklog(ctx, next)
+	}
+}
+
+// moveExpiredCursor sets the cursor of the expired objects collection and
+// persists it in the metabase. Must be called with the semaphore held.
+func (s *Shard) moveExpiredCursor(c *meta.ExpiredCursor) {
+	if c == nil && s.expired.cursor == nil {
+		return
+	}
+
+	s.expired.cursor = c
+
+	err := s.metaBase.WriteExpiredCursor(c)
+	if err != nil {
+		s.log.Warn("could not write expired objects cursor", zap.Error(err))
+	}
+}
+
+// countExpiredBacklog counts the next part of the objects expired at the
+// latest epoch and adds it to the backlog. The counting is started after
+// the cursor c and is continued by the next runs from the position it has
+// been stopped at. At least as many objects are counted per run as may be
+// collected, so the counting stays ahead of the collection. Must be called
+// with the semaphore held.
+func (s *Shard) countExpiredBacklog(ctx context.Context, c *meta.ExpiredCursor) {
+	st := s.expired
+
+	if st.countCursor != nil {
+		c = st.countCursor
+	}
+
+	limit := expiredCountBatch
+	if l := int(s.expiredObjectsLimit); l > limit {
+		limit = l
+	}
+
+	var n int
+
+	next, err := s.metaBase.IterateExpiredAfter(st.epoch, c, func(expiredObject *meta.ExpiredObject) error {
+		if isCollectedOnExpiration(expiredObject.Type()) {
+			n++
+		}
+
+		if n == limit || ctx.Err() != nil {
+			return meta.ErrInterruptIterator
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Warn("could not count expired objects backlog", zap.Error(err))
+		return
+	}
+
+	st.backlog.Add(uint64(n))
+	st.countCursor = next
+	st.backlogKnown = next == nil
+}
+
+// isCollectedOnExpiration checks whether the expired object of the type
+// is marked as garbage by collectExpiredObjects. Expired tombstones and
+// locks are handled separately.
+func isCollectedOnExpiration(typ object.Type) bool {
+	return typ != object.TypeTombstone && typ != object.TypeLock
+}
@@ -0,0 +1,225 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ErrMigrationInProgress is returned when FSTree migration is requested
+// while another one is being performed on the same shard.
+var ErrMigrationInProgress = errors.New("FSTree migration is already in progress")
+
+// migrationCursorInterval is the number of the processed objects after which
+// the migration cursor is persisted.
+const migrationCursorInterval = 100
+
+// FSTreeMigrationPrm groups the parameters of StartFSTreeMigration operation.
+type FSTreeMigrationPrm struct {
+	rateLimit uint32
+	restart   bool
+}
+
+// WithRateLimit is a StartFSTreeMigration option to set the maximum number
+// of objects processed per second. Zero value means no limit.
+func (p *FSTreeMigrationPrm) WithRateLimit(objPerSec uint32) {
+	p.rateLimit = objPerSec
+}
+
+// WithRestart is a StartFSTreeMigration option to start the migration from
+// the beginning instead of continuing the previous interrupted one.
+func (p *FSTreeMigrationPrm) WithRestart(v bool) {
+	p.restart = v
+}
+
+// FSTreeMigrationStatus groups the progress of the FSTree migration.
+type FSTreeMigrationStatus struct {
+	// Running is true while the migration is being performed.
+	Running bool
+	// Completed is true if all the FSTree objects have been processed.
+	Completed bool
+	// Processed is the number of the processed FSTree objects.
+	Processed uint64
+	// Moved is the number of the objects moved to other sub-storages.
+	Moved uint64
+	// Failed is the number of the objects failed to be moved.
+	Failed uint64
+	// Err is the error the migration has been stopped with.
+	Err error
+}
+
+// migrationState contains the state of the FSTree migration.
+type migrationState struct {
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	status FSTreeMigrationStatus
+}
+
+// FSTreeMigrationStatus returns the progress of the current or the latest
+// FSTree migration started after the shard opening.
+func (s *Shard) FSTreeMigrationStatus() FSTreeMigrationStatus {
+	s.migration.mtx.Lock()
+	defer s.migration.mtx.Unlock()
+
+	return s.migration.status
+}
+
+// StartFSTreeMigration starts the background migration of the objects stored
+// in FSTree to the sub-storages they belong to according to the current
+// storage policies, e.g. after the small object size has been raised (see
+// blobstor.MigrateFSTree). Storage IDs of the moved objects are updated in
+// the metabase.
+//
+// Migration is stopped with StopFSTreeMigration, on the shard closing or
+// when the shard is moved to read-only or degraded mode. The last processed object is persisted in the
+// metabase, so the interrupted migration is continued by the next start
+// unless WithRestart option is set.
+//
+// Returns ErrDegradedMode if the shard is in degraded mode.
+// Returns ErrReadOnlyMode if the shard is in read-only mode.
+// Returns ErrMigrationInProgress if the shard is being migrated at the moment.
+func (s *Shard) StartFSTreeMigration(prm FSTreeMigrationPrm) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	s.migration.mtx.Lock()
+	defer s.migration.mtx.Unlock()
+
+	if s.migration.status.Running {
+		return ErrMigrationInProgress
+	}
+
+	var cursor *oid.Address
+	if !prm.restart {
+		var err error
+
+		cursor, err = s.metaBase.ReadBlobMigrationCursor()
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.migration.cancel = cancel
+	s.migration.done = make(chan struct{})
+	s.migration.status = FSTreeMigrationStatus{Running: true}
+
+	go s.migrateFSTree(ctx, prm, cursor, s.migration.done)
+
+	return nil
+}
+
+// StopFSTreeMigration interrupts the FSTree migration and waits for it to finish.
+// Does nothing if the migration is not running.
+func (s *Shard) StopFSTreeMigration() {
+	s.migration.mtx.Lock()
+	cancel, done := s.migration.cancel, s.migration.done
+	s.migration.mtx.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (s *Shard) migrateFSTree(ctx context.Context, prm FSTreeMigrationPrm, cursor *oid.Address, done chan struct{}) {
+	defer close(done)
+
+	if cursor != nil {
+		s.log.Info("continuing FSTree migration", zap.Stringer("cursor", cursor))
+	} else {
+		s.log.Info("starting FSTree migration")
+	}
+
+	var (
+		limiter = newRateLimiter(prm.rateLimit)
+		last    *oid.Address
+		unsaved int
+	)
+
+	err := s.blobStor.MigrateFSTree(blobstor.FSTreeMigrationPrm{
+		After: cursor,
+		Handler: func(elem blobstor.FSTreeMigrationElement) error {
+			if elem.Err != nil {
+				s.log.Warn("could not migrate object from FSTree",
+					zap.Stringer("address", elem.Address),
+					zap.String("error", elem.Err.Error()))
+			}
+
+			s.migration.mtx.Lock()
+			s.migration.status.Processed++
+			if elem.Moved {
+				s.migration.status.Moved++
+			} else if elem.Err != nil {
+				s.migration.status.Failed++
+			}
+			s.migration.mtx.Unlock()
+
+			addr := elem.Address
+			last = &addr
+
+			if unsaved++; unsaved == migrationCursorInterval {
+				s.saveMigrationCursor(last)
+				unsaved = 0
+			}
+
+			if m := s.GetMode(); m.NoMetabase() {
+				return ErrDegradedMode
+			} else if m.ReadOnly() {
+				return ErrReadOnlyMode
+			}
+
+			return limiter.wait(ctx)
+		},
+	})
+
+	completed := err == nil
+	if completed {
+		s.saveMigrationCursor(nil)
+	} else if last != nil {
+		s.saveMigrationCursor(last)
+	}
+
+	if errors.Is(err, context.Canceled) {
+		err = nil
+	}
+
+	s.migration.mtx.Lock()
+	s.migration.cancel = nil
+	s.migration.status.Running = false
+	s.migration.status.Completed = completed
+	s.migration.status.Err = err
+	status := s.migration.status
+	s.migration.mtx.Unlock()
+
+	fields := []zap.Field{
+		zap.Bool("completed", status.Completed),
+		zap.Uint64("processed", status.Processed),
+		zap.Uint64("moved", status.Moved),
+		zap.Uint64("failed", status.Failed),
+	}
+
+	if err != nil {
+		s.log.Error("FSTree migration failed", append(fields, zap.Error(err))...)
+	} else {
+		s.log.Info("FSTree migration finished", fields...)
+	}
+}
+
+func (s *Shard) saveMigrationCursor(addr *oid.Address) {
+	if err := s.metaBase.WriteBlobMigrationCursor(addr); err != nil {
+		s.log.Warn("could not save FSTree migration cursor", zap.Error(err))
+	}
+}
@@ -0,0 +1,148 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestShard_FSTreeMigration(t *testing.T) {
+	const (
+		objCount    = 10
+		smallSize   = 1 << 10
+		payloadSize = smallSize / 2
+	)
+
+	dir := t.TempDir()
+	bsPath := filepath.Join(dir, "blob")
+
+	// all the objects are stored in FSTree until the limit is raised
+	limit := atomic.NewInt64(0)
+
+	sh := newCustomShard(t, dir, false, nil, []blobstor.Option{
+		blobstor.WithStorages([]blobstor.SubStorage{
+			{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithRootPath(filepath.Join(bsPath, "blobovnicza")),
+					blobovniczatree.WithBlobovniczaShallowDepth(1),
+					blobovniczatree.WithBlobovniczaShallowWidth(1)),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return int64(len(data)) < limit.Load()
+				},
+			},
+			{
+				Storage: fstree.New(
+					fstree.WithPath(bsPath),
+					fstree.WithDepth(1)),
+			},
+		}),
+	})
+	defer releaseShard(sh, t)
+
+	// small objects are moved, big ones stay in FSTree
+	var small, big []oid.Address
+	for i := 0; i < 2*objCount; i++ {
+		size := payloadSize
+		if i%2 == 1 {
+			size = 2 * smallSize
+		}
+
+		obj := generateObjectWithPayload(cidtest.ID(), make([]byte, size))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		if i%2 == 1 {
+			big = append(big, objectCore.AddressOf(obj))
+		} else {
+			small = append(small, objectCore.AddressOf(obj))
+		}
+	}
+
+	limit.Store(smallSize)
+
+	wait := func(t *testing.T) shard.FSTreeMigrationStatus {
+		require.Eventually(t, func() bool {
+			return !sh.FSTreeMigrationStatus().Running
+		}, 5*time.Second, 10*time.Millisecond)
+
+		return sh.FSTreeMigrationStatus()
+	}
+
+	requireStorage := func(t *testing.T, addrs []oid.Address, typ string) {
+		for _, addr := range addrs {
+			st, err := sh.ObjectStatus(addr)
+			require.NoError(t, err)
+			require.Equal(t, typ, st.BlobStorage)
+
+			var getPrm shard.GetPrm
+			getPrm.SetAddress(addr)
+
+			_, err = sh.Get(getPrm)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("interrupt and resume", func(t *testing.T) {
+		var prm shard.FSTreeMigrationPrm
+		prm.WithRateLimit(1)
+
+		require.NoError(t, sh.StartFSTreeMigration(prm))
+		require.ErrorIs(t, sh.StartFSTreeMigration(prm), shard.ErrMigrationInProgress)
+
+		sh.StopFSTreeMigration()
+
+		interrupted := sh.FSTreeMigrationStatus()
+		require.False(t, interrupted.Running)
+		require.False(t, interrupted.Completed)
+		require.NoError(t, interrupted.Err)
+		require.LessOrEqual(t, interrupted.Processed, uint64(1))
+
+		require.NoError(t, sh.StartFSTreeMigration(shard.FSTreeMigrationPrm{}))
+
+		st := wait(t)
+		require.True(t, st.Completed)
+		require.NoError(t, st.Err)
+		require.Zero(t, st.Failed)
+		require.EqualValues(t, 2*objCount, interrupted.Processed+st.Processed)
+		require.EqualValues(t, objCount, interrupted.Moved+st.Moved)
+
+		requireStorage(t, small, blobovniczatree.Type)
+		requireStorage(t, big, fstree.Type)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		var prm shard.FSTreeMigrationPrm
+		prm.WithRestart(true)
+
+		require.NoError(t, sh.StartFSTreeMigration(prm))
+
+		// big objects are checked again
+		st := wait(t)
+		require.True(t, st.Completed)
+		require.EqualValues(t, objCount, st.Processed)
+		require.Zero(t, st.Moved)
+	})
+
+	t.Run("read-only mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		t.Cleanup(func() { require.NoError(t, sh.SetMode(mode.ReadWrite)) })
+
+		require.ErrorIs(t, sh.StartFSTreeMigration(shard.FSTreeMigrationPrm{}), shard.ErrReadOnlyMode)
+	})
+}
@@ -2,6 +2,8 @@ package shard
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -68,15 +70,32 @@ type gc struct {
 
 	workerPool util.WorkerPool
 
-	remover func()
+	remover func() GCTickStat
+
+	// expiredCollector continues the collection of the expired
+	// objects carried over from the new epoch handling.
+	expiredCollector func()
+
+	// triggerChannel receives the requests to run the remover immediately,
+	// the statistics of the run is sent to the passed channel.
+	triggerChannel chan chan GCTickStat
+
+	history *gcHistory
 
 	eventChan     chan Event
 	mEventHandler map[eventType]*eventHandlers
+
+	// listenerStopped is closed when the event listener is stopped.
+	listenerStopped chan struct{}
 }
 
 type gcCfg struct {
 	removerInterval time.Duration
 
+	historySize int
+
+	verifyGarbage bool
+
 	log *logger.Logger
 
 	workerPoolInit func(int) util.WorkerPool
@@ -85,6 +104,7 @@ type gcCfg struct {
 func defaultGCCfg() *gcCfg {
 	return &gcCfg{
 		removerInterval: 10 * time.Second,
+		historySize:     defaultGCHistorySize,
 		log:             zap.L(),
 		workerPoolInit: func(int) util.WorkerPool {
 			return nil
@@ -112,6 +132,16 @@ func (gc *gc) listenEvents() {
 		event, ok := <-gc.eventChan
 		if !ok {
 			gc.log.Warn("stop event listener by closed channel")
+
+			// the shard storage may be closed right after the GC
+			// is stopped, so wait for the running handlers
+			for _, v := range gc.mEventHandler {
+				v.cancelFunc()
+				v.prevGroup.Wait()
+			}
+
+			close(gc.listenerStopped)
+
 			return
 		}
 
@@ -162,93 +192,189 @@ func (gc *gc) tickRemover() {
 			gc.log.Debug("GC is stopped")
 			return
 		case <-timer.C:
-			gc.remover()
+			gc.history.add(gc.runRemover())
+
+			// the garbage is removed first not to be starved
+			// by the expired objects backlog
+			if gc.expiredCollector != nil {
+				gc.expiredCollector()
+			}
+
 			timer.Reset(gc.removerInterval)
+		case res := <-gc.triggerChannel:
+			stat := gc.runRemover()
+
+			gc.history.add(stat)
+
+			res <- stat
 		}
 	}
 }
 
+// runRemover calls the remover and sets the timing of the run.
+func (gc *gc) runRemover() GCTickStat {
+	start := time.Now()
+
+	stat := gc.remover()
+	stat.Type = GCRunRemover
+	stat.Time = start
+	stat.Duration = time.Since(start)
+
+	return stat
+}
+
+// stop stops the GC and waits for all its routines to finish.
 func (gc *gc) stop() {
 	gc.onceStop.Do(func() {
 		gc.stopChannel <- struct{}{}
+		<-gc.listenerStopped
 	})
 }
 
+// safeEventHandler wraps the GC event handler with the
+// recovery from the storage panics (see WithStoragePanicCallback).
+func (s *Shard) safeEventHandler(name string, h eventHandler) eventHandler {
+	return func(ctx context.Context, e Event) {
+		var err error
+		defer s.catchStoragePanic(name, &err)()
+
+		h(ctx, e)
+	}
+}
+
 // iterates over metabase and deletes objects
 // with GC-marked graves.
 // Does nothing if shard is in "read-only" mode.
-func (s *Shard) removeGarbage() {
+func (s *Shard) removeGarbage() (stat GCTickStat) {
+	var err error
+	defer s.catchStoragePanic("remove garbage", &err)()
+
 	if s.GetMode() != mode.ReadWrite {
 		return
 	}
 
+	// filter is rebuilt here not to block the requests
+	if s.existenceFilter.stale() {
+		s.rebuildExistenceFilter()
+	}
+
 	buf := make([]oid.Address, 0, s.rmBatchSize)
 
-	var iterPrm meta.GarbageIterationPrm
+	var (
+		iterPrm meta.GarbageIterationPrm
+		batch   []oid.Address
+	)
+
 	iterPrm.SetHandler(func(g meta.GarbageObject) error {
-		buf = append(buf, g.Address())
+		batch = append(batch, g.Address())
+		stat.Candidates++
 
-		if len(buf) == s.rmBatchSize {
+		if len(buf)+len(batch) == s.rmBatchSize {
 			return meta.ErrInterruptIterator
 		}
 
 		return nil
 	})
 
-	// iterate over metabase's objects with GC mark
-	// (no more than s.rmBatchSize objects)
-	err := s.metaBase.IterateOverGarbage(iterPrm)
-	if err != nil {
-		s.log.Warn("iterator over metabase graveyard failed",
-			zap.String("error", err.Error()),
-		)
+	for {
+		batch = batch[:0]
 
-		return
-	} else if len(buf) == 0 {
+		// iterate over metabase's objects with GC mark
+		// (no more than s.rmBatchSize objects)
+		err := s.metaBase.IterateOverGarbage(iterPrm)
+		if err != nil {
+			s.log.Warn("iterator over metabase graveyard failed",
+				zap.String("error", err.Error()),
+			)
+
+			stat.Errors++
+			return
+		} else if len(batch) == 0 {
+			break
+		}
+
+		exhausted := len(buf)+len(batch) < s.rmBatchSize
+		last := batch[len(batch)-1]
+
+		if !s.gcCfg.verifyGarbage {
+			buf = append(buf, batch...)
+			break
+		}
+
+		// skipped objects stay GC-marked, so continue the iteration
+		// after them to fill the batch
+		buf = append(buf, s.verifyGarbage(batch, &stat)...)
+		if exhausted || len(buf) == s.rmBatchSize {
+			break
+		}
+
+		iterPrm.SetOffset(last)
+	}
+
+	if len(buf) == 0 {
 		return
 	}
 
 	var deletePrm DeletePrm
 	deletePrm.SetAddresses(buf...)
+	deletePrm.priority = s.gcPriority
 
 	// delete accumulated objects
-	_, err = s.Delete(deletePrm)
+	res, err := s.Delete(deletePrm)
 	if err != nil {
 		s.log.Warn("could not delete the objects",
 			zap.String("error", err.Error()),
 		)
 
+		stat.Errors++
+		stat.Deferred += uint64(len(buf))
 		return
 	}
+
+	stat.Removed = res.removed
+	stat.Bytes = res.size
+	return
 }
 
-func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
-	expired, err := s.getExpiredObjects(ctx, e.(newEpoch).epoch, func(typ object.Type) bool {
-		return typ != object.TypeTombstone && typ != object.TypeLock
-	})
-	if err != nil || len(expired) == 0 {
+// verifyGarbage re-checks the status of the GC-marked objects right before
+// the removal and returns the objects that are still garbage. Objects
+// which are not GC-marked anymore or have been locked since marking are
+// skipped.
+func (s *Shard) verifyGarbage(addrs []oid.Address, stat *GCTickStat) []oid.Address {
+	res := addrs[:0]
+
+	for i := range addrs {
+		st, err := s.metaBase.ObjectStatus(addrs[i])
 		if err != nil {
-			s.log.Warn("iterator over expired objects failed", zap.String("error", err.Error()))
+			s.log.Warn("could not verify GC-marked object",
+				zap.Stringer("address", addrs[i]),
+				zap.String("error", err.Error()),
+			)
+
+			stat.Errors++
+			stat.Deferred++
+			continue
 		}
-		return
-	}
 
-	var inhumePrm meta.InhumePrm
+		if st.GCMarked && st.Locked {
+			stat.Locked++
+		}
 
-	inhumePrm.SetAddresses(expired...)
-	inhumePrm.SetGCMark()
+		if !st.GCMarked || st.Locked {
+			s.log.Debug("GC-marked object is protected, skip removal",
+				zap.Stringer("address", addrs[i]),
+				zap.Bool("gc marked", st.GCMarked),
+				zap.Bool("locked", st.Locked),
+			)
 
-	// inhume the collected objects
-	res, err := s.metaBase.Inhume(inhumePrm)
-	if err != nil {
-		s.log.Warn("could not inhume the objects",
-			zap.String("error", err.Error()),
-		)
+			stat.Skipped++
+			continue
+		}
 
-		return
+		res = append(res, addrs[i])
 	}
 
-	s.decObjectCounterBy(logical, res.AvailableInhumed())
+	return res
 }
 
 func (s *Shard) collectExpiredTombstones(ctx context.Context, e Event) {
@@ -352,6 +478,7 @@ func (s *Shard) HandleExpiredTombstones(tss []meta.TombstonedObject) {
 
 	pInhume.SetGCMark()
 	pInhume.SetAddresses(tsAddrs...)
+	pInhume.SetPriority(s.gcPriority)
 
 	// inhume tombstones
 	res, err := s.metaBase.Inhume(pInhume)
@@ -388,6 +515,7 @@ func (s *Shard) HandleExpiredLocks(lockers []oid.Address) {
 	var pInhume meta.InhumePrm
 	pInhume.SetAddresses(lockers...)
 	pInhume.SetGCMark()
+	pInhume.SetPriority(s.gcPriority)
 
 	res, err := s.metaBase.Inhume(pInhume)
 	if err != nil {
@@ -417,3 +545,74 @@ func (s *Shard) HandleDeletedLocks(lockers []oid.Address) {
 func (s *Shard) NotificationChannel() chan<- Event {
 	return s.gc.eventChan
 }
+
+// errGCStopped is returned by TriggerGC if the GC of the shard is not running.
+var errGCStopped = errors.New("GC is stopped")
+
+// TriggerGC runs the GC remover immediately without waiting for the next tick
+// and returns the statistics of the run. The run is recorded in the GC history
+// like the regular ones.
+//
+// Returns ErrDegradedMode or ErrReadOnlyMode if the shard is not in the
+// read-write mode since the remover does nothing in the other modes.
+func (s *Shard) TriggerGC(ctx context.Context) (GCTickStat, error) {
+	switch m := s.GetMode(); {
+	case m.NoMetabase():
+		return GCTickStat{}, ErrDegradedMode
+	case m.ReadOnly():
+		return GCTickStat{}, ErrReadOnlyMode
+	}
+
+	if s.gc == nil {
+		return GCTickStat{}, errGCStopped
+	}
+
+	res := make(chan GCTickStat, 1)
+
+	select {
+	case s.gc.triggerChannel <- res:
+	case <-s.gc.listenerStopped:
+		return GCTickStat{}, errGCStopped
+	case <-ctx.Done():
+		return GCTickStat{}, ctx.Err()
+	}
+
+	select {
+	case stat := <-res:
+		return stat, nil
+	case <-ctx.Done():
+		return GCTickStat{}, ctx.Err()
+	}
+}
+
+// PreviewGarbage returns the addresses of at most limit objects marked as
+// garbage, i.e. the ones to be removed by the next GC runs unless they fail
+// the verification (see WithGCVerification). Non-positive limit means no limit.
+//
+// Returns ErrDegradedMode if the shard works without the metabase.
+func (s *Shard) PreviewGarbage(limit int) ([]oid.Address, error) {
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var (
+		res     []oid.Address
+		iterPrm meta.GarbageIterationPrm
+	)
+
+	iterPrm.SetHandler(func(g meta.GarbageObject) error {
+		res = append(res, g.Address())
+		if limit > 0 && len(res) == limit {
+			return meta.ErrInterruptIterator
+		}
+
+		return nil
+	})
+
+	err := s.metaBase.IterateOverGarbage(iterPrm)
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate over garbage: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package shard
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultGCHistorySize is the default number of GC remover ticks kept in memory.
+const defaultGCHistorySize = 360
+
+// GCRunType is a type of the GC run.
+type GCRunType uint8
+
+const (
+	_ GCRunType = iota
+	// GCRunRemover is a GC remover tick removing the objects marked as garbage.
+	GCRunRemover
+	// GCRunExpired is the expired objects handling on a new epoch.
+	GCRunExpired
+)
+
+// GCTickStat groups the statistics of a single GC remover tick or
+// of the expired objects handling on a new epoch.
+type GCTickStat struct {
+	// Type is the type of the tick.
+	Type GCRunType
+	// Time is the start time of the tick.
+	Time time.Time
+	// Duration is the time spent for the tick.
+	Duration time.Duration
+	// Epoch is the handled epoch, zero for the remover ticks.
+	Epoch uint64
+	// Candidates is the number of objects considered for the removal:
+	// GC-marked objects for the remover ticks and expired objects for
+	// the epochs.
+	Candidates uint64
+	// Removed is the number of physically removed objects. For the epochs
+	// it is the number of objects marked as garbage.
+	Removed uint64
+	// Bytes is the total payload size of the removed objects.
+	Bytes uint64
+	// Errors is the number of errors occurred during the tick.
+	Errors uint64
+	// Skipped is the number of GC-marked objects that have not been
+	// removed because they failed the verification (see WithGCVerification).
+	Skipped uint64
+	// Locked is the number of candidates deferred because they are locked.
+	// For the remover ticks these objects are also counted in Skipped.
+	Locked uint64
+	// Deferred is the number of candidates deferred because of the errors.
+	Deferred uint64
+}
+
+// gcHistory is a fixed-size ring buffer of GC tick statistics.
+type gcHistory struct {
+	mtx sync.RWMutex
+
+	// next is the index to write the next tick to.
+	next int
+	// full is true if the buffer has been wrapped around.
+	full bool
+
+	ticks []GCTickStat
+}
+
+func newGCHistory(size int) *gcHistory {
+	if size <= 0 {
+		size = defaultGCHistorySize
+	}
+
+	return &gcHistory{
+		ticks: make([]GCTickStat, size),
+	}
+}
+
+func (h *gcHistory) add(stat GCTickStat) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.ticks[h.next] = stat
+	h.next++
+	if h.next == len(h.ticks) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// last returns at most n latest ticks, the oldest first.
+func (h *gcHistory) last(n int) []GCTickStat {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	ln := h.next
+	if h.full {
+		ln = len(h.ticks)
+	}
+	if n > ln {
+		n = ln
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	res := make([]GCTickStat, n)
+	start := h.next - n
+	if start < 0 {
+		start += len(h.ticks)
+	}
+
+	for i := range res {
+		res[i] = h.ticks[(start+i)%len(h.ticks)]
+	}
+
+	return res
+}
+
+// GCHistory returns the statistics of at most n latest GC remover ticks
+// and new epoch handlings in the order they were performed.
+func (s *Shard) GCHistory(n int) []GCTickStat {
+	if s.gc == nil {
+		return nil
+	}
+
+	return s.gc.history.last(n)
+}
+
+// GCBacklog returns the number of objects which are physically stored in the
+// shard, but are not available logically, i.e. wait for the GC to remove them.
+//
+// Returns ErrDegradedMode if the shard works without the metabase.
+func (s *Shard) GCBacklog() (uint64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	cc, err := s.metaBase.ObjectCounters()
+	if err != nil {
+		return 0, err
+	}
+
+	if cc.Phy() < cc.Logic() {
+		return 0, nil
+	}
+
+	return cc.Phy() - cc.Logic(), nil
+}
@@ -0,0 +1,78 @@
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGCHistory(t *testing.T) {
+	h := newGCHistory(3)
+	require.Empty(t, h.last(10))
+
+	for i := 1; i <= 5; i++ {
+		h.add(GCTickStat{Removed: uint64(i)})
+	}
+
+	removed := func(stats []GCTickStat) []uint64 {
+		res := make([]uint64, len(stats))
+		for i := range stats {
+			res[i] = stats[i].Removed
+		}
+		return res
+	}
+
+	require.Equal(t, []uint64{3, 4, 5}, removed(h.last(10)))
+	require.Equal(t, []uint64{4, 5}, removed(h.last(2)))
+	require.Empty(t, h.last(0))
+}
+
+func TestGCRemoverTicks(t *testing.T) {
+	const tickCount = 5
+
+	var calls uint64
+	gc := &gc{
+		gcCfg: &gcCfg{
+			removerInterval: time.Millisecond,
+			log:             zap.NewNop(),
+		},
+		remover: func() GCTickStat {
+			calls++
+			return GCTickStat{
+				Removed: calls,
+				Bytes:   calls * 100,
+				Errors:  calls % 2,
+			}
+		},
+		history:         newGCHistory(tickCount),
+		stopChannel:     make(chan struct{}),
+		eventChan:       make(chan Event),
+		listenerStopped: make(chan struct{}),
+	}
+
+	go gc.listenEvents()
+
+	done := make(chan struct{})
+	go func() {
+		gc.tickRemover()
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(gc.history.last(tickCount)) == tickCount
+	}, 5*time.Second, time.Millisecond)
+
+	gc.stop()
+	<-done
+
+	stats := gc.history.last(tickCount)
+	for i := 1; i < len(stats); i++ {
+		require.Equal(t, stats[i-1].Removed+1, stats[i].Removed)
+		require.Equal(t, stats[i].Removed*100, stats[i].Bytes)
+		require.Equal(t, stats[i].Removed%2, stats[i].Errors)
+		require.Equal(t, GCRunRemover, stats[i].Type)
+		require.False(t, stats[i].Time.Before(stats[i-1].Time))
+	}
+}
@@ -0,0 +1,300 @@
+package shard
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func putGCTestObject(t *testing.T, sh *Shard, cnr cid.ID, attrs ...objectSDK.Attribute) oid.Address {
+	payload := []byte{1, 2, 3}
+
+	var csum checksum.Checksum
+	csum.SetSHA256(sha256.Sum256(payload))
+
+	obj := objectSDK.New()
+	obj.SetID(oidtest.ID())
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(usertest.ID())
+	obj.SetType(objectSDK.TypeRegular)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(csum)
+	obj.SetAttributes(attrs...)
+
+	var putPrm PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	return object.AddressOf(obj)
+}
+
+func markGarbage(t *testing.T, sh *Shard, addrs ...oid.Address) {
+	var inhumePrm InhumePrm
+	inhumePrm.MarkAsGarbage(addrs...)
+
+	_, err := sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+}
+
+func TestGCVerification(t *testing.T) {
+	cnr := cidtest.ID()
+
+	t.Run("locked after marking", func(t *testing.T) {
+		sh := newTestShard(t, t.TempDir(),
+			// the remover is called manually
+			WithGCRemoverSleepInterval(time.Hour),
+			WithRemoverBatchSize(2),
+			WithGCVerification(true))
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		addrs := make([]oid.Address, 4)
+		for i := range addrs {
+			addrs[i] = putGCTestObject(t, sh, cnr)
+		}
+
+		markGarbage(t, sh, addrs...)
+
+		// lock the objects between marking and removal
+		locked := []oid.ID{addrs[0].Object(), addrs[2].Object()}
+		require.NoError(t, sh.Lock(cnr, oidtest.ID(), locked))
+
+		// locked objects must not take the places of the removable ones
+		// the number of the seen locked objects depends on the iteration order
+		stat := sh.removeGarbage()
+		require.EqualValues(t, 2, stat.Removed)
+		require.Equal(t, stat.Removed+stat.Skipped, stat.Candidates)
+		require.Equal(t, stat.Skipped, stat.Locked)
+		require.Zero(t, stat.Errors)
+		require.Zero(t, stat.Deferred)
+
+		stat = sh.removeGarbage()
+		require.EqualValues(t, 2, stat.Candidates)
+		require.Zero(t, stat.Removed)
+		require.EqualValues(t, 2, stat.Skipped)
+		require.EqualValues(t, 2, stat.Locked)
+
+		for i := range addrs {
+			st, err := sh.metaBase.ObjectStatus(addrs[i])
+			require.NoError(t, err)
+
+			if i%2 == 0 {
+				require.True(t, st.Found, i)
+				require.True(t, st.Locked, i)
+			} else {
+				require.False(t, st.Found, i)
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		sh := newTestShard(t, t.TempDir(),
+			// the remover is called manually
+			WithGCRemoverSleepInterval(time.Hour),
+			WithRemoverBatchSize(10))
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		addr := putGCTestObject(t, sh, cnr)
+		markGarbage(t, sh, addr)
+
+		stat := sh.removeGarbage()
+		require.EqualValues(t, 1, stat.Removed)
+		require.Zero(t, stat.Skipped)
+	})
+}
+
+func TestGCExpiredObjectsStat(t *testing.T) {
+	const epoch = 10
+
+	cnr := cidtest.ID()
+	sh := newTestShard(t, t.TempDir(),
+		// the remover is called manually
+		WithGCRemoverSleepInterval(time.Hour),
+		WithRemoverBatchSize(10))
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	var expAttr objectSDK.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue(strconv.Itoa(epoch - 1))
+
+	for i := 0; i < 3; i++ {
+		putGCTestObject(t, sh, cnr, expAttr)
+	}
+	putGCTestObject(t, sh, cnr)
+
+	sh.collectExpiredObjects(context.Background(), EventNewEpoch(epoch))
+
+	stats := sh.GCHistory(1)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, epoch, stats[0].Epoch)
+	require.Equal(t, GCRunExpired, stats[0].Type)
+	require.EqualValues(t, 3, stats[0].Candidates)
+	require.EqualValues(t, 3, stats[0].Removed)
+	require.Zero(t, stats[0].Locked)
+	require.Zero(t, stats[0].Deferred)
+
+	stat := sh.removeGarbage()
+	require.EqualValues(t, 3, stat.Candidates)
+	require.EqualValues(t, 3, stat.Removed)
+	require.EqualValues(t, 9, stat.Bytes)
+}
+
+func TestGCExpiredObjectsLimit(t *testing.T) {
+	const (
+		epoch   = 10
+		limit   = 10
+		expired = 95
+	)
+
+	sh := newTestShard(t, t.TempDir(),
+		// the remover is called manually
+		WithGCRemoverSleepInterval(time.Hour),
+		WithRemoverBatchSize(100),
+		WithExpiredObjectsLimit(limit))
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	var expAttr objectSDK.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue(strconv.Itoa(epoch - 1))
+
+	for i := 0; i < expired; i++ {
+		// spread the objects over the containers to pass the index buckets
+		putGCTestObject(t, sh, cidtest.ID(), expAttr)
+	}
+	alive := putGCTestObject(t, sh, cidtest.ID())
+
+	sh.collectExpiredObjects(context.Background(), EventNewEpoch(epoch))
+
+	stats := sh.GCHistory(1)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, epoch, stats[0].Epoch)
+	require.Equal(t, GCRunExpired, stats[0].Type)
+	require.EqualValues(t, limit, stats[0].Candidates)
+	require.EqualValues(t, limit, stats[0].Removed)
+	require.EqualValues(t, expired-limit, sh.ExpiredBacklog())
+
+	c, err := sh.metaBase.ReadExpiredCursor()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	// the next epoch continues from the cursor
+	sh.collectExpiredObjects(context.Background(), EventNewEpoch(epoch+1))
+	require.EqualValues(t, expired-2*limit, sh.ExpiredBacklog())
+
+	var (
+		left      = uint64(expired - 2*limit)
+		collected = 2 * limit
+		marked    = uint64(2 * limit)
+	)
+
+	for left > 0 {
+		// the objects marked by the regular operations are
+		// removed on each tick regardless of the backlog
+		garbage := putGCTestObject(t, sh, cidtest.ID())
+		markGarbage(t, sh, garbage)
+
+		stat := sh.removeGarbage()
+		require.Equal(t, marked+1, stat.Removed)
+
+		st, err := sh.metaBase.ObjectStatus(garbage)
+		require.NoError(t, err)
+		require.False(t, st.Found)
+
+		sh.continueExpiredCollection()
+
+		stats = sh.GCHistory(1)
+		require.EqualValues(t, epoch+1, stats[0].Epoch)
+		require.NotZero(t, stats[0].Removed)
+		require.LessOrEqual(t, stats[0].Removed, uint64(limit))
+
+		collected += int(stats[0].Removed)
+		marked = stats[0].Removed
+
+		require.Less(t, sh.ExpiredBacklog(), left)
+		left = sh.ExpiredBacklog()
+	}
+
+	require.Equal(t, expired, collected)
+
+	c, err = sh.metaBase.ReadExpiredCursor()
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	// the index is passed once more since the epoch has changed
+	// during the pass, the objects not removed yet are seen again
+	sh.continueExpiredCollection()
+	require.Equal(t, marked, sh.GCHistory(1)[0].Candidates)
+
+	for sh.expired.pending {
+		sh.removeGarbage()
+		sh.continueExpiredCollection()
+	}
+
+	n := len(sh.GCHistory(defaultGCHistorySize))
+	sh.continueExpiredCollection()
+	require.Len(t, sh.GCHistory(defaultGCHistorySize), n)
+
+	sh.removeGarbage()
+
+	cc, err := sh.metaBase.ObjectCounters()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, cc.Phy())
+	require.EqualValues(t, 1, cc.Logic())
+
+	st, err := sh.metaBase.ObjectStatus(alive)
+	require.NoError(t, err)
+	require.True(t, st.Found)
+}
+
+func TestGCExpiredBacklogInterrupted(t *testing.T) {
+	const (
+		epoch   = 10
+		limit   = 2
+		expired = 10
+	)
+
+	sh := newTestShard(t, t.TempDir(),
+		WithGCRemoverSleepInterval(time.Hour),
+		WithExpiredObjectsLimit(limit))
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	var expAttr objectSDK.Attribute
+	expAttr.SetKey(objectV2.SysAttributeExpEpoch)
+	expAttr.SetValue(strconv.Itoa(epoch - 1))
+
+	for i := 0; i < expired; i++ {
+		putGCTestObject(t, sh, cidtest.ID(), expAttr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	sh.expired.epoch = epoch
+
+	// the counting is stopped right after the first object
+	sh.countExpiredBacklog(ctx, nil)
+	require.EqualValues(t, 1, sh.ExpiredBacklog())
+	require.False(t, sh.expired.backlogKnown)
+	require.NotNil(t, sh.expired.countCursor)
+
+	// and continued from the position it has been stopped at
+	sh.countExpiredBacklog(context.Background(), nil)
+	require.EqualValues(t, expired, sh.ExpiredBacklog())
+	require.True(t, sh.expired.backlogKnown)
+	require.Nil(t, sh.expired.countCursor)
+}
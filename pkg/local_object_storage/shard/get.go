@@ -1,6 +1,8 @@
 package shard
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
@@ -17,10 +19,21 @@ import (
 // method. It represents generalization of `getSmall` and `getBig` methods.
 type storFetcher = func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error)
 
+// ctxError returns the error of the done context. Nil ctx is never done.
+func ctxError(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+
+	return ctx.Err()
+}
+
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
-	addr     oid.Address
-	skipMeta bool
+	addr         oid.Address
+	skipMeta     bool
+	ignoreGCMark bool
+	ctx          context.Context
 }
 
 // GetRes groups the resulting values of Get operation.
@@ -42,6 +55,19 @@ func (p *GetPrm) SetIgnoreMeta(ignore bool) {
 	p.skipMeta = ignore
 }
 
+// SetIgnoreGCMark is a Get option to return the object removed with
+// a tombstone or marked with GC if it is still physically stored.
+// It must be used for the node operator's requests only.
+func (p *GetPrm) SetIgnoreGCMark(ignore bool) {
+	p.ignoreGCMark = ignore
+}
+
+// SetContext is a Get option to set the context which interrupts the
+// operation before reading the object from the blobstor when done.
+func (p *GetPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object.
 func (r GetRes) Object() *objectSDK.Object {
 	return r.obj
@@ -59,9 +85,21 @@ func (r GetRes) HasMeta() bool {
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
+// Returns an error of type *objectSDK.SplitInfoError if the requested object is virtual and only its parts are stored in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(prm GetPrm) (GetRes, error) {
+// Returns the context error if the context is done before the blobstor read.
+func (s *Shard) Get(prm GetPrm) (_ GetRes, err error) {
+	defer s.catchStoragePanic("get", &err)()
+
 	cb := func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error) {
+		if obj, ok := s.getCached(prm.addr); ok {
+			return obj, nil
+		}
+
+		if err := ctxError(prm.ctx); err != nil {
+			return nil, err
+		}
+
 		var getPrm common.GetPrm
 		getPrm.Address = prm.addr
 		getPrm.StorageID = id
@@ -71,6 +109,8 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 			return nil, err
 		}
 
+		s.readCache.put(prm.addr, res.Object)
+
 		return res.Object, nil
 	}
 
@@ -78,8 +118,12 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 		return c.Get(prm.addr)
 	}
 
+	ic := func(obj *objectSDK.Object) (*objectSDK.Object, error) {
+		return obj, nil
+	}
+
 	skipMeta := prm.skipMeta || s.GetMode().NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, prm.ignoreGCMark, cb, wc, ic)
 
 	return GetRes{
 		obj:     obj,
@@ -87,8 +131,11 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 	}, err
 }
 
-// fetchObjectData looks through writeCache and blobStor to find object.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
+// fetchObjectData looks through writeCache, metaBase and blobStor to find object.
+// If ignoreGCMark is set, removed objects are looked for too. The objects
+// stored inline in the metabase are passed to ic.
+func (s *Shard) fetchObjectData(addr oid.Address, skipMeta, ignoreGCMark bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error),
+	ic func(*objectSDK.Object) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
 	var (
 		err error
 		res *objectSDK.Object
@@ -111,9 +158,18 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 	if !skipMeta {
 		var mPrm meta.ExistsPrm
 		mPrm.SetAddress(addr)
+		mPrm.SetIgnoreGCMark(ignoreGCMark)
 
 		mRes, err := s.metaBase.Exists(mPrm)
 		if err != nil && !s.GetMode().NoMetabase() {
+			if errors.Is(err, meta.ErrLackSplitInfo) {
+				// parent header is known, but there is no local
+				// child to start the object assembly from
+				var errNotFound apistatus.ObjectNotFound
+
+				return nil, false, fmt.Errorf("%w: virtual object payload is not stored locally", errNotFound)
+			}
+
 			return res, false, err
 		}
 		exists = mRes.Exists()
@@ -138,7 +194,26 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		return nil, true, fmt.Errorf("can't fetch blobovnicza id from metabase: %w", err)
 	}
 
-	res, err = cb(s.blobStor, mRes.StorageID())
+	id := mRes.StorageID()
+	if meta.IsInlineStorageID(id) {
+		var iPrm meta.GetInlinedPrm
+		iPrm.SetAddress(addr)
+
+		iRes, err := s.metaBase.GetInlined(iPrm)
+		if err != nil {
+			return nil, true, fmt.Errorf("can't fetch inline object from metabase: %w", err)
+		}
+
+		if iRes.Object() != nil {
+			res, err = ic(iRes.Object())
+			return res, true, err
+		}
+
+		// object has been moved to the blobstor concurrently
+		id = iRes.StorageID()
+	}
+
+	res, err = cb(s.blobStor, id)
 
 	return res, true, err
 }
@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -111,6 +112,44 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 	})
 }
 
+func TestShard_GetContext(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	obj := generateObject(t)
+	addPayload(obj, 1<<5)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(object.AddressOf(obj))
+	getPrm.SetContext(ctx)
+
+	_, err = sh.Get(getPrm)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var rngPrm shard.RngPrm
+	rngPrm.SetAddress(object.AddressOf(obj))
+	rngPrm.SetRange(0, 1)
+	rngPrm.SetContext(ctx)
+
+	_, err = sh.GetRange(rngPrm)
+	require.ErrorIs(t, err, context.Canceled)
+
+	getPrm.SetContext(context.Background())
+
+	res, err := sh.Get(getPrm)
+	require.NoError(t, err)
+	require.Equal(t, obj, res.Object())
+}
+
 func testGet(t *testing.T, sh *shard.Shard, getPrm shard.GetPrm, hasWriteCache bool) (shard.GetRes, error) {
 	res, err := sh.Get(getPrm)
 	if hasWriteCache {
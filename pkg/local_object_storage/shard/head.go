@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
@@ -10,8 +11,11 @@ import (
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
-	addr oid.Address
-	raw  bool
+	addr         oid.Address
+	raw          bool
+	short        bool
+	ignoreGCMark bool
+	ctx          context.Context
 }
 
 // HeadRes groups the resulting values of Head operation.
@@ -33,6 +37,26 @@ func (p *HeadPrm) SetRaw(raw bool) {
 	p.raw = raw
 }
 
+// SetShortHeader is a Head option to request only the fields of the short
+// object header. If it is set, Head may return object with the other header
+// fields missing.
+func (p *HeadPrm) SetShortHeader(short bool) {
+	p.short = short
+}
+
+// SetIgnoreGCMark is a Head option to return the header of the object removed
+// with a tombstone or marked with GC if it is still stored in the shard.
+// It must be used for the node operator's requests only.
+func (p *HeadPrm) SetIgnoreGCMark(ignore bool) {
+	p.ignoreGCMark = ignore
+}
+
+// SetContext is a Head option to set the context which interrupts the
+// operation before reading the object from the blobstor when done.
+func (p *HeadPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object header.
 func (r HeadRes) Object() *objectSDK.Object {
 	return r.obj
@@ -45,7 +69,10 @@ func (r HeadRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
+// Returns the context error if the context is done before the blobstor read.
+func (s *Shard) Head(prm HeadPrm) (_ HeadRes, err error) {
+	defer s.catchStoragePanic("head", &err)()
+
 	// object can be saved in write-cache (if enabled) or in metabase
 
 	if s.hasWriteCache() {
@@ -64,11 +91,11 @@ func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
 	}
 
 	var obj *objectSDK.Object
-	var err error
 	if s.GetMode().NoMetabase() {
 		var getPrm GetPrm
 		getPrm.SetAddress(prm.addr)
 		getPrm.SetIgnoreMeta(true)
+		getPrm.SetContext(prm.ctx)
 
 		var res GetRes
 		res, err = s.Get(getPrm)
@@ -77,6 +104,8 @@ func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
 		var headParams meta.GetPrm
 		headParams.SetAddress(prm.addr)
 		headParams.SetRaw(prm.raw)
+		headParams.SetShortHeader(prm.short)
+		headParams.SetIgnoreGCMark(prm.ignoreGCMark)
 
 		var res meta.GetRes
 		res, err = s.metaBase.Get(headParams)
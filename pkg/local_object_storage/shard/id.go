@@ -27,7 +27,7 @@ func (s *Shard) ID() *ID {
 
 // UpdateID reads shard ID saved in the metabase and updates it if it is missing.
 func (s *Shard) UpdateID() (err error) {
-	if err = s.metaBase.Open(false); err != nil {
+	if err = s.metaBase.Open(s.forcedReadOnly); err != nil {
 		return err
 	}
 	defer func() {
@@ -51,7 +51,7 @@ func (s *Shard) UpdateID() (err error) {
 		s.writeCache.SetLogger(s.log)
 	}
 
-	if len(id) != 0 {
+	if len(id) != 0 || s.forcedReadOnly {
 		return nil
 	}
 	return s.metaBase.WriteShardID(*s.info.ID)
@@ -1,6 +1,8 @@
 package shard
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
@@ -25,17 +27,51 @@ type Info struct {
 	// Information about the Write Cache.
 	WriteCacheInfo writecache.Info
 
+	// WriteCacheDisabled is true if the write-cache is detached
+	// from the shard at runtime, see DisableWriteCache.
+	WriteCacheDisabled bool
+
+	// ForcedReadOnly is true if the shard can not be switched
+	// to the modes allowing writes, see WithForcedReadOnly.
+	ForcedReadOnly bool
+
 	// Weight parameters of the shard.
 	WeightValues WeightValues
 
 	// ErrorCount contains amount of errors occurred in shard operations.
 	ErrorCount uint32
 
+	// LastErrors contains the most recent errors occurred in shard operations
+	// starting from the newest one.
+	LastErrors []ErrorInfo
+
 	// PiloramaInfo contains information about trees stored on this shard.
 	PiloramaInfo pilorama.Info
 }
 
+// ErrorInfo groups the information about an error occurred in shard operations.
+type ErrorInfo struct {
+	// Time when the error occurred.
+	Time time.Time
+
+	// Error message, possibly truncated.
+	Message string
+}
+
 // DumpInfo returns information about the Shard.
 func (s *Shard) DumpInfo() Info {
-	return s.info
+	info := s.info
+	info.WriteCacheDisabled = s.writeCacheDisabled.Load()
+
+	return info
+}
+
+// WriteCacheInfo returns the current information about the write-cache,
+// including the flush backlog. Returns zero Info if the write-cache is disabled.
+func (s *Shard) WriteCacheInfo() writecache.Info {
+	if !s.hasWriteCache() {
+		return writecache.Info{}
+	}
+
+	return s.writeCache.DumpInfo()
 }
@@ -60,7 +60,9 @@ var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
 // if at least one object is locked.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
+func (s *Shard) Inhume(prm InhumePrm) (_ InhumeRes, err error) {
+	defer s.catchStoragePanic("inhume", &err)()
+
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return InhumeRes{}, ErrReadOnlyMode
@@ -74,6 +76,8 @@ func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
 		}
 	}
 
+	s.readCache.remove(prm.target...)
+
 	var metaPrm meta.InhumePrm
 	metaPrm.SetAddresses(prm.target...)
 	metaPrm.SetLockObjectHandling()
@@ -103,6 +107,10 @@ func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
 
 	s.decObjectCounterBy(logical, res.AvailableInhumed())
 
+	if prm.tombstone != nil {
+		s.existenceFilter.add(prm.target...)
+	}
+
 	if deletedLockObjs := res.DeletedLockObjects(); len(deletedLockObjs) != 0 {
 		s.deletedLockCallBack(context.Background(), deletedLockObjs)
 	}
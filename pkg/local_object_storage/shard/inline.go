@@ -0,0 +1,191 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// deinlineBatchSize is the number of the inline objects listed from
+// the metabase at once by the de-inliner.
+const deinlineBatchSize = 100
+
+// deinlinerState contains the state of the background de-inliner.
+type deinlinerState struct {
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// inline checks whether the object should be stored inline in the metabase.
+func (s *Shard) inline(obj *objectSDK.Object) bool {
+	return s.inlineThreshold > 0 && uint64(len(obj.Payload())) <= s.inlineThreshold
+}
+
+// putInline saves the object with the payload in the metabase.
+func (s *Shard) putInline(obj *objectSDK.Object) error {
+	var pPrm meta.PutPrm
+	pPrm.SetObject(obj)
+	pPrm.SetInline(true)
+
+	if _, err := s.metaBase.Put(pPrm); err != nil {
+		return fmt.Errorf("could not put inline object to metabase: %w", err)
+	}
+
+	s.incObjectCounter()
+	s.existenceFilter.addObject(obj)
+
+	return nil
+}
+
+// deinline moves the object stored inline in the metabase to the blobstor.
+func (s *Shard) deinline(ctx context.Context, addr oid.Address, priority meta.Priority) error {
+	var gPrm meta.GetInlinedPrm
+	gPrm.SetAddress(addr)
+
+	gRes, err := s.metaBase.GetInlined(gPrm)
+	if err != nil {
+		return fmt.Errorf("could not read inline object: %w", err)
+	}
+
+	obj := gRes.Object()
+	if obj == nil {
+		return nil
+	}
+
+	data, err := obj.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot marshal object: %w", err)
+	}
+
+	var putPrm common.PutPrm
+	putPrm.Address = addr
+	putPrm.Object = obj
+	putPrm.RawData = data
+
+	res, err := s.blobStor.Put(putPrm)
+	if err != nil {
+		return fmt.Errorf("could not put object to BLOB storage: %w", err)
+	}
+
+	var dPrm meta.DeinlinePrm
+	dPrm.SetAddress(addr)
+	dPrm.SetStorageID(res.StorageID)
+	dPrm.SetPriority(priority)
+	dPrm.SetContext(ctx)
+
+	_, err = s.metaBase.Deinline(dPrm)
+	if err != nil {
+		return fmt.Errorf("could not update metabase record: %w", err)
+	}
+
+	return nil
+}
+
+// deinlineAll moves all the objects stored inline in the metabase to
+// the blobstor. Stops when ctx is done or checkMode fails, nil checkMode
+// means the caller holds the shard mode.
+func (s *Shard) deinlineAll(ctx context.Context, priority meta.Priority, checkMode func() error) (uint64, error) {
+	var moved uint64
+
+	for {
+		addrs, err := s.metaBase.ListInlined(deinlineBatchSize)
+		if err != nil {
+			return moved, fmt.Errorf("could not list inline objects: %w", err)
+		}
+
+		if len(addrs) == 0 {
+			if err := s.metaBase.ResetInlineVersion(); err != nil {
+				return moved, fmt.Errorf("could not reset metabase version: %w", err)
+			}
+
+			return moved, nil
+		}
+
+		for i := range addrs {
+			select {
+			case <-ctx.Done():
+				return moved, ctx.Err()
+			default:
+			}
+
+			if checkMode != nil {
+				if err := checkMode(); err != nil {
+					return moved, err
+				}
+			}
+
+			if err := s.deinline(ctx, addrs[i], priority); err != nil {
+				return moved, fmt.Errorf("could not move %s to blobstor: %w", addrs[i], err)
+			}
+
+			moved++
+		}
+	}
+}
+
+// startDeinliner runs the background moving of the inline objects to
+// the blobstor after the inlining has been disabled.
+func (s *Shard) startDeinliner() {
+	s.deinliner.mtx.Lock()
+	defer s.deinliner.mtx.Unlock()
+
+	if s.deinliner.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	s.deinliner.cancel = cancel
+	s.deinliner.done = done
+
+	go func() {
+		defer close(done)
+
+		moved, err := s.deinlineAll(ctx, s.gcPriority, s.checkWritable)
+		if err != nil && ctx.Err() == nil {
+			s.log.Warn("could not move inline objects to blobstor",
+				zap.Uint64("moved", moved),
+				zap.Error(err))
+			return
+		}
+
+		if moved > 0 {
+			s.log.Info("inline objects have been moved to blobstor",
+				zap.Uint64("moved", moved))
+		}
+	}()
+}
+
+// checkWritable returns an error if the shard mode does not allow
+// writing to the blobstor and the metabase.
+func (s *Shard) checkWritable() error {
+	if m := s.GetMode(); m.NoMetabase() {
+		return ErrDegradedMode
+	} else if m.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return nil
+}
+
+// stopDeinliner interrupts the background moving of the inline objects
+// and waits for it to finish.
+func (s *Shard) stopDeinliner() {
+	s.deinliner.mtx.Lock()
+	cancel, done := s.deinliner.cancel, s.deinliner.done
+	s.deinliner.cancel = nil
+	s.deinliner.mtx.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
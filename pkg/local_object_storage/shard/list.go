@@ -32,6 +32,7 @@ func (r ListContainersRes) Containers() []cid.ID {
 type ListWithCursorPrm struct {
 	count  uint32
 	cursor *Cursor
+	cnr    *cid.ID
 }
 
 // ListWithCursorRes contains values returned from ListWithCursor operation.
@@ -52,6 +53,11 @@ func (p *ListWithCursorPrm) WithCursor(cursor *Cursor) {
 	p.cursor = cursor
 }
 
+// WithContainerID limits ListWithCursor to the objects of the container.
+func (p *ListWithCursorPrm) WithContainerID(cnr cid.ID) {
+	p.cnr = &cnr
+}
+
 // AddressList returns addresses selected by ListWithCursor operation.
 func (r ListWithCursorRes) AddressList() []oid.Address {
 	return r.addrList
@@ -109,7 +115,9 @@ func (s *Shard) ListContainers(_ ListContainersPrm) (ListContainersRes, error) {
 //
 // Returns ErrEndOfListing if there are no more objects to return or count
 // parameter set to zero.
-func (s *Shard) ListWithCursor(prm ListWithCursorPrm) (ListWithCursorRes, error) {
+func (s *Shard) ListWithCursor(prm ListWithCursorPrm) (_ ListWithCursorRes, err error) {
+	defer s.catchStoragePanic("list", &err)()
+
 	if s.GetMode().NoMetabase() {
 		return ListWithCursorRes{}, ErrDegradedMode
 	}
@@ -117,6 +125,9 @@ func (s *Shard) ListWithCursor(prm ListWithCursorPrm) (ListWithCursorRes, error)
 	var metaPrm meta.ListPrm
 	metaPrm.SetCount(prm.count)
 	metaPrm.SetCursor(prm.cursor)
+	if prm.cnr != nil {
+		metaPrm.SetContainerID(*prm.cnr)
+	}
 	res, err := s.metaBase.ListWithCursor(metaPrm)
 	if err != nil {
 		return ListWithCursorRes{}, fmt.Errorf("could not get list of objects: %w", err)
@@ -3,6 +3,7 @@ package shard
 import (
 	"fmt"
 
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
@@ -13,7 +14,9 @@ import (
 // Allows locking regular objects only (otherwise returns apistatus.LockNonRegularObject).
 //
 // Locked list should be unique. Panics if it is empty.
-func (s *Shard) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
+func (s *Shard) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) (err error) {
+	defer s.catchStoragePanic("lock", &err)()
+
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return ErrReadOnlyMode
@@ -21,10 +24,52 @@ func (s *Shard) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
 		return ErrDegradedMode
 	}
 
-	err := s.metaBase.Lock(idCnr, locker, locked)
+	err = s.metaBase.Lock(idCnr, locker, locked)
 	if err != nil {
 		return fmt.Errorf("metabase lock: %w", err)
 	}
 
 	return nil
 }
+
+// Locks returns the information about the lock records of the shard.
+func (s *Shard) Locks() (res []meta.LockInfo, err error) {
+	defer s.catchStoragePanic("locks", &err)()
+
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	return s.metaBase.Locks()
+}
+
+// FreeLockedBy removes the lock records of the lockers, so the objects
+// locked by them can be removed. Lock objects themselves are not removed.
+func (s *Shard) FreeLockedBy(lockers []oid.Address) (err error) {
+	defer s.catchStoragePanic("free locked", &err)()
+
+	m := s.GetMode()
+	if m.ReadOnly() {
+		return ErrReadOnlyMode
+	} else if m.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	err = s.metaBase.FreeLockedBy(lockers)
+	if err != nil {
+		return fmt.Errorf("metabase free locked: %w", err)
+	}
+
+	return nil
+}
+
+// LocksFor returns the locks of the object stored in the shard.
+func (s *Shard) LocksFor(addr oid.Address) (res []meta.ObjectLock, err error) {
+	defer s.catchStoragePanic("locks for", &err)()
+
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	return s.metaBase.LocksFor(addr)
+}
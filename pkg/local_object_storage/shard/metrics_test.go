@@ -3,6 +3,7 @@ package shard_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
@@ -10,6 +11,7 @@ import (
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/stretchr/testify/require"
@@ -48,6 +50,32 @@ func (m metricsStore) DecObjectCounter(objectType string) {
 	m.AddToObjectCounter(objectType, -1)
 }
 
+func (m metricsStore) IncReadCacheCounter(hit bool) {
+	if hit {
+		m.s[readCacheHit]++
+	} else {
+		m.s[readCacheMiss]++
+	}
+}
+
+func (m metricsStore) AddMetabaseTxWait(string, time.Duration) {}
+
+func (m metricsStore) AddWriteCacheFlushedObject(uint64) {}
+
+func (m metricsStore) IncWriteCacheFlushErrors() {}
+
+func (m metricsStore) SetWriteCacheBacklog(uint64) {}
+
+func (m metricsStore) IncRangeReadCounter(full bool) {
+	if full {
+		m.s["range_read_full"]++
+	} else {
+		m.s["range_read_ranged"]++
+	}
+}
+
+func (m metricsStore) SetMode(mode.Mode) {}
+
 const physical = "phy"
 const logical = "logic"
 
@@ -179,3 +207,28 @@ func addrFromObjs(oo []*object.Object) []oid.Address {
 
 	return aa
 }
+
+func TestRangeReadCounter(t *testing.T) {
+	sh, mm := shardWithMetrics(t, t.TempDir())
+
+	obj := generateObject(t)
+	addPayload(obj, 1024)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	var rngPrm shard.RngPrm
+	rngPrm.SetAddress(objectcore.AddressOf(obj))
+	rngPrm.SetRange(10, 100)
+
+	res, err := sh.GetRange(rngPrm)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload()[10:110], res.Object().Payload())
+
+	// uncompressed object is read partially
+	require.Equal(t, uint64(1), mm.s["range_read_ranged"])
+	require.Zero(t, mm.s["range_read_full"])
+}
@@ -13,14 +13,42 @@ var ErrReadOnlyMode = errors.New("shard is in read-only mode")
 // ErrDegradedMode is returned when operation requiring metabase is executed in degraded mode.
 var ErrDegradedMode = errors.New("shard is in degraded mode")
 
+// ErrInlineObjects is returned when the shard storing the objects inline
+// in the metabase is switched to the mode without the metabase.
+var ErrInlineObjects = errors.New("shard stores objects inline in the metabase")
+
+// ErrForcedReadOnly is returned when the shard forced to stay read-only
+// is switched to the mode allowing writes (see WithForcedReadOnly).
+var ErrForcedReadOnly = errors.New("shard is forced to stay read-only")
+
 // SetMode sets mode of the shard.
 //
 // Returns any error encountered that did not allow
-// setting shard mode.
+// setting shard mode. Returns ErrForcedReadOnly if the
+// shard is forced to stay read-only and m allows writes.
+// Returns ErrInlineObjects if m is a degraded mode and the
+// shard stores the objects inline in the metabase, they
+// would not be available without it.
 func (s *Shard) SetMode(m mode.Mode) error {
+	if s.forcedReadOnly && !m.ReadOnly() {
+		return ErrForcedReadOnly
+	}
+
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if m.NoMetabase() && !s.info.Mode.NoMetabase() {
+		// the metabase failures must not prevent the switch
+		if has, err := s.metaBase.HasInlined(); err == nil && has {
+			return ErrInlineObjects
+		}
+	}
+
+	return s.setMode(m)
+}
+
+// setMode sets mode of the shard components. `s.m` must be taken.
+func (s *Shard) setMode(m mode.Mode) error {
 	components := []interface{ SetMode(mode.Mode) error }{
 		s.metaBase, s.blobStor,
 	}
@@ -54,6 +82,15 @@ func (s *Shard) SetMode(m mode.Mode) error {
 	}
 
 	s.info.Mode = m
+	s.modeChanges++
+
+	if s.metricsWriter != nil {
+		s.metricsWriter.SetMode(m)
+	}
+
+	if s.modeChangedCallback != nil {
+		s.modeChangedCallback(s.info.ID, m)
+	}
 
 	return nil
 }
@@ -22,7 +22,9 @@ func (p *ToMoveItPrm) SetAddress(addr oid.Address) {
 
 // ToMoveIt calls metabase.ToMoveIt method to mark object as relocatable to
 // another shard.
-func (s *Shard) ToMoveIt(prm ToMoveItPrm) (ToMoveItRes, error) {
+func (s *Shard) ToMoveIt(prm ToMoveItPrm) (_ ToMoveItRes, err error) {
+	defer s.catchStoragePanic("move", &err)()
+
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return ToMoveItRes{}, ErrReadOnlyMode
@@ -33,7 +35,7 @@ func (s *Shard) ToMoveIt(prm ToMoveItPrm) (ToMoveItRes, error) {
 	var toMovePrm meta.ToMoveItPrm
 	toMovePrm.SetAddress(prm.addr)
 
-	_, err := s.metaBase.ToMoveIt(toMovePrm)
+	_, err = s.metaBase.ToMoveIt(toMovePrm)
 	if err != nil {
 		s.log.Debug("could not mark object for shard relocation in metabase",
 			zap.String("error", err.Error()),
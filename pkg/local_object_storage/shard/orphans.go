@@ -0,0 +1,192 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// RemovalSource is an interface that checks
+// object removal in the NeoFS network.
+type RemovalSource interface {
+	// IsRemoved must return true if the object is confirmed
+	// to be removed in the NeoFS network, e.g. a container
+	// node responds that the object is already removed.
+	IsRemoved(ctx context.Context, addr oid.Address) (bool, error)
+}
+
+// defaultOrphanConfirmations is the default number of the GC passes
+// confirming the removal of the parent before the child is GC-marked.
+const defaultOrphanConfirmations = 3
+
+// orphanState contains the state of the orphaned children collection.
+type orphanState struct {
+	mtx sync.Mutex
+
+	// cursor is the position of the next sample in the metabase.
+	cursor *meta.Cursor
+
+	// confirmations contains the number of the consecutive GC passes
+	// which confirmed the removal of the parent of the child object.
+	confirmations map[oid.Address]uint32
+}
+
+// collectOrphans looks through the sample of the stored objects for the
+// children of the split chains, the parents of which have been removed,
+// and marks them with GC. The removal of the parent is checked in the
+// metabase and, if the removal source is set, in the NeoFS network.
+//
+// The child is marked after the removal of its parent is confirmed by
+// the configured number of the GC passes in a row. The children younger
+// than the configured age are never marked.
+func (s *Shard) collectOrphans(ctx context.Context, e Event) {
+	stat := GCTickStat{
+		Time:  time.Now(),
+		Epoch: e.(newEpoch).epoch,
+	}
+
+	defer func() {
+		stat.Duration = time.Since(stat.Time)
+		s.gc.history.add(stat)
+	}()
+
+	if s.GetMode() != mode.ReadWrite {
+		return
+	}
+
+	s.orphans.mtx.Lock()
+	defer s.orphans.mtx.Unlock()
+
+	var listPrm meta.ListPrm
+	listPrm.SetCount(s.orphanSampleSize)
+	listPrm.SetCursor(s.orphans.cursor)
+
+	res, err := s.metaBase.ListWithCursor(listPrm)
+	if err != nil {
+		if errors.Is(err, meta.ErrEndOfListing) {
+			s.orphans.cursor = nil
+			return
+		}
+
+		s.log.Warn("could not list objects to find orphans", zap.Error(err))
+		stat.Errors++
+		return
+	}
+
+	s.orphans.cursor = res.Cursor()
+	if len(res.AddressList()) < int(s.orphanSampleSize) {
+		// start over on the next epoch
+		s.orphans.cursor = nil
+	}
+
+	remoteChecks := s.orphanRemoteChecks
+	var orphans []oid.Address
+
+	for _, addr := range res.AddressList() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		var getPrm meta.GetPrm
+		getPrm.SetAddress(addr)
+		getPrm.SetRaw(true)
+
+		hdr, err := s.metaBase.Get(getPrm)
+		if err != nil {
+			continue
+		}
+
+		parentID, ok := hdr.Header().ParentID()
+		if !ok || hdr.Header().CreationEpoch()+s.orphanMinAge > stat.Epoch {
+			continue
+		}
+
+		parent := addr
+		parent.SetObject(parentID)
+
+		removed := s.isParentRemoved(ctx, parent, &remoteChecks, &stat)
+		if !removed {
+			delete(s.orphans.confirmations, addr)
+			continue
+		}
+
+		s.orphans.confirmations[addr]++
+		if s.orphans.confirmations[addr] < s.orphanConfirmations {
+			continue
+		}
+
+		delete(s.orphans.confirmations, addr)
+		orphans = append(orphans, addr)
+	}
+
+	stat.Candidates = uint64(len(orphans))
+
+	for i := range orphans {
+		var inhumePrm meta.InhumePrm
+		inhumePrm.SetAddresses(orphans[i])
+		inhumePrm.SetGCMark()
+		inhumePrm.SetPriority(s.gcPriority)
+		inhumePrm.SetContext(ctx)
+
+		res, err := s.metaBase.Inhume(inhumePrm)
+		if err != nil {
+			if errors.As(err, new(apistatus.ObjectLocked)) {
+				stat.Locked++
+				continue
+			}
+
+			s.log.Warn("could not mark orphaned child object",
+				zap.Stringer("address", orphans[i]),
+				zap.Error(err))
+
+			stat.Errors++
+			stat.Deferred++
+			continue
+		}
+
+		s.log.Info("orphaned child object is marked with GC",
+			zap.Stringer("address", orphans[i]))
+
+		stat.Removed++
+		s.decObjectCounterBy(logical, res.AvailableInhumed())
+	}
+}
+
+// isParentRemoved checks whether the parent object is removed locally or,
+// while remote checks are left, in the NeoFS network.
+func (s *Shard) isParentRemoved(ctx context.Context, parent oid.Address, remoteChecks *uint32, stat *GCTickStat) bool {
+	st, err := s.metaBase.ObjectStatus(parent)
+	if err != nil {
+		stat.Errors++
+		return false
+	}
+
+	if st.Tombstone != nil {
+		return true
+	}
+
+	if s.orphanRemovalSource == nil || *remoteChecks == 0 {
+		return false
+	}
+
+	*remoteChecks--
+
+	removed, err := s.orphanRemovalSource.IsRemoved(ctx, parent)
+	if err != nil {
+		s.log.Debug("could not check parent object removal",
+			zap.Stringer("address", parent),
+			zap.Error(err))
+
+		stat.Errors++
+		return false
+	}
+
+	return removed
+}
@@ -0,0 +1,128 @@
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testRemovalSource struct {
+	removed map[oid.Address]bool
+	checks  int
+}
+
+func (s *testRemovalSource) IsRemoved(_ context.Context, addr oid.Address) (bool, error) {
+	s.checks++
+	return s.removed[addr], nil
+}
+
+func putOrphanTestChild(t *testing.T, sh *Shard, parent oid.Address, epoch uint64) oid.Address {
+	child := newInlineTestObject(10)
+	child.SetContainerID(parent.Container())
+	child.SetCreationEpoch(epoch)
+	child.SetParentID(parent.Object())
+	child.SetSplitID(objectSDK.NewSplitID())
+
+	var putPrm PutPrm
+	putPrm.SetObject(child)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	return object.AddressOf(child)
+}
+
+func requireGCMarked(t *testing.T, sh *Shard, addr oid.Address, exp bool) {
+	st, err := sh.metaBase.ObjectStatus(addr)
+	require.NoError(t, err)
+	require.Equal(t, exp, st.GCMarked)
+}
+
+func TestShard_CollectOrphans(t *testing.T) {
+	t.Run("remote confirmations", func(t *testing.T) {
+		src := &testRemovalSource{removed: make(map[oid.Address]bool)}
+		sh := newTestShard(t, t.TempDir(),
+			WithOrphanCollection(10),
+			WithOrphanConfirmations(2),
+			WithOrphanMinAge(5),
+			WithOrphanRemovalSource(src, 10))
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		removedParent := oidtest.Address()
+		src.removed[removedParent] = true
+
+		orphan := putOrphanTestChild(t, sh, removedParent, 1)
+		young := putOrphanTestChild(t, sh, removedParent, 4)
+		alive := putOrphanTestChild(t, sh, oidtest.Address(), 1)
+		regular := putGCTestObject(t, sh, cidtest.ID())
+
+		sh.collectOrphans(context.Background(), EventNewEpoch(7))
+		requireGCMarked(t, sh, orphan, false)
+
+		sh.collectOrphans(context.Background(), EventNewEpoch(8))
+		requireGCMarked(t, sh, orphan, true)
+		requireGCMarked(t, sh, young, false)
+		requireGCMarked(t, sh, alive, false)
+		requireGCMarked(t, sh, regular, false)
+
+		// young child reaches the age, but the removal of the
+		// parent must be confirmed again after the failure
+		sh.collectOrphans(context.Background(), EventNewEpoch(9))
+		src.removed[removedParent] = false
+		sh.collectOrphans(context.Background(), EventNewEpoch(10))
+		src.removed[removedParent] = true
+		sh.collectOrphans(context.Background(), EventNewEpoch(11))
+		requireGCMarked(t, sh, young, false)
+
+		sh.collectOrphans(context.Background(), EventNewEpoch(12))
+		requireGCMarked(t, sh, young, true)
+		requireGCMarked(t, sh, alive, false)
+
+		history := sh.GCHistory(1)
+		require.Len(t, history, 1)
+		require.EqualValues(t, 12, history[0].Epoch)
+		require.EqualValues(t, 1, history[0].Removed)
+	})
+
+	t.Run("remote checks limit", func(t *testing.T) {
+		src := &testRemovalSource{removed: make(map[oid.Address]bool)}
+		sh := newTestShard(t, t.TempDir(),
+			WithOrphanCollection(10),
+			WithOrphanRemovalSource(src, 1))
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		putOrphanTestChild(t, sh, oidtest.Address(), 0)
+		putOrphanTestChild(t, sh, oidtest.Address(), 0)
+
+		sh.collectOrphans(context.Background(), EventNewEpoch(1))
+		require.Equal(t, 1, src.checks)
+	})
+
+	t.Run("local tombstone", func(t *testing.T) {
+		sh := newTestShard(t, t.TempDir(),
+			WithOrphanCollection(1),
+			WithOrphanConfirmations(1))
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		parent := oidtest.Address()
+		orphan := putOrphanTestChild(t, sh, parent, 0)
+
+		tomb := oidtest.Address()
+		tomb.SetContainer(parent.Container())
+
+		var inhumePrm InhumePrm
+		inhumePrm.SetTarget(tomb, parent)
+
+		_, err := sh.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		sh.collectOrphans(context.Background(), EventNewEpoch(1))
+		requireGCMarked(t, sh, orphan, true)
+	})
+}
@@ -0,0 +1,46 @@
+package shard
+
+import (
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// ObjectsByOwner returns the addresses of the available objects of the
+// container owned by the given user using the metabase owner index.
+//
+// Returns ErrDegradedMode if the shard is in degraded mode.
+func (s *Shard) ObjectsByOwner(cnr cid.ID, owner user.ID) (_ []oid.Address, err error) {
+	defer s.catchStoragePanic("objects by owner", &err)()
+
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	addrs, err := s.metaBase.ObjectsByOwner(cnr, owner)
+	if err != nil {
+		return nil, fmt.Errorf("could not select objects from metabase: %w", err)
+	}
+
+	return addrs, nil
+}
+
+// RebuildOwnerIndex rebuilds the metabase owner index from the object headers
+// stored in the metabase. Returns the number of the indexed objects.
+//
+// Returns ErrDegradedMode if the shard is in degraded mode.
+// Returns ErrReadOnlyMode if the shard is in read-only mode.
+func (s *Shard) RebuildOwnerIndex() (int, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	} else if s.info.Mode.ReadOnly() {
+		return 0, ErrReadOnlyMode
+	}
+
+	return s.metaBase.RebuildOwnerIndex()
+}
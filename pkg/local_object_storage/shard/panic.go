@@ -0,0 +1,107 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ErrStoragePanic is returned when the shard operation has been interrupted
+// by the panic of the underlying storage, e.g. the access to the closed database.
+var ErrStoragePanic = errors.New("shard storage panic")
+
+// StoragePanicCallback is a callback handling the panic of the shard storage
+// recovered in the shard operation. The error wraps ErrStoragePanic.
+type StoragePanicCallback func(error)
+
+// WithStoragePanicCallback returns option to recover from the panics of the
+// shard storage (BoltDB) and memory faults in the shard operations. Recovered
+// panics are returned as errors and passed to the callback. Other panics are
+// propagated as is.
+//
+// Nil callback disables the recovery. Disabled by default.
+func WithStoragePanicCallback(cb StoragePanicCallback) Option {
+	return func(c *cfg) {
+		c.storagePanicCallback = cb
+	}
+}
+
+// catchStoragePanic returns the function to be deferred in the shard operation
+// which converts the storage panic into the error written to err.
+//
+// Usage:
+//
+//	defer s.catchStoragePanic("get", &err)()
+func (s *Shard) catchStoragePanic(op string, err *error) func() {
+	if s.storagePanicCallback == nil {
+		return func() {}
+	}
+
+	// make the access to the unmapped memory of the database file recoverable
+	panicOnFault := debug.SetPanicOnFault(true)
+
+	return func() {
+		defer debug.SetPanicOnFault(panicOnFault)
+
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if !isStoragePanic(r) {
+			panic(r)
+		}
+
+		*err = fmt.Errorf("%w: %s: %v", ErrStoragePanic, op, r)
+
+		s.log.Error("recovered from storage panic",
+			zap.String("op", op),
+			zap.Any("panic", r),
+			zap.String("stack", string(debug.Stack())))
+
+		s.storagePanicCallback(*err)
+	}
+}
+
+// isStoragePanic checks whether the panic being recovered originates from the
+// storage: it is either a memory fault or a runtime error or an assertion
+// failure raised by BoltDB itself. Panics of the code called by BoltDB, e.g.
+// transaction handlers, are not storage ones.
+//
+// Must be called in the deferred function during panicking only.
+func isStoragePanic(r interface{}) bool {
+	switch r.(type) {
+	case interface{ Addr() uintptr }:
+		return true
+	case runtime.Error, string:
+		return panickedInBolt()
+	default:
+		return false
+	}
+}
+
+// panickedInBolt checks whether the function which raised the panic being
+// recovered is a BoltDB one.
+func panickedInBolt() bool {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(0, pcs)])
+
+	var panicking bool
+	for {
+		f, more := frames.Next()
+		switch {
+		case f.Function == "runtime.gopanic":
+			panicking = true
+		case panicking && !strings.HasPrefix(f.Function, "runtime."):
+			return strings.HasPrefix(f.Function, "go.etcd.io/bbolt.")
+		}
+
+		if !more {
+			return false
+		}
+	}
+}
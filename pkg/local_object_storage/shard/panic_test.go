@@ -0,0 +1,82 @@
+package shard
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+func TestShard_catchStoragePanic(t *testing.T) {
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "db"), 0600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	newShard := func(cb StoragePanicCallback) *Shard {
+		return &Shard{cfg: &cfg{log: zap.NewNop(), storagePanicCallback: cb}}
+	}
+
+	op := func(s *Shard, panicky func() error) (err error) {
+		defer s.catchStoragePanic("test", &err)()
+
+		return panicky()
+	}
+
+	// BoltDB panics on the commit of the managed transaction
+	boltPanic := func() error {
+		return db.View(func(tx *bbolt.Tx) error {
+			return tx.Commit()
+		})
+	}
+
+	otherPanic := func() error {
+		panic("bug")
+	}
+
+	// panics of the transaction handlers are not the storage ones
+	txPanic := func() error {
+		return db.View(func(tx *bbolt.Tx) error {
+			panic("bug in tx")
+		})
+	}
+
+	var nilMap map[string]int
+	txRuntimePanic := func() error {
+		return db.Update(func(tx *bbolt.Tx) error {
+			nilMap["key"] = 1
+			return nil
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newShard(nil)
+
+		require.Panics(t, func() { _ = op(s, boltPanic) })
+		require.NoError(t, op(s, func() error { return nil }))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		var reported []error
+		s := newShard(func(err error) {
+			reported = append(reported, err)
+		})
+
+		errTest := errors.New("test")
+		require.ErrorIs(t, op(s, func() error { return errTest }), errTest)
+		require.Empty(t, reported)
+
+		err := op(s, boltPanic)
+		require.ErrorIs(t, err, ErrStoragePanic)
+		require.Len(t, reported, 1)
+		require.Equal(t, err, reported[0])
+
+		// programming bugs are not hidden
+		require.PanicsWithValue(t, "bug", func() { _ = op(s, otherPanic) })
+		require.PanicsWithValue(t, "bug in tx", func() { _ = op(s, txPanic) })
+		require.Panics(t, func() { _ = op(s, txRuntimePanic) })
+		require.Len(t, reported, 1)
+	})
+}
@@ -0,0 +1,113 @@
+package shard
+
+import (
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// ContainerPlacement restricts the containers the objects of which
+// are placed to the shard. Zero value accepts all the containers.
+type ContainerPlacement struct {
+	// Allow lists the only containers accepted by the shard.
+	// Empty list allows all the containers.
+	Allow []cid.ID
+
+	// Deny lists the containers not accepted by the shard.
+	Deny []cid.ID
+}
+
+// Accepts returns true if the objects of the container can be placed to the shard.
+func (p ContainerPlacement) Accepts(cnr cid.ID) bool {
+	if containsContainer(p.Deny, cnr) {
+		return false
+	}
+
+	return len(p.Allow) == 0 || containsContainer(p.Allow, cnr)
+}
+
+func containsContainer(list []cid.ID, cnr cid.ID) bool {
+	for i := range list {
+		if list[i].Equals(cnr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckContainerPlacement checks that the objects of any container can be
+// placed to at least one of the shards with the given placements.
+// Empty list is considered valid.
+func CheckContainerPlacement(pp []ContainerPlacement) error {
+	if len(pp) == 0 {
+		return nil
+	}
+
+	var unrestricted bool
+
+	for i := range pp {
+		if len(pp[i].Allow) == 0 {
+			unrestricted = true
+			break
+		}
+	}
+
+	if !unrestricted {
+		return fmt.Errorf("all %d shards have container allow lists, other containers can not be placed", len(pp))
+	}
+
+	for i := range pp {
+		for _, list := range [][]cid.ID{pp[i].Allow, pp[i].Deny} {
+			for _, cnr := range list {
+				if !placementAccepts(pp, cnr) {
+					return fmt.Errorf("objects of container %s can not be placed to any shard", cnr)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func placementAccepts(pp []ContainerPlacement, cnr cid.ID) bool {
+	for i := range pp {
+		if pp[i].Accepts(cnr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithContainerPlacement returns option to restrict the containers
+// the objects of which are placed to the shard.
+func WithContainerPlacement(p ContainerPlacement) Option {
+	return func(c *cfg) {
+		c.placement = p
+	}
+}
+
+// SetContainerPlacement changes the container placement restrictions of the shard.
+func (s *Shard) SetContainerPlacement(p ContainerPlacement) {
+	s.placementMtx.Lock()
+	s.placement = p
+	s.placementMtx.Unlock()
+}
+
+// ContainerPlacement returns the container placement restrictions of the shard.
+func (s *Shard) ContainerPlacement() ContainerPlacement {
+	s.placementMtx.RLock()
+	defer s.placementMtx.RUnlock()
+
+	return s.placement
+}
+
+// AcceptsContainer returns true if the objects of the container
+// can be placed to the shard.
+func (s *Shard) AcceptsContainer(cnr cid.ID) bool {
+	s.placementMtx.RLock()
+	defer s.placementMtx.RUnlock()
+
+	return s.placement.Accepts(cnr)
+}
@@ -0,0 +1,60 @@
+package shard
+
+import (
+	"testing"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerPlacement(t *testing.T) {
+	cnr1, cnr2, cnr3 := cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	var p ContainerPlacement
+	require.True(t, p.Accepts(cnr1))
+
+	p = ContainerPlacement{Deny: []cid.ID{cnr1}}
+	require.False(t, p.Accepts(cnr1))
+	require.True(t, p.Accepts(cnr2))
+
+	p = ContainerPlacement{Allow: []cid.ID{cnr1, cnr2}, Deny: []cid.ID{cnr2}}
+	require.True(t, p.Accepts(cnr1))
+	require.False(t, p.Accepts(cnr2))
+	require.False(t, p.Accepts(cnr3))
+
+	for _, tc := range []struct {
+		name  string
+		pp    []ContainerPlacement
+		valid bool
+	}{
+		{name: "no shards", valid: true},
+		{name: "unrestricted", pp: []ContainerPlacement{{}, {}}, valid: true},
+		{
+			name:  "pinned",
+			pp:    []ContainerPlacement{{Allow: []cid.ID{cnr1}}, {Deny: []cid.ID{cnr1}}},
+			valid: true,
+		},
+		{
+			name: "all allow lists",
+			pp:   []ContainerPlacement{{Allow: []cid.ID{cnr1}}, {Allow: []cid.ID{cnr2}}},
+		},
+		{
+			name: "denied everywhere",
+			pp:   []ContainerPlacement{{Deny: []cid.ID{cnr1}}, {Deny: []cid.ID{cnr1, cnr2}}},
+		},
+		{
+			name: "allowed and denied",
+			pp:   []ContainerPlacement{{Allow: []cid.ID{cnr1}, Deny: []cid.ID{cnr1}}, {Deny: []cid.ID{cnr1}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckContainerPlacement(tc.pp)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
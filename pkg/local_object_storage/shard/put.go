@@ -1,11 +1,13 @@
 package shard
 
 import (
+	"errors"
 	"fmt"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -29,12 +31,24 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(prm PutPrm) (PutRes, error) {
+// Returns an error satisfying IsErrInvalidObject if the object is
+// rejected by the write-cache validation (see WithPutValidation
+// write-cache option), such objects are not saved to the blobstor.
+func (s *Shard) Put(prm PutPrm) (_ PutRes, err error) {
+	defer s.catchStoragePanic("put", &err)()
+
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return PutRes{}, ErrReadOnlyMode
 	}
 
+	tryCache := s.hasWriteCache() && !m.NoMetabase()
+	if !tryCache && s.inline(prm.obj) && !m.NoMetabase() {
+		s.readCache.remove(objectCore.AddressOf(prm.obj))
+
+		return PutRes{}, s.putInline(prm.obj)
+	}
+
 	data, err := prm.obj.Marshal()
 	if err != nil {
 		return PutRes{}, fmt.Errorf("cannot marshal object: %w", err)
@@ -45,18 +59,26 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 	putPrm.RawData = data
 	putPrm.Address = objectCore.AddressOf(prm.obj)
 
+	s.readCache.remove(putPrm.Address)
+
 	var res common.PutRes
 
 	// exist check are not performed there, these checks should be executed
 	// ahead of `Put` by storage engine
-	tryCache := s.hasWriteCache() && !m.NoMetabase()
 	if tryCache {
 		res, err = s.writeCache.Put(putPrm)
+		if errors.Is(err, writecache.ErrInvalidObject) {
+			return PutRes{}, invalidObjectError{cause: err}
+		}
 	}
 	if err != nil || !tryCache {
 		if err != nil {
 			s.log.Debug("can't put object to the write-cache, trying blobstor",
 				zap.String("err", err.Error()))
+
+			if s.inline(prm.obj) {
+				return PutRes{}, s.putInline(prm.obj)
+			}
 		}
 
 		res, err = s.blobStor.Put(putPrm)
@@ -78,5 +100,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 		s.incObjectCounter()
 	}
 
+	s.existenceFilter.addObject(prm.obj)
+
 	return PutRes{}, nil
 }
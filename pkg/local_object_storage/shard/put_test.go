@@ -0,0 +1,54 @@
+package shard_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_PutInvalidObject(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	sh := newCustomShard(t, t.TempDir(), true,
+		[]writecache.Option{writecache.WithPutValidation(true)},
+		nil)
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	obj := generateObject(t)
+	require.NoError(t, object.SetIDWithSignature(key.PrivateKey, obj))
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	invalid := generateObject(t)
+	require.NoError(t, object.SetIDWithSignature(key.PrivateKey, invalid))
+	invalid.SetID(oidtest.ID())
+
+	putPrm.SetObject(invalid)
+
+	_, err = sh.Put(putPrm)
+	require.True(t, shard.IsErrInvalidObject(err), err)
+
+	// the object is rejected with the status instead of being put to the blobstor
+	st, ok := err.(apistatus.StatusV2)
+	require.True(t, ok)
+	require.IsType(t, new(apistatus.ServerInternal), apistatus.FromStatusV2(st.ToStatusV2()))
+
+	var existsPrm shard.ExistsPrm
+	existsPrm.SetAddress(objectCore.AddressOf(invalid))
+
+	res, err := sh.Exists(existsPrm)
+	require.NoError(t, err)
+	require.False(t, res.Exists())
+}
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
@@ -18,6 +19,8 @@ type RngPrm struct {
 	addr oid.Address
 
 	skipMeta bool
+
+	ctx context.Context
 }
 
 // RngRes groups the resulting values of GetRange operation.
@@ -44,6 +47,12 @@ func (p *RngPrm) SetIgnoreMeta(ignore bool) {
 	p.skipMeta = ignore
 }
 
+// SetContext is a GetRange option to set the context which interrupts the
+// operation before reading the object from the blobstor when done.
+func (p *RngPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object part.
 //
 // Instance payload contains the requested range of the original object.
@@ -51,6 +60,18 @@ func (r RngRes) Object() *object.Object {
 	return r.obj
 }
 
+// Delivered returns the number of the leading bytes of the requested range
+// which have been read. If GetRange fails in the middle of the payload read,
+// the bytes read before the failure are kept in the Object payload, so the
+// rest of the range can be requested from another copy of the object.
+func (r RngRes) Delivered() uint64 {
+	if r.obj == nil {
+		return 0
+	}
+
+	return uint64(len(r.obj.Payload()))
+}
+
 // HasMeta returns true if info about the object was found in the metabase.
 func (r RngRes) HasMeta() bool {
 	return r.hasMeta
@@ -61,12 +82,24 @@ func (r RngRes) HasMeta() bool {
 // Returns any error encountered that
 // did not allow to completely read the object part.
 //
-// Returns ErrRangeOutOfBounds if the requested object range is out of bounds.
+// Returns an error of type apistatus.ObjectOutOfRange if the requested object range is out of bounds.
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
+// Returns an error of type *object.SplitInfoError if the requested object is virtual and only its parts are stored in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
+// Returns the context error if the context is done before the blobstor read.
+func (s *Shard) GetRange(prm RngPrm) (_ RngRes, err error) {
+	defer s.catchStoragePanic("get range", &err)()
+
 	cb := func(stor *blobstor.BlobStor, id []byte) (*object.Object, error) {
+		if obj, ok := s.getCached(prm.addr); ok {
+			return payloadRange(obj, prm.off, prm.ln)
+		}
+
+		if err := ctxError(prm.ctx); err != nil {
+			return nil, err
+		}
+
 		var getRngPrm common.GetRangePrm
 		getRngPrm.Address = prm.addr
 		getRngPrm.Range.SetOffset(prm.off)
@@ -75,9 +108,19 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 
 		res, err := stor.GetRange(getRngPrm)
 		if err != nil {
-			return nil, err
+			if len(res.Data) == 0 {
+				return nil, err
+			}
+
+			// the read has failed in the middle, return what has been read
+			obj := object.New()
+			obj.SetPayload(res.Data)
+
+			return obj, err
 		}
 
+		s.incRangeReadCounter(res.FullRead)
+
 		obj := object.New()
 		obj.SetPayload(res.Data)
 
@@ -90,23 +133,37 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 			return nil, err
 		}
 
-		payload := res.Payload()
-		from := prm.off
-		to := from + prm.ln
-		if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
-			return nil, apistatus.ObjectOutOfRange{}
-		}
+		// write-cache has no ranged reads
+		s.incRangeReadCounter(true)
 
-		obj := object.New()
-		obj.SetPayload(payload[from:to])
-		return obj, nil
+		return payloadRange(res, prm.off, prm.ln)
+	}
+
+	ic := func(obj *object.Object) (*object.Object, error) {
+		s.incRangeReadCounter(true)
+
+		return payloadRange(obj, prm.off, prm.ln)
 	}
 
 	skipMeta := prm.skipMeta || s.GetMode().NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, false, cb, wc, ic)
 
 	return RngRes{
 		obj:     obj,
 		hasMeta: hasMeta,
 	}, err
 }
+
+// payloadRange returns the object with the requested range of the obj payload.
+func payloadRange(obj *object.Object, off, ln uint64) (*object.Object, error) {
+	payload := obj.Payload()
+	from := off
+	to := from + ln
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return nil, apistatus.ObjectOutOfRange{}
+	}
+
+	res := object.New()
+	res.SetPayload(payload[from:to])
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -13,7 +14,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 )
@@ -114,3 +118,93 @@ func testShardGetRange(t *testing.T, hasWriteCache bool) {
 		})
 	}
 }
+
+func TestShard_GetRangeVirtual(t *testing.T) {
+	t.Run("without write cache", func(t *testing.T) {
+		testShardGetRangeVirtual(t, false)
+	})
+
+	t.Run("with write cache", func(t *testing.T) {
+		testShardGetRangeVirtual(t, true)
+	})
+}
+
+func testShardGetRangeVirtual(t *testing.T, hasWriteCache bool) {
+	sh := newShard(t, hasWriteCache)
+	defer releaseShard(sh, t)
+
+	// putChild stores a child of the new parent object, the parent payload
+	// is not stored in the shard, only its header in the child
+	putChild := func(children ...oid.ID) (*objectSDK.Object, *objectSDK.Object) {
+		cnr := cidtest.ID()
+
+		parent := generateObjectWithCID(t, cnr)
+		parentID, _ := parent.ID()
+
+		child := generateObjectWithCID(t, cnr)
+		child.SetParent(parent)
+		child.SetParentID(parentID)
+		child.SetSplitID(objectSDK.NewSplitID())
+		child.SetChildren(children...)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(child)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		return parent, child
+	}
+
+	getRange := func(addr oid.Address) error {
+		var rngPrm shard.RngPrm
+		rngPrm.SetAddress(addr)
+		rngPrm.SetRange(0, 1)
+
+		_, err := sh.GetRange(rngPrm)
+		if hasWriteCache {
+			// the metabase is updated on write-cache flush
+			require.Eventually(t, func() bool {
+				if shard.IsErrNotFound(err) {
+					_, err = sh.GetRange(rngPrm)
+				}
+				return !shard.IsErrNotFound(err)
+			}, time.Second, time.Millisecond*100)
+		}
+		return err
+	}
+
+	t.Run("parent only", func(t *testing.T) {
+		parent, child := putChild()
+
+		err := getRange(object.AddressOf(parent))
+
+		var errSplit *objectSDK.SplitInfoError
+		require.ErrorAs(t, err, &errSplit)
+
+		childID, _ := child.ID()
+		lastID, ok := errSplit.SplitInfo().LastPart()
+		require.True(t, ok)
+		require.Equal(t, childID, lastID)
+
+		_, ok = errSplit.SplitInfo().Link()
+		require.False(t, ok)
+	})
+
+	t.Run("link only", func(t *testing.T) {
+		parent, link := putChild(oidtest.ID(), oidtest.ID())
+
+		err := getRange(object.AddressOf(parent))
+
+		var errSplit *objectSDK.SplitInfoError
+		require.ErrorAs(t, err, &errSplit)
+
+		linkID, _ := link.ID()
+		id, ok := errSplit.SplitInfo().Link()
+		require.True(t, ok)
+		require.Equal(t, linkID, id)
+
+		_, ok = errSplit.SplitInfo().LastPart()
+		require.False(t, ok)
+	})
+}
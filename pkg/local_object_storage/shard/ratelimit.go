@@ -0,0 +1,52 @@
+package shard
+
+import (
+	"context"
+	"time"
+)
+
+// rateLimiter limits the number of objects processed per second
+// by the long-running shard operations.
+type rateLimiter struct {
+	// limit is the maximum number of objects per second, 0 means no limit.
+	limit uint32
+
+	start time.Time
+	count uint64
+}
+
+func newRateLimiter(objPerSec uint32) *rateLimiter {
+	return &rateLimiter{
+		limit: objPerSec,
+		start: time.Now(),
+	}
+}
+
+// wait blocks until the next object can be processed according to the rate limit.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	l.count++
+	if l.limit == 0 {
+		return nil
+	}
+
+	d := time.Duration(l.count)*time.Second/time.Duration(l.limit) - time.Since(l.start)
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
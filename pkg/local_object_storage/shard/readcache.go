@@ -0,0 +1,210 @@
+package shard
+
+import (
+	"math"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ReadCacheBudget is a memory limit shared by the read caches of several
+// shards. If the total size of the cached objects exceeds the budget, the
+// least recently used objects of the biggest cache are evicted.
+//
+// ReadCacheBudget must be created with NewReadCacheBudget.
+type ReadCacheBudget struct {
+	mtx sync.Mutex
+
+	size     uint64
+	capacity uint64
+
+	caches map[*readCache]struct{}
+}
+
+// NewReadCacheBudget creates a new budget limiting the total size of the
+// read caches to the specified number of bytes.
+func NewReadCacheBudget(capacity uint64) *ReadCacheBudget {
+	return &ReadCacheBudget{
+		capacity: capacity,
+		caches:   make(map[*readCache]struct{}),
+	}
+}
+
+// Capacity returns the size limit of the budget.
+func (b *ReadCacheBudget) Capacity() uint64 {
+	return b.capacity
+}
+
+// Size returns the total size of the objects in the read caches
+// drawing from the budget.
+func (b *ReadCacheBudget) Size() uint64 {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.size
+}
+
+// evict removes the least recently used objects of the biggest caches until
+// sz more bytes fit the budget. Must be called with b.mtx held.
+func (b *ReadCacheBudget) evict(sz uint64) {
+	for b.size+sz > b.capacity {
+		var victim *readCache
+		for c := range b.caches {
+			if victim == nil || c.size > victim.size {
+				victim = c
+			}
+		}
+
+		if victim == nil || victim.size == 0 {
+			return
+		}
+
+		victim.lru.RemoveOldest()
+	}
+}
+
+// readCache is an in-memory LRU cache of the small objects read from
+// the blobstor. Objects are stored in a binary form, the total size of
+// the stored data is limited. Nil readCache is a valid disabled cache.
+type readCache struct {
+	// mtx is shared by all the caches drawing from the same budget,
+	// so that the objects of one cache can be evicted by the others.
+	mtx *sync.Mutex
+	lru *simplelru.LRU
+
+	size          uint64
+	capacity      uint64
+	maxObjectSize uint64
+
+	budget *ReadCacheBudget
+}
+
+func newReadCache(capacity, maxObjectSize uint64, budget *ReadCacheBudget) *readCache {
+	c := &readCache{
+		mtx:           new(sync.Mutex),
+		capacity:      capacity,
+		maxObjectSize: maxObjectSize,
+		budget:        budget,
+	}
+
+	if budget != nil {
+		c.mtx = &budget.mtx
+		if budget.capacity < c.capacity {
+			c.capacity = budget.capacity
+		}
+	}
+
+	// the number of objects is not limited, the size is controlled by put
+	c.lru, _ = simplelru.NewLRU(math.MaxInt32, func(_, value interface{}) {
+		sz := uint64(len(value.([]byte)))
+
+		c.size -= sz
+		if c.budget != nil {
+			c.budget.size -= sz
+		}
+	})
+
+	return c
+}
+
+// get returns the cached object. The returned object can be modified
+// by the caller.
+func (c *readCache) get(addr oid.Address) (*objectSDK.Object, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	v, ok := c.lru.Get(addr.EncodeToString())
+	c.mtx.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(v.([]byte)); err != nil {
+		return nil, false
+	}
+
+	return obj, true
+}
+
+// put stores the object if it is not bigger than the size limit. The least
+// recently used objects are evicted to fit the cache capacity and the
+// shared budget if any.
+func (c *readCache) put(addr oid.Address, obj *objectSDK.Object) {
+	if c == nil || obj.PayloadSize() > c.maxObjectSize {
+		return
+	}
+
+	data, err := obj.Marshal()
+	if err != nil || uint64(len(data)) > c.maxObjectSize || uint64(len(data)) > c.capacity {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := addr.EncodeToString()
+
+	// remove the previous value to keep the size correct
+	c.lru.Remove(key)
+
+	for c.size+uint64(len(data)) > c.capacity {
+		c.lru.RemoveOldest()
+	}
+
+	if c.budget != nil {
+		// the cache could have been purged on shard close
+		c.budget.caches[c] = struct{}{}
+
+		c.budget.evict(uint64(len(data)))
+		c.budget.size += uint64(len(data))
+	}
+
+	c.lru.Add(key, data)
+	c.size += uint64(len(data))
+}
+
+// remove evicts the objects from the cache.
+func (c *readCache) remove(addrs ...oid.Address) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	for i := range addrs {
+		c.lru.Remove(addrs[i].EncodeToString())
+	}
+	c.mtx.Unlock()
+}
+
+// purge evicts all the objects from the cache and
+// releases the budget occupied by the cache.
+func (c *readCache) purge() {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	c.lru.Purge()
+	if c.budget != nil {
+		delete(c.budget.caches, c)
+	}
+	c.mtx.Unlock()
+}
+
+// dataSize returns the total size of the cached objects.
+func (c *readCache) dataSize() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.size
+}
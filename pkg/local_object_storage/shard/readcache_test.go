@@ -0,0 +1,223 @@
+package shard_test
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	readCacheHit  = "read_cache_hit"
+	readCacheMiss = "read_cache_miss"
+)
+
+func shardWithReadCache(t *testing.T, capacity, maxObjectSize uint64, opts ...shard.Option) (*shard.Shard, *metricsStore) {
+	mm := &metricsStore{
+		s: make(map[string]uint64),
+	}
+
+	sh := newCustomShard(t, t.TempDir(), false, nil, nil, append([]shard.Option{
+		shard.WithMetricsWriter(mm),
+		shard.WithReadCache(capacity, maxObjectSize),
+	}, opts...)...)
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	return sh, mm
+}
+
+func putObject(t *testing.T, sh *shard.Shard, obj *object.Object) {
+	var prm shard.PutPrm
+	prm.SetObject(obj)
+
+	_, err := sh.Put(prm)
+	require.NoError(t, err)
+}
+
+func getObject(sh *shard.Shard, obj *object.Object, ignoreMeta bool) (*object.Object, error) {
+	var prm shard.GetPrm
+	prm.SetAddress(objectcore.AddressOf(obj))
+	prm.SetIgnoreMeta(ignoreMeta)
+
+	res, err := sh.Get(prm)
+	return res.Object(), err
+}
+
+func TestShard_ReadCache(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		sh, mm := shardWithReadCache(t, 0, 0)
+
+		obj := generateObject(t)
+		putObject(t, sh, obj)
+
+		for i := 0; i < 2; i++ {
+			_, err := getObject(sh, obj, false)
+			require.NoError(t, err)
+		}
+
+		require.Zero(t, mm.s[readCacheHit])
+		require.Zero(t, mm.s[readCacheMiss])
+	})
+
+	t.Run("hit", func(t *testing.T) {
+		sh, mm := shardWithReadCache(t, 1<<20, 1<<10)
+
+		obj := generateObject(t)
+		putObject(t, sh, obj)
+
+		for i := 0; i < 3; i++ {
+			res, err := getObject(sh, obj, false)
+			require.NoError(t, err)
+			require.Equal(t, obj.Payload(), res.Payload())
+		}
+
+		require.EqualValues(t, 1, mm.s[readCacheMiss])
+		require.EqualValues(t, 2, mm.s[readCacheHit])
+
+		var rngPrm shard.RngPrm
+		rngPrm.SetAddress(objectcore.AddressOf(obj))
+		rngPrm.SetRange(1, 4)
+
+		rngRes, err := sh.GetRange(rngPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload()[1:5], rngRes.Object().Payload())
+		require.EqualValues(t, 3, mm.s[readCacheHit])
+
+		rngPrm.SetRange(1, uint64(len(obj.Payload())))
+		_, err = sh.GetRange(rngPrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+	})
+
+	t.Run("big object", func(t *testing.T) {
+		sh, mm := shardWithReadCache(t, 1<<20, 1<<10)
+
+		obj := generateObjectWithPayload(cidtest.ID(), make([]byte, 2<<10))
+		putObject(t, sh, obj)
+
+		for i := 0; i < 2; i++ {
+			_, err := getObject(sh, obj, false)
+			require.NoError(t, err)
+		}
+
+		require.EqualValues(t, 2, mm.s[readCacheMiss])
+		require.Zero(t, mm.s[readCacheHit])
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		obj1 := generateObject(t)
+		obj2 := generateObject(t)
+
+		data, err := obj1.Marshal()
+		require.NoError(t, err)
+
+		// only one object fits the cache
+		sz := uint64(len(data)) * 3 / 2
+		sh, mm := shardWithReadCache(t, sz, sz)
+
+		putObject(t, sh, obj1)
+		putObject(t, sh, obj2)
+
+		for _, obj := range []*object.Object{obj1, obj2, obj1} {
+			_, err = getObject(sh, obj, false)
+			require.NoError(t, err)
+		}
+
+		require.EqualValues(t, 3, mm.s[readCacheMiss])
+
+		_, err = getObject(sh, obj1, false)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, mm.s[readCacheHit])
+	})
+
+	t.Run("tombstone", func(t *testing.T) {
+		sh, _ := shardWithReadCache(t, 1<<20, 1<<10)
+
+		cnr := cidtest.ID()
+		obj := generateObjectWithCID(t, cnr)
+		ts := generateObjectWithCID(t, cnr)
+		putObject(t, sh, obj)
+
+		_, err := getObject(sh, obj, false)
+		require.NoError(t, err)
+
+		var inhPrm shard.InhumePrm
+		inhPrm.SetTarget(objectcore.AddressOf(ts), objectcore.AddressOf(obj))
+
+		_, err = sh.Inhume(inhPrm)
+		require.NoError(t, err)
+
+		_, err = getObject(sh, obj, false)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		sh, mm := shardWithReadCache(t, 1<<20, 1<<10)
+
+		obj := generateObject(t)
+		putObject(t, sh, obj)
+
+		for i := 0; i < 2; i++ {
+			_, err := getObject(sh, obj, false)
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 1, mm.s[readCacheHit])
+
+		var delPrm shard.DeletePrm
+		delPrm.SetAddresses(objectcore.AddressOf(obj))
+
+		_, err := sh.Delete(delPrm)
+		require.NoError(t, err)
+
+		// blobstor is read directly, the object must not be served from the cache
+		_, err = getObject(sh, obj, true)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		require.EqualValues(t, 1, mm.s[readCacheHit])
+	})
+}
+
+func TestShard_ReadCacheBudget(t *testing.T) {
+	const objNum = 10
+
+	objs := make([]*object.Object, objNum)
+	for i := range objs {
+		objs[i] = generateObjectWithPayload(cidtest.ID(), make([]byte, 100))
+	}
+
+	data, err := objs[0].Marshal()
+	require.NoError(t, err)
+
+	// every cache can hold all the objects, the budget is enough for 3 of them
+	budget := shard.NewReadCacheBudget(uint64(len(data))*7/2 + 1)
+	sh1, _ := shardWithReadCache(t, 1<<20, 1<<10, shard.WithReadCacheBudget(budget))
+	sh2, mm2 := shardWithReadCache(t, 1<<20, 1<<10, shard.WithReadCacheBudget(budget))
+
+	for i, obj := range objs {
+		sh := sh1
+		if i%2 == 1 {
+			sh = sh2
+		}
+
+		putObject(t, sh, obj)
+
+		_, err := getObject(sh, obj, false)
+		require.NoError(t, err)
+
+		require.LessOrEqual(t, budget.Size(), budget.Capacity())
+		require.Equal(t, budget.Size(), sh1.ReadCacheSize()+sh2.ReadCacheSize())
+	}
+
+	require.EqualValues(t, uint64(len(data))*3, budget.Size())
+
+	// the most recently read object is still cached
+	_, err = getObject(sh2, objs[objNum-1], false)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, mm2.s[readCacheHit])
+
+	// closed shard releases the budget
+	require.NoError(t, sh1.Close())
+	require.Equal(t, sh2.ReadCacheSize(), budget.Size())
+}
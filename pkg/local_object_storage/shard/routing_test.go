@@ -0,0 +1,153 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_AttributeRouting(t *testing.T) {
+	t.Run("without write-cache", func(t *testing.T) {
+		testShardAttributeRouting(t, false)
+	})
+	t.Run("with write-cache", func(t *testing.T) {
+		testShardAttributeRouting(t, true)
+	})
+}
+
+func testShardAttributeRouting(t *testing.T, hasWriteCache bool) {
+	dir := t.TempDir()
+
+	hot := fstree.New(
+		fstree.WithPath(filepath.Join(dir, "hot")),
+		fstree.WithDepth(1))
+	cold := fstree.New(
+		fstree.WithPath(filepath.Join(dir, "cold")),
+		fstree.WithDepth(1))
+
+	sh := newCustomShard(t, dir, hasWriteCache, nil, []blobstor.Option{
+		blobstor.WithStorages([]blobstor.SubStorage{
+			{Storage: hot},
+			{
+				Storage: cold,
+				Attributes: []blobstor.AttributeRule{
+					{Key: "Tier", Value: "cold"},
+					{Key: "Tier", Value: "archive*"},
+				},
+			},
+		}),
+	})
+	defer releaseShard(sh, t)
+
+	var hotAddrs, coldAddrs []oid.Address
+	for i, tier := range []string{"", "cold", "hot", "archive-2022", "Cold", "cold"} {
+		// big ones are flushed from the write-cache FSTree as raw data
+		payload := make([]byte, 32)
+		if i%2 == 1 {
+			payload = make([]byte, 64<<10)
+		}
+
+		obj := generateObjectWithPayload(cidtest.ID(), payload)
+		if tier != "" {
+			addAttribute(obj, "Tier", tier)
+		}
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		if tier == "cold" || tier == "archive-2022" {
+			coldAddrs = append(coldAddrs, objectCore.AddressOf(obj))
+		} else {
+			hotAddrs = append(hotAddrs, objectCore.AddressOf(obj))
+		}
+	}
+
+	// mislabeled history: the cold object stored before the routing was configured
+	mislabeled := generateObject(t)
+	addAttribute(mislabeled, "Tier", "cold")
+	mislabeledAddr := objectCore.AddressOf(mislabeled)
+
+	data, err := mislabeled.Marshal()
+	require.NoError(t, err)
+
+	_, err = hot.Put(common.PutPrm{Address: mislabeledAddr, RawData: data})
+	require.NoError(t, err)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(mislabeled)
+
+	// goes to the cold storage as a new copy, so remove it and reuse
+	// the metabase record only
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+	if hasWriteCache {
+		require.NoError(t, sh.FlushWriteCache(shard.FlushWriteCachePrm{}))
+	}
+	_, err = cold.Delete(common.DeletePrm{Address: mislabeledAddr})
+	require.NoError(t, err)
+
+	requireStored := func(st *fstree.FSTree, addrs []oid.Address, stored bool) {
+		for _, addr := range addrs {
+			res, err := st.Exists(common.ExistsPrm{Address: addr})
+			require.NoError(t, err)
+			require.Equal(t, stored, res.Exists, "%s in %s", addr, st.RootPath)
+		}
+	}
+
+	requireStored(hot, hotAddrs, true)
+	requireStored(cold, hotAddrs, false)
+	requireStored(cold, coldAddrs, true)
+	requireStored(hot, coldAddrs, false)
+	requireStored(hot, []oid.Address{mislabeledAddr}, true)
+
+	all := append(append(hotAddrs, coldAddrs...), mislabeledAddr)
+
+	for _, addr := range all {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, addr, objectCore.AddressOf(res.Object()))
+
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(addr)
+
+		exRes, err := sh.Exists(existsPrm)
+		require.NoError(t, err)
+		require.True(t, exRes.Exists())
+	}
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(all...)
+
+	_, err = sh.Delete(delPrm)
+	require.NoError(t, err)
+
+	requireStored(hot, all, false)
+	requireStored(cold, all, false)
+
+	if hasWriteCache {
+		// flushed write-cache stays read-only and still holds the objects
+		return
+	}
+
+	for _, addr := range all {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		_, err := sh.Get(getPrm)
+		require.True(t, shard.IsErrNotFound(err), err)
+	}
+}
@@ -0,0 +1,108 @@
+package shard
+
+import (
+	"context"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ScheduleDeletionPrm groups the parameters of ScheduleDeletion operation.
+type ScheduleDeletionPrm struct {
+	addrs []oid.Address
+
+	epoch uint64
+}
+
+// ScheduleDeletionRes groups the resulting values of ScheduleDeletion operation.
+type ScheduleDeletionRes struct{}
+
+// SetAddresses sets the addresses of the objects to be deleted.
+func (p *ScheduleDeletionPrm) SetAddresses(addrs ...oid.Address) {
+	p.addrs = addrs
+}
+
+// SetEpoch sets the epoch the objects are deleted at.
+func (p *ScheduleDeletionPrm) SetEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+// ScheduleDeletion schedules the objects to be marked as garbage when the
+// specified epoch comes. The objects are fully available until then.
+//
+// Returns an error of type apistatus.ObjectNotFound if some object is not
+// stored in the shard, apistatus.ObjectAlreadyRemoved if it has already been
+// removed. Returns ErrLockObjectRemoval if some object is a lock object.
+//
+// Returns ErrReadOnlyMode error if shard is in "read-only" mode.
+func (s *Shard) ScheduleDeletion(prm ScheduleDeletionPrm) (_ ScheduleDeletionRes, err error) {
+	defer s.catchStoragePanic("schedule deletion", &err)()
+
+	m := s.GetMode()
+	if m.ReadOnly() {
+		return ScheduleDeletionRes{}, ErrReadOnlyMode
+	} else if m.NoMetabase() {
+		return ScheduleDeletionRes{}, ErrDegradedMode
+	}
+
+	var metaPrm meta.ScheduleDeletionPrm
+	metaPrm.SetAddresses(prm.addrs...)
+	metaPrm.SetEpoch(prm.epoch)
+
+	_, err = s.metaBase.ScheduleDeletion(metaPrm)
+
+	return ScheduleDeletionRes{}, err
+}
+
+// collectScheduledDeletions marks as garbage the objects the deletion
+// of which is scheduled at the epoch that has come.
+func (s *Shard) collectScheduledDeletions(ctx context.Context, e Event) {
+	epoch := e.(newEpoch).epoch
+
+	var scheduled []meta.ScheduledDeletion
+
+	err := s.metaBase.IterateScheduledDeletions(epoch, func(d meta.ScheduledDeletion) error {
+		select {
+		case <-ctx.Done():
+			return meta.ErrInterruptIterator
+		default:
+			scheduled = append(scheduled, d)
+			return nil
+		}
+	})
+	if err != nil || ctx.Err() != nil || len(scheduled) == 0 {
+		if err != nil {
+			s.log.Warn("iterator over scheduled deletions failed", zap.String("error", err.Error()))
+		}
+		return
+	}
+
+	addrs := make([]oid.Address, len(scheduled))
+	for i := range scheduled {
+		addrs[i] = scheduled[i].Address()
+	}
+
+	var inhumePrm InhumePrm
+	inhumePrm.MarkAsGarbage(addrs...)
+
+	if _, err := s.Inhume(inhumePrm); err != nil {
+		s.log.Warn("could not mark the objects with scheduled deletion as garbage",
+			zap.Uint64("epoch", epoch),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	if err := s.metaBase.DropScheduledDeletions(scheduled); err != nil {
+		s.log.Warn("could not drop activated scheduled deletions",
+			zap.String("error", err.Error()),
+		)
+	}
+
+	s.log.Debug("scheduled deletions activated",
+		zap.Uint64("epoch", epoch),
+		zap.Int("number", len(scheduled)),
+	)
+}
@@ -13,11 +13,15 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	orderAttr, orderPrefix string
+	ordered                bool
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	values   []string
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -30,16 +34,31 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetPrefixOrder is a Select option to select only the objects with
+// the attribute value starting with the prefix and to sort them by
+// closeness of the value to the prefix.
+func (p *SelectPrm) SetPrefixOrder(attr, prefix string) {
+	p.orderAttr, p.orderPrefix, p.ordered = attr, prefix, true
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Values returns the attribute values of the selected objects in the order
+// of AddressList. Set only if SetPrefixOrder option was used.
+func (r SelectRes) Values() []string {
+	return r.values
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
 // did not allow to completely select the objects.
-func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
+func (s *Shard) Select(prm SelectPrm) (_ SelectRes, err error) {
+	defer s.catchStoragePanic("select", &err)()
+
 	if s.GetMode().NoMetabase() {
 		return SelectRes{}, ErrDegradedMode
 	}
@@ -47,6 +66,9 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	if prm.ordered {
+		selectPrm.SetPrefixOrder(prm.orderAttr, prm.orderPrefix)
+	}
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -55,5 +77,6 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 
 	return SelectRes{
 		addrList: mRes.AddressList(),
+		values:   mRes.Values(),
 	}, nil
 }
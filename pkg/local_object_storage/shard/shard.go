@@ -12,7 +12,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -24,6 +26,18 @@ type Shard struct {
 
 	writeCache writecache.Cache
 
+	// writeCacheDisabled is true if the write-cache is detached
+	// at runtime, see DisableWriteCache.
+	writeCacheDisabled atomic.Bool
+
+	// writeCacheMtx serializes the write-cache detaching and attaching.
+	writeCacheMtx sync.Mutex
+
+	// modeChanges is the number of the shard mode switches, it is used
+	// to detect the switch during the write-cache flush on detaching.
+	// `s.m` must be taken.
+	modeChanges uint64
+
 	blobStor *blobstor.BlobStor
 
 	pilorama pilorama.ForestStorage
@@ -31,6 +45,28 @@ type Shard struct {
 	metaBase *meta.DB
 
 	tsSource TombstoneSource
+
+	// check contains the state of the consistency check.
+	check *checkState
+
+	// migration contains the state of the FSTree migration.
+	migration *migrationState
+
+	// readCache contains recently read small objects, nil if disabled.
+	readCache *readCache
+
+	// existenceFilter contains the addresses known to the shard, nil if disabled.
+	existenceFilter *existenceFilter
+
+	// deinliner contains the state of the background moving of the
+	// inline objects to the blobstor.
+	deinliner *deinlinerState
+
+	// orphans contains the state of the orphaned children collection.
+	orphans *orphanState
+
+	// expired contains the state of the expired objects collection.
+	expired *expiredState
 }
 
 // Option represents Shard's constructor option.
@@ -45,6 +81,13 @@ type ExpiredObjectsCallback func(context.Context, []oid.Address)
 // DeletedLockCallback is a callback handling list of deleted LOCK objects.
 type DeletedLockCallback func(context.Context, []oid.Address)
 
+// ObjectFlushedCallback is a callback handling the address of the object
+// flushed from the write-cache to the blobstor of the shard.
+type ObjectFlushedCallback func(*ID, oid.Address)
+
+// ModeChangedCallback is a callback handling the new mode of the shard.
+type ModeChangedCallback func(*ID, mode.Mode)
+
 // MetricsWriter is an interface that must store shard's metrics.
 type MetricsWriter interface {
 	// SetObjectCounter must set object counter taking into account object type.
@@ -59,6 +102,27 @@ type MetricsWriter interface {
 	// DecObjectCounter must decrement shard's object counter taking into account
 	// object type.
 	DecObjectCounter(objectType string)
+	// IncReadCacheCounter must increment the counter of the read cache
+	// lookups taking into account whether the object was found.
+	IncReadCacheCounter(hit bool)
+	// IncRangeReadCounter must increment the counter of the payload range
+	// reads from the shard storages taking into account whether the whole
+	// object was read to get the range.
+	IncRangeReadCounter(full bool)
+	// SetMode must set the shard mode.
+	SetMode(m mode.Mode)
+	// AddMetabaseTxWait must add the time spent by the metabase write
+	// operation of the specified priority waiting for the transaction limit.
+	AddMetabaseTxWait(priority string, d time.Duration)
+	// AddWriteCacheFlushedObject must account the object of the specified
+	// size flushed from the write-cache to the blobstor.
+	AddWriteCacheFlushedObject(size uint64)
+	// IncWriteCacheFlushErrors must increment the counter of the objects
+	// failed to be flushed from the write-cache.
+	IncWriteCacheFlushErrors()
+	// SetWriteCacheBacklog must set the number of the objects stored in
+	// the write-cache database which are not flushed yet.
+	SetWriteCacheBacklog(v uint64)
 }
 
 type cfg struct {
@@ -66,10 +130,14 @@ type cfg struct {
 
 	refillMetabase bool
 
+	forcedReadOnly bool
+
 	rmBatchSize int
 
 	useWriteCache bool
 
+	writeCacheDrainTimeout time.Duration
+
 	info Info
 
 	blobOpts []blobstor.Option
@@ -78,6 +146,8 @@ type cfg struct {
 
 	writeCacheOpts []writecache.Option
 
+	writeCacheConstructor WriteCacheConstructor
+
 	piloramaOpts []pilorama.Option
 
 	log *logger.Logger
@@ -90,16 +160,48 @@ type cfg struct {
 
 	deletedLockCallBack DeletedLockCallback
 
+	objectFlushedCallback ObjectFlushedCallback
+
+	modeChangedCallback ModeChangedCallback
+
 	tsSource TombstoneSource
 
 	metricsWriter MetricsWriter
+
+	readCacheCapacity      uint64
+	readCacheMaxObjectSize uint64
+	readCacheBudget        *ReadCacheBudget
+
+	existenceFilterSize uint64
+
+	inlineThreshold uint64
+
+	orphanSampleSize    uint32
+	orphanConfirmations uint32
+	orphanMinAge        uint64
+	orphanRemovalSource RemovalSource
+	orphanRemoteChecks  uint32
+
+	expiredObjectsLimit uint32
+
+	placementMtx sync.RWMutex
+	placement    ContainerPlacement
+
+	storagePanicCallback StoragePanicCallback
+
+	gcPriority    meta.Priority
+	flushPriority meta.Priority
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		rmBatchSize: 100,
-		log:         zap.L(),
-		gcCfg:       defaultGCCfg(),
+		rmBatchSize:   100,
+		log:           zap.L(),
+		gcCfg:         defaultGCCfg(),
+		gcPriority:    meta.PriorityLow,
+		flushPriority: meta.PriorityLow,
+
+		orphanConfirmations: defaultOrphanConfirmations,
 	}
 }
 
@@ -111,15 +213,44 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
-	bs := blobstor.New(c.blobOpts...)
-	mb := meta.New(c.metaOpts...)
+	if c.forcedReadOnly {
+		c.info.ForcedReadOnly = true
+		c.info.Mode |= mode.ReadOnly
+	}
+
+	mb := meta.New(append(c.metaOpts, meta.WithTxWaitCallback(func(p meta.Priority, d time.Duration) {
+		if c.metricsWriter != nil {
+			c.metricsWriter.AddMetabaseTxWait(p.String(), d)
+		}
+	}))...)
+	bs := blobstor.New(append(c.blobOpts, blobstor.WithStorageIDUpdater(func(addr oid.Address, id []byte) error {
+		var prm meta.UpdateStorageIDPrm
+		prm.SetAddress(addr)
+		prm.SetStorageID(id)
+
+		_, err := mb.UpdateStorageID(prm)
+		return err
+	}))...)
 
 	var writeCache writecache.Cache
 	if c.useWriteCache {
-		writeCache = writecache.New(
-			append(c.writeCacheOpts,
-				writecache.WithBlobstor(bs),
-				writecache.WithMetabase(mb))...)
+		wcOpts := append(c.writeCacheOpts,
+			writecache.WithBlobstor(bs),
+			writecache.WithMetabase(mb),
+			writecache.WithMetabasePriority(c.flushPriority),
+			writecache.WithInlineThreshold(c.inlineThreshold),
+			writecache.WithMetrics(writeCacheMetrics{c}))
+		if c.objectFlushedCallback != nil {
+			wcOpts = append(wcOpts, writecache.WithFlushCallback(func(addr oid.Address) {
+				c.objectFlushedCallback(c.info.ID, addr)
+			}))
+		}
+
+		if c.writeCacheConstructor != nil {
+			writeCache = c.writeCacheConstructor(bs, mb)
+		} else {
+			writeCache = writecache.New(wcOpts...)
+		}
 	}
 
 	s := &Shard{
@@ -128,6 +259,21 @@ func New(opts ...Option) *Shard {
 		metaBase:   mb,
 		writeCache: writeCache,
 		tsSource:   c.tsSource,
+		check:      new(checkState),
+		migration:  new(migrationState),
+		deinliner:  new(deinlinerState),
+		orphans: &orphanState{
+			confirmations: make(map[oid.Address]uint32),
+		},
+		expired: newExpiredState(),
+	}
+
+	if c.readCacheCapacity > 0 {
+		s.readCache = newReadCache(c.readCacheCapacity, c.readCacheMaxObjectSize, c.readCacheBudget)
+	}
+
+	if c.existenceFilterSize > 0 {
+		s.existenceFilter = newExistenceFilter(c.existenceFilterSize)
 	}
 
 	if s.piloramaOpts != nil {
@@ -167,6 +313,19 @@ func WithWriteCacheOptions(opts ...writecache.Option) Option {
 	}
 }
 
+// WriteCacheConstructor constructs the write-cache of the shard flushing
+// objects to the main storage and the metabase of the shard.
+type WriteCacheConstructor func(*blobstor.BlobStor, *meta.DB) writecache.Cache
+
+// WithWriteCacheConstructor returns option to replace the default write-cache
+// implementation, e.g. with the in-memory one in tests. The write-cache
+// options are ignored if the constructor is set.
+func WithWriteCacheConstructor(f WriteCacheConstructor) Option {
+	return func(c *cfg) {
+		c.writeCacheConstructor = f
+	}
+}
+
 // WithPiloramaOptions returns option to set internal write cache options.
 func WithPiloramaOptions(opts ...pilorama.Option) Option {
 	return func(c *cfg) {
@@ -174,6 +333,18 @@ func WithPiloramaOptions(opts ...pilorama.Option) Option {
 	}
 }
 
+// WithMetabasePriorities returns option to set the priorities of the metabase
+// write transactions made by the GC and by the write-cache flush. The
+// priorities matter only if the metabase transactions are limited, the
+// foreground operations always have meta.PriorityHigh. Both are
+// meta.PriorityLow by default.
+func WithMetabasePriorities(gc, flush meta.Priority) Option {
+	return func(c *cfg) {
+		c.gcPriority = gc
+		c.flushPriority = flush
+	}
+}
+
 // WithLogger returns option to set Shard's logger.
 func WithLogger(l *logger.Logger) Option {
 	return func(c *cfg) {
@@ -189,14 +360,34 @@ func WithWriteCache(use bool) Option {
 	}
 }
 
-// hasWriteCache returns bool if write cache exists on shards.
-func (s Shard) hasWriteCache() bool {
-	return s.cfg.useWriteCache
+// WithWriteCacheDrainTimeout returns option to flush all the write-cache
+// objects to the blobstor on Close within the provided time (see
+// writecache.Cache.Drain), the objects left are flushed after the next
+// start. Zero timeout (default) disables the draining, only the objects
+// taken by the background flush are written then.
+func WithWriteCacheDrainTimeout(d time.Duration) Option {
+	return func(c *cfg) {
+		if d >= 0 {
+			c.writeCacheDrainTimeout = d
+		}
+	}
+}
+
+// hasWriteCache returns bool if write cache exists on shards
+// and is not disabled at runtime.
+func (s *Shard) hasWriteCache() bool {
+	return s.cfg.useWriteCache && !s.writeCacheDisabled.Load()
 }
 
 // needRefillMetabase returns true if metabase is needed to be refilled.
-func (s Shard) needRefillMetabase() bool {
-	return s.cfg.refillMetabase
+func (s *Shard) needRefillMetabase() bool {
+	return s.cfg.refillMetabase && !s.cfg.forcedReadOnly
+}
+
+// ForcedReadOnly returns true if the shard is forced to stay
+// read-only (see WithForcedReadOnly).
+func (s *Shard) ForcedReadOnly() bool {
+	return s.cfg.forcedReadOnly
 }
 
 // WithRemoverBatchSize returns option to set batch size
@@ -207,6 +398,74 @@ func WithRemoverBatchSize(sz int) Option {
 	}
 }
 
+// WithGCVerification returns option to re-check the status of each GC-marked
+// object right before its removal. Objects that are not GC-marked anymore
+// or have been locked since marking are not removed.
+//
+// Disabled by default.
+func WithGCVerification(v bool) Option {
+	return func(c *cfg) {
+		c.gcCfg.verifyGarbage = v
+	}
+}
+
+// WithExpiredObjectsLimit returns option to limit the number of the expired
+// objects marked as garbage per GC run. The objects left are carried over:
+// the GC remover ticks and the next epochs continue the collection from the
+// position in the metabase expiration index the previous run stopped at.
+//
+// Zero limit means no limit. Not limited by default.
+func WithExpiredObjectsLimit(n uint32) Option {
+	return func(c *cfg) {
+		c.expiredObjectsLimit = n
+	}
+}
+
+// WithOrphanCollection returns option to enable the GC of the children of
+// the split chains, the parents of which have been removed, but the
+// tombstones have not reached the shard. Each epoch the GC checks the
+// parents of at most sampleSize stored objects, the whole shard is checked
+// in a round-robin manner.
+//
+// Zero sample size disables the collection. Disabled by default.
+func WithOrphanCollection(sampleSize uint32) Option {
+	return func(c *cfg) {
+		c.orphanSampleSize = sampleSize
+	}
+}
+
+// WithOrphanConfirmations returns option to set the number of the GC passes
+// in a row which must confirm the removal of the parent before the child
+// is marked with GC (see WithOrphanCollection). Defaults to 3.
+func WithOrphanConfirmations(n uint32) Option {
+	return func(c *cfg) {
+		if n > 0 {
+			c.orphanConfirmations = n
+		}
+	}
+}
+
+// WithOrphanMinAge returns option to set the number of epochs since the
+// creation of the child, during which it is never collected as an orphan
+// (see WithOrphanCollection).
+func WithOrphanMinAge(epochs uint64) Option {
+	return func(c *cfg) {
+		c.orphanMinAge = epochs
+	}
+}
+
+// WithOrphanRemovalSource returns option to check the removal of the
+// parents of the orphaned children (see WithOrphanCollection) in the
+// NeoFS network. At most checksPerEpoch checks are made on each epoch.
+//
+// Only the local graveyard of the shard is checked if the source is not set.
+func WithOrphanRemovalSource(src RemovalSource, checksPerEpoch uint32) Option {
+	return func(c *cfg) {
+		c.orphanRemovalSource = src
+		c.orphanRemoteChecks = checksPerEpoch
+	}
+}
+
 // WithGCWorkerPoolInitializer returns option to set initializer of
 // worker pool with specified worker number.
 func WithGCWorkerPoolInitializer(wpInit func(int) util.WorkerPool) Option {
@@ -223,6 +482,14 @@ func WithGCRemoverSleepInterval(dur time.Duration) Option {
 	}
 }
 
+// WithGCHistorySize returns option to specify the number of
+// the latest GC remover ticks kept in memory.
+func WithGCHistorySize(n int) Option {
+	return func(c *cfg) {
+		c.gcCfg.historySize = n
+	}
+}
+
 // WithExpiredTombstonesCallback returns option to specify callback
 // of the expired tombstones handler.
 func WithExpiredTombstonesCallback(cb ExpiredTombstonesCallback) Option {
@@ -255,6 +522,17 @@ func WithMode(v mode.Mode) Option {
 	}
 }
 
+// WithForcedReadOnly returns option to keep the shard read-only for its
+// whole lifetime. All the storages are opened in read-only mode, metabase
+// refill and the background jobs changing the stored data are disabled,
+// switching to the modes allowing writes fails with ErrForcedReadOnly.
+// The mode set via WithMode is made read-only.
+func WithForcedReadOnly(v bool) Option {
+	return func(c *cfg) {
+		c.forcedReadOnly = v
+	}
+}
+
 // WithTombstoneSource returns option to set TombstoneSource.
 func WithTombstoneSource(v TombstoneSource) Option {
 	return func(c *cfg) {
@@ -270,6 +548,22 @@ func WithDeletedLockCallback(v DeletedLockCallback) Option {
 	}
 }
 
+// WithObjectFlushedCallback returns option to specify callback
+// of the objects flushed from the write-cache. The callback must not block.
+func WithObjectFlushedCallback(v ObjectFlushedCallback) Option {
+	return func(c *cfg) {
+		c.objectFlushedCallback = v
+	}
+}
+
+// WithModeChangedCallback returns option to specify callback
+// of the shard mode changes.
+func WithModeChangedCallback(v ModeChangedCallback) Option {
+	return func(c *cfg) {
+		c.modeChangedCallback = v
+	}
+}
+
 // WithMetricsWriter returns option to specify storage of the
 // shard's metrics.
 func WithMetricsWriter(v MetricsWriter) Option {
@@ -278,6 +572,65 @@ func WithMetricsWriter(v MetricsWriter) Option {
 	}
 }
 
+// WithReadCache returns option to enable in-memory cache of the objects
+// read from the blobstor. Capacity limits the total size of the cached
+// objects, only objects not bigger than maxObjectSize are cached.
+//
+// Zero capacity disables the cache. Disabled by default.
+func WithReadCache(capacity, maxObjectSize uint64) Option {
+	return func(c *cfg) {
+		c.readCacheCapacity = capacity
+		c.readCacheMaxObjectSize = maxObjectSize
+	}
+}
+
+// WithReadCacheBudget returns option to draw the memory of the read cache
+// (see WithReadCache) from the budget shared with other shards.
+//
+// Nil budget means that only the capacity of the read cache is limited.
+func WithReadCacheBudget(b *ReadCacheBudget) Option {
+	return func(c *cfg) {
+		c.readCacheBudget = b
+	}
+}
+
+// WithExistenceFilter returns option to enable in-memory filter of the
+// addresses known to the shard (see Shard.MayContain), so that the storage
+// engine skips the shard when looking for the objects it does not store.
+// Size limits the memory occupied by the filter, the filter is built on
+// shard initialization.
+//
+// Zero size disables the filter. Disabled by default.
+func WithExistenceFilter(size uint64) Option {
+	return func(c *cfg) {
+		c.existenceFilterSize = size
+	}
+}
+
+// WithInlineThreshold returns option to store the objects with the payload
+// not bigger than threshold inline, i.e. with the payload embedded into the
+// metabase record instead of the blobstor. It saves a blobstor write and
+// read for tiny objects. If the write-cache is enabled, the objects are
+// inlined on flush.
+//
+// Inline objects exist only in the metabase, so the shard storing them
+// can't be switched to the degraded modes (see ErrInlineObjects).
+//
+// Zero threshold disables inlining. Disabled by default. The objects stored
+// inline remain readable after the inlining is disabled, they are moved to
+// the blobstor in the background after the shard initialization.
+func WithInlineThreshold(threshold uint64) Option {
+	return func(c *cfg) {
+		c.inlineThreshold = threshold
+	}
+}
+
+// ReadCacheSize returns the total size of the objects in the read cache.
+// Returns zero if the read cache is disabled.
+func (s *Shard) ReadCacheSize() uint64 {
+	return s.readCache.dataSize()
+}
+
 func (s *Shard) fillInfo() {
 	s.cfg.info.MetaBaseInfo = s.metaBase.DumpInfo()
 	s.cfg.info.BlobStorInfo = s.blobStor.DumpInfo()
@@ -332,3 +685,23 @@ func (s *Shard) decObjectCounterBy(typ string, v uint64) {
 		s.cfg.metricsWriter.AddToObjectCounter(typ, -int(v))
 	}
 }
+
+// getCached returns the object from the read cache if it is enabled.
+func (s *Shard) getCached(addr oid.Address) (*objectSDK.Object, bool) {
+	if s.readCache == nil {
+		return nil, false
+	}
+
+	obj, ok := s.readCache.get(addr)
+	if s.cfg.metricsWriter != nil {
+		s.cfg.metricsWriter.IncReadCacheCounter(ok)
+	}
+
+	return obj, ok
+}
+
+func (s *Shard) incRangeReadCounter(full bool) {
+	if s.cfg.metricsWriter != nil {
+		s.cfg.metricsWriter.IncRangeReadCounter(full)
+	}
+}
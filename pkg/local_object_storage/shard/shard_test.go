@@ -38,7 +38,7 @@ func newShard(t testing.TB, enableWriteCache bool) *shard.Shard {
 		nil)
 }
 
-func newCustomShard(t testing.TB, rootPath string, enableWriteCache bool, wcOpts []writecache.Option, bsOpts []blobstor.Option) *shard.Shard {
+func newCustomShard(t testing.TB, rootPath string, enableWriteCache bool, wcOpts []writecache.Option, bsOpts []blobstor.Option, shardOpts ...shard.Option) *shard.Shard {
 	if enableWriteCache {
 		rootPath = filepath.Join(rootPath, "wc")
 	} else {
@@ -83,7 +83,7 @@ func newCustomShard(t testing.TB, rootPath string, enableWriteCache bool, wcOpts
 		),
 	}
 
-	sh := shard.New(opts...)
+	sh := shard.New(append(opts, shardOpts...)...)
 
 	require.NoError(t, sh.Open())
 	require.NoError(t, sh.Init())
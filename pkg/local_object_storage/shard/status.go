@@ -0,0 +1,57 @@
+package shard
+
+import (
+	"fmt"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectStatus represents the status of the object in the shard.
+type ObjectStatus struct {
+	// Metabase is the status of the object in the metabase.
+	// Zero value if the shard works without the metabase.
+	Metabase meta.ObjectStatus
+	// WriteCache is the status of the object in the write-cache.
+	// Nil if the shard has no write-cache.
+	WriteCache *writecache.ObjectStatus
+	// BlobStorage is the type of the blobstor sub-storage containing
+	// the object, empty if the object is not in the blobstor.
+	BlobStorage string
+}
+
+// Found returns true if the object is known to any part of the shard.
+func (s ObjectStatus) Found() bool {
+	return s.Metabase.Found || s.Metabase.Tombstone != nil || s.Metabase.GCMarked || s.Metabase.Locked ||
+		s.WriteCache != nil && (s.WriteCache.InDB || s.WriteCache.InFSTree) ||
+		s.BlobStorage != ""
+}
+
+// ObjectStatus returns the status of the object in all shard components.
+func (s *Shard) ObjectStatus(addr oid.Address) (ObjectStatus, error) {
+	var res ObjectStatus
+	var err error
+
+	if !s.GetMode().NoMetabase() {
+		res.Metabase, err = s.metaBase.ObjectStatus(addr)
+		if err != nil {
+			return res, fmt.Errorf("metabase: %w", err)
+		}
+	}
+
+	if s.hasWriteCache() {
+		wcStatus, err := s.writeCache.ObjectStatus(addr)
+		if err != nil {
+			return res, fmt.Errorf("write-cache: %w", err)
+		}
+		res.WriteCache = &wcStatus
+	}
+
+	res.BlobStorage, err = s.blobStor.ObjectStorage(addr)
+	if err != nil {
+		return res, fmt.Errorf("blobstor: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+)
+
+// WarmUpPrm groups the parameters of WarmUp operation.
+type WarmUpPrm struct {
+	metaPrm meta.WarmUpPrm
+
+	byteLimit uint64
+
+	writeCache bool
+}
+
+// WarmUpRes groups the resulting values of WarmUp operation.
+type WarmUpRes struct {
+	metaBytes, writeCacheBytes uint64
+}
+
+// SetBuckets sets the names of the metabase buckets to read.
+// See meta.WarmUpPrm.SetBuckets for details.
+func (p *WarmUpPrm) SetBuckets(names ...string) {
+	p.metaPrm.SetBuckets(names...)
+}
+
+// SetByteLimit sets the maximum number of bytes to read from the shard.
+// Zero means no limit.
+func (p *WarmUpPrm) SetByteLimit(limit uint64) {
+	p.byteLimit = limit
+}
+
+// SetWriteCache sets the flag to warm up the write-cache database
+// after the metabase.
+func (p *WarmUpPrm) SetWriteCache(v bool) {
+	p.writeCache = v
+}
+
+// MetabaseBytes returns the number of bytes read from the metabase.
+func (r WarmUpRes) MetabaseBytes() uint64 {
+	return r.metaBytes
+}
+
+// WriteCacheBytes returns the number of bytes read from the write-cache.
+func (r WarmUpRes) WriteCacheBytes() uint64 {
+	return r.writeCacheBytes
+}
+
+// WarmUp reads the shard's metabase and, optionally, the write-cache database
+// so that their pages are loaded to the OS page cache. The byte limit is shared
+// between the metabase and the write-cache, the metabase is read first.
+//
+// The metabase is skipped if the shard works without it.
+func (s *Shard) WarmUp(ctx context.Context, prm WarmUpPrm) (WarmUpRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	var res WarmUpRes
+
+	if !s.info.Mode.NoMetabase() {
+		prm.metaPrm.SetByteLimit(prm.byteLimit)
+
+		mRes, err := s.metaBase.WarmUp(ctx, prm.metaPrm)
+		res.metaBytes = mRes.Bytes()
+		if err != nil {
+			return res, fmt.Errorf("metabase: %w", err)
+		}
+
+		if prm.byteLimit > 0 && res.metaBytes >= prm.byteLimit {
+			return res, nil
+		}
+	}
+
+	if prm.writeCache && s.hasWriteCache() {
+		limit := prm.byteLimit
+		if limit > 0 {
+			limit -= res.metaBytes
+		}
+
+		var err error
+
+		res.writeCacheBytes, err = s.writeCache.WarmUp(ctx, limit)
+		if err != nil {
+			return res, fmt.Errorf("write-cache: %w", err)
+		}
+	}
+
+	return res, nil
+}
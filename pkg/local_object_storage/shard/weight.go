@@ -1,5 +1,9 @@
 package shard
 
+import (
+	"path/filepath"
+)
+
 // WeightValues groups values of Shard weight parameters.
 type WeightValues struct {
 	// Amount of free disk space. Measured in kilobytes.
@@ -10,3 +14,32 @@ type WeightValues struct {
 func (s *Shard) WeightValues() WeightValues {
 	return s.info.WeightValues
 }
+
+// DiskSpace groups the parameters of the file system storing the shard.
+type DiskSpace struct {
+	// Device is an identifier of the device containing the file system.
+	// Shards with the same Device share the free space.
+	Device uint64
+
+	// Free is the amount of disk space in bytes available
+	// for the shard's BLOB storage.
+	Free uint64
+}
+
+// FreeSpace returns the amount of disk space in bytes available
+// for the shard's BLOB storage.
+func (s *Shard) FreeSpace() (uint64, error) {
+	ds, err := s.DiskSpace()
+	return ds.Free, err
+}
+
+// DiskSpace returns the parameters of the file system storing the shard's
+// BLOB storage. It is not supported on all platforms.
+func (s *Shard) DiskSpace() (DiskSpace, error) {
+	p := s.info.BlobStorInfo.RootPath
+	if p == "" {
+		p = filepath.Dir(s.info.MetaBaseInfo.Path)
+	}
+
+	return diskSpace(p)
+}
@@ -0,0 +1,26 @@
+//go:build linux
+// +build linux
+
+package shard
+
+import (
+	"fmt"
+	"syscall"
+)
+
+func diskSpace(p string) (DiskSpace, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(p, &st); err != nil {
+		return DiskSpace{}, fmt.Errorf("could not get file system statistics: %w", err)
+	}
+
+	var fst syscall.Stat_t
+	if err := syscall.Stat(p, &fst); err != nil {
+		return DiskSpace{}, fmt.Errorf("could not get file statistics: %w", err)
+	}
+
+	return DiskSpace{
+		Device: uint64(fst.Dev),
+		Free:   uint64(st.Bavail) * uint64(st.Bsize),
+	}, nil
+}
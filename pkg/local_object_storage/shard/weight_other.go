@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package shard
+
+import (
+	"errors"
+)
+
+var errDiskSpaceUnsupported = errors.New("disk space statistics are not supported on this platform")
+
+func diskSpace(string) (DiskSpace, error) {
+	return DiskSpace{}, errDiskSpaceUnsupported
+}
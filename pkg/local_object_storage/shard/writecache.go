@@ -1,27 +1,55 @@
 package shard
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
 )
 
 // FlushWriteCachePrm represents parameters of a `FlushWriteCache` operation.
 type FlushWriteCachePrm struct {
-	ignoreErrors bool
+	prm writecache.FlushPrm
 }
 
 // SetIgnoreErrors sets the flag to ignore read-errors during flush.
 func (p *FlushWriteCachePrm) SetIgnoreErrors(ignore bool) {
-	p.ignoreErrors = ignore
+	p.prm.IgnoreErrors = ignore
+}
+
+// SetErrorHandler sets a function to call for every object skipped
+// because of the read-error. Flush is aborted if it returns an error.
+func (p *FlushWriteCachePrm) SetErrorHandler(f func(oid.Address, error) error) {
+	p.prm.ErrorHandler = f
+}
+
+// SetForce sets the flag to flush the write-cache without switching it to the
+// read-only mode. Only the objects stored at the moment of the call are flushed
+// then, the objects put during the flush stay in the write-cache.
+func (p *FlushWriteCachePrm) SetForce(force bool) {
+	p.prm.Force = force
 }
 
 // errWriteCacheDisabled is returned when an operation on write-cache is performed,
 // but write-cache is disabled.
 var errWriteCacheDisabled = errors.New("write-cache is disabled")
 
+// errNoWriteCache is returned when the write-cache is disabled or enabled
+// at runtime, but the shard is configured without it.
+var errNoWriteCache = errors.New("shard is configured without write-cache")
+
+// errNoFlushTimeout is returned when the write-cache is disabled without
+// the flush time limit.
+var errNoFlushTimeout = errors.New("write-cache flush timeout must be positive")
+
 // FlushWriteCache moves writecache in read-only mode and flushes all data from it.
-// After the operation writecache will remain read-only mode.
+// After the operation writecache will remain read-only mode. The forced flush
+// keeps the write-cache in the read-write mode, see SetForce.
 func (s *Shard) FlushWriteCache(p FlushWriteCachePrm) error {
 	if !s.hasWriteCache() {
 		return errWriteCacheDisabled
@@ -38,9 +66,179 @@ func (s *Shard) FlushWriteCache(p FlushWriteCachePrm) error {
 		return ErrDegradedMode
 	}
 
-	if err := s.writeCache.SetMode(mode.ReadOnly); err != nil {
-		return err
+	if !p.prm.Force {
+		if err := s.writeCache.SetMode(mode.ReadOnly); err != nil {
+			return err
+		}
+	}
+
+	return s.writeCache.Flush(p.prm)
+}
+
+// DisableWriteCache detaches the write-cache from the shard at runtime, e.g.
+// when its device is failing. The write-cache is switched to the read-only
+// mode, so the new objects are put directly to the blobstor, and all its
+// objects are flushed. If the flush is not finished in timeout or ctx is
+// done, it is aborted with the context error and the write-cache stays
+// attached. The timeout must be positive: the shard mode can not be changed
+// while the write-cache is being flushed. After the flush the write-cache is
+// closed and the shard operates without it until EnableWriteCache is called
+// or the shard is reopened, the disabled state is not persisted.
+//
+// Does nothing if the write-cache is already disabled. Returns
+// ErrReadOnlyMode or ErrDegradedMode if the shard is not in the
+// read-write mode.
+func (s *Shard) DisableWriteCache(ctx context.Context, timeout time.Duration) error {
+	if !s.useWriteCache {
+		return errNoWriteCache
+	}
+	if timeout <= 0 {
+		return errNoFlushTimeout
+	}
+
+	s.writeCacheMtx.Lock()
+	defer s.writeCacheMtx.Unlock()
+
+	if s.writeCacheDisabled.Load() {
+		return nil
+	}
+
+	s.m.RLock()
+	m := s.info.Mode
+	modeChanges := s.modeChanges
+	s.m.RUnlock()
+
+	if m.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if m.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The shard lock is not held during the flush, so the shard operations
+	// are not blocked by the waiting SetMode. The mode switch itself waits
+	// for the write-cache which is bounded by the timeout.
+	// Puts to the drained read-only write-cache fail and the objects
+	// are put to the blobstor, so nothing is added after the flush.
+	pending, err := s.writeCache.Drain(ctx)
+
+	// shard mode must not be changed until the write-cache is detached
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	// the switch resets the write-cache mode, so the objects
+	// may have been put to it after the flush
+	if err == nil && s.modeChanges != modeChanges {
+		err = fmt.Errorf("shard mode has been switched to %s", s.info.Mode)
+	}
+	if err != nil {
+		if err := s.writeCache.SetMode(s.info.Mode); err != nil {
+			s.log.Error("could not restore write-cache mode",
+				zap.Stringer("mode", s.info.Mode),
+				zap.Error(err))
+		}
+
+		return fmt.Errorf("could not flush write-cache, %d objects left: %w", pending, err)
+	}
+
+	// The operations started after this point do not access the write-cache.
+	// The ones in progress fail to read from the closed write-cache and fall
+	// back to the blobstor which already has all the objects.
+	s.writeCacheDisabled.Store(true)
+
+	if err := s.writeCache.Close(); err != nil {
+		s.log.Error("could not close detached write-cache", zap.Error(err))
+	}
+
+	s.log.Info("write-cache is disabled")
+
+	return nil
+}
+
+// EnableWriteCache attaches the write-cache detached by DisableWriteCache
+// back to the shard. The objects left in the write-cache are already
+// flushed, they are recognized as such and removed on its initialization.
+//
+// Does nothing if the write-cache is not disabled. Returns ErrReadOnlyMode
+// or ErrDegradedMode if the shard is not in the read-write mode.
+func (s *Shard) EnableWriteCache() error {
+	if !s.useWriteCache {
+		return errNoWriteCache
 	}
 
-	return s.writeCache.Flush(p.ignoreErrors)
+	s.writeCacheMtx.Lock()
+	defer s.writeCacheMtx.Unlock()
+
+	if !s.writeCacheDisabled.Load() {
+		return nil
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	if err := s.writeCache.Open(false); err != nil {
+		return fmt.Errorf("could not open write-cache: %w", err)
+	}
+
+	err := s.writeCache.SetMode(s.info.Mode)
+	if err == nil {
+		err = s.writeCache.Init()
+	}
+	if err != nil {
+		if err := s.writeCache.Close(); err != nil {
+			s.log.Error("could not close write-cache", zap.Error(err))
+		}
+
+		return fmt.Errorf("could not initialize write-cache: %w", err)
+	}
+
+	s.writeCacheDisabled.Store(false)
+
+	s.log.Info("write-cache is enabled")
+
+	return nil
+}
+
+// WriteCacheStats returns the statistics of the shard's write-cache.
+// Returns false if the write-cache is disabled.
+func (s *Shard) WriteCacheStats() (writecache.Stats, bool) {
+	if !s.hasWriteCache() {
+		return writecache.Stats{}, false
+	}
+
+	return s.writeCache.Stats(), true
+}
+
+// writeCacheMetrics passes the write-cache flush metrics to the metrics
+// writer of the shard if it is set.
+type writeCacheMetrics struct {
+	cfg *cfg
+}
+
+func (m writeCacheMetrics) AddFlushedObject(size uint64) {
+	if m.cfg.metricsWriter != nil {
+		m.cfg.metricsWriter.AddWriteCacheFlushedObject(size)
+	}
+}
+
+func (m writeCacheMetrics) IncFlushErrors() {
+	if m.cfg.metricsWriter != nil {
+		m.cfg.metricsWriter.IncWriteCacheFlushErrors()
+	}
+}
+
+func (m writeCacheMetrics) SetBacklog(v uint64) {
+	if m.cfg.metricsWriter != nil {
+		m.cfg.metricsWriter.SetWriteCacheBacklog(v)
+	}
 }
@@ -0,0 +1,170 @@
+package shard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_DisableWriteCache(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	put := func(size int) *objectSDK.Object {
+		obj := generateObject(t)
+		addPayload(obj, size)
+
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+
+		return obj
+	}
+
+	requireObjects := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			var prm shard.GetPrm
+			prm.SetAddress(object.AddressOf(obj))
+
+			res, err := sh.Get(prm)
+			require.NoError(t, err)
+			require.Equal(t, obj, res.Object())
+		}
+	}
+
+	cached := []*objectSDK.Object{put(1 << 5), put(1 << 20)}
+
+	st, ok := sh.WriteCacheStats()
+	require.True(t, ok)
+	require.EqualValues(t, 2, st.Objects)
+
+	require.NoError(t, sh.DisableWriteCache(context.Background(), time.Minute))
+	require.True(t, sh.DumpInfo().WriteCacheDisabled)
+
+	_, ok = sh.WriteCacheStats()
+	require.False(t, ok)
+	require.Error(t, sh.FlushWriteCache(shard.FlushWriteCachePrm{}))
+
+	// the flushed objects are read from the blobstor
+	requireObjects(cached...)
+
+	// the new objects are put to the blobstor
+	direct := put(1 << 5)
+	requireObjects(direct)
+
+	// repeated call does nothing
+	require.NoError(t, sh.DisableWriteCache(context.Background(), time.Minute))
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+	require.ErrorIs(t, sh.EnableWriteCache(), shard.ErrReadOnlyMode)
+	require.NoError(t, sh.SetMode(mode.ReadWrite))
+
+	require.NoError(t, sh.EnableWriteCache())
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+
+	// the objects left in the write-cache are recognized as flushed
+	st, ok = sh.WriteCacheStats()
+	require.True(t, ok)
+	require.Zero(t, st.DBObjects)
+
+	cached = append(cached, put(1<<5))
+
+	st, ok = sh.WriteCacheStats()
+	require.True(t, ok)
+	require.EqualValues(t, 1, st.DBObjects)
+
+	requireObjects(append(cached, direct)...)
+
+	// repeated call does nothing
+	require.NoError(t, sh.EnableWriteCache())
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+	require.ErrorIs(t, sh.DisableWriteCache(context.Background(), time.Minute), shard.ErrReadOnlyMode)
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+}
+
+func TestShard_DisableWriteCacheInterrupted(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	obj := generateObject(t)
+	addPayload(obj, 1<<5)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	// the flush time limit is required
+	require.Error(t, sh.DisableWriteCache(context.Background(), 0))
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, sh.DisableWriteCache(ctx, time.Minute), context.Canceled)
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+
+	// the write-cache is writable again
+	obj = generateObject(t)
+	addPayload(obj, 1<<5)
+	putPrm.SetObject(obj)
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	st, ok := sh.WriteCacheStats()
+	require.True(t, ok)
+	require.EqualValues(t, 2, st.DBObjects)
+}
+
+func TestShard_DisableWriteCacheWithoutWriteCache(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	require.Error(t, sh.DisableWriteCache(context.Background(), time.Minute))
+	require.Error(t, sh.EnableWriteCache())
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+}
+
+func TestShard_ForceFlushWriteCache(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	put := func() {
+		obj := generateObject(t)
+		addPayload(obj, 1<<5)
+
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	put()
+
+	var prm shard.FlushWriteCachePrm
+	prm.SetForce(true)
+	require.NoError(t, sh.FlushWriteCache(prm))
+
+	st, ok := sh.WriteCacheStats()
+	require.True(t, ok)
+	require.Zero(t, st.DBObjects)
+
+	// the write-cache stays writable
+	put()
+
+	st, ok = sh.WriteCacheStats()
+	require.True(t, ok)
+	require.EqualValues(t, 1, st.DBObjects)
+}
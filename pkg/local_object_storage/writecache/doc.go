@@ -5,7 +5,15 @@
 // 2. Filesystem tree for storing big objects.
 //
 // Flushing from the writecache to the main storage is done in the background.
-// To make it possible to serve Read requests after the object was flushed,
-// we maintain an LRU cache containing addresses of all the objects that
-// could be safely deleted. The actual deletion is done during eviction from this cache.
+// Small objects are removed from the database right after the flush. To make it
+// possible to serve Read requests after the big object was flushed, we maintain
+// an LRU cache containing addresses of the big objects that could be safely
+// deleted. The actual deletion is done during eviction from this cache.
+//
+// Objects are removed from the write-cache only after they have been flushed,
+// so no object put to the write-cache is lost across a clean shutdown. On close,
+// the objects already taken by the background flush are written to the main
+// storage within the shutdown timeout, the rest ones are flushed after the next
+// start. To flush all the objects before the shutdown, the write-cache can be
+// drained with Drain first, shards do it on close within the configured time.
 package writecache
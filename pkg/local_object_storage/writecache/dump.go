@@ -0,0 +1,93 @@
+package writecache
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// Dump writes the objects which are stored in the write-cache and are not
+// flushed yet to w. Every object is written as a record consisting of the
+// stringified object address and the raw object data, each prefixed with its
+// length as a 4-byte little-endian number. Small objects from the database are
+// written first, then the objects from the FSTree.
+//
+// The write-cache is not modified, so Dump can be called in any mode except the
+// degraded one, concurrently with the other operations.
+//
+// Returns ErrDegraded if the write-cache is in degraded mode.
+func (c *cache) Dump(w io.Writer) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.mode.NoMetabase() {
+		return ErrDegraded
+	}
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		if b == nil {
+			return ErrNoDefaultBucket
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if _, ok := c.flushed.Peek(string(k)); ok {
+				return nil
+			}
+
+			return writeDumpRecord(w, k, v)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+		saddr := addr.EncodeToString()
+		if _, ok := c.flushed.Peek(saddr); ok {
+			return nil
+		}
+
+		data, err := f()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// object has been removed after the flush
+				return nil
+			}
+			return err
+		}
+
+		data, err = c.fsTree.Decompress(data)
+		if err != nil {
+			return err
+		}
+
+		return writeDumpRecord(w, []byte(saddr), data)
+	}
+
+	_, err = c.fsTree.Iterate(prm)
+	return err
+}
+
+func writeDumpRecord(w io.Writer, addr, data []byte) error {
+	var size [4]byte
+
+	for _, field := range [][]byte{addr, data} {
+		binary.LittleEndian.PutUint32(size[:], uint32(len(field)))
+		if _, err := w.Write(size[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package writecache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDump(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	// prevent background flushes
+	require.NoError(t, mb.SetMode(mode.ReadOnly))
+	require.NoError(t, bs.SetMode(mode.ReadOnly))
+
+	wc := newTestCache(t, bs, mb, WithSmallObjectSize(smallSize))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	exp := make(map[string][]byte)
+	for i := 0; i < 6; i++ {
+		obj, data := newObject(t, 1+(i%2)*smallSize)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		require.NoError(t, err)
+
+		exp[prm.Address.EncodeToString()] = data
+	}
+
+	readDump := func(t *testing.T, r io.Reader) map[string][]byte {
+		readField := func() ([]byte, error) {
+			var size [4]byte
+			if _, err := io.ReadFull(r, size[:]); err != nil {
+				return nil, err
+			}
+
+			field := make([]byte, binary.LittleEndian.Uint32(size[:]))
+			_, err := io.ReadFull(r, field)
+			require.NoError(t, err)
+			return field, nil
+		}
+
+		res := make(map[string][]byte)
+		for {
+			addr, err := readField()
+			if err == io.EOF {
+				return res
+			}
+			require.NoError(t, err)
+
+			data, err := readField()
+			require.NoError(t, err)
+
+			res[string(addr)] = data
+		}
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, wc.Dump(&buf))
+		require.Equal(t, exp, readDump(t, &buf))
+	})
+
+	t.Run("flushed objects are skipped", func(t *testing.T) {
+		var flushed string
+		for addr := range exp {
+			flushed = addr
+			break
+		}
+
+		wc.flushed.Add(flushed, true)
+		defer wc.flushed.Remove(flushed)
+
+		var buf bytes.Buffer
+		require.NoError(t, wc.Dump(&buf))
+
+		res := readDump(t, &buf)
+		require.Len(t, res, len(exp)-1)
+		require.NotContains(t, res, flushed)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, wc.SetMode(mode.ReadOnly))
+
+		var buf bytes.Buffer
+		require.NoError(t, wc.Dump(&buf))
+		require.Equal(t, exp, readDump(t, &buf))
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		// objects are flushed on the transition to the degraded mode
+		require.NoError(t, mb.SetMode(mode.ReadWrite))
+		require.NoError(t, bs.SetMode(mode.ReadWrite))
+
+		require.NoError(t, wc.SetMode(mode.DegradedReadOnly))
+		require.ErrorIs(t, wc.Dump(io.Discard), ErrDegraded)
+	})
+}
@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
@@ -30,10 +34,18 @@ const (
 // in read-only mode to perform an operation.
 var errMustBeReadOnly = errors.New("write-cache must be in read-only mode")
 
+// errFlushBudgetExhausted is returned when the big object flush is interrupted
+// because the budget of the single flush cycle is exhausted.
+var errFlushBudgetExhausted = errors.New("big object flush budget is exhausted")
+
+// errShutdownTimeout is returned when the background flush is interrupted
+// because the shutdown timeout has expired.
+var errShutdownTimeout = errors.New("shutdown timeout expired")
+
 // runFlushLoop starts background workers which periodically flush objects to the blobstor.
 func (c *cache) runFlushLoop() {
 	for i := 0; i < c.workersCount; i++ {
-		c.wg.Add(1)
+		c.workersWg.Add(1)
 		go c.flushWorker(i)
 	}
 
@@ -44,14 +56,14 @@ func (c *cache) runFlushLoop() {
 	go func() {
 		defer c.wg.Done()
 
-		tt := time.NewTimer(defaultFlushInterval)
+		tt := time.NewTimer(c.flushInterval)
 		defer tt.Stop()
 
 		for {
 			select {
 			case <-tt.C:
 				c.flushDB()
-				tt.Reset(defaultFlushInterval)
+				tt.Reset(c.flushInterval)
 			case <-c.closeCh:
 				return
 			}
@@ -59,9 +71,17 @@ func (c *cache) runFlushLoop() {
 	}()
 }
 
+// flushDB sends the objects stored in the database to the flush workers in
+// batches. Each batch continues from the key following the last one read by
+// the previous batch, the iteration wraps around at the end of the keyspace,
+// so all the objects get their turn even if the leading ones can not be
+// flushed for a long time. Returns when the pass from the beginning of the
+// keyspace finds no objects to flush.
 func (c *cache) flushDB() {
-	lastKey := []byte{}
-	var m []objectInfo
+	var (
+		lastKey []byte
+		m       []objectInfo
+	)
 	for {
 		select {
 		case <-c.closeCh:
@@ -79,12 +99,21 @@ func (c *cache) flushDB() {
 			continue
 		}
 
+		var (
+			start   = lastKey
+			last    []byte
+			reached bool
+		)
+
 		// We put objects in batches of fixed size to not interfere with main put cycle a lot.
 		_ = c.db.View(func(tx *bbolt.Tx) error {
 			b := tx.Bucket(defaultBucket)
 			cs := b.Cursor()
-			for k, v := cs.Seek(lastKey); k != nil && len(m) < flushBatchSize; k, v = cs.Next() {
-				if _, ok := c.flushed.Peek(string(k)); ok {
+
+			var k, v []byte
+			for k, v = cs.Seek(start); k != nil && len(m) < flushBatchSize; k, v = cs.Next() {
+				last = k
+				if c.isFlushing(string(k)) {
 					continue
 				}
 
@@ -94,40 +123,146 @@ func (c *cache) flushDB() {
 					data: slice.Copy(v),
 				})
 			}
+
+			reached = k == nil
+			if !reached {
+				// successor of the last read key
+				lastKey = append(slice.Copy(last), 0)
+			}
 			return nil
 		})
 
+		if reached {
+			lastKey = nil
+
+			db, _ := c.backlog()
+			c.metrics.SetBacklog(db)
+		}
+
+		m = c.startFlushStored(m)
+
 		for i := range m {
 			obj := object.New()
 			if err := obj.Unmarshal(m[i].data); err != nil {
+				c.finishFlush(m[i].addr)
 				continue
 			}
 
-			select {
-			case c.flushCh <- obj:
-			case <-c.closeCh:
+			if !c.sendToFlush(obj) {
+				for j := i; j < len(m); j++ {
+					c.finishFlush(m[j].addr)
+				}
 				c.modeMtx.RUnlock()
+
+				c.log.Info("background flush is interrupted by the shutdown timeout, "+
+					"the rest objects will be flushed after the restart",
+					zap.Int("count", len(m)-i))
 				return
 			}
 		}
 
-		if len(m) == 0 {
+		if len(m) == 0 && len(start) == 0 {
 			c.modeMtx.RUnlock()
 			break
 		}
 
 		c.modeMtx.RUnlock()
 
-		c.log.Debug("tried to flush items from write-cache",
-			zap.Int("count", len(m)),
-			zap.String("start", base58.Encode(lastKey)))
+		if len(m) != 0 {
+			c.log.Debug("tried to flush items from write-cache",
+				zap.Int("count", len(m)),
+				zap.String("start", base58.Encode(start)))
+		}
 	}
 }
 
+// startFlushStored marks the objects read from the database as being flushed
+// and returns the ones which are still stored in it, the marks of the rest
+// are released. The objects flushed by the other routines after the read are
+// removed from the database before their marks are released, so the keys are
+// checked in the transaction started after marking to not flush them twice.
+func (c *cache) startFlushStored(objs []objectInfo) []objectInfo {
+	res := objs[:0]
+	for i := range objs {
+		if c.startFlush(objs[i].addr) {
+			res = append(res, objs[i])
+		}
+	}
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+
+		n := 0
+		for i := range res {
+			if b.Get([]byte(res[i].addr)) == nil {
+				c.finishFlush(res[i].addr)
+				continue
+			}
+
+			res[n] = res[i]
+			n++
+		}
+
+		res = res[:n]
+		return nil
+	})
+	if err != nil {
+		for i := range res {
+			c.finishFlush(res[i].addr)
+		}
+		return res[:0]
+	}
+
+	return res
+}
+
+// sendToFlush passes the object to the flush workers. After the write-cache
+// is closed, the object is passed only until the shutdown timeout expires.
+// Returns false if the object has not been passed.
+func (c *cache) sendToFlush(obj *object.Object) bool {
+	select {
+	case c.flushCh <- obj:
+		return true
+	case <-c.closeCh:
+	}
+
+	select {
+	case c.flushCh <- obj:
+		return true
+	case <-c.shutdownCh:
+		return false
+	}
+}
+
+// stopFlush stops the background flush. The objects already read from the
+// database are still passed to the flush workers until the shutdown timeout
+// expires, the rest ones are kept in the write-cache. Writes to the main
+// storage which are in progress are always finished.
+func (c *cache) stopFlush() {
+	if c.closeCh == nil {
+		return
+	}
+
+	close(c.closeCh)
+
+	shutdownCh := c.shutdownCh
+	t := time.AfterFunc(c.shutdownTimeout, func() { close(shutdownCh) })
+
+	c.wg.Wait()
+	t.Stop()
+
+	// No more objects are sent, so the workers exit after the flush
+	// of the objects they have already received.
+	close(c.flushCh)
+	c.workersWg.Wait()
+
+	c.closeCh = nil
+}
+
 func (c *cache) flushBigObjects() {
 	defer c.wg.Done()
 
-	tick := time.NewTicker(defaultFlushInterval * 10)
+	tick := time.NewTicker(c.flushInterval * 10)
 	for {
 		select {
 		case <-tick.C:
@@ -137,87 +272,226 @@ func (c *cache) flushBigObjects() {
 				break
 			}
 
-			evictNum := 0
+			c.flushBigObjectsTick()
 
-			var prm common.IteratePrm
-			prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
-				sAddr := addr.EncodeToString()
+			c.modeMtx.RUnlock()
+		case <-c.closeCh:
+			return
+		}
+	}
+}
 
-				if _, ok := c.store.flushed.Peek(sAddr); ok {
-					return nil
-				}
+// flushBigObjectsTick flushes the big objects from FSTree to the blobstor
+// until the big object flush budget is exhausted. The next call continues
+// from the object following the last processed one, so that the small
+// objects are flushed between the calls.
+func (c *cache) flushBigObjectsTick() {
+	var (
+		start   = time.Now()
+		written uint64
+		last    *oid.Address
+	)
 
-				data, err := f()
-				if err != nil {
-					c.log.Error("can't read a file", zap.Stringer("address", addr))
-					return nil
-				}
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+		select {
+		case <-c.shutdownCh:
+			return errShutdownTimeout
+		default:
+		}
 
-				c.mtx.Lock()
-				_, compress := c.compressFlags[sAddr]
-				c.mtx.Unlock()
+		// at least one object is flushed per call
+		if written > 0 && (c.bigFlushBytes > 0 && written >= c.bigFlushBytes ||
+			c.bigFlushTime > 0 && time.Since(start) >= c.bigFlushTime) {
+			return errFlushBudgetExhausted
+		}
 
-				var prm common.PutPrm
-				prm.Address = addr
-				prm.RawData = data
-				prm.DontCompress = !compress
+		last = &addr
 
-				if _, err := c.blobstor.Put(prm); err != nil {
-					c.log.Error("cant flush object to blobstor", zap.Error(err))
-					return nil
-				}
+		sAddr := addr.EncodeToString()
 
-				if compress {
-					c.mtx.Lock()
-					delete(c.compressFlags, sAddr)
-					c.mtx.Unlock()
-				}
+		if _, ok := c.store.flushed.Peek(sAddr); ok {
+			return nil
+		}
 
-				// mark object as flushed
-				c.flushed.Add(sAddr, false)
+		if !c.startFlush(sAddr) {
+			return nil
+		}
+		defer c.finishFlush(sAddr)
 
-				evictNum++
+		data, err := f()
+		if err != nil {
+			c.errLog.Error(logger.ErrorClass(err), "can't read a file",
+				zap.Stringer("address", addr),
+				zap.Error(err))
+			return nil
+		}
 
-				return nil
-			}
+		c.mtx.Lock()
+		_, compress := c.compressFlags[sAddr]
+		c.mtx.Unlock()
 
-			_, _ = c.fsTree.Iterate(prm)
+		var prm common.PutPrm
+		prm.Address = addr
+		prm.RawData = data
+		prm.DontCompress = !compress
 
-			c.modeMtx.RUnlock()
-		case <-c.closeCh:
-			return
+		if _, err := c.blobstor.Put(prm); err != nil {
+			c.metrics.IncFlushErrors()
+			c.errLog.Error(logger.ErrorClass(err), "cant flush object to blobstor",
+				zap.Stringer("address", addr),
+				zap.Error(err))
+			return nil
 		}
+
+		if compress {
+			c.mtx.Lock()
+			delete(c.compressFlags, sAddr)
+			c.mtx.Unlock()
+		}
+
+		// mark object as flushed
+		c.markFlushed(sAddr, false)
+		c.metrics.AddFlushedObject(uint64(len(data)))
+
+		written += uint64(len(data))
+
+		return nil
+	}
+
+	var err error
+	if c.bigFlushCursor != nil {
+		_, err = c.fsTree.IterateAfter(*c.bigFlushCursor, prm)
+	} else {
+		_, err = c.fsTree.Iterate(prm)
 	}
+
+	if errors.Is(err, errFlushBudgetExhausted) {
+		c.bigFlushCursor = last
+
+		c.log.Debug("big object flush budget is exhausted, the rest objects will be flushed on the next tick",
+			zap.Uint64("written", written),
+			zap.Stringer("duration", time.Since(start)))
+		return
+	}
+
+	c.bigFlushCursor = nil
 }
 
-// flushWorker writes objects to the main storage.
+// flushWorker writes objects to the main storage until
+// the flush channel is closed.
 func (c *cache) flushWorker(_ int) {
-	defer c.wg.Done()
+	defer c.workersWg.Done()
 
-	var obj *object.Object
-	for {
-		// Give priority to direct put.
-		select {
-		case obj = <-c.flushCh:
-		case <-c.closeCh:
-			return
+	for obj := range c.flushCh {
+		sAddr := objectCore.AddressOf(obj).EncodeToString()
+
+		c.flushMtx.RLock()
+		if c.flushDisabled() {
+			// mode has been changed after the object was sent to the channel
+			c.flushMtx.RUnlock()
+			c.finishFlush(sAddr)
+			continue
 		}
 
+		start := time.Now()
 		err := c.flushObject(obj)
+		elapsed := time.Since(start)
+		if err == nil {
+			c.deleteFlushed(sAddr)
+		}
+		c.flushMtx.RUnlock()
+
+		c.latency.add(elapsed)
+		if c.slowFlushThreshold > 0 && elapsed > c.slowFlushThreshold {
+			c.log.Debug("slow object flush",
+				zap.String("address", sAddr),
+				zap.Uint64("size", obj.PayloadSize()),
+				zap.Stringer("duration", elapsed))
+		}
+
 		if err != nil {
-			c.log.Error("can't flush object to the main storage", zap.Error(err))
-		} else {
-			c.flushed.Add(objectCore.AddressOf(obj).EncodeToString(), true)
+			c.errLog.Error(logger.ErrorClass(err), "can't flush object to the main storage",
+				zap.String("address", sAddr),
+				zap.Error(err))
 		}
+
+		c.finishFlush(sAddr)
+	}
+}
+
+// startFlush marks the object as being flushed. Returns false if
+// the object is already being flushed by another routine.
+func (c *cache) startFlush(addr string) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.flushing[addr]; ok {
+		return false
 	}
+	c.flushing[addr] = struct{}{}
+	return true
+}
+
+// finishFlush removes the in-flight mark set by startFlush.
+func (c *cache) finishFlush(addr string) {
+	c.mtx.Lock()
+	delete(c.flushing, addr)
+	c.mtx.Unlock()
+}
+
+// isFlushing checks whether the object is being flushed at the moment.
+func (c *cache) isFlushing(addr string) bool {
+	c.mtx.RLock()
+	_, ok := c.flushing[addr]
+	c.mtx.RUnlock()
+	return ok
+}
+
+// flushTarget is a storage to flush objects to.
+type flushTarget interface {
+	Put(common.PutPrm) (common.PutRes, error)
 }
 
 // flushObject is used to write object directly to the main storage.
 func (c *cache) flushObject(obj *object.Object) error {
+	data, err := obj.Marshal()
+	if err == nil {
+		err = c.flushObjectTo(c.blobstor, obj, data)
+	}
+
+	if err != nil {
+		c.metrics.IncFlushErrors()
+		return err
+	}
+
+	c.metrics.AddFlushedObject(uint64(len(data)))
+	return nil
+}
+
+// flushObjectTo writes object to the target storage and updates
+// its storage ID in the metabase. If data is nil, the object is marshaled.
+// The small objects flushed to the blobstor are stored inline in the
+// metabase if configured, see WithInlineThreshold.
+func (c *cache) flushObjectTo(dst flushTarget, obj *object.Object, data []byte) error {
+	if dst == flushTarget(c.blobstor) && c.inlineThreshold > 0 &&
+		uint64(len(obj.Payload())) <= c.inlineThreshold {
+		return c.flushInline(obj)
+	}
+
 	var prm common.PutPrm
 	prm.Object = obj
+	prm.Address = objectCore.AddressOf(obj)
+	prm.RawData = data
+
+	if prm.RawData == nil {
+		var err error
+		if prm.RawData, err = obj.Marshal(); err != nil {
+			return err
+		}
+	}
 
-	res, err := c.blobstor.Put(prm)
+	res, err := dst.Put(prm)
 	if err != nil {
 		return err
 	}
@@ -225,15 +499,219 @@ func (c *cache) flushObject(obj *object.Object) error {
 	var pPrm meta.PutPrm
 	pPrm.SetObject(obj)
 	pPrm.SetStorageID(res.StorageID)
+	pPrm.SetPriority(c.metaPriority)
 
 	_, err = c.metabase.Put(pPrm)
+	if err == nil && c.flushCallback != nil {
+		c.flushCallback(prm.Address)
+	}
 	return err
 }
 
+// flushInline saves the object with the payload in the metabase.
+func (c *cache) flushInline(obj *object.Object) error {
+	var pPrm meta.PutPrm
+	pPrm.SetObject(obj)
+	pPrm.SetInline(true)
+	pPrm.SetPriority(c.metaPriority)
+
+	_, err := c.metabase.Put(pPrm)
+	if err == nil && c.flushCallback != nil {
+		c.flushCallback(objectCore.AddressOf(obj))
+	}
+	return err
+}
+
+// FlushPrm groups the parameters of Flush and FlushTo operations.
+type FlushPrm struct {
+	// IgnoreErrors makes the flush skip the objects which can't be read
+	// or decoded instead of aborting.
+	IgnoreErrors bool
+	// ErrorHandler is called for every object skipped in IgnoreErrors mode.
+	// Flush is aborted if it returns an error. Address is zero if it can't
+	// be decoded.
+	ErrorHandler func(oid.Address, error) error
+
+	// Force allows Flush to be performed in the read-write mode concurrently
+	// with the writes. Only the objects stored at the moment of the call are
+	// flushed, the ones put during the flush may be skipped.
+	Force bool
+
+	// ctx aborts the flush when done, nil means no cancellation.
+	ctx context.Context
+
+	// flushed counts the written objects, nil means no counting.
+	flushed *uint64
+}
+
+// objectFlushed is called for every object written by the flush.
+func (p FlushPrm) objectFlushed() {
+	if p.flushed != nil {
+		*p.flushed++
+	}
+}
+
+// interrupted returns the reason the flush must be aborted for,
+// nil if it may be continued.
+func (p FlushPrm) interrupted() error {
+	if p.ctx != nil {
+		return p.ctx.Err()
+	}
+	return nil
+}
+
+// skip returns nil if the object failed with err can be skipped.
+func (p FlushPrm) skip(addr oid.Address, err error) error {
+	if !p.IgnoreErrors {
+		return err
+	}
+	if p.ErrorHandler != nil {
+		return p.ErrorHandler(addr, err)
+	}
+	return nil
+}
+
 // Flush flushes all objects from the write-cache to the main storage.
 // Write-cache must be in readonly mode to ensure correctness of an operation and
+// to prevent interference with background flush workers, unless p.Force is set.
+func (c *cache) Flush(p FlushPrm) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if !c.mode.ReadOnly() {
+		if !p.Force {
+			return errMustBeReadOnly
+		}
+		if c.mode.NoMetabase() {
+			return ErrDegraded
+		}
+
+		return c.flushSnapshot(p)
+	}
+
+	return c.flush(c.blobstor, false, p)
+}
+
+// flushSnapshot flushes the objects stored in the write-cache at the moment
+// of the call while the new ones are being put, the ones put during the flush
+// may be flushed too. The database is read in batches, so the transactions do
+// not block its growth. The objects being flushed by the background flush are
+// skipped. Like the background flush does, flushed objects are removed from
+// the database and marked as flushed in FSTree, so they are not flushed twice.
+// `c.modeMtx` must be taken.
+func (c *cache) flushSnapshot(p FlushPrm) error {
+	var (
+		start  []byte
+		dbKeys = make([]string, 0, flushBatchSize)
+	)
+
+	for {
+		dbKeys = dbKeys[:0]
+
+		err := c.db.View(func(tx *bbolt.Tx) error {
+			cs := tx.Bucket(defaultBucket).Cursor()
+			for k, _ := cs.Seek(start); k != nil && len(dbKeys) < flushBatchSize; k, _ = cs.Next() {
+				dbKeys = append(dbKeys, string(k))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range dbKeys {
+			var addr oid.Address
+			if err := addr.DecodeString(dbKeys[i]); err != nil {
+				if err = p.skip(oid.Address{}, err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := c.flushStored(addr, true, p, func() (*object.Object, []byte, error) {
+				data, err := Get(c.db, []byte(dbKeys[i]))
+				if err != nil {
+					return nil, nil, err
+				}
+
+				obj := object.New()
+				return obj, data, obj.Unmarshal(data)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(dbKeys) < flushBatchSize {
+			break
+		}
+
+		// successor of the last read key
+		start = append([]byte(dbKeys[len(dbKeys)-1]), 0)
+	}
+
+	var prm common.IteratePrm
+	prm.IgnoreErrors = p.IgnoreErrors
+	prm.ErrorHandler = p.skip
+	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
+		return c.flushStored(addr, false, p, func() (*object.Object, []byte, error) {
+			res, err := c.fsTree.Get(common.GetPrm{Address: addr})
+			return res.Object, res.RawData, err
+		})
+	}
+
+	_, err := c.fsTree.Iterate(prm)
+	return err
+}
+
+// flushStored flushes the object read with read unless it is flushed or being
+// flushed already. The objects removed from the write-cache since the snapshot
+// are skipped.
+func (c *cache) flushStored(addr oid.Address, fromDatabase bool, p FlushPrm,
+	read func() (*object.Object, []byte, error)) error {
+	if err := p.interrupted(); err != nil {
+		return err
+	}
+
+	sAddr := addr.EncodeToString()
+
+	if _, ok := c.flushed.Peek(sAddr); ok {
+		return nil
+	}
+
+	if !c.startFlush(sAddr) {
+		return nil
+	}
+	defer c.finishFlush(sAddr)
+
+	obj, data, err := read()
+	if err != nil {
+		if errors.As(err, new(apistatus.ObjectNotFound)) {
+			return nil
+		}
+		return p.skip(addr, err)
+	}
+
+	if err := c.flushObjectTo(c.blobstor, obj, data); err != nil {
+		return err
+	}
+
+	if fromDatabase {
+		c.deleteFlushed(sAddr)
+	} else {
+		c.markFlushed(sAddr, false)
+	}
+
+	return nil
+}
+
+// FlushTo flushes all objects from the write-cache to the target storage
+// instead of the main one, e.g. to migrate to another blobstor implementation.
+// Unlike Flush, objects already flushed to the main storage are written too.
+// Metabase records are updated to point to the storage IDs in the target.
+// Write-cache must be in readonly mode to ensure correctness of an operation and
 // to prevent interference with background flush workers.
-func (c *cache) Flush(ignoreErrors bool) error {
+func (c *cache) FlushTo(target common.Storage, p FlushPrm) error {
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 
@@ -241,36 +719,82 @@ func (c *cache) Flush(ignoreErrors bool) error {
 		return errMustBeReadOnly
 	}
 
-	return c.flush(ignoreErrors)
+	return c.flush(target, true, p)
+}
+
+// Drain switches the write-cache to the read-only mode and flushes all the
+// objects which are not flushed yet to the main storage, e.g. before the
+// planned shutdown or the write-cache device replacement. The write-cache
+// stays read-only afterwards.
+//
+// The flush is interrupted when ctx is done, so a stuck main storage does
+// not block the caller forever. The estimated number of the objects left
+// not flushed is returned along with the error, such objects are flushed
+// after the next start.
+func (c *cache) Drain(ctx context.Context) (uint64, error) {
+	if err := c.SetMode(mode.ReadOnly); err != nil {
+		return 0, err
+	}
+
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if !c.mode.ReadOnly() {
+		// mode has been changed concurrently
+		return 0, errMustBeReadOnly
+	}
+
+	db, fs := c.backlog()
+
+	var flushed uint64
+
+	err := c.flush(c.blobstor, false, FlushPrm{ctx: ctx, flushed: &flushed})
+	if err != nil {
+		var pending uint64
+		if db+fs > flushed {
+			pending = db + fs - flushed
+		}
+
+		return pending, err
+	}
+
+	return 0, nil
 }
 
-func (c *cache) flush(ignoreErrors bool) error {
+// flush writes objects to dst. If all is false, objects
+// marked as flushed are skipped.
+func (c *cache) flush(dst flushTarget, all bool, p FlushPrm) error {
 	var prm common.IteratePrm
-	prm.IgnoreErrors = ignoreErrors
+	prm.IgnoreErrors = p.IgnoreErrors
+	prm.ErrorHandler = p.skip
 	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
-		_, ok := c.flushed.Peek(addr.EncodeToString())
-		if ok {
-			return nil
+		if err := p.interrupted(); err != nil {
+			return err
 		}
 
-		data, err := f()
-		if err != nil {
-			if ignoreErrors {
+		if !all {
+			if _, ok := c.flushed.Peek(addr.EncodeToString()); ok {
 				return nil
 			}
-			return err
+		}
+
+		data, err := f()
+		if err != nil {
+			return p.skip(addr, err)
 		}
 
 		var obj object.Object
 		err = obj.Unmarshal(data)
 		if err != nil {
-			if ignoreErrors {
-				return nil
-			}
+			return p.skip(addr, err)
+		}
+
+		if err := c.flushObjectTo(dst, &obj, data); err != nil {
 			return err
 		}
 
-		return c.flushObject(&obj)
+		p.objectFlushed()
+		return nil
 	}
 
 	_, err := c.fsTree.Iterate(prm)
@@ -284,29 +808,37 @@ func (c *cache) flush(ignoreErrors bool) error {
 		b := tx.Bucket(defaultBucket)
 		cs := b.Cursor()
 		for k, data := cs.Seek(nil); k != nil; k, data = cs.Next() {
+			if err := p.interrupted(); err != nil {
+				return err
+			}
+
 			sa := string(k)
-			if _, ok := c.flushed.Peek(sa); ok {
-				continue
+			if !all {
+				if _, ok := c.flushed.Peek(sa); ok {
+					continue
+				}
 			}
 
 			if err := addr.DecodeString(sa); err != nil {
-				if ignoreErrors {
-					continue
+				if err = p.skip(oid.Address{}, err); err != nil {
+					return err
 				}
-				return err
+				continue
 			}
 
 			var obj object.Object
 			if err := obj.Unmarshal(data); err != nil {
-				if ignoreErrors {
-					continue
+				if err = p.skip(addr, err); err != nil {
+					return err
 				}
-				return err
+				continue
 			}
 
-			if err := c.flushObject(&obj); err != nil {
+			if err := c.flushObjectTo(dst, &obj, data); err != nil {
 				return err
 			}
+
+			p.objectFlushed()
 		}
 		return nil
 	})
@@ -1,17 +1,24 @@
 package writecache
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -20,6 +27,7 @@ import (
 	versionSDK "github.com/nspcc-dev/neofs-sdk-go/version"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
+	"go.uber.org/atomic"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -35,36 +43,15 @@ func TestFlush(t *testing.T) {
 	}
 
 	newCache := func(t *testing.T) (Cache, *blobstor.BlobStor, *meta.DB) {
-		dir := t.TempDir()
-		mb := meta.New(
-			meta.WithPath(filepath.Join(dir, "meta")),
-			meta.WithEpochState(dummyEpoch{}))
-		require.NoError(t, mb.Open(false))
-		require.NoError(t, mb.Init())
-
-		fsTree := fstree.New(
-			fstree.WithPath(filepath.Join(dir, "blob")),
-			fstree.WithDepth(0),
-			fstree.WithDirNameLen(1))
-		bs := blobstor.New(blobstor.WithStorages([]blobstor.SubStorage{
-			{Storage: fsTree},
-		}))
-		require.NoError(t, bs.Open(false))
-		require.NoError(t, bs.Init())
-
-		wc := New(
-			WithLogger(zaptest.NewLogger(t)),
-			WithPath(filepath.Join(dir, "writecache")),
-			WithSmallObjectSize(smallSize),
-			WithMetabase(mb),
-			WithBlobstor(bs))
-		require.NoError(t, wc.Open(false))
-		require.NoError(t, wc.Init())
+		bs, mb := newTestStorage(t, blobstor.SubStorage{})
 
 		// First set mode for metabase and blobstor to prevent background flushes.
 		require.NoError(t, mb.SetMode(mode.ReadOnly))
 		require.NoError(t, bs.SetMode(mode.ReadOnly))
 
+		wc := newTestCache(t, bs, mb, WithSmallObjectSize(smallSize))
+		require.NoError(t, wc.Init())
+
 		return wc, bs, mb
 	}
 
@@ -116,7 +103,7 @@ func TestFlush(t *testing.T) {
 		wc.(*cache).flushed.Add(objects[0].addr.EncodeToString(), true)
 		wc.(*cache).flushed.Add(objects[1].addr.EncodeToString(), false)
 
-		require.NoError(t, wc.Flush(false))
+		require.NoError(t, wc.Flush(FlushPrm{}))
 
 		for i := 0; i < 2; i++ {
 			var mPrm meta.GetPrm
@@ -171,8 +158,15 @@ func TestFlush(t *testing.T) {
 			require.NoError(t, bs.SetMode(mode.ReadWrite))
 			require.NoError(t, mb.SetMode(mode.ReadWrite))
 
-			require.Error(t, wc.Flush(false))
-			require.NoError(t, wc.Flush(true))
+			require.Error(t, wc.Flush(FlushPrm{}))
+
+			errHandler := errors.New("handler error")
+			require.ErrorIs(t, wc.Flush(FlushPrm{
+				IgnoreErrors: true,
+				ErrorHandler: func(oid.Address, error) error { return errHandler },
+			}), errHandler)
+
+			require.NoError(t, wc.Flush(FlushPrm{IgnoreErrors: true}))
 
 			check(t, mb, bs, objects)
 		}
@@ -224,6 +218,227 @@ func TestFlush(t *testing.T) {
 	})
 }
 
+func TestPutDuringFlush(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	blocking := &blockingBlob{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	c := newTestCache(t, bs, mb, WithSmallObjectSize(smallSize), wrapBlob(func(b blob) blob {
+		blocking.blob = b
+		return blocking
+	}))
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	obj, data := newObject(t, 1)
+
+	var prm common.PutPrm
+	prm.Address = objectCore.AddressOf(obj)
+	prm.Object = obj
+	prm.RawData = data
+
+	_, err := c.Put(prm)
+	require.NoError(t, err)
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("object flush has not started")
+	}
+
+	// Object is being flushed at the moment.
+	require.True(t, c.isFlushing(prm.Address.EncodeToString()))
+
+	_, err = c.Put(prm)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), c.objCounters.DB())
+
+	close(blocking.release)
+
+	// flushed object is removed from the database
+	require.Eventually(t, func() bool {
+		return c.objCounters.DB() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.False(t, c.isFlushing(prm.Address.EncodeToString()))
+	require.Equal(t, uint64(1), blocking.count.Load())
+
+	_, err = c.Get(prm.Address)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	res, err := bs.Get(common.GetPrm{Address: prm.Address})
+	require.NoError(t, err)
+	require.Equal(t, obj, res.Object)
+
+	var mPrm meta.GetPrm
+	mPrm.SetAddress(prm.Address)
+	_, err = mb.Get(mPrm)
+	require.NoError(t, err)
+}
+
+func TestCloseDuringFlush(t *testing.T) {
+	const (
+		objCount  = 10
+		smallSize = 256
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	blocking := &blockingBlob{
+		blob:    bs,
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	wcPath := filepath.Join(t.TempDir(), "writecache")
+
+	newCache := func() Cache {
+		wc := newTestCache(t, bs, mb,
+			WithPath(wcPath),
+			WithSmallObjectSize(smallSize),
+			WithFlushWorkersCount(1),
+			WithShutdownTimeout(10*time.Millisecond),
+			wrapBlob(func(blob) blob { return blocking }))
+		require.NoError(t, wc.Init())
+
+		return wc
+	}
+
+	wc := newCache()
+
+	addrs := make([]oid.Address, objCount)
+	for i := range addrs {
+		obj, data := newObject(t, 1)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		require.NoError(t, err)
+
+		addrs[i] = prm.Address
+	}
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("object flush has not started")
+	}
+
+	closed := make(chan error)
+	go func() { closed <- wc.Close() }()
+
+	select {
+	case <-closed:
+		t.Fatal("close must wait for the object being flushed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(blocking.release)
+	require.NoError(t, <-closed)
+
+	// Only the object being flushed on close is written to the main storage.
+	require.Equal(t, uint64(1), blocking.count.Load())
+
+	flushed := func() int {
+		var n int
+		for i := range addrs {
+			var prm meta.ExistsPrm
+			prm.SetAddress(addrs[i])
+
+			res, err := mb.Exists(prm)
+			require.NoError(t, err)
+			if res.Exists() {
+				n++
+			}
+		}
+		return n
+	}
+
+	require.Equal(t, 1, flushed())
+
+	// The rest objects are kept in the write-cache and flushed after the restart.
+	wc = newCache()
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	require.Eventually(t, func() bool {
+		return flushed() == objCount
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(objCount), blocking.count.Load())
+}
+
+// blockingBlob blocks the first Put until release is closed.
+type blockingBlob struct {
+	blob
+	count   atomic.Uint64
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingBlob) Put(prm common.PutPrm) (common.PutRes, error) {
+	if b.count.Inc() == 1 {
+		close(b.started)
+		<-b.release
+	}
+	return b.blob.Put(prm)
+}
+
+// newTestStorage returns opened and initialized blobstor with the only
+// sub-storage s and metabase in the test directory for the write-cache to
+// flush the objects to. FSTree in the test directory is used if s.Storage is
+// nil. Metabase options are applied after the default ones.
+func newTestStorage(t *testing.T, s blobstor.SubStorage, metaOpts ...meta.Option) (*blobstor.BlobStor, *meta.DB) {
+	dir := t.TempDir()
+	mb := meta.New(append([]meta.Option{
+		meta.WithPath(filepath.Join(dir, "meta")),
+		meta.WithEpochState(dummyEpoch{}),
+	}, metaOpts...)...)
+	require.NoError(t, mb.Open(false))
+	require.NoError(t, mb.Init())
+	t.Cleanup(func() { require.NoError(t, mb.Close()) })
+
+	if s.Storage == nil {
+		s.Storage = fstree.New(
+			fstree.WithPath(filepath.Join(dir, "blob")),
+			fstree.WithDepth(0),
+			fstree.WithDirNameLen(1))
+	}
+
+	bs := blobstor.New(blobstor.WithStorages([]blobstor.SubStorage{s}))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+
+	return bs, mb
+}
+
+// newTestCache returns opened write-cache in the test directory flushing the
+// objects to bs and mb. Options are applied after the default ones. The
+// write-cache is not initialized, so the background flush is not started
+// until Init.
+func newTestCache(t *testing.T, bs *blobstor.BlobStor, mb *meta.DB, opts ...Option) *cache {
+	wc := New(append([]Option{
+		WithLogger(zaptest.NewLogger(t)),
+		WithPath(filepath.Join(t.TempDir(), "writecache")),
+		WithMetabase(mb),
+		WithBlobstor(bs),
+	}, opts...)...)
+	require.NoError(t, wc.Open(false))
+
+	return wc.(*cache)
+}
+
+// wrapBlob returns an option wrapping the blobstor set by WithBlobstor.
+func wrapBlob(f func(blob) blob) Option {
+	return func(o *options) {
+		o.blobstor = f(o.blobstor)
+	}
+}
+
 func newObject(t *testing.T, size int) (*object.Object, []byte) {
 	obj := object.New()
 	ver := versionSDK.Current()
@@ -247,3 +462,770 @@ type dummyEpoch struct{}
 func (dummyEpoch) CurrentEpoch() uint64 {
 	return 0
 }
+
+func TestFlushTo(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	wc := newTestCache(t, bs, mb, WithSmallObjectSize(smallSize))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	objects := make([]*object.Object, 4)
+	for i := range objects {
+		obj, data := newObject(t, 1+(i%2)*smallSize)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		require.NoError(t, err)
+
+		objects[i] = obj
+	}
+
+	target := newMemStorage()
+
+	require.ErrorIs(t, wc.FlushTo(target, FlushPrm{}), errMustBeReadOnly)
+	require.NoError(t, wc.SetMode(mode.ReadOnly))
+
+	// Objects flushed to the main storage are written to the target too.
+	wc.flushed.Add(objectCore.AddressOf(objects[0]).EncodeToString(), true)
+
+	require.NoError(t, wc.FlushTo(target, FlushPrm{}))
+	require.Len(t, target.objects, len(objects))
+
+	for i := range objects {
+		addr := objectCore.AddressOf(objects[i])
+
+		var mPrm meta.StorageIDPrm
+		mPrm.SetAddress(addr)
+
+		mRes, err := mb.StorageID(mPrm)
+		require.NoError(t, err)
+		require.Equal(t, []byte(memStorageID), mRes.StorageID())
+
+		res, err := target.Get(common.GetPrm{Address: addr, StorageID: mRes.StorageID()})
+		require.NoError(t, err)
+		require.Equal(t, objects[i], res.Object)
+
+		_, err = bs.Get(common.GetPrm{Address: addr})
+		require.Error(t, err)
+	}
+}
+
+const memStorageID = "mem"
+
+// memStorage is an in-memory common.Storage.
+type memStorage struct {
+	mtx     sync.RWMutex
+	objects map[oid.Address][]byte
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{objects: make(map[oid.Address][]byte)}
+}
+
+func (*memStorage) Open(bool) error                   { return nil }
+func (*memStorage) Init() error                       { return nil }
+func (*memStorage) Close() error                      { return nil }
+func (*memStorage) Type() string                      { return "memory" }
+func (*memStorage) SetCompressor(*compression.Config) {}
+func (*memStorage) Iterate(common.IteratePrm) (common.IterateRes, error) {
+	return common.IterateRes{}, nil
+}
+
+func (s *memStorage) Put(prm common.PutPrm) (common.PutRes, error) {
+	s.mtx.Lock()
+	s.objects[prm.Address] = slice.Copy(prm.RawData)
+	s.mtx.Unlock()
+	return common.PutRes{StorageID: []byte(memStorageID)}, nil
+}
+
+func (s *memStorage) Get(prm common.GetPrm) (common.GetRes, error) {
+	s.mtx.RLock()
+	data, ok := s.objects[prm.Address]
+	s.mtx.RUnlock()
+	if !ok {
+		return common.GetRes{}, apistatus.ObjectNotFound{}
+	}
+
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return common.GetRes{}, err
+	}
+	return common.GetRes{Object: obj, RawData: data}, nil
+}
+
+func (s *memStorage) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	return common.GetRangeRes{}, errors.New("not implemented")
+}
+
+func (s *memStorage) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	s.mtx.RLock()
+	_, ok := s.objects[prm.Address]
+	s.mtx.RUnlock()
+	return common.ExistsRes{Exists: ok}, nil
+}
+
+func (s *memStorage) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	s.mtx.Lock()
+	delete(s.objects, prm.Address)
+	s.mtx.Unlock()
+	return common.DeleteRes{}, nil
+}
+
+func TestFlushBigObjectsBudget(t *testing.T) {
+	const (
+		objCount  = 10
+		smallSize = 256
+		putDelay  = 20 * time.Millisecond
+		budget    = 3 * putDelay
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	c := newTestCache(t, bs, mb,
+		WithSmallObjectSize(smallSize),
+		WithBigObjectFlushBudget(0, budget),
+		wrapBlob(func(b blob) blob {
+			return &slowBlob{
+				blob:  b,
+				delay: func(common.PutPrm) time.Duration { return putDelay },
+			}
+		}))
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	for i := 0; i < 2*objCount; i++ {
+		obj, data := newObject(t, 1+(i%2)*smallSize)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := c.Put(prm)
+		require.NoError(t, err)
+	}
+
+	info := c.DumpInfo()
+	require.Equal(t, uint64(objCount), info.DBBacklog)
+	require.Equal(t, uint64(objCount), info.FSBacklog)
+
+	// small objects are flushed in the background
+	require.NoError(t, c.Init())
+
+	var ticks int
+	for c.DumpInfo().FSBacklog > 0 {
+		start := time.Now()
+		c.modeMtx.RLock()
+		c.flushBigObjectsTick()
+		c.modeMtx.RUnlock()
+
+		// the budget is checked before each object flush
+		require.Less(t, time.Since(start), budget+2*putDelay)
+		ticks++
+
+		if c.DumpInfo().FSBacklog > 0 {
+			require.NotNil(t, c.bigFlushCursor)
+		}
+	}
+
+	require.Greater(t, ticks, 1)
+	require.Less(t, ticks, objCount)
+
+	require.Eventually(t, func() bool {
+		return c.DumpInfo().DBBacklog == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the next cycle is started from the beginning after the completed one
+	c.modeMtx.RLock()
+	c.flushBigObjectsTick()
+	c.modeMtx.RUnlock()
+	require.Nil(t, c.bigFlushCursor)
+}
+
+func TestFlushDBFairness(t *testing.T) {
+	// the leading objects are never flushed, but the others must not wait for them
+	const objCount = 2*flushBatchSize + flushBatchSize/2
+
+	wc := New(
+		WithLogger(zaptest.NewLogger(t)),
+		WithPath(t.TempDir()))
+	c := wc.(*cache)
+
+	require.NoError(t, wc.Open(false))
+	t.Cleanup(func() {
+		c.closeCh = nil // closed by the test
+		require.NoError(t, wc.Close())
+	})
+
+	addrs := make(map[string]struct{}, objCount)
+	require.NoError(t, c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		for i := 0; i < objCount; i++ {
+			obj, data := newObject(t, 1)
+			addr := objectCore.AddressOf(obj).EncodeToString()
+			addrs[addr] = struct{}{}
+			if err := b.Put([]byte(addr), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		c.flushDB()
+		close(done)
+	}()
+
+	// slow worker failing to flush the objects
+	queued := make(map[string]int, objCount)
+	for i := 0; i < objCount; i++ {
+		obj := <-c.flushCh
+		addr := objectCore.AddressOf(obj).EncodeToString()
+		queued[addr]++
+
+		time.Sleep(10 * time.Microsecond)
+		c.finishFlush(addr)
+	}
+
+	close(c.closeCh)
+	close(c.shutdownCh)
+	<-done
+
+	// each object is queued once before any of them is queued again
+	require.Equal(t, objCount, len(queued))
+	for addr, n := range queued {
+		require.Contains(t, addrs, addr)
+		require.Equal(t, 1, n, addr)
+	}
+}
+
+// countingBlob counts the objects put to the main storage.
+type countingBlob struct {
+	blob
+	count atomic.Uint64
+}
+
+func (b *countingBlob) Put(prm common.PutPrm) (common.PutRes, error) {
+	b.count.Inc()
+	return b.blob.Put(prm)
+}
+
+func TestFlushInterval(t *testing.T) {
+	const (
+		window   = 500 * time.Millisecond
+		putDelay = 10 * time.Millisecond
+	)
+
+	// flushedInWindow returns the number of the objects flushed while
+	// the objects are put to the write-cache during the window
+	flushedInWindow := func(t *testing.T, opts ...Option) (uint64, int) {
+		bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+		counter := &countingBlob{blob: bs}
+
+		wc := newTestCache(t, bs, mb, append(opts, wrapBlob(func(blob) blob { return counter }))...)
+		require.NoError(t, wc.Init())
+		t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+		var put int
+		for start := time.Now(); time.Since(start) < window; put++ {
+			obj, data := newObject(t, 1)
+
+			_, err := wc.Put(common.PutPrm{
+				Address: objectCore.AddressOf(obj),
+				Object:  obj,
+				RawData: data,
+			})
+			require.NoError(t, err)
+
+			time.Sleep(putDelay)
+		}
+
+		return counter.count.Load(), put
+	}
+
+	t.Run("default", func(t *testing.T) {
+		flushed, _ := flushedInWindow(t)
+		require.Zero(t, flushed, "the first flush happens after %s", defaultFlushInterval)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		flushed, put := flushedInWindow(t, WithFlushInterval(window/10))
+		require.Greater(t, flushed, uint64(put/2))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, d := range []time.Duration{0, -time.Second} {
+			wc := New(WithFlushInterval(d))
+			require.Equal(t, defaultFlushInterval, wc.(*cache).flushInterval)
+		}
+	})
+}
+
+// testMetrics records the write-cache flush metrics.
+type testMetrics struct {
+	objects atomic.Uint64
+	bytes   atomic.Uint64
+	errors  atomic.Uint64
+	backlog atomic.Int64
+}
+
+func (m *testMetrics) AddFlushedObject(size uint64) {
+	m.objects.Inc()
+	m.bytes.Add(size)
+}
+
+func (m *testMetrics) IncFlushErrors() {
+	m.errors.Inc()
+}
+
+func (m *testMetrics) SetBacklog(v uint64) {
+	m.backlog.Store(int64(v))
+}
+
+// failingBlob fails to put the objects to the main storage until fail is unset.
+type failingBlob struct {
+	blob
+	fail atomic.Bool
+}
+
+func (b *failingBlob) Put(prm common.PutPrm) (common.PutRes, error) {
+	if b.fail.Load() {
+		return common.PutRes{}, errors.New("test error")
+	}
+	return b.blob.Put(prm)
+}
+
+func TestFlushMetrics(t *testing.T) {
+	const objCount = 10
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+	m := &testMetrics{}
+	m.backlog.Store(-1)
+
+	failing := &failingBlob{blob: bs}
+	failing.fail.Store(true)
+
+	wc := newTestCache(t, bs, mb,
+		WithFlushInterval(10*time.Millisecond),
+		WithMetrics(m),
+		wrapBlob(func(blob) blob { return failing }))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	var size uint64
+	for i := 0; i < objCount; i++ {
+		obj, data := newObject(t, 1)
+		size += uint64(len(data))
+
+		_, err := wc.Put(common.PutPrm{
+			Address: objectCore.AddressOf(obj),
+			Object:  obj,
+			RawData: data,
+		})
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return m.errors.Load() >= objCount && m.backlog.Load() == objCount
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, m.objects.Load())
+	require.Zero(t, m.bytes.Load())
+
+	failing.fail.Store(false)
+
+	require.Eventually(t, func() bool {
+		return m.objects.Load() == objCount && m.backlog.Load() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, size, m.bytes.Load())
+
+	t.Run("nil", func(t *testing.T) {
+		wc := New(WithMetrics(nil))
+		require.Equal(t, noopMetrics{}, wc.(*cache).metrics)
+	})
+}
+
+func TestFlushRemovesFromDB(t *testing.T) {
+	const objCount = 50
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+	wcPath := filepath.Join(t.TempDir(), "writecache")
+
+	newCache := func() *cache {
+		return newTestCache(t, bs, mb,
+			WithPath(wcPath),
+			WithFlushInterval(10*time.Millisecond))
+	}
+
+	dbKeys := func(c *cache) int {
+		var n int
+		require.NoError(t, c.db.View(func(tx *bbolt.Tx) error {
+			n = tx.Bucket(defaultBucket).Stats().KeyN
+			return nil
+		}))
+		return n
+	}
+
+	putObjects := func(c *cache) []oid.Address {
+		addrs := make([]oid.Address, objCount)
+		for i := range addrs {
+			obj, data := newObject(t, 1)
+			addrs[i] = objectCore.AddressOf(obj)
+
+			_, err := c.Put(common.PutPrm{Address: addrs[i], Object: obj, RawData: data})
+			require.NoError(t, err)
+		}
+		return addrs
+	}
+
+	requireFlushed := func(addrs []oid.Address) {
+		for i := range addrs {
+			res, err := bs.Exists(common.ExistsPrm{Address: addrs[i]})
+			require.NoError(t, err)
+			require.True(t, res.Exists)
+		}
+	}
+
+	t.Run("background flush", func(t *testing.T) {
+		c := newCache()
+		require.NoError(t, c.Init())
+		t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+		// the database does not accumulate the flushed objects
+		for i := 0; i < 2; i++ {
+			addrs := putObjects(c)
+
+			require.Eventually(t, func() bool {
+				return dbKeys(c) == 0 && c.objCounters.DB() == 0
+			}, 5*time.Second, 10*time.Millisecond)
+			require.Zero(t, c.flushed.Len())
+
+			requireFlushed(addrs)
+		}
+	})
+
+	t.Run("stop after flush", func(t *testing.T) {
+		c := newCache()
+
+		// the objects are written to the main storage, but the node stops
+		// before they are removed from the database
+		addrs := putObjects(c)
+		for i := range addrs {
+			obj, err := c.Get(addrs[i])
+			require.NoError(t, err)
+			require.NoError(t, c.flushObject(obj))
+
+			// repeated flush is harmless
+			require.NoError(t, c.flushObject(obj))
+		}
+		require.Equal(t, objCount, dbKeys(c))
+		require.NoError(t, c.Close())
+
+		c = newCache()
+		require.NoError(t, c.Init())
+		t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+		require.Zero(t, dbKeys(c))
+		require.Zero(t, c.objCounters.DB())
+		requireFlushed(addrs)
+
+		for i := range addrs {
+			var prm meta.ExistsPrm
+			prm.SetAddress(addrs[i])
+
+			res, err := mb.Exists(prm)
+			require.NoError(t, err)
+			require.True(t, res.Exists())
+		}
+	})
+}
+
+func TestDrain(t *testing.T) {
+	const (
+		objCount  = 10
+		smallSize = 256
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+	// the background flush is not started, so the objects are
+	// written to the main storage by Drain only
+	newCache := func(t *testing.T, opts ...Option) *cache {
+		c := newTestCache(t, bs, mb, append([]Option{WithSmallObjectSize(smallSize)}, opts...)...)
+		t.Cleanup(func() { require.NoError(t, c.Close()) })
+		return c
+	}
+
+	putObjects := func(t *testing.T, c *cache) []oid.Address {
+		addrs := make([]oid.Address, objCount)
+		for i := range addrs {
+			obj, data := newObject(t, 1+(i%2)*smallSize)
+			addrs[i] = objectCore.AddressOf(obj)
+
+			_, err := c.Put(common.PutPrm{Address: addrs[i], Object: obj, RawData: data})
+			require.NoError(t, err)
+		}
+		return addrs
+	}
+
+	t.Run("all flushed", func(t *testing.T) {
+		c := newCache(t)
+		addrs := putObjects(t, c)
+
+		pending, err := c.Drain(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, pending)
+
+		for i := range addrs {
+			res, err := bs.Exists(common.ExistsPrm{Address: addrs[i]})
+			require.NoError(t, err)
+			require.True(t, res.Exists)
+
+			var prm meta.ExistsPrm
+			prm.SetAddress(addrs[i])
+
+			mRes, err := mb.Exists(prm)
+			require.NoError(t, err)
+			require.True(t, mRes.Exists())
+		}
+
+		// the write-cache stays read-only
+		obj, data := newObject(t, 1)
+		_, err = c.Put(common.PutPrm{Address: objectCore.AddressOf(obj), Object: obj, RawData: data})
+		require.ErrorIs(t, err, ErrReadOnly)
+	})
+
+	t.Run("inline", func(t *testing.T) {
+		c := newCache(t, WithInlineThreshold(1))
+		addrs := putObjects(t, c)
+
+		pending, err := c.Drain(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, pending)
+
+		for i := range addrs {
+			inline := i%2 == 0
+
+			res, err := bs.Exists(common.ExistsPrm{Address: addrs[i]})
+			require.NoError(t, err)
+			require.Equal(t, !inline, res.Exists)
+
+			var prm meta.GetInlinedPrm
+			prm.SetAddress(addrs[i])
+
+			mRes, err := mb.GetInlined(prm)
+			require.NoError(t, err)
+			require.Equal(t, inline, mRes.Object() != nil)
+
+			// flush marks are restored after the restart
+			require.True(t, c.isFlushed(addrs[i]))
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		blocking := &blockingBlob{
+			blob:    bs,
+			started: make(chan struct{}),
+			release: make(chan struct{}),
+		}
+
+		c := newCache(t, wrapBlob(func(blob) blob { return blocking }))
+		putObjects(t, c)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// the main storage is stuck until the deadline
+		go func() {
+			<-ctx.Done()
+			close(blocking.release)
+		}()
+
+		pending, err := c.Drain(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.EqualValues(t, objCount-1, pending)
+		require.EqualValues(t, 1, blocking.count.Load())
+	})
+}
+
+func TestForcedFlush(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+	blocking := &blockingBlob{
+		blob:    bs,
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	// the background flush is not started, so the objects are
+	// written to the main storage by Flush only
+	c := newTestCache(t, bs, mb,
+		WithSmallObjectSize(smallSize),
+		wrapBlob(func(blob) blob { return blocking }))
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	put := func(size int) oid.Address {
+		obj, data := newObject(t, size)
+		addr := objectCore.AddressOf(obj)
+
+		_, err := c.Put(common.PutPrm{Address: addr, Object: obj, RawData: data})
+		require.NoError(t, err)
+		return addr
+	}
+
+	requireFlushed := func(addr oid.Address, flushed bool) {
+		res, err := bs.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		require.Equal(t, flushed, res.Exists)
+	}
+
+	snapshot := []oid.Address{put(1), put(1), put(smallSize), put(smallSize)}
+
+	// the object being flushed by the background flush is skipped
+	inFlight := put(1)
+	require.True(t, c.startFlush(inFlight.EncodeToString()))
+
+	require.ErrorIs(t, c.Flush(FlushPrm{}), errMustBeReadOnly)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- c.Flush(FlushPrm{Force: true}) }()
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("object flush has not started")
+	}
+
+	// the objects are put during the flush, the database is read before,
+	// FSTree is walked after the database is flushed
+	added := []oid.Address{put(1), put(smallSize)}
+
+	close(blocking.release)
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("flush has not finished")
+	}
+
+	for i := range snapshot {
+		requireFlushed(snapshot[i], true)
+	}
+	requireFlushed(added[1], true)
+	require.EqualValues(t, len(snapshot)+1, blocking.count.Load())
+
+	// the objects missing in the snapshot are kept in the write-cache
+	for _, addr := range []oid.Address{added[0], inFlight} {
+		requireFlushed(addr, false)
+
+		_, err := c.Get(addr)
+		require.NoError(t, err)
+	}
+
+	// small objects are removed from the database
+	require.EqualValues(t, 2, c.objCounters.DB())
+
+	// the objects are not flushed twice
+	c.finishFlush(inFlight.EncodeToString())
+	require.NoError(t, c.Flush(FlushPrm{Force: true}))
+	require.EqualValues(t, len(snapshot)+len(added)+1, blocking.count.Load())
+	require.Zero(t, c.objCounters.DB())
+}
+
+func TestForcedFlushBatches(t *testing.T) {
+	// the database is read in several transactions
+	const objCount = 2*flushBatchSize + 1
+
+	// the objects are flushed one by one, so the batches are not delayed
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()},
+		meta.WithMaxBatchDelay(time.Microsecond))
+
+	counting := &countingBlob{blob: bs}
+
+	c := newTestCache(t, bs, mb,
+		WithMaxBatchDelay(time.Microsecond),
+		wrapBlob(func(blob) blob { return counting }))
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	addrs := make([]oid.Address, objCount)
+	require.NoError(t, c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		for i := range addrs {
+			obj, data := newObject(t, 1)
+			addrs[i] = objectCore.AddressOf(obj)
+			if err := b.Put([]byte(addrs[i].EncodeToString()), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	require.NoError(t, c.Flush(FlushPrm{Force: true}))
+	require.EqualValues(t, objCount, counting.count.Load())
+
+	require.NoError(t, c.db.View(func(tx *bbolt.Tx) error {
+		require.Zero(t, tx.Bucket(defaultBucket).Stats().KeyN)
+		return nil
+	}))
+
+	for i := range addrs {
+		res, err := bs.Exists(common.ExistsPrm{Address: addrs[i]})
+		require.NoError(t, err)
+		require.True(t, res.Exists)
+	}
+}
+
+func TestStartFlushStored(t *testing.T) {
+	wc := New(
+		WithLogger(zaptest.NewLogger(t)),
+		WithPath(t.TempDir()))
+	require.NoError(t, wc.Open(false))
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	c := wc.(*cache)
+
+	objs := make([]objectInfo, 3)
+	require.NoError(t, c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		for i := range objs {
+			obj, data := newObject(t, 1)
+			objs[i] = objectInfo{addr: objectCore.AddressOf(obj).EncodeToString(), data: data}
+			if err := b.Put([]byte(objs[i].addr), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	flushed, inFlight, stored := objs[0].addr, objs[1].addr, objs[2].addr
+
+	// the object is flushed by another routine after the read
+	require.True(t, c.startFlush(flushed))
+	require.NoError(t, c.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(defaultBucket).Delete([]byte(flushed))
+	}))
+	c.finishFlush(flushed)
+
+	// the object is being flushed by another routine
+	require.True(t, c.startFlush(inFlight))
+
+	res := c.startFlushStored(objs)
+	require.Len(t, res, 1)
+	require.Equal(t, stored, res[0].addr)
+
+	require.False(t, c.isFlushing(flushed))
+	require.True(t, c.isFlushing(inFlight))
+	require.True(t, c.isFlushing(stored))
+}
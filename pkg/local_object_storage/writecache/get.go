@@ -18,7 +18,6 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 	value, err := Get(c.db, []byte(saddr))
 	if err == nil {
 		obj := objectSDK.New()
-		c.flushed.Get(saddr)
 		return obj, obj.Unmarshal(value)
 	}
 
@@ -29,6 +28,7 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 		return nil, errNotFound
 	}
 
+	// the flushed object is kept in FSTree while it is read
 	c.flushed.Get(saddr)
 	return res.Object, nil
 }
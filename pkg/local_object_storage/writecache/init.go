@@ -17,13 +17,13 @@ func (c *cache) initFlushMarks() {
 	var prm common.IteratePrm
 	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
 		if c.isFlushed(addr) {
-			c.store.flushed.Add(addr.EncodeToString(), true)
+			c.markFlushed(addr.EncodeToString(), false)
 		}
 		return nil
 	}
 	_, _ = c.fsTree.Iterate(prm)
 
-	c.log.Info("filling flush marks for objects in database")
+	c.log.Info("removing flushed objects from database")
 
 	var m []string
 	var lastKey []byte
@@ -41,14 +41,17 @@ func (c *cache) initFlushMarks() {
 			return nil
 		})
 
-		var addr oid.Address
+		var (
+			addr    oid.Address
+			flushed []string
+		)
 		for i := range m {
 			if err := addr.DecodeString(m[i]); err != nil {
 				continue
 			}
 
 			if c.isFlushed(addr) {
-				c.store.flushed.Add(addr.EncodeToString(), true)
+				flushed = append(flushed, m[i])
 			}
 		}
 
@@ -56,6 +59,15 @@ func (c *cache) initFlushMarks() {
 			break
 		}
 		lastKey = append([]byte(m[len(m)-1]), 0)
+
+		// the objects flushed right before the previous shutdown
+		// are removed, unless the database is read-only
+		if !c.readOnly() {
+			flushed = c.deleteFromDB(flushed)
+		}
+		for i := range flushed {
+			c.markFlushed(flushed[i], true)
+		}
 	}
 
 	c.log.Info("finished updating flush marks")
@@ -74,6 +86,15 @@ func (c *cache) isFlushed(addr oid.Address) bool {
 		return false
 	}
 
+	// the inline objects are stored in the metabase only
+	var idPrm meta.StorageIDPrm
+	idPrm.SetAddress(addr)
+
+	idRes, err := c.metabase.StorageID(idPrm)
+	if err == nil && meta.IsInlineStorageID(idRes.StorageID()) {
+		return true
+	}
+
 	res, err := c.blobstor.Exists(common.ExistsPrm{Address: addr})
 	return err == nil && res.Exists
 }
@@ -0,0 +1,44 @@
+package writecache
+
+import (
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitFlushMarks(t *testing.T) {
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	obj, data := newObject(t, 1)
+	addr := objectCore.AddressOf(obj)
+
+	// The object has already been flushed to the main storage.
+	_, err := bs.Put(common.PutPrm{Address: addr, Object: obj, RawData: data})
+	require.NoError(t, err)
+
+	var mPrm meta.PutPrm
+	mPrm.SetObject(obj)
+	_, err = mb.Put(mPrm)
+	require.NoError(t, err)
+
+	c := newTestCache(t, bs, mb)
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	_, err = c.fsTree.Put(common.PutPrm{Address: addr, RawData: data})
+	require.NoError(t, err)
+
+	c.initFlushMarks()
+
+	fromDB, ok := c.flushed.Peek(addr.EncodeToString())
+	require.True(t, ok)
+	require.False(t, fromDB.(bool))
+
+	// Evicted object is removed from FSTree, not from the database.
+	c.flushed.Remove(addr.EncodeToString())
+	require.Equal(t, []string{addr.EncodeToString()}, c.fsKeysToRemove)
+	require.Empty(t, c.dbKeysToRemove)
+}
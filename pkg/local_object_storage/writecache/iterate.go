@@ -28,6 +28,16 @@ func (p *IterationPrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// Handler returns the callback set by WithHandler.
+func (p IterationPrm) Handler() func([]byte) error {
+	return p.handler
+}
+
+// IgnoreErrors returns the flag set by WithIgnoreErrors.
+func (p IterationPrm) IgnoreErrors() bool {
+	return p.ignoreErrors
+}
+
 // Iterate iterates over all objects present in write cache.
 // This is very difficult to do correctly unless write-cache is put in read-only mode.
 // Thus we silently fail if shard is not in read-only mode to avoid reporting misleading results.
@@ -0,0 +1,20 @@
+package writecache
+
+// Metrics is an interface of the write-cache flush metrics.
+type Metrics interface {
+	// AddFlushedObject must account the object of the specified size
+	// written to the main storage by the background flush.
+	AddFlushedObject(size uint64)
+	// IncFlushErrors must increment the counter of the objects
+	// the background flush failed to write to the main storage.
+	IncFlushErrors()
+	// SetBacklog must set the number of the objects stored in
+	// the database which are not flushed yet.
+	SetBacklog(v uint64)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) AddFlushedObject(uint64) {}
+func (noopMetrics) IncFlushErrors()         {}
+func (noopMetrics) SetBacklog(uint64)       {}
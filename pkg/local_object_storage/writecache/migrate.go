@@ -0,0 +1,177 @@
+package writecache
+
+import (
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// migrateObjects moves the objects stored according to the previous small
+// object size to the storage they belong to according to the current one:
+// big objects are moved from the database to the FSTree and small objects
+// are moved from the FSTree to the database. Migration errors are logged,
+// objects which are not moved are still flushed from the storage they are in.
+//
+// Does nothing if the write-cache is opened in read-only mode.
+func (c *cache) migrateObjects() {
+	if c.db.IsReadOnly() {
+		return
+	}
+
+	toFS := c.migrateToFSTree()
+	toDB := c.migrateToDB()
+
+	if toFS > 0 || toDB > 0 {
+		c.log.Info("objects moved according to the small object size",
+			zap.Uint64("small object size", c.smallObjectSize),
+			zap.Int("to FSTree", toFS),
+			zap.Int("to database", toDB))
+	}
+}
+
+// migrateToFSTree moves the objects bigger than the small object size from
+// the database to the FSTree. Returns the number of the objects moved.
+func (c *cache) migrateToFSTree() int {
+	var (
+		moved   int
+		lastKey []byte
+		m       []objectInfo
+	)
+
+	for {
+		m = m[:0]
+		scanned := 0
+
+		// Keys are read in batches of fixed size to not interfere with the flush.
+		_ = c.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket(defaultBucket)
+			cs := b.Cursor()
+			for k, v := cs.Seek(lastKey); k != nil && scanned < flushBatchSize; k, v = cs.Next() {
+				scanned++
+				lastKey = append(slice.Copy(k), 0)
+
+				if uint64(len(v)) > c.smallObjectSize {
+					m = append(m, objectInfo{
+						addr: string(k),
+						data: slice.Copy(v),
+					})
+				}
+			}
+			return nil
+		})
+
+		if scanned == 0 {
+			return moved
+		}
+
+		for i := range m {
+			if err := c.moveToFSTree(m[i]); err != nil {
+				c.log.Error("can't move object from the database to FSTree",
+					zap.String("address", m[i].addr),
+					zap.Error(err))
+				continue
+			}
+
+			moved++
+		}
+	}
+}
+
+func (c *cache) moveToFSTree(oi objectInfo) error {
+	var prm common.PutPrm
+	if err := prm.Address.DecodeString(oi.addr); err != nil {
+		return err
+	}
+
+	obj := object.New()
+	if err := obj.Unmarshal(oi.data); err != nil {
+		return err
+	}
+
+	prm.Object = obj
+	prm.RawData = oi.data
+
+	if _, err := c.fsTree.Put(prm); err != nil {
+		return err
+	}
+
+	if c.blobstor.NeedsCompression(obj) {
+		c.mtx.Lock()
+		c.compressFlags[oi.addr] = struct{}{}
+		c.mtx.Unlock()
+	}
+
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(defaultBucket).Delete([]byte(oi.addr))
+	})
+	if err != nil {
+		// the object is stored in both places now, remove the new copy
+		_, _ = c.fsTree.Delete(common.DeletePrm{Address: prm.Address})
+		return err
+	}
+
+	c.objCounters.DecDB()
+	c.objCounters.IncFS()
+	storagelog.Write(c.log, storagelog.AddressField(oi.addr), storagelog.OpField("MOVE to fstree"))
+
+	return nil
+}
+
+// migrateToDB moves the objects not bigger than the small object size from
+// the FSTree to the database. Returns the number of the objects moved.
+func (c *cache) migrateToDB() int {
+	var moved int
+
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+		data, err := f()
+		if err != nil || uint64(len(data)) > c.smallObjectSize {
+			return nil
+		}
+
+		saddr := addr.EncodeToString()
+
+		err = c.moveToDB(addr, saddr, data)
+		if err != nil {
+			c.log.Error("can't move object from FSTree to the database",
+				zap.String("address", saddr),
+				zap.Error(err))
+			return nil
+		}
+
+		c.objCounters.DecFS()
+		c.objCounters.IncDB()
+		storagelog.Write(c.log, storagelog.AddressField(saddr), storagelog.OpField("MOVE to db"))
+
+		moved++
+		return nil
+	}
+
+	_, _ = c.fsTree.Iterate(prm)
+
+	return moved
+}
+
+func (c *cache) moveToDB(addr oid.Address, saddr string, data []byte) error {
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(defaultBucket).Put([]byte(saddr), data)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.fsTree.Delete(common.DeletePrm{Address: addr})
+	if err != nil {
+		// the object is stored in both places now, remove the new copy
+		_ = c.db.Update(func(tx *bbolt.Tx) error {
+			return tx.Bucket(defaultBucket).Delete([]byte(saddr))
+		})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package writecache
+
+import (
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmallObjectSizeChange(t *testing.T) {
+	const (
+		smallSize = 256
+		bigSize   = 4 * smallSize
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+	wcPath := filepath.Join(t.TempDir(), "writecache")
+
+	openCache := func(t *testing.T, smallObjectSize uint64) Cache {
+		// prevent background flushes
+		require.NoError(t, mb.SetMode(mode.ReadOnly))
+		require.NoError(t, bs.SetMode(mode.ReadOnly))
+
+		wc := newTestCache(t, bs, mb,
+			WithPath(wcPath),
+			WithSmallObjectSize(smallObjectSize))
+		require.NoError(t, wc.Init())
+		return wc
+	}
+
+	checkStatus := func(t *testing.T, c Cache, addr oid.Address, inDB bool) {
+		st, err := c.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.Equal(t, inDB, st.InDB)
+		require.Equal(t, !inDB, st.InFSTree)
+	}
+
+	// first session: objects of medium size are put to FSTree
+	wc := openCache(t, smallSize)
+
+	var small, medium []oid.Address
+	for i := 0; i < 6; i++ {
+		size := 1
+		if i%2 == 1 {
+			size = 2 * smallSize
+		}
+
+		obj, data := newObject(t, size)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		require.NoError(t, err)
+
+		if size == 1 {
+			small = append(small, prm.Address)
+		} else {
+			medium = append(medium, prm.Address)
+		}
+	}
+
+	for i := range medium {
+		checkStatus(t, wc, medium[i], false)
+	}
+	require.NoError(t, wc.Close())
+
+	// second session: medium objects are small now
+	wc = openCache(t, bigSize)
+	for i := range medium {
+		checkStatus(t, wc, medium[i], true)
+	}
+	require.EqualValues(t, 6, wc.Stats().Objects)
+	require.EqualValues(t, 6, wc.(*cache).objCounters.DB())
+	require.Zero(t, wc.(*cache).objCounters.FS())
+	require.NoError(t, wc.Close())
+
+	// third session: medium objects are big again
+	wc = openCache(t, smallSize)
+	for i := range medium {
+		checkStatus(t, wc, medium[i], false)
+	}
+	for i := range small {
+		checkStatus(t, wc, small[i], true)
+	}
+	require.EqualValues(t, len(small), wc.(*cache).objCounters.DB())
+	require.EqualValues(t, len(medium), wc.(*cache).objCounters.FS())
+
+	// everything is flushed
+	require.NoError(t, wc.SetMode(mode.ReadOnly))
+	require.NoError(t, mb.SetMode(mode.ReadWrite))
+	require.NoError(t, bs.SetMode(mode.ReadWrite))
+	require.NoError(t, wc.Flush(FlushPrm{}))
+
+	for _, addr := range append(small, medium...) {
+		res, err := bs.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		require.True(t, res.Exists)
+	}
+
+	require.NoError(t, wc.Close())
+}
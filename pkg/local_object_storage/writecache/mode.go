@@ -11,6 +11,9 @@ import (
 // ErrReadOnly is returned when Put/Write is performed in a read-only mode.
 var ErrReadOnly = errors.New("write-cache is in read-only mode")
 
+// ErrDegraded is returned when the database is accessed in a degraded mode.
+var ErrDegraded = errors.New("write-cache is in degraded mode")
+
 // SetMode sets write-cache mode of operation.
 // When shard is put in read-only mode all objects in memory are flushed to disk
 // and all background jobs are suspended.
@@ -19,7 +22,7 @@ func (c *cache) SetMode(m mode.Mode) error {
 	defer c.modeMtx.Unlock()
 
 	if m.NoMetabase() && !c.mode.NoMetabase() {
-		err := c.flush(true)
+		err := c.flush(c.blobstor, false, FlushPrm{IgnoreErrors: true})
 		if err != nil {
 			return err
 		}
@@ -39,6 +42,11 @@ func (c *cache) SetMode(m mode.Mode) error {
 		time.Sleep(time.Second)
 	}
 
+	// Wait for flush workers to finish writing objects they have already
+	// received, the rest ones are skipped after the mode change.
+	c.flushMtx.Lock()
+	defer c.flushMtx.Unlock()
+
 	if m.NoMetabase() {
 		c.mode = m
 		return nil
@@ -52,6 +60,12 @@ func (c *cache) SetMode(m mode.Mode) error {
 	return nil
 }
 
+// flushDisabled returns true if objects must not be written to the main storage
+// in the current mode. Either `c.modeMtx` or `c.flushMtx` must be taken.
+func (c *cache) flushDisabled() bool {
+	return c.mode.ReadOnly() || c.mode.NoMetabase()
+}
+
 // readOnly returns true if current mode is read-only.
 // `c.modeMtx` must be taken.
 func (c *cache) readOnly() bool {
@@ -0,0 +1,162 @@
+package writecache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// guardedMetabase counts metabase writes performed
+// while the write-cache is expected to be read-only.
+type guardedMetabase struct {
+	metabase
+	readOnly   *atomic.Bool
+	violations atomic.Uint32
+}
+
+func (m *guardedMetabase) Put(prm meta.PutPrm) (meta.PutRes, error) {
+	// widen the window between blobstor and metabase writes
+	time.Sleep(10 * time.Millisecond)
+	if m.readOnly.Load() {
+		m.violations.Inc()
+	}
+	return m.metabase.Put(prm)
+}
+
+// blockingMetabase blocks the first Put until release is closed.
+type blockingMetabase struct {
+	metabase
+	count   atomic.Uint64
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingMetabase) Put(prm meta.PutPrm) (meta.PutRes, error) {
+	if m.count.Inc() == 1 {
+		close(m.started)
+		<-m.release
+	}
+	return m.metabase.Put(prm)
+}
+
+// wrapMetabase returns an option wrapping the metabase set by WithMetabase.
+func wrapMetabase(f func(metabase) metabase) Option {
+	return func(o *options) {
+		o.metabase = f(o.metabase)
+	}
+}
+
+func TestSetModeWaitsForFlush(t *testing.T) {
+	blocking := &blockingMetabase{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+	wc := newTestCache(t, bs, mb, WithSmallObjectSize(256), wrapMetabase(func(mb metabase) metabase {
+		blocking.metabase = mb
+		return blocking
+	}))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	var releaseOnce sync.Once
+	release := func() { releaseOnce.Do(func() { close(blocking.release) }) }
+	t.Cleanup(release) // called before the cache is closed
+
+	obj, data := newObject(t, 1)
+
+	var prm common.PutPrm
+	prm.Address = objectCore.AddressOf(obj)
+	prm.Object = obj
+	prm.RawData = data
+
+	_, err := wc.Put(prm)
+	require.NoError(t, err)
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("object flush has not started")
+	}
+
+	modeSet := make(chan error, 1)
+	go func() { modeSet <- wc.SetMode(mode.ReadOnly) }()
+
+	select {
+	case err := <-modeSet:
+		t.Fatalf("mode was changed during the flush: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release()
+
+	select {
+	case err := <-modeSet:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("mode has not been changed after the flush")
+	}
+}
+
+func TestSetModeUnderLoad(t *testing.T) {
+	readOnly := atomic.NewBool(false)
+	guarded := &guardedMetabase{readOnly: readOnly}
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+	wc := newTestCache(t, bs, mb, WithSmallObjectSize(256), wrapMetabase(func(mb metabase) metabase {
+		guarded.metabase = mb
+		return guarded
+	}))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			obj, data := newObject(t, 1)
+
+			var prm common.PutPrm
+			prm.Address = objectCore.AddressOf(obj)
+			prm.Object = obj
+			prm.RawData = data
+
+			_, _ = wc.Put(prm)
+		}
+	}()
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		require.NoError(t, wc.SetMode(mode.ReadOnly))
+		readOnly.Store(true)
+
+		time.Sleep(100 * time.Millisecond)
+
+		readOnly.Store(false)
+		require.NoError(t, wc.SetMode(mode.ReadWrite))
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	close(done)
+	wg.Wait()
+
+	require.Zero(t, guarded.violations.Load())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
@@ -17,12 +18,14 @@ type Option func(*options)
 type metabase interface {
 	Put(meta.PutPrm) (meta.PutRes, error)
 	Exists(meta.ExistsPrm) (meta.ExistsRes, error)
+	StorageID(meta.StorageIDPrm) (meta.StorageIDRes, error)
 }
 
 // blob is an interface for the blobstor.
 type blob interface {
 	Put(common.PutPrm) (common.PutRes, error)
 	NeedsCompression(obj *objectSDK.Object) bool
+	CanPut(obj *objectSDK.Object, data []byte) bool
 	Exists(res common.ExistsPrm) (common.ExistsRes, error)
 }
 
@@ -40,6 +43,9 @@ type options struct {
 	smallObjectSize uint64
 	// workersCount is the number of workers flushing objects in parallel.
 	workersCount int
+	// flushInterval is the interval between the flushes of the small
+	// objects, the big objects are flushed 10 times less often.
+	flushInterval time.Duration
 	// maxCacheSize is the maximum total size of all objects saved in cache (DB + FS).
 	// 1 GiB by default.
 	maxCacheSize uint64
@@ -49,6 +55,40 @@ type options struct {
 	maxBatchSize int
 	// maxBatchDelay is the maximum batch wait time for the small object database.
 	maxBatchDelay time.Duration
+	// errorLogInterval is the interval during which repeated flush errors
+	// are aggregated in a single log message.
+	errorLogInterval time.Duration
+	// slowFlushThreshold is the duration of the object flush
+	// after which the object is logged as a slow one.
+	slowFlushThreshold time.Duration
+	// shutdownTimeout is the time given to the background flush
+	// to pass the objects to the flush workers on Close.
+	shutdownTimeout time.Duration
+	// bigFlushBytes is the maximum size of the big objects flushed
+	// from FSTree in a single flush cycle.
+	bigFlushBytes uint64
+	// bigFlushTime is the maximum duration of a single flush cycle
+	// of the big objects.
+	bigFlushTime time.Duration
+	// flushCallback is called for each object written from the
+	// write-cache to the main storage.
+	flushCallback func(oid.Address)
+	// repairOnInit enables moving the FSTree files which are not
+	// valid objects to the quarantine directory on Init.
+	repairOnInit bool
+	// metaPriority is the priority of the metabase
+	// transactions made by the flush.
+	metaPriority meta.Priority
+	// validatePut enables the validation of the objects on Put.
+	validatePut bool
+	// validatePayload enables the payload checksum verification
+	// of the objects validated on Put.
+	validatePayload bool
+	// metrics is the write-cache flush metrics.
+	metrics Metrics
+	// inlineThreshold is the maximum payload size of the objects
+	// flushed inline to the metabase instead of the blobstor.
+	inlineThreshold uint64
 }
 
 // WithLogger sets logger.
@@ -97,6 +137,8 @@ func WithSmallObjectSize(sz uint64) Option {
 	}
 }
 
+// WithFlushWorkersCount sets the number of workers flushing
+// objects to the main storage in parallel.
 func WithFlushWorkersCount(c int) Option {
 	return func(o *options) {
 		if c > 0 {
@@ -105,6 +147,17 @@ func WithFlushWorkersCount(c int) Option {
 	}
 }
 
+// WithFlushInterval sets the interval between the background flushes of
+// the small objects, the big objects are flushed 10 times less often.
+// Non-positive interval is ignored.
+func WithFlushInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.flushInterval = d
+		}
+	}
+}
+
 // WithMaxCacheSize sets maximum write-cache size in bytes.
 func WithMaxCacheSize(sz uint64) Option {
 	return func(o *options) {
@@ -129,3 +182,111 @@ func WithMaxBatchDelay(d time.Duration) Option {
 		}
 	}
 }
+
+// WithErrorLogInterval sets the interval during which repeated flush errors
+// are aggregated in a single log message. Zero value disables the aggregation.
+func WithErrorLogInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d >= 0 {
+			o.errorLogInterval = d
+		}
+	}
+}
+
+// WithSlowFlushThreshold sets the duration of the object flush after which
+// the object is logged as a slow one. Zero value disables the logging.
+func WithSlowFlushThreshold(d time.Duration) Option {
+	return func(o *options) {
+		if d >= 0 {
+			o.slowFlushThreshold = d
+		}
+	}
+}
+
+// WithShutdownTimeout sets the time during which the objects already
+// taken by the background flush are still written to the main storage
+// on Close. The rest objects are flushed after the next start.
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(o *options) {
+		if d >= 0 {
+			o.shutdownTimeout = d
+		}
+	}
+}
+
+// WithFlushCallback sets the function called with the address of each object
+// written from the write-cache to the main storage. The callback must not block.
+func WithFlushCallback(f func(oid.Address)) Option {
+	return func(o *options) {
+		o.flushCallback = f
+	}
+}
+
+// WithBigObjectFlushBudget sets the maximum total size of the big objects
+// flushed from FSTree in a single flush cycle and the maximum duration of
+// the cycle. The interrupted flush is continued from the next object in the
+// next cycle, so the flush of the big objects does not occupy the main
+// storage for long. Zero value means no limit.
+func WithBigObjectFlushBudget(bytes uint64, d time.Duration) Option {
+	return func(o *options) {
+		o.bigFlushBytes = bytes
+		if d >= 0 {
+			o.bigFlushTime = d
+		}
+	}
+}
+
+// WithRepairOnInit enables the FSTree check on Init: the files which names
+// are not the object addresses or which contents are not the objects with
+// these addresses are moved to the quarantine directory of the write-cache,
+// so they are neither flushed nor iterated.
+func WithRepairOnInit(v bool) Option {
+	return func(o *options) {
+		o.repairOnInit = v
+	}
+}
+
+// WithMetabasePriority sets the priority of the metabase write transactions
+// made by the flush in the queue of the limited transactions.
+func WithMetabasePriority(p meta.Priority) Option {
+	return func(o *options) {
+		o.metaPriority = p
+	}
+}
+
+// WithPutValidation enables the validation of the objects on Put: the
+// objects with the incomplete header, the identifier not matching the
+// header or the malformed signature are rejected with ErrInvalidObject
+// instead of failing the flush later. Disabled by default.
+func WithPutValidation(v bool) Option {
+	return func(o *options) {
+		o.validatePut = v
+	}
+}
+
+// WithPayloadValidation enables the payload checksum verification of the
+// objects validated on Put (see WithPutValidation). The payload is hashed
+// on each Put, so it is disabled by default.
+func WithPayloadValidation(v bool) Option {
+	return func(o *options) {
+		o.validatePayload = v
+	}
+}
+
+// WithInlineThreshold sets the maximum payload size of the objects flushed
+// inline, i.e. with the payload embedded into the metabase record, instead
+// of the blobstor (see meta.PutPrm.SetInline). Zero disables inlining.
+func WithInlineThreshold(threshold uint64) Option {
+	return func(o *options) {
+		o.inlineThreshold = threshold
+	}
+}
+
+// WithMetrics sets the write-cache flush metrics. Nil value is ignored.
+func WithMetrics(m Metrics) Option {
+	return func(o *options) {
+		if m != nil {
+			o.metrics = m
+		}
+	}
+}
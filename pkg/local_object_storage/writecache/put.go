@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
@@ -9,13 +10,18 @@ import (
 )
 
 var (
-	// ErrBigObject is returned when object is too big to be placed in cache.
+	// ErrBigObject is returned when object is too big to be placed in cache
+	// or cannot be flushed to the blobstor because of its limits.
 	ErrBigObject = errors.New("too big object")
 	// ErrOutOfSpace is returned when there is no space left to put a new object.
 	ErrOutOfSpace = errors.New("no space left in the write cache")
 )
 
 // Put puts object to write-cache.
+//
+// Returns ErrBigObject if the object exceeds the maximum size of the
+// write-cache or the blobstor limits. Returns ErrInvalidObject if the
+// validation is enabled and the object fails it.
 func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
@@ -23,13 +29,32 @@ func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
 		return common.PutRes{}, ErrReadOnly
 	}
 
+	if c.validatePut {
+		if err := c.validateObject(prm); err != nil {
+			return common.PutRes{}, err
+		}
+	}
+
 	sz := uint64(len(prm.RawData))
 	if sz > c.maxObjectSize {
 		return common.PutRes{}, ErrBigObject
 	}
+	if !c.blobstor.CanPut(prm.Object, prm.RawData) {
+		// Caching would lead to the endless flush failures
+		// while the client gets a success.
+		return common.PutRes{}, fmt.Errorf("%w: blobstor has no place for it", ErrBigObject)
+	}
+
+	addr := prm.Address.EncodeToString()
+	if c.isFlushing(addr) {
+		// The same object is being flushed right now, its data
+		// is already in the cache, so there is nothing to write.
+		storagelog.Write(c.log, storagelog.AddressField(addr), storagelog.OpField("PUT (flush in progress)"))
+		return common.PutRes{}, nil
+	}
 
 	oi := objectInfo{
-		addr: prm.Address.EncodeToString(),
+		addr: addr,
 		obj:  prm.Object,
 		data: prm.RawData,
 	}
@@ -48,11 +73,22 @@ func (c *cache) putSmall(obj objectInfo) error {
 		return ErrOutOfSpace
 	}
 
+	var exists bool
 	err := c.db.Batch(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
-		return b.Put([]byte(obj.addr), obj.data)
+		key := []byte(obj.addr)
+
+		exists = b.Get(key) != nil
+		if exists {
+			return nil
+		}
+		return b.Put(key, obj.data)
 	})
-	if err == nil {
+	if err != nil {
+		return err
+	}
+
+	if !exists {
 		storagelog.Write(c.log, storagelog.AddressField(obj.addr), storagelog.OpField("db PUT"))
 		c.objCounters.IncDB()
 	}
@@ -66,7 +102,15 @@ func (c *cache) putBig(addr string, prm common.PutPrm) error {
 		return ErrOutOfSpace
 	}
 
-	_, err := c.fsTree.Put(prm)
+	var ePrm common.ExistsPrm
+	ePrm.Address = prm.Address
+
+	eRes, err := c.fsTree.Exists(ePrm)
+	if err == nil && eRes.Exists {
+		return nil
+	}
+
+	_, err = c.fsTree.Put(prm)
 	if err != nil {
 		return err
 	}
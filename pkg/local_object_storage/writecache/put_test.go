@@ -0,0 +1,157 @@
+package writecache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutBlobstorLimits(t *testing.T) {
+	const (
+		smallSize = 256
+		blobLimit = 1024
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{
+		Policy: func(_ *objectSDK.Object, data []byte) bool {
+			return len(data) < blobLimit
+		},
+	})
+
+	wc := newTestCache(t, bs, mb, WithSmallObjectSize(smallSize))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	put := func(size int) (oid.Address, error) {
+		obj, data := newObject(t, size)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		return prm.Address, err
+	}
+
+	t.Run("fits", func(t *testing.T) {
+		for _, size := range []int{1, smallSize * 2} {
+			addr, err := put(size)
+			require.NoError(t, err)
+
+			_, err = wc.Get(addr)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("too big for blobstor", func(t *testing.T) {
+		addr, err := put(blobLimit * 2)
+		require.ErrorIs(t, err, ErrBigObject)
+
+		_, err = wc.Get(addr)
+		require.True(t, errors.As(err, new(apistatus.ObjectNotFound)), err)
+	})
+}
+
+func TestPutValidation(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	newCache := func(t *testing.T, opts ...Option) Cache {
+		bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+		wc := newTestCache(t, bs, mb, append([]Option{
+			// the objects are flushed on demand only
+			WithFlushInterval(time.Hour),
+			WithPutValidation(true),
+		}, opts...)...)
+		require.NoError(t, wc.Init())
+		t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+		return wc
+	}
+
+	newSignedObject := func(t *testing.T) *objectSDK.Object {
+		obj, _ := newObject(t, 1)
+		objectSDK.CalculateAndSetPayloadChecksum(obj)
+		require.NoError(t, objectSDK.SetIDWithSignature(key.PrivateKey, obj))
+		return obj
+	}
+
+	put := func(wc Cache, obj *objectSDK.Object) error {
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		_, err = wc.Put(common.PutPrm{
+			Address: objectCore.AddressOf(obj),
+			Object:  obj,
+			RawData: data,
+		})
+		return err
+	}
+
+	wc := newCache(t)
+
+	require.NoError(t, put(wc, newSignedObject(t)))
+
+	for name, corrupt := range map[string]func(*objectSDK.Object){
+		"mismatched ID": func(obj *objectSDK.Object) {
+			obj.SetID(oidtest.ID())
+		},
+		"missing owner": func(obj *objectSDK.Object) {
+			obj.ToV2().GetHeader().SetOwnerID(nil)
+		},
+		"missing checksum": func(obj *objectSDK.Object) {
+			obj.ToV2().GetHeader().SetPayloadHash(nil)
+		},
+		"missing signature": func(obj *objectSDK.Object) {
+			obj.ToV2().SetSignature(nil)
+		},
+		"malformed key": func(obj *objectSDK.Object) {
+			obj.ToV2().GetSignature().SetKey([]byte{1, 2, 3})
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			obj := newSignedObject(t)
+			corrupt(obj)
+
+			require.ErrorIs(t, put(wc, obj), ErrInvalidObject)
+
+			// the object is rejected synchronously and never reaches the flush
+			_, err := wc.Get(objectCore.AddressOf(obj))
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		})
+	}
+
+	require.Equal(t, uint64(1), wc.DumpInfo().DBBacklog)
+
+	t.Run("payload", func(t *testing.T) {
+		obj := newSignedObject(t)
+		obj.SetPayload([]byte("corrupted"))
+
+		// the payload is not hashed by default
+		require.NoError(t, put(wc, obj))
+
+		obj = newSignedObject(t)
+		obj.SetPayload([]byte("corrupted"))
+
+		require.ErrorIs(t, put(newCache(t, WithPayloadValidation(true)), obj), ErrInvalidObject)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		obj := newSignedObject(t)
+		obj.SetID(oidtest.ID())
+
+		require.NoError(t, put(newCache(t, WithPutValidation(false)), obj))
+	})
+}
@@ -0,0 +1,149 @@
+package writecache
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+// quarantineDir is the name of the write-cache directory containing the
+// FSTree files which are not valid objects. It is never iterated by FSTree
+// since its name is not a valid FSTree directory name.
+const quarantineDir = "quarantine"
+
+var errAddressMismatch = errors.New("object address differs from the file path")
+
+// repairFSTree moves the FSTree files which are not valid objects to the
+// quarantine directory and logs the summary.
+func (c *cache) repairFSTree() {
+	var (
+		root   = c.fsTree.RootPath
+		qPath  = filepath.Join(root, quarantineDir)
+		dbPath = filepath.Join(root, dbName)
+
+		moved, failed uint64
+	)
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			c.log.Warn("can't check write-cache FSTree entry",
+				zap.String("path", p),
+				zap.Error(err))
+			return nil
+		}
+
+		if d.IsDir() {
+			if p == qPath {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if p == dbPath {
+			return nil
+		}
+
+		reason := c.checkFSTreeFile(p)
+		if reason == nil {
+			return nil
+		}
+
+		if err := c.quarantine(root, qPath, p); err != nil {
+			c.log.Error("can't move invalid write-cache FSTree entry to quarantine",
+				zap.String("path", p),
+				zap.String("reason", reason.Error()),
+				zap.Error(err))
+			failed++
+			return nil
+		}
+
+		c.log.Debug("invalid write-cache FSTree entry is moved to quarantine",
+			zap.String("path", p),
+			zap.String("reason", reason.Error()))
+		moved++
+
+		return nil
+	})
+	if err != nil {
+		c.log.Error("can't check write-cache FSTree", zap.Error(err))
+	}
+
+	if moved > 0 || failed > 0 {
+		c.log.Warn("invalid write-cache FSTree entries are found",
+			zap.String("quarantine", qPath),
+			zap.Uint64("moved", moved),
+			zap.Uint64("failed", failed))
+	}
+}
+
+// checkFSTreeFile returns the reason why the file at p is not
+// a valid object of the FSTree or nil if it is valid.
+func (c *cache) checkFSTreeFile(p string) error {
+	addr, err := c.fsTree.AddressFromPath(p)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+
+	data, err = c.fsTree.Decompress(data)
+	if err != nil {
+		return err
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return err
+	}
+
+	if object.AddressOf(obj) != addr {
+		return errAddressMismatch
+	}
+
+	return nil
+}
+
+// quarantine moves the file at p to the quarantine directory keeping its
+// path relative to the root in the file name.
+func (c *cache) quarantine(root, qPath, p string) error {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		return err
+	}
+
+	if err := util.MkdirAllX(qPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.Rename(p, filepath.Join(qPath, strings.ReplaceAll(rel, string(filepath.Separator), "_")))
+}
+
+// countQuarantined returns the number of the files in the quarantine directory.
+func (c *cache) countQuarantined() uint64 {
+	des, err := os.ReadDir(filepath.Join(c.fsTree.RootPath, quarantineDir))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			c.log.Warn("can't read write-cache quarantine directory", zap.Error(err))
+		}
+		return 0
+	}
+
+	var n uint64
+	for i := range des {
+		if !des[i].IsDir() {
+			n++
+		}
+	}
+
+	return n
+}
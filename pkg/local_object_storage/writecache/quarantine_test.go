@@ -0,0 +1,129 @@
+package writecache
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepairOnInit(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	// prevent background flushes
+	require.NoError(t, mb.SetMode(mode.ReadOnly))
+	require.NoError(t, bs.SetMode(mode.ReadOnly))
+
+	wcPath := filepath.Join(t.TempDir(), "writecache")
+
+	newCache := func(t *testing.T, repair bool) Cache {
+		wc := newTestCache(t, bs, mb,
+			WithPath(wcPath),
+			WithSmallObjectSize(smallSize),
+			WithRepairOnInit(repair))
+		require.NoError(t, wc.Init())
+		return wc
+	}
+
+	// write-cache FSTree has depth 1 and directory name length 1
+	treePath := func(addr oid.Address) string {
+		s := addr.Object().EncodeToString() + "." + addr.Container().EncodeToString()
+		return filepath.Join(wcPath, s[:1], s[1:])
+	}
+
+	listFSTree := func(t *testing.T, wc Cache) []string {
+		var res []string
+
+		var prm common.IteratePrm
+		prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
+			res = append(res, addr.EncodeToString())
+			return nil
+		}
+
+		_, err := wc.(*cache).fsTree.Iterate(prm)
+		require.NoError(t, err)
+		return res
+	}
+
+	wc := newCache(t, false)
+
+	var valid []string
+	var validPath string
+	for i := 0; i < 2; i++ {
+		obj, data := newObject(t, 2*smallSize)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+
+		_, err := wc.Put(prm)
+		require.NoError(t, err)
+
+		valid = append(valid, prm.Address.EncodeToString())
+		validPath = treePath(prm.Address)
+	}
+	require.NoError(t, wc.Close())
+
+	validData, err := os.ReadFile(validPath)
+	require.NoError(t, err)
+
+	invalid := []struct {
+		path string
+		data []byte
+	}{
+		{filepath.Join(wcPath, "tmp123"), validData},
+		{filepath.Join(wcPath, "A", "not-an-address"), validData},
+		{treePath(oidtest.Address()), bytes.Repeat([]byte{0xFF}, 2*smallSize)},
+		{treePath(oidtest.Address()), validData},
+	}
+	// contents are bigger than the small object size to not be migrated to the database
+	for _, f := range invalid {
+		require.NoError(t, os.MkdirAll(filepath.Dir(f.path), os.ModePerm))
+		require.NoError(t, os.WriteFile(f.path, f.data, 0600))
+	}
+
+	t.Run("without repair", func(t *testing.T) {
+		wc := newCache(t, false)
+		t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+		require.Zero(t, wc.Stats().Quarantined)
+		for _, f := range invalid {
+			require.FileExists(t, f.path)
+		}
+	})
+
+	t.Run("with repair", func(t *testing.T) {
+		wc := newCache(t, true)
+		t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+		require.EqualValues(t, len(invalid), wc.Stats().Quarantined)
+		require.ElementsMatch(t, valid, listFSTree(t, wc))
+
+		for _, f := range invalid {
+			require.NoFileExists(t, f.path)
+		}
+
+		des, err := os.ReadDir(filepath.Join(wcPath, quarantineDir))
+		require.NoError(t, err)
+		require.Len(t, des, len(invalid))
+	})
+
+	t.Run("quarantine is skipped", func(t *testing.T) {
+		wc := newCache(t, true)
+		t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+		require.EqualValues(t, len(invalid), wc.Stats().Quarantined)
+		require.ElementsMatch(t, valid, listFSTree(t, wc))
+	})
+}
@@ -65,6 +65,11 @@ func (c *cache) initCounters() error {
 		return fmt.Errorf("could not read write-cache FS counter: %w", err)
 	}
 
+	// the database file is located in the FSTree root and is counted too
+	if inFS > 0 {
+		inFS--
+	}
+
 	c.objCounters.cDB.Store(inDB)
 	c.objCounters.cFS.Store(inFS)
 
@@ -0,0 +1,32 @@
+package writecache
+
+import (
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestInitCounters(t *testing.T) {
+	wc := New(
+		WithLogger(zaptest.NewLogger(t)),
+		WithPath(t.TempDir()))
+	require.NoError(t, wc.Open(false))
+
+	c := wc.(*cache)
+	require.Zero(t, c.objCounters.FS())
+	require.Zero(t, c.objCounters.DB())
+
+	obj, data := newObject(t, 1)
+	_, err := c.fsTree.Put(common.PutPrm{Address: objectCore.AddressOf(obj), RawData: data})
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+
+	require.NoError(t, wc.Open(false))
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	require.Equal(t, uint64(1), c.objCounters.FS())
+	require.Zero(t, c.objCounters.DB())
+}
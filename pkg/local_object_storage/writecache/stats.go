@@ -0,0 +1,117 @@
+package writecache
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// flushLatencySamples is the number of the latest flush durations
+// used to calculate flush latency percentile.
+const flushLatencySamples = 1024
+
+// Stats groups write-cache statistics.
+type Stats struct {
+	// Flushed is the number of objects processed by the background flush workers.
+	Flushed uint64
+	// FlushLatencyP99 is the 99th percentile of the latest object flush durations.
+	FlushLatencyP99 time.Duration
+	// LastFlush is the time of the latest object flush or of the
+	// write-cache initialization if no objects have been flushed since.
+	LastFlush time.Time
+
+	// Objects is the number of objects stored in the write-cache.
+	Objects uint64
+	// DBObjects is the number of objects stored in the small object database.
+	DBObjects uint64
+	// FSObjects is the number of objects stored in FSTree.
+	FSObjects uint64
+	// Size is the estimated size of the objects stored in the write-cache.
+	Size uint64
+	// Capacity is the maximum size of the objects stored in the write-cache.
+	Capacity uint64
+
+	// Quarantined is the number of the invalid FSTree files
+	// moved to the quarantine directory.
+	Quarantined uint64
+
+	// FlushedMarks is the number of objects flushed to the main
+	// storage which have not been removed from the write-cache yet.
+	FlushedMarks uint64
+	// FlushQueue is the number of objects taken by the background
+	// flush which have not been written to the main storage yet.
+	FlushQueue uint64
+}
+
+// flushLatency keeps the latest flush durations in a ring buffer.
+type flushLatency struct {
+	mtx     sync.Mutex
+	count   uint64
+	last    time.Time
+	samples []time.Duration
+}
+
+func (l *flushLatency) add(d time.Duration) {
+	l.mtx.Lock()
+	if len(l.samples) < flushLatencySamples {
+		l.samples = append(l.samples, d)
+	} else {
+		l.samples[l.count%flushLatencySamples] = d
+	}
+	l.count++
+	l.last = time.Now()
+	l.mtx.Unlock()
+}
+
+// percentile returns p-th percentile of the saved durations.
+func (l *flushLatency) percentile(p int) time.Duration {
+	l.mtx.Lock()
+	samples := make([]time.Duration, len(l.samples))
+	copy(samples, l.samples)
+	l.mtx.Unlock()
+
+	if len(samples) == 0 {
+		return 0
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	// nearest-rank method
+	i := (len(samples)*p + 99) / 100
+	return samples[i-1]
+}
+
+// Stats returns write-cache statistics. The values are taken from the
+// counters maintained by the write-cache, the storages are not read, so
+// the concurrent operations are not blocked.
+func (c *cache) Stats() Stats {
+	c.latency.mtx.Lock()
+	flushed := c.latency.count
+	last := c.latency.last
+	c.latency.mtx.Unlock()
+
+	c.mtx.RLock()
+	queue := len(c.flushing)
+	c.mtx.RUnlock()
+
+	var marks int
+	if c.flushed != nil {
+		marks = c.flushed.Len()
+	}
+
+	db, fs := c.objCounters.DB(), c.objCounters.FS()
+
+	return Stats{
+		Flushed:         flushed,
+		FlushLatencyP99: c.latency.percentile(99),
+		LastFlush:       last,
+		Objects:         db + fs,
+		DBObjects:       db,
+		FSObjects:       fs,
+		Size:            c.estimateCacheSize(),
+		Capacity:        c.maxCacheSize,
+		Quarantined:     c.quarantined.Load(),
+		FlushedMarks:    uint64(marks),
+		FlushQueue:      uint64(queue),
+	}
+}
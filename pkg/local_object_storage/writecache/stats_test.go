@@ -0,0 +1,186 @@
+package writecache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFlushLatencyPercentile(t *testing.T) {
+	var l flushLatency
+	require.Zero(t, l.percentile(99))
+
+	for i := 1; i <= 100; i++ {
+		l.add(time.Duration(i) * time.Millisecond)
+	}
+	require.Equal(t, 99*time.Millisecond, l.percentile(99))
+	require.Equal(t, 50*time.Millisecond, l.percentile(50))
+
+	// Old samples are replaced with the new ones.
+	for i := 0; i < flushLatencySamples; i++ {
+		l.add(time.Second)
+	}
+	require.Equal(t, time.Second, l.percentile(99))
+	require.Len(t, l.samples, flushLatencySamples)
+}
+
+type slowBlob struct {
+	blob
+	delay func(common.PutPrm) time.Duration
+}
+
+func (b *slowBlob) Put(prm common.PutPrm) (common.PutRes, error) {
+	time.Sleep(b.delay(prm))
+	return b.blob.Put(prm)
+}
+
+func TestSlowFlushLogging(t *testing.T) {
+	const (
+		smallSize = 256
+		threshold = 50 * time.Millisecond
+	)
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{})
+
+	obj, data := newObject(t, 1)
+	slowObj, _ := newObject(t, 2)
+	slowObj.SetPayloadSize(2)
+	slowData, err := slowObj.Marshal()
+	require.NoError(t, err)
+	slowAddr := objectCore.AddressOf(slowObj)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	wc := newTestCache(t, bs, mb,
+		WithLogger(zap.New(core)),
+		WithSmallObjectSize(smallSize),
+		WithSlowFlushThreshold(threshold),
+		wrapBlob(func(b blob) blob {
+			return &slowBlob{
+				blob: b,
+				delay: func(prm common.PutPrm) time.Duration {
+					if prm.Address == slowAddr {
+						return 2 * threshold
+					}
+					return 0
+				},
+			}
+		}))
+	require.NoError(t, wc.Init())
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	for _, prm := range []common.PutPrm{
+		{Address: objectCore.AddressOf(obj), Object: obj, RawData: data},
+		{Address: slowAddr, Object: slowObj, RawData: slowData},
+	} {
+		_, err = wc.Put(prm)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return wc.Stats().Flushed == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	slowLogs := logs.FilterMessage("slow object flush").All()
+	require.Len(t, slowLogs, 1)
+	require.Equal(t, slowAddr.EncodeToString(), slowLogs[0].ContextMap()["address"])
+	require.Equal(t, uint64(2), slowLogs[0].ContextMap()["size"])
+
+	require.GreaterOrEqual(t, wc.Stats().FlushLatencyP99, 2*threshold)
+}
+
+func TestStats(t *testing.T) {
+	const smallSize = 256
+
+	bs, mb := newTestStorage(t, blobstor.SubStorage{Storage: newMemStorage()})
+
+	blocking := &blockingBlob{
+		blob:    bs,
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	c := newTestCache(t, bs, mb,
+		WithSmallObjectSize(smallSize),
+		wrapBlob(func(blob) blob { return blocking }))
+
+	put := func(size int) common.PutPrm {
+		obj, data := newObject(t, size)
+
+		prm := common.PutPrm{Address: objectCore.AddressOf(obj), Object: obj, RawData: data}
+		_, err := c.Put(prm)
+		require.NoError(t, err)
+
+		return prm
+	}
+
+	small := []common.PutPrm{put(1), put(1), put(1)}
+	big := []common.PutPrm{put(smallSize), put(smallSize)}
+
+	st := c.Stats()
+	require.EqualValues(t, 3, st.DBObjects)
+	require.EqualValues(t, 2, st.FSObjects)
+	require.EqualValues(t, 5, st.Objects)
+	require.Equal(t, 3*c.smallObjectSize+2*c.maxObjectSize, st.Size)
+	require.Zero(t, st.FlushedMarks)
+	require.Zero(t, st.FlushQueue)
+
+	require.NoError(t, c.Delete(small[0].Address))
+	require.NoError(t, c.Delete(big[0].Address))
+
+	st = c.Stats()
+	require.EqualValues(t, 2, st.DBObjects)
+	require.EqualValues(t, 1, st.FSObjects)
+	require.Equal(t, 2*c.smallObjectSize+c.maxObjectSize, st.Size)
+
+	var releaseOnce sync.Once
+	release := func() { releaseOnce.Do(func() { close(blocking.release) }) }
+
+	// the first object flush is blocked
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+	t.Cleanup(release)
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("object flush has not started")
+	}
+
+	// the rest objects are flushed, the blocked one is still in the queue
+	require.Eventually(t, func() bool {
+		return c.Stats().FlushQueue == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	release()
+
+	// small objects are removed after the flush
+	require.Eventually(t, func() bool {
+		st := c.Stats()
+		return st.DBObjects == 0 && st.FlushQueue == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// big object is kept until the flushed mark is evicted
+	c.modeMtx.RLock()
+	c.flushBigObjectsTick()
+	c.modeMtx.RUnlock()
+
+	st = c.Stats()
+	require.EqualValues(t, 1, st.FSObjects)
+	require.EqualValues(t, 1, st.FlushedMarks)
+	require.EqualValues(t, 2, st.Flushed)
+
+	for _, prm := range append(small[1:], big[1:]...) {
+		res, err := bs.Exists(common.ExistsPrm{Address: prm.Address})
+		require.NoError(t, err)
+		require.True(t, res.Exists)
+	}
+}
@@ -0,0 +1,44 @@
+package writecache
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// ObjectStatus represents the status of the object in the write-cache.
+type ObjectStatus struct {
+	// InDB is true if the object is stored in the small object database.
+	InDB bool
+	// InFSTree is true if the object is stored in the FSTree.
+	InFSTree bool
+	// Flushed is true if the object has been flushed to the main storage.
+	Flushed bool
+}
+
+// ObjectStatus returns the status of the object in the write-cache.
+func (c *cache) ObjectStatus(addr oid.Address) (ObjectStatus, error) {
+	var res ObjectStatus
+
+	saddr := addr.EncodeToString()
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		if b != nil {
+			res.InDB = b.Get([]byte(saddr)) != nil
+		}
+		return nil
+	})
+	if err != nil {
+		return res, err
+	}
+
+	eRes, err := c.fsTree.Exists(common.ExistsPrm{Address: addr})
+	if err != nil {
+		return res, err
+	}
+
+	res.InFSTree = eRes.Exists
+	_, res.Flushed = c.flushed.Peek(saddr)
+	return res, nil
+}
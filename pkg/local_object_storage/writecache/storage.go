@@ -11,21 +11,36 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
 // store represents persistent storage with in-memory LRU cache
-// for flushed items on top of it.
+// for flushed items on top of it. The small objects are removed from
+// the database right after the flush, so the cache contains the big
+// ones only, unless the database is read-only.
 type store struct {
 	maxFlushedMarksCount int
 	maxRemoveBatchSize   int
 
+	// flushed contains the addresses of the objects flushed to the main
+	// storage but still kept in the write-cache. The big objects are not
+	// removed from FSTree right after the flush: the recently read ones stay
+	// in the write-cache, so the repeated reads of the hot objects do not
+	// reach the main storage. They are removed on eviction, which also
+	// batches the FSTree removals. The small objects get here only if they
+	// can't be removed from the database, e.g. when it is read-only.
 	flushed simplelru.LRUCache
 	db      *bbolt.DB
 
+	// flushedDB and flushedFS are the numbers of the flush marks
+	// of the objects stored in the database and FSTree respectively.
+	flushedDB, flushedFS atomic.Int64
+
 	dbKeysToRemove []string
 	fsKeysToRemove []string
 }
@@ -73,6 +88,37 @@ func (c *cache) openStore(readOnly bool) error {
 	return nil
 }
 
+// markFlushed marks the object as flushed to the main storage.
+func (c *cache) markFlushed(addr string, fromDatabase bool) {
+	if !c.flushed.Contains(addr) {
+		if fromDatabase {
+			c.flushedDB.Inc()
+		} else {
+			c.flushedFS.Inc()
+		}
+	}
+
+	c.flushed.Add(addr, fromDatabase)
+}
+
+// backlog returns the estimated numbers of the objects stored in the
+// database and FSTree which are not flushed to the main storage yet.
+func (c *cache) backlog() (uint64, uint64) {
+	pending := func(stored uint64, flushed int64) uint64 {
+		switch {
+		case flushed <= 0:
+			return stored
+		case uint64(flushed) > stored:
+			return 0
+		default:
+			return stored - uint64(flushed)
+		}
+	}
+
+	return pending(c.objCounters.DB(), c.flushedDB.Load()),
+		pending(c.objCounters.FS(), c.flushedFS.Load())
+}
+
 // removeFlushed removes an object from the writecache.
 // To minimize interference with the client operations, the actual removal
 // is done in batches.
@@ -80,8 +126,10 @@ func (c *cache) openStore(readOnly bool) error {
 func (c *cache) removeFlushed(key, value interface{}) {
 	fromDatabase := value.(bool)
 	if fromDatabase {
+		c.flushedDB.Dec()
 		c.dbKeysToRemove = append(c.dbKeysToRemove, key.(string))
 	} else {
+		c.flushedFS.Dec()
 		c.fsKeysToRemove = append(c.fsKeysToRemove, key.(string))
 	}
 
@@ -91,31 +139,59 @@ func (c *cache) removeFlushed(key, value interface{}) {
 	}
 }
 
+// deleteFlushed removes the small object flushed to the main storage from
+// the database. The concurrent removals by the flush workers are batched in
+// a single transaction. If the object is not removed, e.g. the node stops
+// right after the flush, it is flushed once more, which is harmless.
+func (c *cache) deleteFlushed(addr string) {
+	var removed bool
+	err := c.db.Batch(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		key := []byte(addr)
+
+		removed = b.Get(key) != nil
+		return b.Delete(key)
+	})
+	if err != nil {
+		c.errLog.Error(logger.ErrorClass(err), "can't remove flushed object from the database",
+			zap.String("address", addr),
+			zap.Error(err))
+		return
+	}
+
+	if removed {
+		storagelog.Write(c.log, storagelog.AddressField(addr), storagelog.OpField("db DELETE"))
+		c.objCounters.DecDB()
+	}
+}
+
+// deleteFromDB removes the objects from the database in a single
+// transaction. Returns the keys which have not been removed.
 func (c *cache) deleteFromDB(keys []string) []string {
 	if len(keys) == 0 {
 		return keys
 	}
 
-	var errorIndex int
 	err := c.db.Batch(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
-		for errorIndex = range keys {
-			if err := b.Delete([]byte(keys[errorIndex])); err != nil {
+		for i := range keys {
+			if err := b.Delete([]byte(keys[i])); err != nil {
 				return err
 			}
 		}
 		return nil
 	})
-	for i := 0; i < errorIndex; i++ {
-		c.objCounters.DecDB()
-		storagelog.Write(c.log, storagelog.AddressField(keys[i]), storagelog.OpField("db DELETE"))
-	}
 	if err != nil {
 		c.log.Error("can't remove objects from the database", zap.Error(err))
+		return keys
+	}
+
+	for i := range keys {
+		c.objCounters.DecDB()
+		storagelog.Write(c.log, storagelog.AddressField(keys[i]), storagelog.OpField("db DELETE"))
 	}
 
-	copy(keys, keys[errorIndex:])
-	return keys[:len(keys)-errorIndex]
+	return keys[:0]
 }
 
 func (c *cache) deleteFromDisk(keys []string) []string {
@@ -0,0 +1,105 @@
+package writecache
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// ErrInvalidObject is returned by Put when the object fails the validation
+// (see WithPutValidation). Such objects are never accepted, since they
+// would fail the flush after the client has got a success.
+var ErrInvalidObject = errors.New("invalid object")
+
+// validateObject checks that the object has the complete header with the
+// identifier matching it and the well-formed signature. The payload checksum
+// is verified if WithPayloadValidation is set.
+//
+// Returns ErrInvalidObject with the failure reason.
+func (c *cache) validateObject(prm common.PutPrm) error {
+	if err := c.checkObject(prm); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+
+	return nil
+}
+
+func (c *cache) checkObject(prm common.PutPrm) error {
+	obj := prm.Object
+	if obj == nil {
+		return errors.New("missing object")
+	}
+
+	if _, ok := obj.ID(); !ok {
+		return errors.New("missing identifier")
+	}
+
+	if _, ok := obj.ContainerID(); !ok {
+		return errors.New("missing container identifier")
+	}
+
+	if owner := obj.OwnerID(); owner == nil || len(owner.WalletBytes()) == 0 {
+		return errors.New("missing owner")
+	}
+
+	if object.AddressOf(obj) != prm.Address {
+		return errors.New("object address differs from the requested one")
+	}
+
+	cs, ok := obj.PayloadChecksum()
+	if !ok {
+		return errors.New("missing payload checksum")
+	}
+
+	if cs.Type() != checksum.SHA256 || len(cs.Value()) != sha256.Size {
+		return fmt.Errorf("invalid payload checksum %s", cs)
+	}
+
+	if err := objectSDK.VerifyID(obj); err != nil {
+		return fmt.Errorf("identifier does not match the header: %w", err)
+	}
+
+	if err := checkSignatureFormat(obj); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if c.validatePayload {
+		if err := objectSDK.VerifyPayloadChecksum(obj); err != nil {
+			return fmt.Errorf("payload checksum mismatch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkSignatureFormat checks that the object signature is set and its
+// public key is valid. The signature itself is not verified.
+func checkSignatureFormat(obj *objectSDK.Object) error {
+	sig := obj.Signature()
+	if sig == nil {
+		return errors.New("missing signature")
+	}
+
+	var sigV2 refs.Signature
+	sig.WriteToV2(&sigV2)
+
+	if len(sigV2.GetSign()) == 0 {
+		return errors.New("empty signature value")
+	}
+
+	var key neofsecdsa.PublicKey
+
+	err := key.Decode(sigV2.GetKey())
+	if err != nil {
+		return fmt.Errorf("decode public key: %w", err)
+	}
+
+	return nil
+}
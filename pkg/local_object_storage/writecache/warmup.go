@@ -0,0 +1,54 @@
+package writecache
+
+import (
+	"context"
+
+	"go.etcd.io/bbolt"
+)
+
+// warmUpCheckInterval is the number of the objects read between
+// the checks of the context.
+const warmUpCheckInterval = 256
+
+// WarmUp sequentially reads the small objects database, so its pages are
+// loaded to the OS page cache. Reading stops when the byte limit (if non-zero)
+// is reached or the context is done. Returns the number of bytes read.
+//
+// Does nothing if the write-cache is in degraded mode.
+func (c *cache) WarmUp(ctx context.Context, byteLimit uint64) (uint64, error) {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.mode.NoMetabase() || c.db == nil {
+		return 0, nil
+	}
+
+	var read uint64
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		if b == nil {
+			return nil
+		}
+
+		var n int
+
+		cur := b.Cursor()
+		for k, v := cur.First(); k != nil; k, v = cur.Next() {
+			read += uint64(len(k) + len(v))
+			if byteLimit > 0 && read >= byteLimit {
+				return nil
+			}
+
+			if n++; n%warmUpCheckInterval == 0 {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return read, err
+}
@@ -1,14 +1,19 @@
 package writecache
 
 import (
+	"context"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -16,6 +21,14 @@ import (
 type Info struct {
 	// Full path to the write-cache.
 	Path string
+
+	// DBBacklog is the estimated number of the small objects
+	// stored in the database which are not flushed yet.
+	DBBacklog uint64
+
+	// FSBacklog is the estimated number of the big objects
+	// stored in FSTree which are not flushed yet.
+	FSBacklog uint64
 }
 
 // Cache represents write-cache for objects.
@@ -28,7 +41,13 @@ type Cache interface {
 	SetMode(mode.Mode) error
 	SetLogger(*zap.Logger)
 	DumpInfo() Info
-	Flush(bool) error
+	Flush(FlushPrm) error
+	FlushTo(common.Storage, FlushPrm) error
+	Drain(context.Context) (uint64, error)
+	Stats() Stats
+	ObjectStatus(oid.Address) (ObjectStatus, error)
+	WarmUp(ctx context.Context, byteLimit uint64) (uint64, error)
+	Dump(io.Writer) error
 
 	Init() error
 	Open(readOnly bool) error
@@ -38,26 +57,47 @@ type Cache interface {
 type cache struct {
 	options
 
-	// mtx protects statistics, counters and compressFlags.
+	// mtx protects statistics, counters, compressFlags and flushing.
 	mtx sync.RWMutex
 
 	mode    mode.Mode
 	modeMtx sync.RWMutex
+	// flushMtx is held in shared mode by flush workers while an object
+	// is written to the main storage. SetMode takes it exclusively, so the mode
+	// is changed only when there are no flush writes in progress.
+	flushMtx sync.RWMutex
 
 	// compressFlags maps address of a big object to boolean value indicating
 	// whether object should be compressed.
 	compressFlags map[string]struct{}
+	// flushing contains addresses of the objects which are being
+	// flushed to the main storage at the moment.
+	flushing map[string]struct{}
 
 	// flushCh is a channel with objects to flush.
 	flushCh chan *object.Object
 	// closeCh is close channel.
 	closeCh chan struct{}
-	// wg is a wait group for flush workers.
+	// shutdownCh is closed when the shutdown timeout expires after the
+	// write-cache is closed.
+	shutdownCh chan struct{}
+	// wg is a wait group for the routines passing objects to flush.
 	wg sync.WaitGroup
+	// workersWg is a wait group for flush workers.
+	workersWg sync.WaitGroup
 	// store contains underlying database.
 	store
 	// fsTree contains big files stored directly on file-system.
 	fsTree *fstree.FSTree
+	// errLog aggregates repeated flush errors.
+	errLog *logger.Suppressor
+	// latency contains durations of the latest object flushes.
+	latency flushLatency
+	// bigFlushCursor is the address of the last big object processed by the
+	// interrupted flush cycle. Nil if the last cycle is completed.
+	bigFlushCursor *oid.Address
+	// quarantined is the number of the files in the quarantine directory.
+	quarantined atomic.Uint64
 }
 
 type objectInfo struct {
@@ -70,6 +110,11 @@ const (
 	defaultMaxObjectSize   = 64 * 1024 * 1024 // 64 MiB
 	defaultSmallObjectSize = 32 * 1024        // 32 KiB
 	defaultMaxCacheSize    = 1 << 30          // 1 GiB
+
+	defaultErrorLogInterval   = time.Minute
+	defaultSlowFlushThreshold = time.Second
+	defaultShutdownTimeout    = 10 * time.Second
+	defaultBigFlushTime       = 5 * defaultFlushInterval
 )
 
 var (
@@ -83,14 +128,22 @@ func New(opts ...Option) Cache {
 		mode:    mode.ReadWrite,
 
 		compressFlags: make(map[string]struct{}),
+		flushing:      make(map[string]struct{}),
 		options: options{
 			log:             zap.NewNop(),
 			maxObjectSize:   defaultMaxObjectSize,
 			smallObjectSize: defaultSmallObjectSize,
 			workersCount:    defaultFlushWorkersCount,
+			flushInterval:   defaultFlushInterval,
 			maxCacheSize:    defaultMaxCacheSize,
 			maxBatchSize:    bbolt.DefaultMaxBatchSize,
 			maxBatchDelay:   bbolt.DefaultMaxBatchDelay,
+
+			errorLogInterval:   defaultErrorLogInterval,
+			slowFlushThreshold: defaultSlowFlushThreshold,
+			shutdownTimeout:    defaultShutdownTimeout,
+			bigFlushTime:       defaultBigFlushTime,
+			metrics:            noopMetrics{},
 		},
 	}
 
@@ -99,10 +152,17 @@ func New(opts ...Option) Cache {
 	}
 
 	// Make the LRU cache contain which take approximately 3/4 of the maximum space.
-	// Assume small and big objects are stored in 50-50 proportion.
+	// Assume small and big objects are stored in 50-50 proportion. Only the big
+	// objects are kept after the flush normally, but the read-only database may
+	// need the marks for the small ones.
 	c.maxFlushedMarksCount = int(c.maxCacheSize/c.maxObjectSize+c.maxCacheSize/c.smallObjectSize) / 2 * 3 / 4
+	if c.maxFlushedMarksCount < 1 {
+		// LRU cache can't be created for the tiny write-caches otherwise
+		c.maxFlushedMarksCount = 1
+	}
 	// Trigger the removal when the cache is 7/8 full, so that new items can still arrive.
 	c.maxRemoveBatchSize = c.maxFlushedMarksCount / 8
+	c.errLog = logger.NewSuppressor(c.log, c.errorLogInterval)
 
 	return c
 }
@@ -113,8 +173,12 @@ func (c *cache) SetLogger(l *zap.Logger) {
 }
 
 func (c *cache) DumpInfo() Info {
+	db, fs := c.backlog()
+
 	return Info{
-		Path: c.path,
+		Path:      c.path,
+		DBBacklog: db,
+		FSBacklog: fs,
 	}
 }
 
@@ -126,33 +190,47 @@ func (c *cache) Open(readOnly bool) error {
 	}
 
 	// Opening after Close is done during maintenance mode,
-	// thus we need to create a channel here.
+	// thus we need to create channels here.
+	c.flushCh = make(chan *object.Object)
 	c.closeCh = make(chan struct{})
+	c.shutdownCh = make(chan struct{})
 
 	return c.initCounters()
 }
 
 // Init runs necessary services.
 func (c *cache) Init() error {
+	// Logger could have been changed with SetLogger.
+	c.errLog = logger.NewSuppressor(c.log, c.errorLogInterval)
+	if c.repairOnInit && !c.readOnly() {
+		c.repairFSTree()
+	}
+	c.quarantined.Store(c.countQuarantined())
+	c.migrateObjects()
 	c.initFlushMarks()
+
+	c.latency.mtx.Lock()
+	c.latency.last = time.Now()
+	c.latency.mtx.Unlock()
+
 	c.runFlushLoop()
 	return nil
 }
 
 // Close closes db connection and stops services. Executes ObjectCounters.FlushAndClose op.
+//
+// No object put to the write-cache is lost on Close: the objects which
+// have not been flushed until the shutdown timeout expires are kept in
+// the write-cache and flushed after the next start.
 func (c *cache) Close() error {
+	c.stopFlush()
+
 	// Finish all in-progress operations.
 	if err := c.SetMode(mode.ReadOnly); err != nil {
 		return err
 	}
 
-	if c.closeCh != nil {
-		close(c.closeCh)
-	}
-	c.wg.Wait()
-	if c.closeCh != nil {
-		c.closeCh = nil
-	}
+	c.errLog.Flush()
 
 	var err error
 	if c.db != nil {
@@ -19,10 +19,36 @@ type (
 		rangeDuration                 prometheus.Counter
 		searchDuration                prometheus.Counter
 		listObjectsDuration           prometheus.Counter
+
+		shardsMode            *prometheus.GaugeVec
+		gcBacklog             prometheus.Gauge
+		expiredBacklog        prometheus.Gauge
+		writeCachePending     prometheus.Gauge
+		writeCacheQuarantined prometheus.Gauge
+		evacuatedObjects      *prometheus.GaugeVec
+		evacuationsRunning    *prometheus.GaugeVec
+		metabaseTxWait        *prometheus.CounterVec
+
+		writeCacheFlushedObjects *prometheus.CounterVec
+		writeCacheFlushedBytes   *prometheus.CounterVec
+		writeCacheFlushErrors    *prometheus.CounterVec
+		writeCacheBacklog        *prometheus.GaugeVec
+		writeCacheObjects        *prometheus.GaugeVec
+		writeCacheSize           *prometheus.GaugeVec
+		writeCacheFlushQueue     *prometheus.GaugeVec
+		writeCacheFlushedMarks   *prometheus.GaugeVec
 	}
 )
 
-const engineSubsystem = "engine"
+const (
+	engineSubsystem = "engine"
+
+	shardModeLabelKey = "mode"
+
+	txPriorityLabelKey = "priority"
+
+	writeCacheStorageLabelKey = "storage"
+)
 
 func newEngineMetrics() engineMetrics {
 	var (
@@ -102,6 +128,142 @@ func newEngineMetrics() engineMetrics {
 			Name:      "list_objects_duration",
 			Help:      "Accumulated duration of engine list objects operations",
 		})
+
+		shardsMode = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "shards",
+			Help:      "Number of shards in each mode",
+		},
+			[]string{shardModeLabelKey},
+		)
+
+		gcBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_backlog",
+			Help:      "Number of objects waiting for the GC in all shards",
+		})
+
+		expiredBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "expired_backlog",
+			Help:      "Number of expired objects waiting to be marked by the GC in all shards",
+		})
+
+		writeCachePending = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_pending",
+			Help:      "Number of objects waiting for the flush in all write-caches",
+		})
+
+		writeCacheQuarantined = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_quarantined",
+			Help:      "Number of invalid files moved to the quarantine directories of all write-caches",
+		})
+
+		evacuatedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "evacuated_objects",
+			Help:      "Number of objects evacuated from the shard by the last evacuation",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		evacuationsRunning = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "evacuation_running",
+			Help:      "Whether the shard is being evacuated (1) or not (0)",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		metabaseTxWait = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "metabase_tx_wait_time",
+			Help:      "Accumulated time in nanoseconds the metabase write operations waited for the transaction limit",
+		},
+			[]string{shardIDLabelKey, txPriorityLabelKey},
+		)
+
+		writeCacheFlushedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_flushed_objects",
+			Help:      "Number of objects flushed from the shard write-cache to the blobstor",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheFlushedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_flushed_bytes",
+			Help:      "Size of objects flushed from the shard write-cache to the blobstor",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheFlushErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_flush_errors",
+			Help:      "Number of objects failed to be flushed from the shard write-cache to the blobstor",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheBacklog = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_backlog",
+			Help:      "Number of objects in the shard write-cache database not flushed yet as of the last flush pass",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_objects",
+			Help:      "Number of objects stored in the shard write-cache",
+		},
+			[]string{shardIDLabelKey, writeCacheStorageLabelKey},
+		)
+
+		writeCacheSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_size",
+			Help:      "Estimated size of objects stored in the shard write-cache",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheFlushQueue = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_flush_queue",
+			Help:      "Number of objects taken by the shard write-cache background flush and not written yet",
+		},
+			[]string{shardIDLabelKey},
+		)
+
+		writeCacheFlushedMarks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "write_cache_flushed_marks",
+			Help:      "Number of flushed objects not removed from the shard write-cache yet",
+		},
+			[]string{shardIDLabelKey},
+		)
 	)
 
 	return engineMetrics{
@@ -116,6 +278,22 @@ func newEngineMetrics() engineMetrics {
 		rangeDuration:                 rangeDuration,
 		searchDuration:                searchDuration,
 		listObjectsDuration:           listObjectsDuration,
+		shardsMode:                    shardsMode,
+		gcBacklog:                     gcBacklog,
+		expiredBacklog:                expiredBacklog,
+		writeCachePending:             writeCachePending,
+		writeCacheQuarantined:         writeCacheQuarantined,
+		evacuatedObjects:              evacuatedObjects,
+		evacuationsRunning:            evacuationsRunning,
+		metabaseTxWait:                metabaseTxWait,
+		writeCacheFlushedObjects:      writeCacheFlushedObjects,
+		writeCacheFlushedBytes:        writeCacheFlushedBytes,
+		writeCacheFlushErrors:         writeCacheFlushErrors,
+		writeCacheBacklog:             writeCacheBacklog,
+		writeCacheObjects:             writeCacheObjects,
+		writeCacheSize:                writeCacheSize,
+		writeCacheFlushQueue:          writeCacheFlushQueue,
+		writeCacheFlushedMarks:        writeCacheFlushedMarks,
 	}
 }
 
@@ -131,6 +309,22 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.rangeDuration)
 	prometheus.MustRegister(m.searchDuration)
 	prometheus.MustRegister(m.listObjectsDuration)
+	prometheus.MustRegister(m.shardsMode)
+	prometheus.MustRegister(m.gcBacklog)
+	prometheus.MustRegister(m.expiredBacklog)
+	prometheus.MustRegister(m.writeCachePending)
+	prometheus.MustRegister(m.writeCacheQuarantined)
+	prometheus.MustRegister(m.evacuatedObjects)
+	prometheus.MustRegister(m.evacuationsRunning)
+	prometheus.MustRegister(m.metabaseTxWait)
+	prometheus.MustRegister(m.writeCacheFlushedObjects)
+	prometheus.MustRegister(m.writeCacheFlushedBytes)
+	prometheus.MustRegister(m.writeCacheFlushErrors)
+	prometheus.MustRegister(m.writeCacheBacklog)
+	prometheus.MustRegister(m.writeCacheObjects)
+	prometheus.MustRegister(m.writeCacheSize)
+	prometheus.MustRegister(m.writeCacheFlushQueue)
+	prometheus.MustRegister(m.writeCacheFlushedMarks)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -176,3 +370,94 @@ func (m engineMetrics) AddSearchDuration(d time.Duration) {
 func (m engineMetrics) AddListObjectsDuration(d time.Duration) {
 	m.listObjectsDuration.Add(float64(d))
 }
+
+func (m engineMetrics) SetShardsInMode(mode string, v int) {
+	m.shardsMode.With(
+		prometheus.Labels{
+			shardModeLabelKey: mode,
+		},
+	).Set(float64(v))
+}
+
+func (m engineMetrics) SetGCBacklog(v uint64) {
+	m.gcBacklog.Set(float64(v))
+}
+
+func (m engineMetrics) SetExpiredBacklog(v uint64) {
+	m.expiredBacklog.Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCachePending(v uint64) {
+	m.writeCachePending.Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCacheQuarantined(v uint64) {
+	m.writeCacheQuarantined.Set(float64(v))
+}
+
+func (m engineMetrics) SetEvacuationProgress(shardID string, evacuated uint64, running bool) {
+	labels := prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}
+
+	var v float64
+	if running {
+		v = 1
+	}
+
+	m.evacuatedObjects.With(labels).Set(float64(evacuated))
+	m.evacuationsRunning.With(labels).Set(v)
+}
+
+func (m engineMetrics) AddMetabaseTxWaitDuration(shardID, priority string, d time.Duration) {
+	m.metabaseTxWait.With(prometheus.Labels{
+		shardIDLabelKey:    shardID,
+		txPriorityLabelKey: priority,
+	}).Add(float64(d))
+}
+
+func (m engineMetrics) AddWriteCacheFlushedObject(shardID string, size uint64) {
+	labels := prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}
+
+	m.writeCacheFlushedObjects.With(labels).Inc()
+	m.writeCacheFlushedBytes.With(labels).Add(float64(size))
+}
+
+func (m engineMetrics) IncWriteCacheFlushErrors(shardID string) {
+	m.writeCacheFlushErrors.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}).Inc()
+}
+
+func (m engineMetrics) SetWriteCacheBacklog(shardID string, v uint64) {
+	m.writeCacheBacklog.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}).Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCacheObjects(shardID, storage string, v uint64) {
+	m.writeCacheObjects.With(prometheus.Labels{
+		shardIDLabelKey:           shardID,
+		writeCacheStorageLabelKey: storage,
+	}).Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCacheSize(shardID string, v uint64) {
+	m.writeCacheSize.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}).Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCacheFlushQueue(shardID string, v uint64) {
+	m.writeCacheFlushQueue.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}).Set(float64(v))
+}
+
+func (m engineMetrics) SetWriteCacheFlushedMarks(shardID string, v uint64) {
+	m.writeCacheFlushedMarks.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+	}).Set(float64(v))
+}
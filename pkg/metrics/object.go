@@ -30,12 +30,20 @@ type (
 		getPayload prometheus.Counter
 
 		shardMetrics *prometheus.GaugeVec
+
+		readCacheMetrics *prometheus.CounterVec
+		rangeReadMetrics *prometheus.CounterVec
+
+		auditDropped prometheus.Counter
 	}
 )
 
 const (
 	shardIDLabelKey     = "shard"
 	counterTypeLabelKey = "type"
+
+	readCacheResultLabelKey = "result"
+	rangeReadTypeLabelKey   = "read"
 )
 
 func newObjectServiceMetrics() objectServiceMetrics {
@@ -164,6 +172,31 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		},
 			[]string{shardIDLabelKey, counterTypeLabelKey},
 		)
+
+		readCacheMetrics = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "read_cache_requests",
+			Help:      "Number of shard read cache lookups per result",
+		},
+			[]string{shardIDLabelKey, readCacheResultLabelKey},
+		)
+
+		rangeReadMetrics = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "range_reads",
+			Help:      "Number of shard storage reads of object payload ranges per read type",
+		},
+			[]string{shardIDLabelKey, rangeReadTypeLabelKey},
+		)
+
+		auditDropped = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "audit_dropped_records",
+			Help:      "Number of object audit records which were not written",
+		})
 	)
 
 	return objectServiceMetrics{
@@ -184,6 +217,9 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		putPayload:        putPayload,
 		getPayload:        getPayload,
 		shardMetrics:      shardsMetrics,
+		readCacheMetrics:  readCacheMetrics,
+		rangeReadMetrics:  rangeReadMetrics,
+		auditDropped:      auditDropped,
 	}
 }
 
@@ -208,6 +244,10 @@ func (m objectServiceMetrics) register() {
 	prometheus.MustRegister(m.getPayload)
 
 	prometheus.MustRegister(m.shardMetrics)
+	prometheus.MustRegister(m.readCacheMetrics)
+	prometheus.MustRegister(m.rangeReadMetrics)
+
+	prometheus.MustRegister(m.auditDropped)
 }
 
 func (m objectServiceMetrics) IncGetReqCounter() {
@@ -291,3 +331,35 @@ func (m objectServiceMetrics) SetObjectCounter(shardID, objectType string, v uin
 		},
 	).Set(float64(v))
 }
+
+func (m objectServiceMetrics) IncReadCacheCounter(shardID string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.readCacheMetrics.With(
+		prometheus.Labels{
+			shardIDLabelKey:         shardID,
+			readCacheResultLabelKey: result,
+		},
+	).Inc()
+}
+
+func (m objectServiceMetrics) IncRangeReadCounter(shardID string, full bool) {
+	read := "ranged"
+	if full {
+		read = "full"
+	}
+
+	m.rangeReadMetrics.With(
+		prometheus.Labels{
+			shardIDLabelKey:       shardID,
+			rangeReadTypeLabelKey: read,
+		},
+	).Inc()
+}
+
+func (m objectServiceMetrics) IncAuditDroppedCounter() {
+	m.auditDropped.Inc()
+}
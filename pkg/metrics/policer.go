@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const policerSubsystem = "policer"
+
+const taskResultLabelKey = "result"
+
+type policerMetrics struct {
+	inspectedObjects       prometheus.Counter
+	underReplicatedObjects prometheus.Counter
+	replicationTasks       prometheus.Counter
+	replicationTaskResults *prometheus.CounterVec
+	lastPassTime           prometheus.Gauge
+}
+
+func newPolicerMetrics() policerMetrics {
+	return policerMetrics{
+		inspectedObjects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "inspected_objects_count",
+			Help:      "Number of objects checked for compliance with the storage policy",
+		}),
+		underReplicatedObjects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "under_replicated_objects_count",
+			Help:      "Number of inspected objects lacking copies",
+		}),
+		replicationTasks: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "replication_tasks_count",
+			Help:      "Number of replication tasks passed to the replicator",
+		}),
+		replicationTaskResults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "replication_task_results_count",
+			Help:      "Number of finished replication tasks by result",
+		}, []string{taskResultLabelKey}),
+		lastPassTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "last_pass_time",
+			Help:      "Unix time of the last completed pass over the local objects",
+		}),
+	}
+}
+
+func (m policerMetrics) register() {
+	prometheus.MustRegister(m.inspectedObjects)
+	prometheus.MustRegister(m.underReplicatedObjects)
+	prometheus.MustRegister(m.replicationTasks)
+	prometheus.MustRegister(m.replicationTaskResults)
+	prometheus.MustRegister(m.lastPassTime)
+}
+
+func (m policerMetrics) IncInspectedObjects() {
+	m.inspectedObjects.Inc()
+}
+
+func (m policerMetrics) IncUnderReplicatedObjects() {
+	m.underReplicatedObjects.Inc()
+}
+
+func (m policerMetrics) IncReplicationTasks() {
+	m.replicationTasks.Inc()
+}
+
+func (m policerMetrics) IncReplicationTaskResults(success bool) {
+	result := "failed"
+	if success {
+		result = "completed"
+	}
+
+	m.replicationTaskResults.With(prometheus.Labels{taskResultLabelKey: result}).Inc()
+}
+
+func (m policerMetrics) SetLastPassTime(t time.Time) {
+	m.lastPassTime.Set(float64(t.Unix()))
+}
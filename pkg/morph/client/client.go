@@ -464,6 +464,24 @@ func (c *Client) BlockCount() (res uint32, err error) {
 	return c.rpcActor.GetBlockCount()
 }
 
+// Endpoint returns the address of the RPC node the client is connected to.
+// Returns false if the connection to all the RPC nodes is lost. The address
+// is empty if the client has been created with WithSingleClient.
+func (c *Client) Endpoint() (string, bool) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return "", false
+	}
+
+	if len(c.endpoints.list) == 0 {
+		return "", true
+	}
+
+	return c.endpoints.list[c.endpoints.curr].Address, true
+}
+
 // MsPerBlock returns MillisecondsPerBlock network parameter.
 func (c *Client) MsPerBlock() (res int64, err error) {
 	c.switchLock.RLock()
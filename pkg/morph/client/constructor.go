@@ -44,6 +44,9 @@ type cfg struct {
 	singleCli *rpcclient.WSClient // neo-go client for single client mode
 
 	inactiveModeCb Callback
+
+	invokeRetries    int
+	invokeRetryDelay time.Duration
 }
 
 const (
@@ -53,10 +56,12 @@ const (
 
 func defaultConfig() *cfg {
 	return &cfg{
-		ctx:          context.Background(),
-		dialTimeout:  defaultDialTimeout,
-		logger:       zap.L(),
-		waitInterval: defaultWaitInterval,
+		ctx:              context.Background(),
+		dialTimeout:      defaultDialTimeout,
+		logger:           zap.L(),
+		waitInterval:     defaultWaitInterval,
+		invokeRetries:    defaultInvokeRetries,
+		invokeRetryDelay: defaultInvokeRetryDelay,
 		signer: &transaction.Signer{
 			Scopes: transaction.Global,
 		},
@@ -75,6 +80,7 @@ func defaultConfig() *cfg {
 //   - blockchain network type: netmode.PrivNet;
 //   - signer with the global scope;
 //   - wait interval: 500ms;
+//   - invocation retries: 3 with 1s base delay;
 //   - logger: zap.L().
 //
 // If desired option satisfies the default value, it can be omitted.
@@ -289,3 +295,20 @@ func WithConnLostCallback(cb Callback) Option {
 		c.inactiveModeCb = cb
 	}
 }
+
+// WithInvokeRetries returns a client constructor option
+// that specifies the number of retries of the contract
+// invocations failed with temporary errors and the base
+// delay between them. Random jitter is added to the delay.
+//
+// Ignores negative values. Zero number disables retries.
+func WithInvokeRetries(n int, delay time.Duration) Option {
+	return func(c *cfg) {
+		if n >= 0 {
+			c.invokeRetries = n
+		}
+		if delay >= 0 {
+			c.invokeRetryDelay = delay
+		}
+	}
+}
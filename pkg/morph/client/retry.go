@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInvokeRetries    = 3
+	defaultInvokeRetryDelay = time.Second
+)
+
+// invokeErrorClass describes how the invocation error must be handled.
+type invokeErrorClass uint8
+
+const (
+	// invokePermanentError is the error that is not going to disappear if
+	// the invocation is repeated (e.g. failed witness check or insufficient
+	// GAS), it is returned as is.
+	invokePermanentError invokeErrorClass = iota
+
+	// invokeTemporaryError is the error that can disappear if the invocation
+	// is repeated later. Such errors usually appear in a short window around
+	// the epoch change (the transaction has expired or the Alphabet has just
+	// been changed).
+	invokeTemporaryError
+
+	// invokeAlreadySent is the error meaning that the same transaction has
+	// already been accepted by the network, so the invocation is successful.
+	invokeAlreadySent
+)
+
+// alreadySentInvokeErrors contains messages of the errors returned when
+// the transaction is already in the mempool or on chain.
+var alreadySentInvokeErrors = []string{
+	"already exists in mempool",
+	"already in the memory pool",
+	"already on chain",
+}
+
+// temporaryInvokeErrors contains messages of the temporary invocation errors.
+var temporaryInvokeErrors = []string{
+	"transaction has expired",
+	"invalid validator",
+	"unknown validator",
+}
+
+// classifyInvokeError returns the class of the invocation error.
+// ErrConnectionLost is permanent since the Client is in the inactive mode.
+func classifyInvokeError(err error) invokeErrorClass {
+	if errors.Is(err, ErrConnectionLost) {
+		return invokePermanentError
+	}
+
+	msg := err.Error()
+	for i := range alreadySentInvokeErrors {
+		if strings.Contains(msg, alreadySentInvokeErrors[i]) {
+			return invokeAlreadySent
+		}
+	}
+
+	for i := range temporaryInvokeErrors {
+		if strings.Contains(msg, temporaryInvokeErrors[i]) {
+			return invokeTemporaryError
+		}
+	}
+
+	return invokePermanentError
+}
+
+// invokeWithRetries calls f until it succeeds, returns permanent error
+// or the number of retries configured for the Client is exceeded. Retries
+// are delayed with a random jitter. Errors meaning that the transaction has
+// already been sent are treated as a success.
+func (c *Client) invokeWithRetries(method string, f func() error) error {
+	for i := 0; ; i++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+
+		switch classifyInvokeError(err) {
+		case invokeAlreadySent:
+			c.logger.Debug("contract invocation has already been sent",
+				zap.String("method", method),
+				zap.String("reason", err.Error()))
+
+			return nil
+		case invokePermanentError:
+			return err
+		}
+
+		if i >= c.cfg.invokeRetries {
+			return err
+		}
+
+		delay := c.cfg.invokeRetryDelay
+		if delay > 0 {
+			delay += time.Duration(rand.Int63n(int64(delay)))
+		}
+
+		c.logger.Debug("retrying contract invocation",
+			zap.String("method", method),
+			zap.Int("attempt", i+1),
+			zap.Duration("delay", delay),
+			zap.String("reason", err.Error()))
+
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// scriptedInvoker fails invocations with the predefined errors
+// and counts the calls.
+type scriptedInvoker struct {
+	errs  []error
+	calls int
+}
+
+func (s *scriptedInvoker) invoke() error {
+	s.calls++
+
+	if s.calls > len(s.errs) {
+		return nil
+	}
+	return s.errs[s.calls-1]
+}
+
+func newRetryTestClient(retries int) (*Client, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	return &Client{
+		logger: zap.New(core),
+		cfg: cfg{
+			invokeRetries:    retries,
+			invokeRetryDelay: time.Millisecond,
+		},
+	}, logs
+}
+
+func TestClassifyInvokeError(t *testing.T) {
+	for _, tc := range []struct {
+		err   error
+		class invokeErrorClass
+	}{
+		{errors.New("method not found"), invokePermanentError},
+		{ErrConnectionLost, invokePermanentError},
+		{errors.New("insufficient funds"), invokePermanentError},
+		{errors.New("witness check failed"), invokePermanentError},
+		{errors.New("transaction has expired"), invokeTemporaryError},
+		{errors.New("invalid validator"), invokeTemporaryError},
+		{errors.New("unknown validator"), invokeTemporaryError},
+		{errors.New("already exists in mempool"), invokeAlreadySent},
+		{errors.New("already in the memory pool"), invokeAlreadySent},
+		{errors.New("already on chain"), invokeAlreadySent},
+	} {
+		err := fmt.Errorf("could not invoke method: %w", tc.err)
+		require.Equal(t, tc.class, classifyInvokeError(err), tc.err.Error())
+	}
+}
+
+func TestClient_InvokeWithRetries(t *testing.T) {
+	errExpired := errors.New("rpc error: transaction has expired")
+
+	t.Run("success after retries", func(t *testing.T) {
+		c, logs := newRetryTestClient(3)
+		inv := &scriptedInvoker{errs: []error{errExpired, errExpired}}
+
+		require.NoError(t, c.invokeWithRetries("put", inv.invoke))
+		require.Equal(t, 3, inv.calls)
+
+		entries := logs.FilterMessage("retrying contract invocation").All()
+		require.Len(t, entries, 2)
+		require.Equal(t, "put", entries[0].ContextMap()["method"])
+		require.Equal(t, errExpired.Error(), entries[0].ContextMap()["reason"])
+	})
+
+	t.Run("retries exceeded", func(t *testing.T) {
+		c, _ := newRetryTestClient(2)
+		inv := &scriptedInvoker{errs: []error{errExpired, errExpired, errExpired, errExpired}}
+
+		require.ErrorIs(t, c.invokeWithRetries("put", inv.invoke), errExpired)
+		require.Equal(t, 3, inv.calls)
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		c, _ := newRetryTestClient(0)
+		inv := &scriptedInvoker{errs: []error{errExpired}}
+
+		require.ErrorIs(t, c.invokeWithRetries("put", inv.invoke), errExpired)
+		require.Equal(t, 1, inv.calls)
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		for _, msg := range []string{"method not found", "insufficient funds", "witness check failed"} {
+			c, logs := newRetryTestClient(3)
+			errFatal := errors.New(msg)
+			inv := &scriptedInvoker{errs: []error{errFatal}}
+
+			require.ErrorIs(t, c.invokeWithRetries("put", inv.invoke), errFatal, msg)
+			require.Equal(t, 1, inv.calls, msg)
+			require.Zero(t, logs.Len(), msg)
+		}
+	})
+
+	t.Run("already sent", func(t *testing.T) {
+		c, logs := newRetryTestClient(3)
+		inv := &scriptedInvoker{errs: []error{errExpired, errors.New("already exists in mempool")}}
+
+		require.NoError(t, c.invokeWithRetries("put", inv.invoke))
+		require.Equal(t, 2, inv.calls)
+		require.Equal(t, 1, logs.FilterMessage("contract invocation has already been sent").Len())
+	})
+}
@@ -111,41 +111,50 @@ func (i *InvokePrmOptional) SetHash(hash util.Uint256) {
 //   - if AsAlphabet is provided, calls NotaryInvoke;
 //   - otherwise, calls NotaryInvokeNotAlpha.
 //
+// If notary support of the Client is disabled, NotaryInvoke and
+// NotaryInvokeNotAlpha send plain transaction.
+//
+// Invocations failed with temporary errors (e.g. around the epoch change)
+// are retried, see WithInvokeRetries. Invocation whose transaction is already
+// in the mempool or on chain is considered successful.
+//
 // If fee for the operation executed using specified method is customized, then StaticClient uses it.
 // Otherwise, default fee is used.
 func (s StaticClient) Invoke(prm InvokePrm) error {
 	fee := s.fees.feeForMethod(prm.method)
 
-	if s.tryNotary {
-		if s.alpha {
-			var (
-				nonce uint32 = 1
-				vubP  *uint32
-				vub   uint32
-				err   error
-			)
-
-			if prm.hash != nil {
-				nonce, vub, err = s.client.CalculateNonceAndVUB(*prm.hash)
-				if err != nil {
-					return fmt.Errorf("could not calculate nonce and VUB for notary alphabet invoke: %w", err)
+	return s.client.invokeWithRetries(prm.method, func() error {
+		if s.tryNotary {
+			if s.alpha {
+				var (
+					nonce uint32 = 1
+					vubP  *uint32
+					vub   uint32
+					err   error
+				)
+
+				if prm.hash != nil {
+					nonce, vub, err = s.client.CalculateNonceAndVUB(*prm.hash)
+					if err != nil {
+						return fmt.Errorf("could not calculate nonce and VUB for notary alphabet invoke: %w", err)
+					}
+
+					vubP = &vub
 				}
 
-				vubP = &vub
+				return s.client.NotaryInvoke(s.scScriptHash, fee, nonce, vubP, prm.method, prm.args...)
 			}
 
-			return s.client.NotaryInvoke(s.scScriptHash, fee, nonce, vubP, prm.method, prm.args...)
+			return s.client.NotaryInvokeNotAlpha(s.scScriptHash, fee, prm.method, prm.args...)
 		}
 
-		return s.client.NotaryInvokeNotAlpha(s.scScriptHash, fee, prm.method, prm.args...)
-	}
-
-	return s.client.Invoke(
-		s.scScriptHash,
-		fee,
-		prm.method,
-		prm.args...,
-	)
+		return s.client.Invoke(
+			s.scScriptHash,
+			fee,
+			prm.method,
+			prm.args...,
+		)
+	})
 }
 
 // TestInvokePrm groups parameters of the TestInvoke operation.
@@ -200,3 +200,291 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type resetShardErrorsResponseWrapper struct {
+	*ResetShardErrorsResponse
+}
+
+func (w *resetShardErrorsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ResetShardErrorsResponse
+}
+
+func (w *resetShardErrorsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ResetShardErrorsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ResetShardErrorsResponse)(nil))
+	}
+
+	w.ResetShardErrorsResponse = r
+	return nil
+}
+
+type checkShardResponseWrapper struct {
+	*CheckShardResponse
+}
+
+func (w *checkShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.CheckShardResponse
+}
+
+func (w *checkShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*CheckShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*CheckShardResponse)(nil))
+	}
+
+	w.CheckShardResponse = r
+	return nil
+}
+
+type objectStatusResponseWrapper struct {
+	*ObjectStatusResponse
+}
+
+func (w *objectStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ObjectStatusResponse
+}
+
+func (w *objectStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ObjectStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ObjectStatusResponse)(nil))
+	}
+
+	w.ObjectStatusResponse = r
+	return nil
+}
+
+type getNetmapStatusResponseWrapper struct {
+	*GetNetmapStatusResponse
+}
+
+func (w *getNetmapStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetNetmapStatusResponse
+}
+
+func (w *getNetmapStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetNetmapStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetNetmapStatusResponse)(nil))
+	}
+
+	w.GetNetmapStatusResponse = r
+	return nil
+}
+
+type getPolicerStatusResponseWrapper struct {
+	*GetPolicerStatusResponse
+}
+
+func (w *getPolicerStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetPolicerStatusResponse
+}
+
+func (w *getPolicerStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetPolicerStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetPolicerStatusResponse)(nil))
+	}
+
+	w.GetPolicerStatusResponse = r
+	return nil
+}
+
+type startFSTreeMigrationResponseWrapper struct {
+	*StartFSTreeMigrationResponse
+}
+
+func (w *startFSTreeMigrationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartFSTreeMigrationResponse
+}
+
+func (w *startFSTreeMigrationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartFSTreeMigrationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartFSTreeMigrationResponse)(nil))
+	}
+
+	w.StartFSTreeMigrationResponse = r
+	return nil
+}
+
+type stopFSTreeMigrationResponseWrapper struct {
+	*StopFSTreeMigrationResponse
+}
+
+func (w *stopFSTreeMigrationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopFSTreeMigrationResponse
+}
+
+func (w *stopFSTreeMigrationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopFSTreeMigrationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopFSTreeMigrationResponse)(nil))
+	}
+
+	w.StopFSTreeMigrationResponse = r
+	return nil
+}
+
+type getFSTreeMigrationStatusResponseWrapper struct {
+	*GetFSTreeMigrationStatusResponse
+}
+
+func (w *getFSTreeMigrationStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetFSTreeMigrationStatusResponse
+}
+
+func (w *getFSTreeMigrationStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetFSTreeMigrationStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetFSTreeMigrationStatusResponse)(nil))
+	}
+
+	w.GetFSTreeMigrationStatusResponse = r
+	return nil
+}
+
+type listLocksResponseWrapper struct {
+	*ListLocksResponse
+}
+
+func (w *listLocksResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ListLocksResponse
+}
+
+func (w *listLocksResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ListLocksResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ListLocksResponse)(nil))
+	}
+
+	w.ListLocksResponse = r
+	return nil
+}
+
+type removeLockResponseWrapper struct {
+	*RemoveLockResponse
+}
+
+func (w *removeLockResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RemoveLockResponse
+}
+
+func (w *removeLockResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RemoveLockResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RemoveLockResponse)(nil))
+	}
+
+	w.RemoveLockResponse = r
+	return nil
+}
+
+type objectLocksResponseWrapper struct {
+	*ObjectLocksResponse
+}
+
+func (w *objectLocksResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ObjectLocksResponse
+}
+
+func (w *objectLocksResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ObjectLocksResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ObjectLocksResponse)(nil))
+	}
+
+	w.ObjectLocksResponse = r
+	return nil
+}
+
+type dumpObjectResponseWrapper struct {
+	*DumpObjectResponse
+}
+
+func (w *dumpObjectResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.DumpObjectResponse
+}
+
+func (w *dumpObjectResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*DumpObjectResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*DumpObjectResponse)(nil))
+	}
+
+	w.DumpObjectResponse = r
+	return nil
+}
+
+type getGCStatsResponseWrapper struct {
+	*GetGCStatsResponse
+}
+
+func (w *getGCStatsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetGCStatsResponse
+}
+
+func (w *getGCStatsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetGCStatsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetGCStatsResponse)(nil))
+	}
+
+	w.GetGCStatsResponse = r
+	return nil
+}
+
+type getSupportBundleResponseWrapper struct {
+	*GetSupportBundleResponse
+}
+
+func (w *getSupportBundleResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetSupportBundleResponse
+}
+
+func (w *getSupportBundleResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetSupportBundleResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetSupportBundleResponse)(nil))
+	}
+
+	w.GetSupportBundleResponse = r
+	return nil
+}
+
+type setShardWriteCacheResponseWrapper struct {
+	*SetShardWriteCacheResponse
+}
+
+func (w *setShardWriteCacheResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.SetShardWriteCacheResponse
+}
+
+func (w *setShardWriteCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*SetShardWriteCacheResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*SetShardWriteCacheResponse)(nil))
+	}
+
+	w.SetShardWriteCacheResponse = r
+	return nil
+}
+
+type resyncMetabaseResponseWrapper struct {
+	*ResyncMetabaseResponse
+}
+
+func (w *resyncMetabaseResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ResyncMetabaseResponse
+}
+
+func (w *resyncMetabaseResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ResyncMetabaseResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ResyncMetabaseResponse)(nil))
+	}
+
+	w.ResyncMetabaseResponse = r
+	return nil
+}
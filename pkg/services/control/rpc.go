@@ -8,16 +8,36 @@ import (
 const serviceName = "control.ControlService"
 
 const (
-	rpcHealthCheck     = "HealthCheck"
-	rpcSetNetmapStatus = "SetNetmapStatus"
-	rpcDropObjects     = "DropObjects"
-	rpcListShards      = "ListShards"
-	rpcSetShardMode    = "SetShardMode"
-	rpcDumpShard       = "DumpShard"
-	rpcRestoreShard    = "RestoreShard"
-	rpcSynchronizeTree = "SynchronizeTree"
-	rpcEvacuateShard   = "EvacuateShard"
-	rpcFlushCache      = "FlushCache"
+	rpcHealthCheck      = "HealthCheck"
+	rpcSetNetmapStatus  = "SetNetmapStatus"
+	rpcDropObjects      = "DropObjects"
+	rpcListShards       = "ListShards"
+	rpcSetShardMode     = "SetShardMode"
+	rpcDumpShard        = "DumpShard"
+	rpcRestoreShard     = "RestoreShard"
+	rpcSynchronizeTree  = "SynchronizeTree"
+	rpcEvacuateShard    = "EvacuateShard"
+	rpcFlushCache       = "FlushCache"
+	rpcResetShardErrors = "ResetShardErrors"
+	rpcCheckShard       = "CheckShard"
+	rpcObjectStatus     = "ObjectStatus"
+	rpcGetNetmapStatus  = "GetNetmapStatus"
+	rpcGetPolicerStatus = "GetPolicerStatus"
+
+	rpcStartFSTreeMigration     = "StartFSTreeMigration"
+	rpcStopFSTreeMigration      = "StopFSTreeMigration"
+	rpcGetFSTreeMigrationStatus = "GetFSTreeMigrationStatus"
+
+	rpcListLocks   = "ListLocks"
+	rpcRemoveLock  = "RemoveLock"
+	rpcObjectLocks = "ObjectLocks"
+	rpcDumpObject  = "DumpObject"
+
+	rpcGetGCStats       = "GetGCStats"
+	rpcGetSupportBundle = "GetSupportBundle"
+
+	rpcSetShardWriteCache = "SetShardWriteCache"
+	rpcResyncMetabase     = "ResyncMetabase"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -191,3 +211,227 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// ResetShardErrors executes ControlService.ResetShardErrors RPC.
+func ResetShardErrors(cli *client.Client, req *ResetShardErrorsRequest, opts ...client.CallOption) (*ResetShardErrorsResponse, error) {
+	wResp := &resetShardErrorsResponseWrapper{new(ResetShardErrorsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcResetShardErrors), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ResetShardErrorsResponse, nil
+}
+
+// CheckShard executes ControlService.CheckShard RPC.
+func CheckShard(cli *client.Client, req *CheckShardRequest, opts ...client.CallOption) (*CheckShardResponse, error) {
+	wResp := &checkShardResponseWrapper{new(CheckShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcCheckShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.CheckShardResponse, nil
+}
+
+// ObjectStatus executes ControlService.ObjectStatus RPC.
+func ObjectStatus(cli *client.Client, req *ObjectStatusRequest, opts ...client.CallOption) (*ObjectStatusResponse, error) {
+	wResp := &objectStatusResponseWrapper{new(ObjectStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcObjectStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ObjectStatusResponse, nil
+}
+
+// GetNetmapStatus executes ControlService.GetNetmapStatus RPC.
+func GetNetmapStatus(cli *client.Client, req *GetNetmapStatusRequest, opts ...client.CallOption) (*GetNetmapStatusResponse, error) {
+	wResp := &getNetmapStatusResponseWrapper{new(GetNetmapStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetNetmapStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetNetmapStatusResponse, nil
+}
+
+// GetPolicerStatus executes ControlService.GetPolicerStatus RPC.
+func GetPolicerStatus(cli *client.Client, req *GetPolicerStatusRequest, opts ...client.CallOption) (*GetPolicerStatusResponse, error) {
+	wResp := &getPolicerStatusResponseWrapper{new(GetPolicerStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetPolicerStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetPolicerStatusResponse, nil
+}
+
+// StartFSTreeMigration executes ControlService.StartFSTreeMigration RPC.
+func StartFSTreeMigration(cli *client.Client, req *StartFSTreeMigrationRequest, opts ...client.CallOption) (*StartFSTreeMigrationResponse, error) {
+	wResp := &startFSTreeMigrationResponseWrapper{new(StartFSTreeMigrationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartFSTreeMigration), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartFSTreeMigrationResponse, nil
+}
+
+// StopFSTreeMigration executes ControlService.StopFSTreeMigration RPC.
+func StopFSTreeMigration(cli *client.Client, req *StopFSTreeMigrationRequest, opts ...client.CallOption) (*StopFSTreeMigrationResponse, error) {
+	wResp := &stopFSTreeMigrationResponseWrapper{new(StopFSTreeMigrationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopFSTreeMigration), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopFSTreeMigrationResponse, nil
+}
+
+// GetFSTreeMigrationStatus executes ControlService.GetFSTreeMigrationStatus RPC.
+func GetFSTreeMigrationStatus(cli *client.Client, req *GetFSTreeMigrationStatusRequest, opts ...client.CallOption) (*GetFSTreeMigrationStatusResponse, error) {
+	wResp := &getFSTreeMigrationStatusResponseWrapper{new(GetFSTreeMigrationStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetFSTreeMigrationStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetFSTreeMigrationStatusResponse, nil
+}
+
+// ListLocks executes ControlService.ListLocks RPC.
+func ListLocks(cli *client.Client, req *ListLocksRequest, opts ...client.CallOption) (*ListLocksResponse, error) {
+	wResp := &listLocksResponseWrapper{new(ListLocksResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListLocks), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ListLocksResponse, nil
+}
+
+// RemoveLock executes ControlService.RemoveLock RPC.
+func RemoveLock(cli *client.Client, req *RemoveLockRequest, opts ...client.CallOption) (*RemoveLockResponse, error) {
+	wResp := &removeLockResponseWrapper{new(RemoveLockResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRemoveLock), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.RemoveLockResponse, nil
+}
+
+// ObjectLocks executes ControlService.ObjectLocks RPC.
+func ObjectLocks(cli *client.Client, req *ObjectLocksRequest, opts ...client.CallOption) (*ObjectLocksResponse, error) {
+	wResp := &objectLocksResponseWrapper{new(ObjectLocksResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcObjectLocks), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ObjectLocksResponse, nil
+}
+
+// DumpObjectResponseReader reads the responses of the DumpObject stream.
+type DumpObjectResponseReader struct {
+	r client.MessageReader
+}
+
+// Read reads the next response of the stream. Returns io.EOF after the last
+// response.
+func (r *DumpObjectResponseReader) Read() (*DumpObjectResponse, error) {
+	wResp := &dumpObjectResponseWrapper{new(DumpObjectResponse)}
+
+	if err := r.r.ReadMessage(wResp); err != nil {
+		return nil, err
+	}
+
+	return wResp.DumpObjectResponse, nil
+}
+
+// DumpObject executes ControlService.DumpObject RPC.
+func DumpObject(cli *client.Client, req *DumpObjectRequest, opts ...client.CallOption) (*DumpObjectResponseReader, error) {
+	wReq := &requestWrapper{m: req}
+
+	r, err := client.OpenServerStream(cli, common.CallMethodInfoServerStream(serviceName, rpcDumpObject), wReq, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DumpObjectResponseReader{r: r}, nil
+}
+
+// GetGCStats executes ControlService.GetGCStats RPC.
+func GetGCStats(cli *client.Client, req *GetGCStatsRequest, opts ...client.CallOption) (*GetGCStatsResponse, error) {
+	wResp := &getGCStatsResponseWrapper{new(GetGCStatsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetGCStats), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetGCStatsResponse, nil
+}
+
+// GetSupportBundle executes ControlService.GetSupportBundle RPC.
+func GetSupportBundle(cli *client.Client, req *GetSupportBundleRequest, opts ...client.CallOption) (*GetSupportBundleResponse, error) {
+	wResp := &getSupportBundleResponseWrapper{new(GetSupportBundleResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetSupportBundle), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetSupportBundleResponse, nil
+}
+
+// SetShardWriteCache executes ControlService.SetShardWriteCache RPC.
+func SetShardWriteCache(cli *client.Client, req *SetShardWriteCacheRequest, opts ...client.CallOption) (*SetShardWriteCacheResponse, error) {
+	wResp := &setShardWriteCacheResponseWrapper{new(SetShardWriteCacheResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetShardWriteCache), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.SetShardWriteCacheResponse, nil
+}
+
+// ResyncMetabase executes ControlService.ResyncMetabase RPC.
+func ResyncMetabase(cli *client.Client, req *ResyncMetabaseRequest, opts ...client.CallOption) (*ResyncMetabaseResponse, error) {
+	wResp := &resyncMetabaseResponseWrapper{new(ResyncMetabaseResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcResyncMetabase), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ResyncMetabaseResponse, nil
+}
@@ -0,0 +1,52 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) CheckShard(ctx context.Context, req *control.CheckShardRequest) (*control.CheckShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	var prm engine.CheckShardPrm
+	prm.SetShardID(shard.NewIDFromBytes(body.GetShard_ID()))
+	prm.SetRepairOrphans(body.GetRepairOrphans())
+	prm.SetMarkDangling(body.GetMarkDangling())
+	prm.SetResume(body.GetResume())
+	prm.SetRateLimit(body.GetRateLimit())
+
+	res, err := s.s.CheckShard(ctx, prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	summary := res.Summary()
+
+	resp := &control.CheckShardResponse{
+		Body: &control.CheckShardResponse_Body{
+			Records:         summary.Records,
+			Blobs:           summary.Blobs,
+			Dangling:        summary.Dangling,
+			Orphans:         summary.Orphans,
+			MarkedDangling:  summary.MarkedDangling,
+			RepairedOrphans: summary.RepairedOrphans,
+			Completed:       summary.Completed,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package control
+
+import (
+	"encoding/hex"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dumpObjectChunkSize is the maximum size of the object chunk sent in a
+// single DumpObject response, it fits the default gRPC message size limit.
+const dumpObjectChunkSize = 1 << 20
+
+// DumpObject streams the object stored on the node ignoring its GC mark,
+// so that the recently removed objects can be inspected. Each request is
+// logged with the requester's key.
+func (s *Server) DumpObject(req *control.DumpObjectRequest, stream control.ControlService_DumpObjectServer) error {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var addr oid.Address
+	if err := addr.DecodeString(req.GetBody().GetAddress()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	key := req.GetSignature().GetKey()
+
+	s.log.Info("object is requested ignoring its GC mark via the Control service",
+		zap.Stringer("address", addr),
+		zap.String("key", hex.EncodeToString(key)))
+
+	var prm engine.GetPrm
+	prm.WithAddress(addr)
+	prm.WithIgnoreGCMark()
+	prm.WithIdentity(engine.AccessIdentity{ControlKey: key})
+
+	res, err := s.s.Get(stream.Context(), prm)
+	if err != nil {
+		if shard.IsErrNotFound(err) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	data, err := res.Object().Marshal()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for len(data) > 0 {
+		n := len(data)
+		if n > dumpObjectChunkSize {
+			n = dumpObjectChunkSize
+		}
+
+		resp := &control.DumpObjectResponse{
+			Body: &control.DumpObjectResponse_Body{
+				Chunk: data[:n],
+			},
+		}
+
+		err = SignMessage(s.key, resp)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+
+		data = data[n:]
+	}
+
+	return nil
+}
@@ -29,7 +29,10 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 
 	var prm engine.EvacuateShardPrm
 	prm.WithShardID(shardID)
-	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithMaintenance(engine.MaintenancePrm{
+		IgnoreErrors: req.GetBody().GetIgnoreErrors(),
+		ErrorLimit:   int(req.GetBody().GetErrorLimit()),
+	})
 	prm.WithFaultHandler(s.replicate)
 
 	res, err := s.s.Evacuate(prm)
@@ -39,7 +42,9 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 
 	resp := &control.EvacuateShardResponse{
 		Body: &control.EvacuateShardResponse_Body{
-			Count: uint32(res.Count()),
+			Count:      uint32(res.Count()),
+			ErrorCount: uint32(res.ErrorCount()),
+			Errors:     maintenanceErrors(res.MaintenanceRes),
 		},
 	}
 
@@ -50,6 +55,21 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 	return resp, nil
 }
 
+// maintenanceErrors returns the messages of the errors skipped by the engine
+// maintenance operation.
+func maintenanceErrors(res engine.MaintenanceRes) []string {
+	errs := res.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(errs))
+	for i := range errs {
+		msgs[i] = errs[i].Error()
+	}
+	return msgs
+}
+
 func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 	cid, ok := obj.ContainerID()
 	if !ok {
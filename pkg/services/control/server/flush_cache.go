@@ -20,13 +20,23 @@ func (s *Server) FlushCache(_ context.Context, req *control.FlushCacheRequest) (
 
 	var prm engine.FlushWriteCachePrm
 	prm.SetShardID(shardID)
+	prm.SetForce(req.GetBody().GetForce())
+	prm.SetMaintenance(engine.MaintenancePrm{
+		IgnoreErrors: req.GetBody().GetIgnoreErrors(),
+		ErrorLimit:   int(req.GetBody().GetErrorLimit()),
+	})
 
-	_, err = s.s.FlushWriteCache(prm)
+	res, err := s.s.FlushWriteCache(prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	resp := &control.FlushCacheResponse{Body: &control.FlushCacheResponse_Body{}}
+	resp := &control.FlushCacheResponse{
+		Body: &control.FlushCacheResponse_Body{
+			ErrorCount: uint32(res.ErrorCount()),
+			Errors:     maintenanceErrors(res.MaintenanceRes),
+		},
+	}
 
 	err = SignMessage(s.key, resp)
 	if err != nil {
@@ -0,0 +1,90 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartFSTreeMigration(_ context.Context, req *control.StartFSTreeMigrationRequest) (*control.StartFSTreeMigrationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	var prm engine.FSTreeMigrationPrm
+	prm.SetShardID(shard.NewIDFromBytes(body.GetShard_ID()))
+	prm.SetRateLimit(body.GetRateLimit())
+	prm.SetRestart(body.GetRestart())
+
+	err = s.s.StartFSTreeMigration(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartFSTreeMigrationResponse{Body: &control.StartFSTreeMigrationResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopFSTreeMigration(_ context.Context, req *control.StopFSTreeMigrationRequest) (*control.StopFSTreeMigrationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StopFSTreeMigration(shard.NewIDFromBytes(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StopFSTreeMigrationResponse{Body: &control.StopFSTreeMigrationResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetFSTreeMigrationStatus(_ context.Context, req *control.GetFSTreeMigrationStatusRequest) (*control.GetFSTreeMigrationStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st, err := s.s.FSTreeMigrationStatus(shard.NewIDFromBytes(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := &control.GetFSTreeMigrationStatusResponse_Body{
+		Running:   st.Running,
+		Completed: st.Completed,
+		Processed: st.Processed,
+		Moved:     st.Moved,
+		Failed:    st.Failed,
+	}
+
+	if st.Err != nil {
+		body.Error = st.Err.Error()
+	}
+
+	resp := &control.GetFSTreeMigrationStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
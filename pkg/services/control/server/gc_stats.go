@@ -0,0 +1,77 @@
+package control
+
+import (
+	"context"
+	"math"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGCStats returns the statistics of the latest garbage collector runs
+// of the shard. Zero count requests all the retained runs.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) GetGCStats(_ context.Context, req *control.GetGCStatsRequest) (*control.GetGCStatsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	n := int(body.GetCount())
+	if n == 0 {
+		n = math.MaxInt
+	}
+
+	stats, err := s.s.GCHistory(shard.NewIDFromBytes(body.GetShard_ID()), n)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.GetGCStatsResponse{
+		Body: &control.GetGCStatsResponse_Body{
+			Runs: gcRunsToGRPC(stats),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func gcRunsToGRPC(stats []shard.GCTickStat) []*control.GetGCStatsResponse_Body_Run {
+	runs := make([]*control.GetGCStatsResponse_Body_Run, 0, len(stats))
+	for i := range stats {
+		runs = append(runs, &control.GetGCStatsResponse_Body_Run{
+			Type:       gcRunTypeToGRPC(stats[i].Type),
+			Time:       stats[i].Time.Unix(),
+			Duration:   uint64(stats[i].Duration.Milliseconds()),
+			Epoch:      stats[i].Epoch,
+			Candidates: stats[i].Candidates,
+			Removed:    stats[i].Removed,
+			Bytes:      stats[i].Bytes,
+			Locked:     stats[i].Locked,
+			Deferred:   stats[i].Deferred,
+			Errors:     stats[i].Errors,
+		})
+	}
+
+	return runs
+}
+
+func gcRunTypeToGRPC(typ shard.GCRunType) control.GetGCStatsResponse_Body_Run_Type {
+	switch typ {
+	case shard.GCRunRemover:
+		return control.GetGCStatsResponse_Body_Run_REMOVER
+	case shard.GCRunExpired:
+		return control.GetGCStatsResponse_Body_Run_EXPIRED
+	default:
+		return control.GetGCStatsResponse_Body_Run_TYPE_UNDEFINED
+	}
+}
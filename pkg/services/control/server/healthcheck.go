@@ -2,7 +2,9 @@ package control
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,6 +28,18 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 	body.SetNetmapStatus(s.healthChecker.NetmapStatus())
 	body.SetHealthStatus(s.healthChecker.HealthStatus())
 
+	health := s.s.HealthSummary()
+	body.SetStorageHealthStatus(storageHealthStatus(health.Status))
+
+	var problems []string
+	for _, sh := range health.Shards {
+		for _, p := range sh.Problems {
+			problems = append(problems, fmt.Sprintf("shard %s: %s", sh.ID, p))
+		}
+	}
+
+	body.SetStorageProblems(problems)
+
 	// sign the response
 	if err := SignMessage(s.key, resp); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -33,3 +47,16 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 
 	return resp, nil
 }
+
+func storageHealthStatus(st engine.HealthStatus) control.StorageHealthStatus {
+	switch st {
+	case engine.HealthOK:
+		return control.StorageHealthStatus_STORAGE_OK
+	case engine.HealthDegraded:
+		return control.StorageHealthStatus_STORAGE_DEGRADED
+	case engine.HealthCritical:
+		return control.StorageHealthStatus_STORAGE_CRITICAL
+	default:
+		return control.StorageHealthStatus_STORAGE_HEALTH_UNDEFINED
+	}
+}
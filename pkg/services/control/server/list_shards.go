@@ -3,6 +3,7 @@ package control
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"google.golang.org/grpc/codes"
@@ -26,33 +27,7 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 	shardInfos := make([]*control.ShardInfo, 0, len(info.Shards))
 
 	for _, sh := range info.Shards {
-		si := new(control.ShardInfo)
-
-		si.SetID(*sh.ID)
-		si.SetMetabasePath(sh.MetaBaseInfo.Path)
-		si.SetBlobstorPath(sh.BlobStorInfo.RootPath)
-		si.SetWriteCachePath(sh.WriteCacheInfo.Path)
-		si.SetPiloramaPath(sh.PiloramaInfo.Path)
-
-		var m control.ShardMode
-
-		switch sh.Mode {
-		case mode.ReadWrite:
-			m = control.ShardMode_READ_WRITE
-		case mode.ReadOnly:
-			m = control.ShardMode_READ_ONLY
-		case mode.Degraded:
-			m = control.ShardMode_DEGRADED
-		case mode.DegradedReadOnly:
-			m = control.ShardMode_DEGRADED_READ_ONLY
-		default:
-			m = control.ShardMode_SHARD_MODE_UNDEFINED
-		}
-
-		si.SetMode(m)
-		si.SetErrorCount(sh.ErrorCount)
-
-		shardInfos = append(shardInfos, si)
+		shardInfos = append(shardInfos, shardInfoToGRPC(sh))
 	}
 
 	body.SetShards(shardInfos)
@@ -64,3 +39,40 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 	return resp, nil
 }
+
+func shardInfoToGRPC(sh shard.Info) *control.ShardInfo {
+	si := new(control.ShardInfo)
+
+	si.SetID(*sh.ID)
+	si.SetMetabasePath(sh.MetaBaseInfo.Path)
+	si.SetBlobstorPath(sh.BlobStorInfo.RootPath)
+	si.SetWriteCachePath(sh.WriteCacheInfo.Path)
+	si.SetPiloramaPath(sh.PiloramaInfo.Path)
+
+	var m control.ShardMode
+
+	switch sh.Mode {
+	case mode.ReadWrite:
+		m = control.ShardMode_READ_WRITE
+	case mode.ReadOnly:
+		m = control.ShardMode_READ_ONLY
+	case mode.Degraded:
+		m = control.ShardMode_DEGRADED
+	case mode.DegradedReadOnly:
+		m = control.ShardMode_DEGRADED_READ_ONLY
+	default:
+		m = control.ShardMode_SHARD_MODE_UNDEFINED
+	}
+
+	si.SetMode(m)
+	si.SetErrorCount(sh.ErrorCount)
+	si.SetForcedReadOnly(sh.ForcedReadOnly)
+	si.SetWriteCacheDisabled(sh.WriteCacheDisabled)
+
+	if len(sh.LastErrors) != 0 {
+		si.SetLastError(sh.LastErrors[0].Message)
+		si.SetLastErrorTime(sh.LastErrors[0].Time.Unix())
+	}
+
+	return si
+}
@@ -0,0 +1,123 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListLocks(_ context.Context, req *control.ListLocksRequest) (*control.ListLocksResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	res, err := s.s.Locks()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locks := make([]*control.ListLocksResponse_Body_Lock, 0, len(res))
+	for i := range res {
+		locks = append(locks, &control.ListLocksResponse_Body_Lock{
+			Address: res[i].Locker.EncodeToString(),
+			Members: uint64(res[i].Members),
+			Found:   res[i].LockerFound,
+		})
+	}
+
+	resp := &control.ListLocksResponse{
+		Body: &control.ListLocksResponse_Body{
+			Locks: locks,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) RemoveLock(_ context.Context, req *control.RemoveLockRequest) (*control.RemoveLockResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	var addr oid.Address
+	if err := addr.DecodeString(body.GetAddress()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !body.GetForce() {
+		res, err := s.s.ObjectStatus(addr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		for i := range res {
+			if res[i].Status.Metabase.Found {
+				return nil, status.Error(codes.FailedPrecondition,
+					"lock object is stored on the node, force flag is required to remove its lock records")
+			}
+		}
+	}
+
+	err = s.s.FreeLockedBy([]oid.Address{addr})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.RemoveLockResponse{Body: &control.RemoveLockResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) ObjectLocks(_ context.Context, req *control.ObjectLocksRequest) (*control.ObjectLocksResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var addr oid.Address
+	if err := addr.DecodeString(req.GetBody().GetAddress()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.s.LocksFor(addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locks := make([]*control.ObjectLocksResponse_Body_Lock, 0, len(res))
+	for i := range res {
+		locks = append(locks, &control.ObjectLocksResponse_Body_Lock{
+			Address:    res[i].Locker.EncodeToString(),
+			Expiration: res[i].Expiration,
+			Found:      res[i].LockerFound,
+			Malformed:  res[i].Malformed,
+		})
+	}
+
+	resp := &control.ObjectLocksResponse{
+		Body: &control.ObjectLocksResponse_Body{
+			Locks: locks,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package control
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetNetmapStatus returns the node's view of its state in the network map:
+// current epoch, status in the latest network map snapshot and the
+// information about the last bootstrap.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) GetNetmapStatus(_ context.Context, req *control.GetNetmapStatusRequest) (*control.GetNetmapStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body, err := s.netmapStatus()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.GetNetmapStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) netmapStatus() (*control.GetNetmapStatusResponse_Body, error) {
+	body := &control.GetNetmapStatusResponse_Body{
+		Epoch: s.netState.CurrentEpoch(),
+	}
+
+	nm, err := s.netMapSrc.GetNetMap(0)
+	if err != nil {
+		return nil, err
+	}
+
+	body.NetmapEpoch = nm.Epoch()
+
+	bs := (*keys.PublicKey)(&s.key.PublicKey).Bytes()
+	nodes := nm.Nodes()
+
+	for i := range nodes {
+		if bytes.Equal(nodes[i].PublicKey(), bs) {
+			body.NetmapStatus = nodeStatus(nodes[i])
+			break
+		}
+	}
+
+	if bi, ok := s.netState.LastBootstrap(); ok {
+		body.BootstrapEpoch = bi.Epoch
+		body.BootstrapTime = bi.Time.Unix()
+		body.Announced = nodeInfoToGRPC(bi.NodeInfo)
+	}
+
+	return body, nil
+}
+
+func nodeStatus(ni netmapSDK.NodeInfo) control.NetmapStatus {
+	switch {
+	case ni.IsOnline():
+		return control.NetmapStatus_ONLINE
+	case ni.IsOffline():
+		return control.NetmapStatus_OFFLINE
+	case ni.IsMaintenance():
+		return control.NetmapStatus_MAINTENANCE
+	default:
+		return control.NetmapStatus_STATUS_UNDEFINED
+	}
+}
+
+func nodeInfoToGRPC(ni netmapSDK.NodeInfo) *control.NodeInfo {
+	res := new(control.NodeInfo)
+	res.SetPublicKey(ni.PublicKey())
+	res.SetState(nodeStatus(ni))
+
+	addrs := make([]string, 0, ni.NumberOfNetworkEndpoints())
+	ni.IterateNetworkEndpoints(func(addr string) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	res.SetAddresses(addrs)
+
+	attrs := make([]*control.NodeInfo_Attribute, 0, ni.NumberOfAttributes())
+	ni.IterateAttributes(func(key, value string) {
+		a := new(control.NodeInfo_Attribute)
+		a.SetKey(key)
+		a.SetValue(value)
+
+		attrs = append(attrs, a)
+	})
+	res.SetAttributes(attrs)
+
+	return res
+}
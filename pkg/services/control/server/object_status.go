@@ -0,0 +1,68 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ObjectStatus(_ context.Context, req *control.ObjectStatusRequest) (*control.ObjectStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var addr oid.Address
+	if err := addr.DecodeString(req.GetBody().GetAddress()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.s.ObjectStatus(addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	shards := make([]*control.ObjectStatusResponse_Body_Shard, 0, len(res))
+	for _, sh := range res {
+		st := sh.Status
+
+		si := &control.ObjectStatusResponse_Body_Shard{
+			Shard_ID:        *sh.ID,
+			InMetabase:      st.Metabase.Found,
+			BlobstorStorage: st.BlobStorage,
+			Storage_ID:      st.Metabase.StorageID,
+			GcMarked:        st.Metabase.GCMarked,
+			Locked:          st.Metabase.Locked,
+		}
+
+		if st.WriteCache != nil {
+			switch {
+			case st.WriteCache.InDB:
+				si.WriteCache = "db"
+			case st.WriteCache.InFSTree:
+				si.WriteCache = "fstree"
+			}
+		}
+
+		if st.Metabase.Tombstone != nil {
+			si.Tombstone = st.Metabase.Tombstone.EncodeToString()
+		}
+
+		shards = append(shards, si)
+	}
+
+	resp := &control.ObjectStatusResponse{
+		Body: &control.ObjectStatusResponse_Body{
+			Shards: shards,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPolicerStatus returns the counters of the object policer work.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) GetPolicerStatus(_ context.Context, req *control.GetPolicerStatusRequest) (*control.GetPolicerStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st := s.policer.Stats()
+
+	body := &control.GetPolicerStatusResponse_Body{
+		InspectedObjects:       st.InspectedObjects,
+		UnderReplicatedObjects: st.UnderReplicatedObjects,
+		QueuedTasks:            st.QueuedTasks,
+		CompletedTasks:         st.CompletedTasks,
+		FailedTasks:            st.FailedTasks,
+		Passes:                 st.Passes,
+	}
+
+	if !st.LastPassTime.IsZero() {
+		body.LastPassTime = st.LastPassTime.Unix()
+	}
+
+	resp := &control.GetPolicerStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ResyncMetabase(_ context.Context, req *control.ResyncMetabaseRequest) (*control.ResyncMetabaseResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+
+	var prm engine.ResyncMetabasePrm
+	prm.SetShardID(shardID)
+	prm.SetMaintenance(engine.MaintenancePrm{
+		IgnoreErrors: req.GetBody().GetIgnoreErrors(),
+		ErrorLimit:   int(req.GetBody().GetErrorLimit()),
+	})
+
+	res, err := s.s.ResyncMetabase(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.ResyncMetabaseResponse{
+		Body: &control.ResyncMetabaseResponse_Body{
+			ErrorCount: uint32(res.ErrorCount()),
+			Errors:     maintenanceErrors(res.MaintenanceRes),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -2,12 +2,17 @@ package control
 
 import (
 	"crypto/ecdsa"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
 )
 
 // Server is an entity that serves
@@ -37,10 +42,61 @@ type NodeState interface {
 	SetNetmapStatus(control.NetmapStatus) error
 }
 
+// BootstrapInfo describes the last bootstrap of the storage node.
+type BootstrapInfo struct {
+	// NodeInfo is the node information sent in the bootstrap transaction.
+	NodeInfo netmapSDK.NodeInfo
+
+	// Epoch is the epoch at which the transaction was sent.
+	Epoch uint64
+
+	// Time is the time at which the transaction was sent.
+	Time time.Time
+}
+
+// NetworkState is an interface of the storage node's view
+// of its state in the network map.
+type NetworkState interface {
+	netmap.State
+
+	// LastBootstrap must return the information about the last bootstrap
+	// of the node. Returns false if the node has not been bootstrapped
+	// since start.
+	LastBootstrap() (BootstrapInfo, bool)
+}
+
+// MorphState describes the connection of the node to the NeoFS sidechain.
+type MorphState struct {
+	// Endpoint is the address of the RPC node the node is connected to.
+	Endpoint string
+
+	// Connected is false if the connection to all the RPC nodes is lost.
+	Connected bool
+
+	// Height is the current sidechain height.
+	Height uint32
+}
+
+// SupportInfo is an interface of the node application
+// state source used to collect the support bundle.
+type SupportInfo interface {
+	// Version must return the version of the node application.
+	Version() string
+
+	// ConfigHash must return the SHA-256 hash of the node configuration
+	// file, nil if the node is configured without a file.
+	ConfigHash() ([]byte, error)
+
+	// MorphState must return the state of the connection to the NeoFS sidechain.
+	MorphState() (MorphState, error)
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
 type cfg struct {
+	log *logger.Logger
+
 	key *ecdsa.PrivateKey
 
 	allowedKeys [][]byte
@@ -53,15 +109,23 @@ type cfg struct {
 
 	replicator *replicator.Replicator
 
+	policer *policer.Policer
+
 	nodeState NodeState
 
+	netState NetworkState
+
 	treeService TreeService
 
+	supportInfo SupportInfo
+
 	s *engine.StorageEngine
 }
 
 func defaultCfg() *cfg {
-	return &cfg{}
+	return &cfg{
+		log: zap.NewNop(),
+	}
 }
 
 // New creates, initializes and returns new Server instance.
@@ -77,6 +141,14 @@ func New(opts ...Option) *Server {
 	}
 }
 
+// WithLogger returns option to set the logger of the
+// operations with the stored data, e.g. DumpObject.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l
+	}
+}
+
 // WithKey returns option to set private key
 // used for signing responses.
 func WithKey(key *ecdsa.PrivateKey) Option {
@@ -122,6 +194,14 @@ func WithReplicator(r *replicator.Replicator) Option {
 	}
 }
 
+// WithPolicer returns option to set the object policer
+// which work is reported.
+func WithPolicer(p *policer.Policer) Option {
+	return func(c *cfg) {
+		c.policer = p
+	}
+}
+
 // WithNodeState returns option to set node network state component.
 func WithNodeState(state NodeState) Option {
 	return func(c *cfg) {
@@ -129,6 +209,14 @@ func WithNodeState(state NodeState) Option {
 	}
 }
 
+// WithNetworkState returns option to set the component
+// providing the node's view of the network map state.
+func WithNetworkState(state NetworkState) Option {
+	return func(c *cfg) {
+		c.netState = state
+	}
+}
+
 // WithLocalStorage returns option to set local storage engine that
 // contains information about shards.
 func WithLocalStorage(engine *engine.StorageEngine) Option {
@@ -143,3 +231,11 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithSupportInfo returns an option to set the source of the node
+// application state included in the support bundle.
+func WithSupportInfo(info SupportInfo) Option {
+	return func(c *cfg) {
+		c.supportInfo = info
+	}
+}
@@ -0,0 +1,42 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) SetShardWriteCache(ctx context.Context, req *control.SetShardWriteCacheRequest) (*control.SetShardWriteCacheResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+	shardID := shard.NewIDFromBytes(body.GetShard_ID())
+
+	if body.GetDisable() {
+		if body.GetFlushTimeout() == 0 {
+			return nil, status.Error(codes.InvalidArgument, "flush timeout is required to disable write-cache")
+		}
+
+		err = s.s.DisableWriteCache(ctx, shardID, time.Duration(body.GetFlushTimeout())*time.Millisecond)
+	} else {
+		err = s.s.EnableWriteCache(shardID)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.SetShardWriteCacheResponse{Body: &control.SetShardWriteCacheResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
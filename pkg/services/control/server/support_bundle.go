@@ -0,0 +1,169 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultComponentTimeout is the time limit for collecting
+// the state of a single component of the support bundle.
+const defaultComponentTimeout = 5 * time.Second
+
+var errComponentTimeout = errors.New("timeout exceeded")
+
+// GetSupportBundle collects the state of the node application, its network
+// map status, sidechain connection and local storage in a single structure.
+// Neither object data nor keys are included. Collection of each component
+// is limited in time, the components which state could not be collected
+// are listed in the response instead of failing the whole request.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) GetSupportBundle(ctx context.Context, req *control.GetSupportBundleRequest) (*control.GetSupportBundleResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	reqBody := req.GetBody()
+
+	n := int(reqBody.GetCount())
+	if n == 0 {
+		n = math.MaxInt
+	}
+
+	timeout := time.Duration(reqBody.GetComponentTimeout()) * time.Millisecond
+	if timeout == 0 {
+		timeout = defaultComponentTimeout
+	}
+
+	body := &control.GetSupportBundleResponse_Body{
+		Time: time.Now().Unix(),
+	}
+
+	collect := func(component string, f func() error) bool {
+		err := withTimeout(ctx, timeout, f)
+		if err != nil {
+			body.Errors = append(body.Errors, &control.GetSupportBundleResponse_Body_ComponentError{
+				Component: component,
+				Error:     err.Error(),
+			})
+		}
+
+		return err == nil
+	}
+
+	if s.healthChecker != nil {
+		body.HealthStatus = s.healthChecker.HealthStatus()
+	}
+
+	if s.supportInfo != nil {
+		body.Version = s.supportInfo.Version()
+
+		var hash []byte
+		if collect("config", func() (err error) {
+			hash, err = s.supportInfo.ConfigHash()
+			return
+		}) {
+			body.ConfigHash = hash
+		}
+
+		var st MorphState
+		if collect("morph", func() (err error) {
+			st, err = s.supportInfo.MorphState()
+			return
+		}) {
+			body.Morph = &control.GetSupportBundleResponse_Body_Morph{
+				Endpoint:  st.Endpoint,
+				Connected: st.Connected,
+				Height:    st.Height,
+			}
+		}
+	}
+
+	var nmStatus *control.GetNetmapStatusResponse_Body
+	if collect("netmap", func() (err error) {
+		nmStatus, err = s.netmapStatus()
+		return
+	}) {
+		if nmStatus.Announced != nil {
+			// the bundle must not contain any keys
+			nmStatus.Announced.PublicKey = nil
+		}
+
+		body.Netmap = nmStatus
+	}
+
+	var info engine.Info
+	if collect("storage engine", func() error {
+		info = s.s.DumpInfo()
+		return nil
+	}) {
+		body.Shards = make([]*control.GetSupportBundleResponse_Body_Shard, 0, len(info.Shards))
+
+		for i := range info.Shards {
+			sh := &control.GetSupportBundleResponse_Body_Shard{
+				Info:                shardInfoToGRPC(info.Shards[i]),
+				WritecacheDbBacklog: info.Shards[i].WriteCacheInfo.DBBacklog,
+				WritecacheFsBacklog: info.Shards[i].WriteCacheInfo.FSBacklog,
+			}
+
+			var stats []shard.GCTickStat
+			if collect(fmt.Sprintf("shard %s GC", info.Shards[i].ID), func() (err error) {
+				stats, err = s.s.GCHistory(info.Shards[i].ID, n)
+				return
+			}) {
+				sh.GcRuns = gcRunsToGRPC(stats)
+			}
+
+			body.Shards = append(body.Shards, sh)
+		}
+
+		for _, e := range s.s.SuppressedErrors(n) {
+			body.SuppressedErrors = append(body.SuppressedErrors, &control.GetSupportBundleResponse_Body_SuppressedError{
+				Class:   e.Class,
+				Message: e.Message,
+				Count:   e.Count,
+				Time:    e.Time.Unix(),
+			})
+		}
+	}
+
+	resp := &control.GetSupportBundleResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// withTimeout runs f and waits for it at most timeout, so that a hung
+// component does not hang the caller. If the timeout is exceeded, f keeps
+// running in background, the caller must not access the values set by f.
+func withTimeout(ctx context.Context, timeout time.Duration, f func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-t.C:
+		return errComponentTimeout
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -21,6 +21,20 @@ func (x *HealthCheckResponse_Body) SetHealthStatus(v HealthStatus) {
 	}
 }
 
+// SetStorageHealthStatus sets health status of the local object storage.
+func (x *HealthCheckResponse_Body) SetStorageHealthStatus(v StorageHealthStatus) {
+	if x != nil {
+		x.StorageHealthStatus = v
+	}
+}
+
+// SetStorageProblems sets problems of the local object storage shards.
+func (x *HealthCheckResponse_Body) SetStorageProblems(v []string) {
+	if x != nil {
+		x.StorageProblems = v
+	}
+}
+
 // SetBody sets health check response body.
 func (x *HealthCheckResponse) SetBody(v *HealthCheckResponse_Body) {
 	if x != nil {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestHealthCheckResponse_Body_StableMarshal(t *testing.T) {
@@ -24,13 +25,27 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 	body := new(control.HealthCheckResponse_Body)
 	body.SetNetmapStatus(control.NetmapStatus_ONLINE)
 	body.SetHealthStatus(control.HealthStatus_SHUTTING_DOWN)
+	body.SetStorageHealthStatus(control.StorageHealthStatus_STORAGE_DEGRADED)
+	body.SetStorageProblems([]string{"problem 1", "problem 2"})
 
 	return body
 }
 
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
-	return b1.GetNetmapStatus() == b2.GetNetmapStatus() &&
-		b1.GetHealthStatus() == b2.GetHealthStatus()
+	if b1.GetNetmapStatus() != b2.GetNetmapStatus() ||
+		b1.GetHealthStatus() != b2.GetHealthStatus() ||
+		b1.GetStorageHealthStatus() != b2.GetStorageHealthStatus() ||
+		len(b1.GetStorageProblems()) != len(b2.GetStorageProblems()) {
+		return false
+	}
+
+	for i := range b1.GetStorageProblems() {
+		if b1.GetStorageProblems()[i] != b2.GetStorageProblems()[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
 func TestSetNetmapStatusRequest_Body_StableMarshal(t *testing.T) {
@@ -80,6 +95,10 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 			b1.Shards[i].GetBlobstorPath() != b2.Shards[i].GetBlobstorPath() ||
 			b1.Shards[i].GetWritecachePath() != b2.Shards[i].GetWritecachePath() ||
 			b1.Shards[i].GetPiloramaPath() != b2.Shards[i].GetPiloramaPath() ||
+			b1.Shards[i].GetErrorCount() != b2.Shards[i].GetErrorCount() ||
+			b1.Shards[i].GetLastError() != b2.Shards[i].GetLastError() ||
+			b1.Shards[i].GetLastErrorTime() != b2.Shards[i].GetLastErrorTime() ||
+			b1.Shards[i].GetForcedReadOnly() != b2.Shards[i].GetForcedReadOnly() ||
 			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) {
 			return false
 		}
@@ -155,3 +174,327 @@ func TestSynchronizeTreeRequest_Body_StableMarshal(t *testing.T) {
 		},
 	)
 }
+
+func TestGetNetmapStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateGetNetmapStatusResponseBody(),
+		new(control.GetNetmapStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalGetNetmapStatusResponseBodies(
+				m1.(*control.GetNetmapStatusResponse_Body),
+				m2.(*control.GetNetmapStatusResponse_Body),
+			)
+		},
+	)
+}
+
+func generateGetNetmapStatusResponseBody() *control.GetNetmapStatusResponse_Body {
+	return &control.GetNetmapStatusResponse_Body{
+		Epoch:          13,
+		NetmapEpoch:    12,
+		NetmapStatus:   control.NetmapStatus_ONLINE,
+		BootstrapEpoch: 10,
+		BootstrapTime:  1665000000,
+		Announced:      generateNetmap().GetNodes()[0],
+	}
+}
+
+func equalGetNetmapStatusResponseBodies(b1, b2 *control.GetNetmapStatusResponse_Body) bool {
+	return b1.GetEpoch() == b2.GetEpoch() &&
+		b1.GetNetmapEpoch() == b2.GetNetmapEpoch() &&
+		b1.GetNetmapStatus() == b2.GetNetmapStatus() &&
+		b1.GetBootstrapEpoch() == b2.GetBootstrapEpoch() &&
+		b1.GetBootstrapTime() == b2.GetBootstrapTime() &&
+		equalNodeInfos(b1.GetAnnounced(), b2.GetAnnounced())
+}
+
+func TestGetPolicerStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateGetPolicerStatusResponseBody(),
+		new(control.GetPolicerStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalGetPolicerStatusResponseBodies(
+				m1.(*control.GetPolicerStatusResponse_Body),
+				m2.(*control.GetPolicerStatusResponse_Body),
+			)
+		},
+	)
+}
+
+func generateGetPolicerStatusResponseBody() *control.GetPolicerStatusResponse_Body {
+	return &control.GetPolicerStatusResponse_Body{
+		InspectedObjects:       1000,
+		UnderReplicatedObjects: 20,
+		QueuedTasks:            25,
+		CompletedTasks:         21,
+		FailedTasks:            3,
+		Passes:                 2,
+		LastPassTime:           1665000000,
+	}
+}
+
+func equalGetPolicerStatusResponseBodies(b1, b2 *control.GetPolicerStatusResponse_Body) bool {
+	return b1.GetInspectedObjects() == b2.GetInspectedObjects() &&
+		b1.GetUnderReplicatedObjects() == b2.GetUnderReplicatedObjects() &&
+		b1.GetQueuedTasks() == b2.GetQueuedTasks() &&
+		b1.GetCompletedTasks() == b2.GetCompletedTasks() &&
+		b1.GetFailedTasks() == b2.GetFailedTasks() &&
+		b1.GetPasses() == b2.GetPasses() &&
+		b1.GetLastPassTime() == b2.GetLastPassTime()
+}
+
+func TestStartFSTreeMigrationRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.StartFSTreeMigrationRequest_Body{
+			Shard_ID:  []byte{0, 1, 2, 3},
+			RateLimit: 100,
+			Restart:   true,
+		},
+		new(control.StartFSTreeMigrationRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.StartFSTreeMigrationRequest_Body)
+			b2 := m2.(*control.StartFSTreeMigrationRequest_Body)
+			return bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) &&
+				b1.GetRateLimit() == b2.GetRateLimit() &&
+				b1.GetRestart() == b2.GetRestart()
+		},
+	)
+}
+
+func TestGetFSTreeMigrationStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetFSTreeMigrationStatusResponse_Body{
+			Running:   false,
+			Completed: false,
+			Processed: 1000,
+			Moved:     900,
+			Failed:    2,
+			Error:     "shard is in read-only mode",
+		},
+		new(control.GetFSTreeMigrationStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.GetFSTreeMigrationStatusResponse_Body)
+			b2 := m2.(*control.GetFSTreeMigrationStatusResponse_Body)
+			return b1.GetRunning() == b2.GetRunning() &&
+				b1.GetCompleted() == b2.GetCompleted() &&
+				b1.GetProcessed() == b2.GetProcessed() &&
+				b1.GetMoved() == b2.GetMoved() &&
+				b1.GetFailed() == b2.GetFailed() &&
+				b1.GetError() == b2.GetError()
+		},
+	)
+}
+
+func TestListLocksResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ListLocksResponse_Body{
+			Locks: []*control.ListLocksResponse_Body_Lock{
+				{
+					Address: "7bMSYsZRiJEdHhbQajqMfPKuPpdAp9RsHA1BRACQEH58/FCfLnyiBtQjmu8iVSq5LX6DiLjs6QBnczHUPE5Q8Zc2V",
+					Members: 10,
+					Found:   true,
+				},
+				{
+					Address: "7bMSYsZRiJEdHhbQajqMfPKuPpdAp9RsHA1BRACQEH58/3ab4NAwXDodoV9tY8atF5Yi3dHidK2t2WZYYvT5QvHdJ",
+					Members: 1,
+				},
+			},
+		},
+		new(control.ListLocksResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			l1 := m1.(*control.ListLocksResponse_Body).GetLocks()
+			l2 := m2.(*control.ListLocksResponse_Body).GetLocks()
+			if len(l1) != len(l2) {
+				return false
+			}
+
+			for i := range l1 {
+				if l1[i].GetAddress() != l2[i].GetAddress() ||
+					l1[i].GetMembers() != l2[i].GetMembers() ||
+					l1[i].GetFound() != l2[i].GetFound() {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func TestRemoveLockRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.RemoveLockRequest_Body{
+			Address: "7bMSYsZRiJEdHhbQajqMfPKuPpdAp9RsHA1BRACQEH58/3ab4NAwXDodoV9tY8atF5Yi3dHidK2t2WZYYvT5QvHdJ",
+			Force:   true,
+		},
+		new(control.RemoveLockRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RemoveLockRequest_Body)
+			b2 := m2.(*control.RemoveLockRequest_Body)
+			return b1.GetAddress() == b2.GetAddress() &&
+				b1.GetForce() == b2.GetForce()
+		},
+	)
+}
+
+func TestObjectLocksResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ObjectLocksResponse_Body{
+			Locks: []*control.ObjectLocksResponse_Body_Lock{
+				{
+					Address:    "7bMSYsZRiJEdHhbQajqMfPKuPpdAp9RsHA1BRACQEH58/FCfLnyiBtQjmu8iVSq5LX6DiLjs6QBnczHUPE5Q8Zc2V",
+					Expiration: 100,
+					Found:      true,
+				},
+				{
+					Address: "7bMSYsZRiJEdHhbQajqMfPKuPpdAp9RsHA1BRACQEH58/3ab4NAwXDodoV9tY8atF5Yi3dHidK2t2WZYYvT5QvHdJ",
+				},
+			},
+		},
+		new(control.ObjectLocksResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			l1 := m1.(*control.ObjectLocksResponse_Body).GetLocks()
+			l2 := m2.(*control.ObjectLocksResponse_Body).GetLocks()
+			if len(l1) != len(l2) {
+				return false
+			}
+
+			for i := range l1 {
+				if l1[i].GetAddress() != l2[i].GetAddress() ||
+					l1[i].GetExpiration() != l2[i].GetExpiration() ||
+					l1[i].GetFound() != l2[i].GetFound() {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func TestDumpObjectResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.DumpObjectResponse_Body{
+			Chunk: []byte{1, 2, 3, 4, 5},
+		},
+		new(control.DumpObjectResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return bytes.Equal(m1.(*control.DumpObjectResponse_Body).GetChunk(),
+				m2.(*control.DumpObjectResponse_Body).GetChunk())
+		},
+	)
+}
+
+func TestGetSupportBundleResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetSupportBundleResponse_Body{
+			Time:         1665000000,
+			Version:      "v0.32.0",
+			ConfigHash:   testData(32),
+			HealthStatus: control.HealthStatus_READY,
+			Netmap:       generateGetNetmapStatusResponseBody(),
+			Morph: &control.GetSupportBundleResponse_Body_Morph{
+				Endpoint:  "ws://localhost:30333/ws",
+				Connected: true,
+				Height:    1000,
+			},
+			Shards: []*control.GetSupportBundleResponse_Body_Shard{{
+				Info:                generateShardInfo(0),
+				WritecacheDbBacklog: 10,
+				WritecacheFsBacklog: 2,
+				GcRuns: []*control.GetGCStatsResponse_Body_Run{{
+					Type:       control.GetGCStatsResponse_Body_Run_REMOVER,
+					Time:       1665000000,
+					Duration:   15,
+					Candidates: 3,
+					Removed:    2,
+					Deferred:   1,
+				}},
+			}},
+			SuppressedErrors: []*control.GetSupportBundleResponse_Body_SuppressedError{{
+				Class:   "*errors.errorString: could not get object",
+				Message: "could not get object from shard",
+				Count:   42,
+				Time:    1665000000,
+			}},
+			Errors: []*control.GetSupportBundleResponse_Body_ComponentError{{
+				Component: "morph",
+				Error:     "timeout exceeded",
+			}},
+		},
+		new(control.GetSupportBundleResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestSetShardWriteCacheRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.SetShardWriteCacheRequest_Body{
+			Shard_ID:     []byte{0, 1, 2, 3},
+			Disable:      true,
+			FlushTimeout: 60000,
+		},
+		new(control.SetShardWriteCacheRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestFlushCacheRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.FlushCacheRequest_Body{
+			Shard_ID:     []byte{0, 1, 2, 3},
+			Force:        true,
+			IgnoreErrors: true,
+			ErrorLimit:   10,
+		},
+		new(control.FlushCacheRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestFlushCacheResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.FlushCacheResponse_Body{
+			ErrorCount: 2,
+			Errors:     []string{"first error", "second error"},
+		},
+		new(control.FlushCacheResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestEvacuateShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.EvacuateShardResponse_Body{
+			Count:      5,
+			ErrorCount: 2,
+			Errors:     []string{"first error", "second error"},
+		},
+		new(control.EvacuateShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestResyncMetabaseRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ResyncMetabaseRequest_Body{
+			Shard_ID:     []byte{0, 1, 2, 3},
+			IgnoreErrors: true,
+			ErrorLimit:   10,
+		},
+		new(control.ResyncMetabaseRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
@@ -121,3 +121,24 @@ func (x *ShardInfo) SetMode(v ShardMode) {
 func (x *ShardInfo) SetErrorCount(count uint32) {
 	x.ErrorCount = count
 }
+
+// SetLastError sets message of the last error occurred in the shard.
+func (x *ShardInfo) SetLastError(v string) {
+	x.LastError = v
+}
+
+// SetLastErrorTime sets Unix timestamp (in seconds) of the last error occurred in the shard.
+func (x *ShardInfo) SetLastErrorTime(v int64) {
+	x.LastErrorTime = v
+}
+
+// SetForcedReadOnly sets flag indicating that the shard is forced to stay read-only.
+func (x *ShardInfo) SetForcedReadOnly(v bool) {
+	x.ForcedReadOnly = v
+}
+
+// SetWriteCacheDisabled sets flag indicating that the write-cache
+// is detached from the shard at runtime.
+func (x *ShardInfo) SetWriteCacheDisabled(v bool) {
+	x.WritecacheDisabled = v
+}
@@ -141,6 +141,11 @@ func generateShardInfo(id int) *control.ShardInfo {
 	si.SetBlobstorPath(filepath.Join(path, "blobstor"))
 	si.SetWriteCachePath(filepath.Join(path, "writecache"))
 	si.SetPiloramaPath(filepath.Join(path, "pilorama"))
+	si.SetErrorCount(uint32(id))
+	si.SetLastError("error " + strconv.Itoa(id))
+	si.SetLastErrorTime(int64(id) + 1)
+	si.SetForcedReadOnly(id%2 == 0)
+	si.SetWriteCacheDisabled(id%2 == 1)
 
 	return si
 }
@@ -80,6 +80,31 @@ func (c senderClassifier) classify(
 	}, nil
 }
 
+// signedByContainerNode checks whether any layer of the request verification
+// header is signed by the node of the given container.
+func (c senderClassifier) signedByContainerNode(req MetaWithToken, idCnr cid.ID, cnr container.Container) bool {
+	binCnr := make([]byte, sha256.Size)
+	idCnr.Encode(binCnr)
+
+	for v := req.vheader; v != nil; v = v.GetOrigin() {
+		key := v.GetBodySignature().GetKey()
+		if len(key) == 0 {
+			continue
+		}
+
+		in, err := c.isContainerKey(key, binCnr, cnr)
+		if err != nil {
+			c.log.Debug("can't check if request signed by container node",
+				zap.String("error", err.Error()))
+			return false
+		} else if in {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c senderClassifier) isInnerRingKey(owner []byte) (bool, error) {
 	innerRingKeys, err := c.innerRing.InnerRingKeys()
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 )
 
 var (
@@ -13,10 +14,14 @@ var (
 	ErrMalformedRequest = errors.New("malformed request")
 	// ErrInvalidVerb is returned when session token verb doesn't include necessary operation.
 	ErrInvalidVerb = errors.New("session token verb is invalid")
+
+	errSessionContainerMismatch = errors.New("requested container is out of the session scope")
+	errSessionObjectMismatch    = errors.New("requested object is out of the session scope")
 )
 
 const accessDeniedACLReasonFmt = "access to operation %s is denied by basic ACL check"
 const accessDeniedEACLReasonFmt = "access to operation %s is denied by extended ACL check: %v"
+const accessDeniedSessionReasonFmt = "access to operation %s is denied by session token check: %v"
 
 func basicACLErr(info RequestInfo) error {
 	var errAccessDenied apistatus.ObjectAccessDenied
@@ -31,3 +36,10 @@ func eACLErr(info RequestInfo, err error) error {
 
 	return errAccessDenied
 }
+
+func sessionScopeErr(op acl.Op, err error) error {
+	var errAccessDenied apistatus.ObjectAccessDenied
+	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedSessionReasonFmt, op, err))
+
+	return errAccessDenied
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/zap"
@@ -130,18 +131,16 @@ func (b Service) Get(request *objectV2.GetRequest, stream object.GetObjectStream
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectGet)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectGet, obj)
 	if err != nil {
 		return err
 	}
 
-	useObjectIDFromSession(&reqInfo, sTok)
-
 	if !b.checker.CheckBasicACL(reqInfo) {
 		return basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
@@ -189,18 +188,16 @@ func (b Service) Head(
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectHead)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return nil, err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectHead, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	useObjectIDFromSession(&reqInfo, sTok)
-
 	if !b.checker.CheckBasicACL(reqInfo) {
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
@@ -240,12 +237,12 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, id, acl.OpObjectSearch)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, id, acl.OpObjectSearch, obj)
 	if err != nil {
 		return err
 	}
@@ -288,18 +285,16 @@ func (b Service) Delete(
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectDelete)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return nil, err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectDelete, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	useObjectIDFromSession(&reqInfo, sTok)
-
 	if !b.checker.CheckBasicACL(reqInfo) {
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
@@ -332,16 +327,15 @@ func (b Service) GetRange(request *objectV2.GetRangeRequest, stream object.GetOb
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectRange)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectRange, obj)
 	if err != nil {
 		return err
 	}
-	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
 		return basicACLErr(reqInfo)
@@ -381,18 +375,16 @@ func (b Service) GetRangeHash(
 		src:     request,
 	}
 
-	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectHash)
+	obj, err := getObjectIDFromRequestBody(request.GetBody())
 	if err != nil {
 		return nil, err
 	}
 
-	reqInfo.obj, err = getObjectIDFromRequestBody(request.GetBody())
+	reqInfo, err := b.findRequestInfo(req, cnr, acl.OpObjectHash, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	useObjectIDFromSession(&reqInfo, sTok)
-
 	if !b.checker.CheckBasicACL(reqInfo) {
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
@@ -436,6 +428,11 @@ func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 			if err != nil {
 				return fmt.Errorf("invalid session token: %w", err)
 			}
+
+			err = assertPutObjectType(*sTok, part.GetHeader().GetObjectType())
+			if err != nil {
+				return sessionScopeErr(acl.OpObjectPut, err)
+			}
 		}
 
 		bTok, err := originalBearerToken(request.GetMetaHeader())
@@ -450,18 +447,16 @@ func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 			src:     request,
 		}
 
-		reqInfo, err := p.source.findRequestInfo(req, cnr, acl.OpObjectPut)
+		obj, err := getObjectIDFromRequestBody(part)
 		if err != nil {
 			return err
 		}
 
-		reqInfo.obj, err = getObjectIDFromRequestBody(part)
+		reqInfo, err := p.source.findRequestInfo(req, cnr, acl.OpObjectPut, obj)
 		if err != nil {
 			return err
 		}
 
-		useObjectIDFromSession(&reqInfo, sTok)
-
 		if !p.source.checker.CheckBasicACL(reqInfo) || !p.source.checker.StickyBitCheck(reqInfo, idOwner) {
 			return basicACLErr(reqInfo)
 		} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
@@ -502,7 +497,7 @@ func (g *searchStreamBasicChecker) Send(resp *objectV2.SearchResponse) error {
 	return g.SearchStream.Send(resp)
 }
 
-func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (info RequestInfo, err error) {
+func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op, obj *oid.ID) (info RequestInfo, err error) {
 	cnr, err := b.containers.Get(idCnr) // fetch actual container
 	if err != nil {
 		return info, err
@@ -518,8 +513,14 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 				ErrMalformedRequest, currentEpoch)
 		}
 
-		if !assertVerb(*req.token, op) {
-			return info, ErrInvalidVerb
+		err = assertSessionScope(*req.token, op, idCnr, obj)
+		if errors.Is(err, errSessionObjectMismatch) && b.c.signedByContainerNode(req, idCnr, cnr.Value) {
+			// container nodes request the members of the split object
+			// (children, link) under the session bound to the parent
+			err = nil
+		}
+		if err != nil {
+			return info, sessionScopeErr(op, err)
 		}
 	}
 
@@ -534,6 +535,7 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 	info.operation = op
 	info.cnrOwner = cnr.Value.Owner()
 	info.idCnr = idCnr
+	info.obj = obj
 
 	// it is assumed that at the moment the key will be valid,
 	// otherwise the request would not pass validation
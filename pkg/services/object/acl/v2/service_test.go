@@ -0,0 +1,175 @@
+package v2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+type testNetmapSource struct {
+	nm *netmap.NetMap
+}
+
+func (s testNetmapSource) GetNetMap(uint64) (*netmap.NetMap, error) {
+	return s.nm, nil
+}
+
+func (s testNetmapSource) GetNetMapByEpoch(uint64) (*netmap.NetMap, error) {
+	return s.nm, nil
+}
+
+func (s testNetmapSource) Epoch() (uint64, error) {
+	return s.nm.Epoch(), nil
+}
+
+type testContainerSource struct {
+	cnr container.Container
+}
+
+func (s testContainerSource) Get(cid.ID) (*containercore.Container, error) {
+	return &containercore.Container{Value: s.cnr}, nil
+}
+
+type testIRFetcher struct{}
+
+func (testIRFetcher) InnerRingKeys() ([][]byte, error) {
+	return nil, nil
+}
+
+func testKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func publicKeyBytes(key *ecdsa.PrivateKey) []byte {
+	return (*keys.PublicKey)(&key.PublicKey).Bytes()
+}
+
+func testVerificationHeader(signers ...*ecdsa.PrivateKey) *sessionV2.RequestVerificationHeader {
+	var res *sessionV2.RequestVerificationHeader
+
+	// keys are listed from the original sender to the last forwarder
+	for i := range signers {
+		var sig refsV2.Signature
+		sig.SetKey(publicKeyBytes(signers[i]))
+
+		v := new(sessionV2.RequestVerificationHeader)
+		v.SetBodySignature(&sig)
+		v.SetOrigin(res)
+
+		res = v
+	}
+
+	return res
+}
+
+func TestFindRequestInfoSplitMember(t *testing.T) {
+	userKey := testKey(t)
+	nodeKey := testKey(t)
+	otherKey := testKey(t)
+
+	var node netmap.NodeInfo
+	node.SetPublicKey(publicKeyBytes(nodeKey))
+
+	var nm netmap.NetMap
+	nm.SetEpoch(10)
+	nm.SetNodes([]netmap.NodeInfo{node})
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 1"))
+
+	var owner user.ID
+	user.IDFromKey(&owner, userKey.PublicKey)
+
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(owner)
+	cnr.SetBasicACL(acl.PublicRWExtended)
+	cnr.SetPlacementPolicy(policy)
+
+	idCnr := cidtest.ID()
+	parent := oidtest.ID()
+	child := oidtest.ID()
+
+	b := Service{
+		cfg: &cfg{
+			log:        test.NewLogger(false),
+			containers: testContainerSource{cnr: cnr},
+			nm:         testNetmapSource{nm: &nm},
+		},
+		c: senderClassifier{
+			log:       test.NewLogger(false),
+			innerRing: testIRFetcher{},
+			netmap:    testNetmapSource{nm: &nm},
+		},
+	}
+
+	verbs := map[acl.Op]sessionSDK.ObjectVerb{
+		acl.OpObjectGet:    sessionSDK.VerbObjectGet,
+		acl.OpObjectHead:   sessionSDK.VerbObjectHead,
+		acl.OpObjectRange:  sessionSDK.VerbObjectRange,
+		acl.OpObjectDelete: sessionSDK.VerbObjectDelete,
+	}
+
+	for op, verb := range verbs {
+		var tok sessionSDK.Object
+		tok.ForVerb(verb)
+		tok.BindContainer(idCnr)
+		tok.LimitByObject(parent)
+		tok.SetExp(nm.Epoch() + 1)
+		require.NoError(t, tok.Sign(*userKey))
+
+		// DELETE session is used to HEAD the link object and the children
+		reqOp := op
+		if op == acl.OpObjectDelete {
+			reqOp = acl.OpObjectHead
+		}
+
+		t.Run(op.String(), func(t *testing.T) {
+			req := MetaWithToken{
+				vheader: testVerificationHeader(userKey),
+				token:   &tok,
+			}
+
+			info, err := b.findRequestInfo(req, idCnr, reqOp, &parent)
+			require.NoError(t, err)
+			require.Equal(t, acl.RoleOwner, info.RequestRole())
+
+			_, err = b.findRequestInfo(req, idCnr, reqOp, &child)
+			require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+
+			req.vheader = testVerificationHeader(otherKey)
+			_, err = b.findRequestInfo(req, idCnr, reqOp, &child)
+			require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+
+			// split member requested by the container node
+			req.vheader = testVerificationHeader(nodeKey)
+			info, err = b.findRequestInfo(req, idCnr, reqOp, &child)
+			require.NoError(t, err)
+			require.Equal(t, acl.RoleOwner, info.RequestRole())
+
+			// request forwarded by the container node
+			req.vheader = testVerificationHeader(userKey, nodeKey)
+			_, err = b.findRequestInfo(req, idCnr, reqOp, &child)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -123,32 +123,40 @@ func getObjectIDFromRequestBody(body interface{}) (*oid.ID, error) {
 	return &id, nil
 }
 
-func useObjectIDFromSession(req *RequestInfo, token *sessionSDK.Object) {
-	if token == nil {
-		return
+// assertSessionScope checks that the session token is applicable to the
+// operation over the given container and object. Object is nil for the
+// operations without the target object.
+//
+// Token limited to the particular object is applied to that object only,
+// otherwise the token covers all objects of the bound container. Put
+// operation under DELETE session is not checked against the object since
+// it is the tombstone which ID is unknown to the token issuer (see
+// assertPutObjectType).
+func assertSessionScope(tok sessionSDK.Object, op acl.Op, cnr cid.ID, obj *oid.ID) error {
+	if !assertVerb(tok, op) {
+		return ErrInvalidVerb
 	}
 
-	// TODO(@cthulhu-rider): It'd be nice to not pull object identifiers from
-	//  the token, but assert them. Track #1420
-	var tokV2 sessionV2.Token
-	token.WriteToV2(&tokV2)
-
-	ctx, ok := tokV2.GetBody().GetContext().(*sessionV2.ObjectSessionContext)
-	if !ok {
-		panic(fmt.Sprintf("wrong object session context %T, is it verified?", tokV2.GetBody().GetContext()))
+	if !tok.AssertContainer(cnr) {
+		return errSessionContainerMismatch
 	}
 
-	idV2 := ctx.GetAddress().GetObjectID()
-	if idV2 == nil {
-		return
+	tombstonePut := op == acl.OpObjectPut && tok.AssertVerb(sessionSDK.VerbObjectDelete)
+	if obj != nil && !tombstonePut && !tok.AssertObject(*obj) {
+		return errSessionObjectMismatch
 	}
 
-	req.obj = new(oid.ID)
+	return nil
+}
 
-	err := req.obj.ReadFromV2(*idV2)
-	if err != nil {
-		panic(fmt.Sprintf("unexpected protocol violation error after correct session token decoding: %v", err))
+// assertPutObjectType checks that the object put under DELETE session is
+// a tombstone, the other objects can be put under PUT session only.
+func assertPutObjectType(tok sessionSDK.Object, typ objectV2.Type) error {
+	if tok.AssertVerb(sessionSDK.VerbObjectDelete) && typ != objectV2.TypeTombstone {
+		return ErrInvalidVerb
 	}
+
+	return nil
 }
 
 func ownerFromToken(token *sessionSDK.Object) (*user.ID, *keys.PublicKey, error) {
@@ -7,9 +7,13 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	aclsdk "github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
 	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
 	"github.com/stretchr/testify/require"
@@ -104,3 +108,93 @@ func TestIsVerbCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestAssertSessionScope(t *testing.T) {
+	ops := []aclsdk.Op{
+		aclsdk.OpObjectPut,
+		aclsdk.OpObjectDelete,
+		aclsdk.OpObjectGet,
+		aclsdk.OpObjectHead,
+		aclsdk.OpObjectRange,
+		aclsdk.OpObjectHash,
+		aclsdk.OpObjectSearch,
+	}
+
+	verbs := map[aclsdk.Op]sessionSDK.ObjectVerb{
+		aclsdk.OpObjectPut:    sessionSDK.VerbObjectPut,
+		aclsdk.OpObjectDelete: sessionSDK.VerbObjectDelete,
+		aclsdk.OpObjectGet:    sessionSDK.VerbObjectGet,
+		aclsdk.OpObjectHead:   sessionSDK.VerbObjectHead,
+		aclsdk.OpObjectRange:  sessionSDK.VerbObjectRange,
+		aclsdk.OpObjectHash:   sessionSDK.VerbObjectRangeHash,
+		aclsdk.OpObjectSearch: sessionSDK.VerbObjectSearch,
+	}
+
+	cnr := cidtest.ID()
+	obj := oidtest.ID()
+	otherCnr := cidtest.ID()
+	otherObj := oidtest.ID()
+
+	for _, op := range ops {
+		var tok sessionSDK.Object
+		tok.ForVerb(verbs[op])
+		tok.BindContainer(cnr)
+
+		t.Run(op.String()+"/container scope", func(t *testing.T) {
+			require.NoError(t, assertSessionScope(tok, op, cnr, nil))
+			require.NoError(t, assertSessionScope(tok, op, cnr, &obj))
+			require.NoError(t, assertSessionScope(tok, op, cnr, &otherObj))
+			require.ErrorIs(t, assertSessionScope(tok, op, otherCnr, &obj), errSessionContainerMismatch)
+		})
+
+		tokObj := tok
+		tokObj.LimitByObject(obj)
+
+		t.Run(op.String()+"/object scope", func(t *testing.T) {
+			require.NoError(t, assertSessionScope(tokObj, op, cnr, nil))
+			require.NoError(t, assertSessionScope(tokObj, op, cnr, &obj))
+			require.ErrorIs(t, assertSessionScope(tokObj, op, otherCnr, &obj), errSessionContainerMismatch)
+
+			require.ErrorIs(t, assertSessionScope(tokObj, op, cnr, &otherObj), errSessionObjectMismatch)
+		})
+
+		t.Run(op.String()+"/wrong verb", func(t *testing.T) {
+			var tokVerb sessionSDK.Object
+			tokVerb.BindContainer(cnr)
+
+			for verbOp, verb := range verbs {
+				if verbOp == op {
+					continue
+				}
+
+				tokVerb.ForVerb(verb)
+				if assertVerb(tokVerb, op) {
+					continue
+				}
+
+				require.ErrorIs(t, assertSessionScope(tokVerb, op, cnr, &obj), ErrInvalidVerb)
+			}
+		})
+	}
+
+	t.Run("tombstone", func(t *testing.T) {
+		var tok sessionSDK.Object
+		tok.ForVerb(sessionSDK.VerbObjectDelete)
+		tok.BindContainer(cnr)
+		tok.LimitByObject(obj)
+
+		// tombstone ID is unknown to the issuer of DELETE session
+		require.NoError(t, assertSessionScope(tok, aclsdk.OpObjectPut, cnr, &otherObj))
+
+		require.NoError(t, assertPutObjectType(tok, objectV2.TypeTombstone))
+		require.ErrorIs(t, assertPutObjectType(tok, objectV2.TypeRegular), ErrInvalidVerb)
+
+		tok.ForVerb(sessionSDK.VerbObjectPut)
+		require.NoError(t, assertPutObjectType(tok, objectV2.TypeRegular))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		err := sessionScopeErr(aclsdk.OpObjectGet, errSessionObjectMismatch)
+		require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+	})
+}
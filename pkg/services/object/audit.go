@@ -0,0 +1,502 @@
+package object
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.uber.org/atomic"
+)
+
+// Operations recorded in the audit log.
+const (
+	AuditPut       = "PUT"
+	AuditGet       = "GET"
+	AuditHead      = "HEAD"
+	AuditSearch    = "SEARCH"
+	AuditDelete    = "DELETE"
+	AuditRange     = "RANGE"
+	AuditRangeHash = "RANGEHASH"
+)
+
+// AuditRecord describes a single request to the object service.
+type AuditRecord struct {
+	// Time is the time the request was received at.
+	Time time.Time `json:"time"`
+
+	// RequestID is a unique identifier of the request.
+	RequestID string `json:"request_id"`
+
+	// Operation is one of the Audit* operations.
+	Operation string `json:"op"`
+
+	// Owner is a user ID of the original request signer.
+	Owner string `json:"owner,omitempty"`
+
+	// SenderKey is a hex-encoded public key of the original request signer.
+	SenderKey string `json:"sender_key,omitempty"`
+
+	// Container is the requested container ID.
+	Container string `json:"container,omitempty"`
+
+	// Object is the requested object ID. Empty for AuditSearch.
+	Object string `json:"object,omitempty"`
+
+	// SessionID is an ID of the session token attached to the request.
+	SessionID string `json:"session_id,omitempty"`
+
+	// SessionIssuer is an issuer of the session token attached to the request.
+	SessionIssuer string `json:"session_issuer,omitempty"`
+
+	// BearerIssuer is an issuer of the bearer token attached to the request.
+	BearerIssuer string `json:"bearer_issuer,omitempty"`
+
+	// Status is a code of the NeoFS API status the request resulted in.
+	Status uint32 `json:"status"`
+
+	// Error is a text of the error the request failed with.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditService is a ServiceServer wrapper which records the requests
+// to the audit log.
+//
+// Records are written asynchronously, so the requests are never blocked
+// by the audit log. If the log writer stalls and the record buffer is full,
+// new records are dropped.
+type AuditService struct {
+	cfg auditCfg
+
+	next ServiceServer
+
+	w io.Writer
+
+	records chan AuditRecord
+
+	dropped *atomic.Uint64
+}
+
+type auditCfg struct {
+	bufSize int
+
+	containers map[cid.ID]struct{}
+
+	ops map[string]struct{}
+
+	metrics AuditMetrics
+}
+
+// AuditMetrics is an interface of the audit log metrics.
+type AuditMetrics interface {
+	// IncAuditDroppedCounter increments the number of the records
+	// which were not written to the audit log.
+	IncAuditDroppedCounter()
+}
+
+type noopAuditMetrics struct{}
+
+func (noopAuditMetrics) IncAuditDroppedCounter() {}
+
+// AuditOption is an option of AuditService constructor.
+type AuditOption func(*auditCfg)
+
+// AuditBufferSizeDefault is a default number of the buffered audit records.
+const AuditBufferSizeDefault = 1024
+
+// WithAuditBufferSize returns option to set the number of records
+// buffered while the log writer is busy.
+func WithAuditBufferSize(sz int) AuditOption {
+	return func(c *auditCfg) {
+		c.bufSize = sz
+	}
+}
+
+// WithAuditContainers returns option to record the requests
+// to the specified containers only.
+func WithAuditContainers(cnrs ...cid.ID) AuditOption {
+	return func(c *auditCfg) {
+		c.containers = make(map[cid.ID]struct{}, len(cnrs))
+		for i := range cnrs {
+			c.containers[cnrs[i]] = struct{}{}
+		}
+	}
+}
+
+// WithAuditOperations returns option to record the specified
+// Audit* operations only.
+func WithAuditOperations(ops ...string) AuditOption {
+	return func(c *auditCfg) {
+		c.ops = make(map[string]struct{}, len(ops))
+		for i := range ops {
+			c.ops[ops[i]] = struct{}{}
+		}
+	}
+}
+
+// WithAuditMetrics returns option to report the audit log metrics to m.
+func WithAuditMetrics(m AuditMetrics) AuditOption {
+	return func(c *auditCfg) {
+		c.metrics = m
+	}
+}
+
+// NewAuditService returns ServiceServer which records the requests to next
+// as JSON lines written to w. Records are written by Run only.
+func NewAuditService(next ServiceServer, w io.Writer, opts ...AuditOption) *AuditService {
+	c := auditCfg{
+		bufSize: AuditBufferSizeDefault,
+		metrics: noopAuditMetrics{},
+	}
+
+	for i := range opts {
+		opts[i](&c)
+	}
+
+	return &AuditService{
+		cfg:     c,
+		next:    next,
+		w:       w,
+		records: make(chan AuditRecord, c.bufSize),
+		dropped: atomic.NewUint64(0),
+	}
+}
+
+// Run writes the audit records until the context is done.
+// Records which could not be written are counted as dropped.
+func (a *AuditService) Run(ctx context.Context) {
+	enc := json.NewEncoder(a.w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case rec := <-a.records:
+			if err := enc.Encode(rec); err != nil {
+				a.drop()
+			}
+		}
+	}
+}
+
+// Dropped returns the number of the records which were not written
+// to the audit log.
+func (a *AuditService) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+func (a *AuditService) drop() {
+	a.dropped.Inc()
+	a.cfg.metrics.IncAuditDroppedCounter()
+}
+
+// auditRequest is a part of the request relevant to the audit log.
+type auditRequest interface {
+	GetMetaHeader() *sessionV2.RequestMetaHeader
+	GetVerificationHeader() *sessionV2.RequestVerificationHeader
+}
+
+func (a *AuditService) newRecord(op string, req auditRequest) (AuditRecord, bool) {
+	if a.cfg.ops != nil {
+		if _, ok := a.cfg.ops[op]; !ok {
+			return AuditRecord{}, false
+		}
+	}
+
+	rec := AuditRecord{
+		Time:      time.Now(),
+		RequestID: uuid.New().String(),
+		Operation: op,
+	}
+
+	if req != nil {
+		setAuditIdentity(&rec, req)
+	}
+
+	return rec, true
+}
+
+// setAuditTarget sets the requested container and object to the record.
+// Returns false if the container is filtered out.
+func (a *AuditService) setAuditTarget(rec *AuditRecord, cnrV2 *refs.ContainerID, objV2 *refs.ObjectID) bool {
+	var cnr cid.ID
+
+	cnrOK := cnrV2 != nil && cnr.ReadFromV2(*cnrV2) == nil
+	if cnrOK {
+		rec.Container = cnr.EncodeToString()
+	}
+
+	if objV2 != nil {
+		var obj oid.ID
+		if obj.ReadFromV2(*objV2) == nil {
+			rec.Object = obj.EncodeToString()
+		}
+	}
+
+	if a.cfg.containers == nil {
+		return true
+	}
+
+	if !cnrOK {
+		return false
+	}
+
+	_, ok := a.cfg.containers[cnr]
+
+	return ok
+}
+
+// push finishes the record with the request result and
+// schedules it for writing. Never blocks.
+func (a *AuditService) push(rec AuditRecord, err error) {
+	if err != nil {
+		rec.Error = err.Error()
+
+		// unwrap error
+		for e := errors.Unwrap(err); e != nil; e = errors.Unwrap(err) {
+			err = e
+		}
+
+		rec.Status = uint32(apistatus.ToStatusV2(apistatus.ErrToStatus(err)).Code())
+	}
+
+	select {
+	case a.records <- rec:
+	default:
+		a.drop()
+	}
+}
+
+func setAuditIdentity(rec *AuditRecord, req auditRequest) {
+	v := req.GetVerificationHeader()
+	if v != nil {
+		for v.GetOrigin() != nil {
+			v = v.GetOrigin()
+		}
+
+		if key := v.GetBodySignature().GetKey(); len(key) > 0 {
+			rec.SenderKey = hex.EncodeToString(key)
+
+			pub, err := keys.NewPublicKeyFromBytes(key, elliptic.P256())
+			if err == nil {
+				var id user.ID
+				user.IDFromKey(&id, (ecdsa.PublicKey)(*pub))
+				rec.Owner = id.EncodeToString()
+			}
+		}
+	}
+
+	meta := req.GetMetaHeader()
+	if meta == nil {
+		return
+	}
+
+	for meta.GetOrigin() != nil {
+		meta = meta.GetOrigin()
+	}
+
+	if tokV2 := meta.GetSessionToken(); tokV2 != nil {
+		var tok session.Object
+		if tok.ReadFromV2(*tokV2) == nil {
+			rec.SessionID = tok.ID().String()
+			rec.SessionIssuer = tok.Issuer().EncodeToString()
+		}
+	}
+
+	if tokV2 := meta.GetBearerToken(); tokV2 != nil {
+		var tok bearer.Token
+		if tok.ReadFromV2(*tokV2) == nil {
+			issuer := bearer.ResolveIssuer(tok)
+			rec.BearerIssuer = issuer.EncodeToString()
+		}
+	}
+}
+
+func (a *AuditService) Get(req *object.GetRequest, stream GetObjectStream) error {
+	rec, ok := a.newRecord(AuditGet, req)
+
+	err := a.next.Get(req, stream)
+
+	if ok {
+		addr := req.GetBody().GetAddress()
+		if a.setAuditTarget(&rec, addr.GetContainerID(), addr.GetObjectID()) {
+			a.push(rec, err)
+		}
+	}
+
+	return err
+}
+
+func (a *AuditService) Put(ctx context.Context) (PutObjectStream, error) {
+	rec, ok := a.newRecord(AuditPut, nil)
+
+	stream, err := a.next.Put(ctx)
+	if err != nil {
+		if ok && a.cfg.containers == nil {
+			a.push(rec, err)
+		}
+
+		return nil, err
+	}
+
+	if !ok {
+		return stream, nil
+	}
+
+	return &putStreamAudit{
+		stream: stream,
+		svc:    a,
+		rec:    rec,
+	}, nil
+}
+
+func (a *AuditService) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	rec, ok := a.newRecord(AuditHead, req)
+
+	resp, err := a.next.Head(ctx, req)
+
+	if ok {
+		addr := req.GetBody().GetAddress()
+		if a.setAuditTarget(&rec, addr.GetContainerID(), addr.GetObjectID()) {
+			a.push(rec, err)
+		}
+	}
+
+	return resp, err
+}
+
+func (a *AuditService) Search(req *object.SearchRequest, stream SearchStream) error {
+	rec, ok := a.newRecord(AuditSearch, req)
+
+	err := a.next.Search(req, stream)
+
+	if ok && a.setAuditTarget(&rec, req.GetBody().GetContainerID(), nil) {
+		a.push(rec, err)
+	}
+
+	return err
+}
+
+func (a *AuditService) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	rec, ok := a.newRecord(AuditDelete, req)
+
+	resp, err := a.next.Delete(ctx, req)
+
+	if ok {
+		addr := req.GetBody().GetAddress()
+		if a.setAuditTarget(&rec, addr.GetContainerID(), addr.GetObjectID()) {
+			a.push(rec, err)
+		}
+	}
+
+	return resp, err
+}
+
+func (a *AuditService) GetRange(req *object.GetRangeRequest, stream GetObjectRangeStream) error {
+	rec, ok := a.newRecord(AuditRange, req)
+
+	err := a.next.GetRange(req, stream)
+
+	if ok {
+		addr := req.GetBody().GetAddress()
+		if a.setAuditTarget(&rec, addr.GetContainerID(), addr.GetObjectID()) {
+			a.push(rec, err)
+		}
+	}
+
+	return err
+}
+
+func (a *AuditService) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	rec, ok := a.newRecord(AuditRangeHash, req)
+
+	resp, err := a.next.GetRangeHash(ctx, req)
+
+	if ok {
+		addr := req.GetBody().GetAddress()
+		if a.setAuditTarget(&rec, addr.GetContainerID(), addr.GetObjectID()) {
+			a.push(rec, err)
+		}
+	}
+
+	return resp, err
+}
+
+// putStreamAudit records the object upload when the stream is
+// closed or fails.
+type putStreamAudit struct {
+	stream PutObjectStream
+
+	svc *AuditService
+
+	rec AuditRecord
+
+	// init is the initial part of the stream, nil until received.
+	init *object.PutRequest
+
+	done bool
+}
+
+func (s *putStreamAudit) Send(req *object.PutRequest) error {
+	if s.init == nil {
+		s.init = req
+		setAuditIdentity(&s.rec, req)
+	}
+
+	err := s.stream.Send(req)
+	if err != nil && !errors.Is(err, util.ErrAbortStream) {
+		s.finish(nil, err)
+	}
+
+	return err
+}
+
+func (s *putStreamAudit) CloseAndRecv() (*object.PutResponse, error) {
+	resp, err := s.stream.CloseAndRecv()
+
+	s.finish(resp, err)
+
+	return resp, err
+}
+
+func (s *putStreamAudit) finish(resp *object.PutResponse, err error) {
+	if s.done {
+		return
+	}
+
+	s.done = true
+
+	var (
+		cnr *refs.ContainerID
+		obj *refs.ObjectID
+	)
+
+	if init, ok := s.init.GetBody().GetObjectPart().(*object.PutObjectPartInit); ok {
+		cnr = init.GetHeader().GetContainerID()
+		obj = init.GetObjectID()
+	}
+
+	if id := resp.GetBody().GetObjectID(); id != nil {
+		obj = id
+	}
+
+	if s.svc.setAuditTarget(&s.rec, cnr, obj) {
+		s.svc.push(s.rec, err)
+	}
+}
@@ -0,0 +1,358 @@
+package object
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+type auditTestServer struct {
+	err error
+
+	putID *refs.ObjectID
+}
+
+type auditTestPutStream struct {
+	srv *auditTestServer
+}
+
+func (s auditTestPutStream) Send(*object.PutRequest) error {
+	return nil
+}
+
+func (s auditTestPutStream) CloseAndRecv() (*object.PutResponse, error) {
+	if s.srv.err != nil {
+		return nil, s.srv.err
+	}
+
+	var body object.PutResponseBody
+	body.SetObjectID(s.srv.putID)
+
+	var resp object.PutResponse
+	resp.SetBody(&body)
+
+	return &resp, nil
+}
+
+func (s *auditTestServer) Get(*object.GetRequest, GetObjectStream) error {
+	return s.err
+}
+
+func (s *auditTestServer) Put(context.Context) (PutObjectStream, error) {
+	return auditTestPutStream{srv: s}, nil
+}
+
+func (s *auditTestServer) Head(context.Context, *object.HeadRequest) (*object.HeadResponse, error) {
+	return nil, s.err
+}
+
+func (s *auditTestServer) Search(*object.SearchRequest, SearchStream) error {
+	return s.err
+}
+
+func (s *auditTestServer) Delete(context.Context, *object.DeleteRequest) (*object.DeleteResponse, error) {
+	return nil, s.err
+}
+
+func (s *auditTestServer) GetRange(*object.GetRangeRequest, GetObjectRangeStream) error {
+	return s.err
+}
+
+func (s *auditTestServer) GetRangeHash(context.Context, *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	return nil, s.err
+}
+
+// auditTestRequest contains the request parts shared by all the operations.
+type auditTestRequest struct {
+	meta   *sessionV2.RequestMetaHeader
+	verify *sessionV2.RequestVerificationHeader
+	addr   *refs.Address
+}
+
+func newAuditTestRequest(t *testing.T, cnr cid.ID, obj oid.ID) (auditTestRequest, AuditRecord) {
+	senderKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	sessionTok := sessiontest.ObjectSigned()
+
+	bearerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	bearerTok := bearertest.Token()
+	require.NoError(t, bearerTok.Sign(bearerKey.PrivateKey))
+
+	var sessionV2Tok sessionV2.Token
+	sessionTok.WriteToV2(&sessionV2Tok)
+
+	var meta sessionV2.RequestMetaHeader
+	meta.SetSessionToken(&sessionV2Tok)
+	meta.SetBearerToken(bearerTokenV2(bearerTok))
+
+	var sig refs.Signature
+	sig.SetKey(senderKey.PublicKey().Bytes())
+
+	var verify sessionV2.RequestVerificationHeader
+	verify.SetBodySignature(&sig)
+
+	var cnrV2 refs.ContainerID
+	cnr.WriteToV2(&cnrV2)
+
+	var objV2 refs.ObjectID
+	obj.WriteToV2(&objV2)
+
+	var addr refs.Address
+	addr.SetContainerID(&cnrV2)
+	addr.SetObjectID(&objV2)
+
+	var owner user.ID
+	user.IDFromKey(&owner, senderKey.PrivateKey.PublicKey)
+
+	bearerIssuer := bearer.ResolveIssuer(bearerTok)
+	sessionIssuer := sessionTok.Issuer()
+
+	return auditTestRequest{meta: &meta, verify: &verify, addr: &addr}, AuditRecord{
+		Owner:         owner.EncodeToString(),
+		SenderKey:     hex.EncodeToString(senderKey.PublicKey().Bytes()),
+		Container:     cnr.EncodeToString(),
+		Object:        obj.EncodeToString(),
+		SessionID:     sessionTok.ID().String(),
+		SessionIssuer: sessionIssuer.EncodeToString(),
+		BearerIssuer:  bearerIssuer.EncodeToString(),
+	}
+}
+
+func bearerTokenV2(tok bearer.Token) *acl.BearerToken {
+	var v2 acl.BearerToken
+	tok.WriteToV2(&v2)
+	return &v2
+}
+
+func (r auditTestRequest) requests() map[string]func(ServiceServer) error {
+	type request interface {
+		SetMetaHeader(*sessionV2.RequestMetaHeader)
+		SetVerificationHeader(*sessionV2.RequestVerificationHeader)
+	}
+
+	withHeaders := func(req request) {
+		req.SetMetaHeader(r.meta)
+		req.SetVerificationHeader(r.verify)
+	}
+
+	return map[string]func(ServiceServer) error{
+		AuditGet: func(s ServiceServer) error {
+			var body object.GetRequestBody
+			body.SetAddress(r.addr)
+
+			var req object.GetRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			return s.Get(&req, nil)
+		},
+		AuditPut: func(s ServiceServer) error {
+			var hdr object.Header
+			hdr.SetContainerID(r.addr.GetContainerID())
+
+			var init object.PutObjectPartInit
+			init.SetHeader(&hdr)
+
+			var body object.PutRequestBody
+			body.SetObjectPart(&init)
+
+			var req object.PutRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			stream, err := s.Put(context.Background())
+			if err != nil {
+				return err
+			}
+
+			if err = stream.Send(&req); err != nil {
+				return err
+			}
+
+			_, err = stream.CloseAndRecv()
+			return err
+		},
+		AuditHead: func(s ServiceServer) error {
+			var body object.HeadRequestBody
+			body.SetAddress(r.addr)
+
+			var req object.HeadRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			_, err := s.Head(context.Background(), &req)
+			return err
+		},
+		AuditSearch: func(s ServiceServer) error {
+			var body object.SearchRequestBody
+			body.SetContainerID(r.addr.GetContainerID())
+
+			var req object.SearchRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			return s.Search(&req, nil)
+		},
+		AuditDelete: func(s ServiceServer) error {
+			var body object.DeleteRequestBody
+			body.SetAddress(r.addr)
+
+			var req object.DeleteRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			_, err := s.Delete(context.Background(), &req)
+			return err
+		},
+		AuditRange: func(s ServiceServer) error {
+			var body object.GetRangeRequestBody
+			body.SetAddress(r.addr)
+
+			var req object.GetRangeRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			return s.GetRange(&req, nil)
+		},
+		AuditRangeHash: func(s ServiceServer) error {
+			var body object.GetRangeHashRequestBody
+			body.SetAddress(r.addr)
+
+			var req object.GetRangeHashRequest
+			req.SetBody(&body)
+			withHeaders(&req)
+
+			_, err := s.GetRangeHash(context.Background(), &req)
+			return err
+		},
+	}
+}
+
+func TestAuditService(t *testing.T) {
+	cnr := cidtest.ID()
+	obj := oidtest.ID()
+
+	req, exp := newAuditTestRequest(t, cnr, obj)
+	next := &auditTestServer{putID: req.addr.GetObjectID()}
+
+	r, w := io.Pipe()
+	svc := NewAuditService(next, w)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go svc.Run(ctx)
+
+	dec := json.NewDecoder(r)
+
+	notFound := fmt.Errorf("could not get object: %w", apistatus.ObjectNotFound{})
+
+	for op, do := range req.requests() {
+		for _, opErr := range []error{nil, notFound} {
+			next.err = opErr
+
+			err := do(svc)
+			require.ErrorIs(t, err, opErr, op)
+
+			var rec AuditRecord
+			require.NoError(t, dec.Decode(&rec), op)
+			require.NotEmpty(t, rec.RequestID, op)
+			require.False(t, rec.Time.IsZero(), op)
+
+			expRec := exp
+			expRec.Time = rec.Time
+			expRec.RequestID = rec.RequestID
+			expRec.Operation = op
+
+			if op == AuditSearch || op == AuditPut && opErr != nil {
+				expRec.Object = ""
+			}
+
+			if opErr != nil {
+				expRec.Error = opErr.Error()
+				expRec.Status = uint32(apistatus.ToStatusV2(apistatus.ObjectNotFound{}).Code())
+			}
+
+			require.Equal(t, expRec, rec, op)
+		}
+	}
+
+	require.Zero(t, svc.Dropped())
+}
+
+func TestAuditService_Filters(t *testing.T) {
+	cnr := cidtest.ID()
+
+	req, _ := newAuditTestRequest(t, cnr, oidtest.ID())
+	otherReq, _ := newAuditTestRequest(t, cidtest.ID(), oidtest.ID())
+
+	next := &auditTestServer{putID: req.addr.GetObjectID()}
+
+	svc := NewAuditService(next, io.Discard,
+		WithAuditContainers(cnr),
+		WithAuditOperations(AuditPut, AuditGet))
+
+	for op, do := range req.requests() {
+		require.NoError(t, do(svc))
+
+		if op == AuditPut || op == AuditGet {
+			require.Len(t, svc.records, 1, op)
+
+			rec := <-svc.records
+			require.Equal(t, op, rec.Operation)
+		} else {
+			require.Empty(t, svc.records, op)
+		}
+	}
+
+	for op, do := range otherReq.requests() {
+		require.NoError(t, do(svc))
+		require.Empty(t, svc.records, op)
+	}
+}
+
+func TestAuditService_Dropped(t *testing.T) {
+	req, _ := newAuditTestRequest(t, cidtest.ID(), oidtest.ID())
+
+	var m auditTestMetrics
+	svc := NewAuditService(&auditTestServer{}, io.Discard, WithAuditBufferSize(1), WithAuditMetrics(&m))
+
+	// writer is not running, so the records are not consumed
+	for i := 0; i < 3; i++ {
+		require.NoError(t, req.requests()[AuditHead](svc))
+	}
+
+	require.Len(t, svc.records, 1)
+	require.EqualValues(t, 2, svc.Dropped())
+	require.EqualValues(t, 2, m.dropped)
+}
+
+type auditTestMetrics struct {
+	dropped int
+}
+
+func (m *auditTestMetrics) IncAuditDroppedCounter() {
+	m.dropped++
+}
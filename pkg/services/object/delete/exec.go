@@ -2,6 +2,7 @@ package deletesvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -13,6 +14,16 @@ import (
 	"go.uber.org/zap"
 )
 
+// errIncompleteTombstonePlacement is returned if the tombstone
+// has been saved on fewer nodes than required.
+type errIncompleteTombstonePlacement struct {
+	placed, required uint32
+}
+
+func (x errIncompleteTombstonePlacement) Error() string {
+	return fmt.Sprintf("incomplete tombstone placement: saved on %d nodes, %d required", x.placed, x.required)
+}
+
 type statusError struct {
 	status int
 	err    error
@@ -34,6 +45,9 @@ type execCtx struct {
 	splitInfo *object.SplitInfo
 
 	tombstoneObj *object.Object
+
+	// number of nodes the tombstone has been saved on
+	tombstoneCopies uint32
 }
 
 const (
@@ -264,7 +278,11 @@ func (exec *execCtx) initTombstoneObject() bool {
 }
 
 func (exec *execCtx) saveTombstone() bool {
-	id, err := exec.svc.placer.put(exec)
+	id, copies, err := exec.svc.placer.put(exec)
+	if err == nil {
+		exec.tombstoneCopies = copies
+		err = exec.checkTombstoneCopies()
+	}
 
 	switch {
 	default:
@@ -282,7 +300,45 @@ func (exec *execCtx) saveTombstone() bool {
 
 		exec.prm.tombAddrWriter.
 			SetAddress(exec.newAddress(*id))
+
+		if exec.prm.tombCopiesWriter != nil {
+			exec.prm.tombCopiesWriter.SetTombstoneCopies(copies)
+		}
 	}
 
 	return true
 }
+
+// checkTombstoneCopies returns an error if the tombstone has been saved
+// on fewer nodes than required.
+func (exec *execCtx) checkTombstoneCopies() error {
+	if exec.isLocal() {
+		return nil
+	}
+
+	required := exec.svc.tombstoneCopies
+	if required == 0 {
+		if exec.svc.cnrSrc == nil {
+			return nil
+		}
+
+		cnr, err := exec.svc.cnrSrc.Get(exec.containerID())
+		if err != nil {
+			return fmt.Errorf("could not get container: %w", err)
+		}
+
+		policy := cnr.Value.PlacementPolicy()
+		for i := 0; i < policy.NumberOfReplicas(); i++ {
+			required += policy.ReplicaNumberByIndex(i)
+		}
+	}
+
+	if exec.tombstoneCopies < required {
+		return errIncompleteTombstonePlacement{
+			placed:   exec.tombstoneCopies,
+			required: required,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package deletesvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testPlacer struct {
+	copies uint32
+}
+
+func (p testPlacer) put(*execCtx) (*oid.ID, uint32, error) {
+	id := oidtest.ID()
+	return &id, p.copies, nil
+}
+
+type testContainerSource struct {
+	policy string
+}
+
+func (s testContainerSource) Get(cid.ID) (*container.Container, error) {
+	var policy netmap.PlacementPolicy
+	if err := policy.DecodeString(s.policy); err != nil {
+		return nil, err
+	}
+
+	var cnr containerSDK.Container
+	cnr.SetPlacementPolicy(policy)
+
+	return &container.Container{Value: cnr}, nil
+}
+
+type testAddressWriter struct {
+	set bool
+}
+
+func (w *testAddressWriter) SetAddress(oid.Address) {
+	w.set = true
+}
+
+type testCopiesWriter struct {
+	n uint32
+}
+
+func (w *testCopiesWriter) SetTombstoneCopies(n uint32) {
+	w.n = n
+}
+
+func TestSaveTombstone(t *testing.T) {
+	newExec := func(placed, minCopies uint32) (*execCtx, *testAddressWriter, *testCopiesWriter) {
+		var (
+			addrWriter   testAddressWriter
+			copiesWriter testCopiesWriter
+		)
+
+		exec := &execCtx{
+			svc: &Service{cfg: &cfg{
+				placer:          testPlacer{copies: placed},
+				cnrSrc:          testContainerSource{policy: "REP 2 REP 1"},
+				tombstoneCopies: minCopies,
+			}},
+			log: zap.NewNop(),
+		}
+		exec.prm.WithAddress(oidtest.Address())
+		exec.prm.WithTombstoneAddressTarget(&addrWriter)
+		exec.prm.WithTombstoneCopiesTarget(&copiesWriter)
+
+		return exec, &addrWriter, &copiesWriter
+	}
+
+	t.Run("policy replicas", func(t *testing.T) {
+		exec, addrWriter, copiesWriter := newExec(3, 0)
+		require.True(t, exec.saveTombstone())
+		require.Equal(t, statusOK, exec.status)
+		require.True(t, addrWriter.set)
+		require.EqualValues(t, 3, copiesWriter.n)
+
+		exec, addrWriter, _ = newExec(2, 0)
+		require.False(t, exec.saveTombstone())
+		require.ErrorAs(t, exec.err, new(errIncompleteTombstonePlacement))
+		require.False(t, addrWriter.set)
+	})
+
+	t.Run("configured copies", func(t *testing.T) {
+		exec, _, copiesWriter := newExec(1, 1)
+		require.True(t, exec.saveTombstone())
+		require.EqualValues(t, 1, copiesWriter.n)
+
+		exec, _, _ = newExec(1, 2)
+		require.False(t, exec.saveTombstone())
+		require.ErrorAs(t, exec.err, new(errIncompleteTombstonePlacement))
+	})
+}
+
+type testHeader struct{}
+
+func (testHeader) splitInfo(*execCtx) (*object.SplitInfo, error) {
+	return nil, nil
+}
+
+func (testHeader) children(*execCtx) ([]oid.ID, error) {
+	return nil, nil
+}
+
+func (testHeader) previous(*execCtx, oid.ID) (*oid.ID, error) {
+	return nil, nil
+}
+
+type testNetInfo struct{}
+
+func (testNetInfo) CurrentEpoch() uint64 {
+	return 10
+}
+
+func (testNetInfo) TombstoneLifetime() (uint64, error) {
+	return 5, nil
+}
+
+func (testNetInfo) LocalNodeID() user.ID {
+	return *usertest.ID()
+}
+
+// testStorage saves tombstones and remembers the objects they cover.
+type testStorage struct {
+	saved int
+
+	graves map[oid.Address]oid.Address
+}
+
+func (s *testStorage) put(exec *execCtx) (*oid.ID, uint32, error) {
+	id := oidtest.ID()
+
+	s.saved++
+	s.graves[exec.address()] = exec.newAddress(id)
+
+	return &id, 1, nil
+}
+
+func (s *testStorage) tombstone(exec *execCtx) (*oid.Address, error) {
+	if tomb, ok := s.graves[exec.address()]; ok {
+		return &tomb, nil
+	}
+
+	return nil, nil
+}
+
+type testReuseWriter struct {
+	addr   oid.Address
+	reused bool
+}
+
+func (w *testReuseWriter) SetAddress(addr oid.Address) {
+	w.addr = addr
+}
+
+func (w *testReuseWriter) SetTombstoneReused(v bool) {
+	w.reused = v
+}
+
+func TestRepeatedDelete(t *testing.T) {
+	storage := &testStorage{graves: make(map[oid.Address]oid.Address)}
+
+	svc := &Service{cfg: &cfg{
+		log:             zap.NewNop(),
+		header:          testHeader{},
+		placer:          storage,
+		graveyard:       storage,
+		netInfo:         testNetInfo{},
+		tombstoneCopies: 1,
+	}}
+
+	addr := oidtest.Address()
+
+	del := func(force bool) testReuseWriter {
+		var (
+			w   testReuseWriter
+			prm Prm
+		)
+
+		prm.WithAddress(addr)
+		prm.WithTombstoneAddressTarget(&w)
+		prm.WithTombstoneReuseTarget(&w)
+		prm.WithForceTombstone(force)
+
+		require.NoError(t, svc.Delete(context.Background(), prm))
+
+		return w
+	}
+
+	res := del(false)
+	require.False(t, res.reused)
+	require.Equal(t, 1, storage.saved)
+
+	tomb := res.addr
+
+	res = del(false)
+	require.True(t, res.reused)
+	require.Equal(t, tomb, res.addr)
+	require.Equal(t, 1, storage.saved)
+
+	t.Run("force", func(t *testing.T) {
+		res := del(true)
+		require.False(t, res.reused)
+		require.NotEqual(t, tomb, res.addr)
+		require.Equal(t, 2, storage.saved)
+	})
+
+	t.Run("graveyard failure", func(t *testing.T) {
+		svc.graveyard = failingGraveyard{}
+		t.Cleanup(func() { svc.graveyard = storage })
+
+		res := del(false)
+		require.False(t, res.reused)
+		require.Equal(t, 3, storage.saved)
+	})
+}
+
+type failingGraveyard struct{}
+
+func (failingGraveyard) tombstone(*execCtx) (*oid.Address, error) {
+	return nil, errors.New("any error")
+}
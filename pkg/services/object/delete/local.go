@@ -7,6 +7,10 @@ import (
 )
 
 func (exec *execCtx) executeLocal() {
+	if !exec.prm.forceTombstone && exec.reuseTombstone() {
+		return
+	}
+
 	exec.log.Debug("forming tombstone structure...")
 
 	ok := exec.formTombstone()
@@ -63,3 +67,39 @@ func (exec *execCtx) formTombstone() (ok bool) {
 
 	return true
 }
+
+// reuseTombstone checks whether the object has already been removed and
+// writes the address of its existing tombstone to the response if so.
+func (exec *execCtx) reuseTombstone() bool {
+	if exec.svc.graveyard == nil {
+		return false
+	}
+
+	tomb, err := exec.svc.graveyard.tombstone(exec)
+
+	switch {
+	case err != nil:
+		exec.log.Debug("could not look for the existing tombstone",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	case tomb == nil:
+		return false
+	}
+
+	exec.log.Debug("object is already removed, reusing its tombstone",
+		zap.Stringer("tombstone", tomb),
+	)
+
+	exec.status = statusOK
+	exec.err = nil
+
+	exec.prm.tombAddrWriter.SetAddress(*tomb)
+
+	if exec.prm.tombReuseWriter != nil {
+		exec.prm.tombReuseWriter.SetTombstoneReused(true)
+	}
+
+	return true
+}
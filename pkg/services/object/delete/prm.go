@@ -10,6 +10,19 @@ type TombstoneAddressWriter interface {
 	SetAddress(address oid.Address)
 }
 
+// TombstoneCopiesWriter is an interface of the setter of the number
+// of nodes the tombstone has been saved on.
+type TombstoneCopiesWriter interface {
+	SetTombstoneCopies(uint32)
+}
+
+// TombstoneReuseWriter is an interface of the setter of the flag
+// telling whether the existing tombstone has been returned instead
+// of the new one.
+type TombstoneReuseWriter interface {
+	SetTombstoneReused(bool)
+}
+
 // Prm groups parameters of Delete service call.
 type Prm struct {
 	common *util.CommonPrm
@@ -17,6 +30,12 @@ type Prm struct {
 	addr oid.Address
 
 	tombAddrWriter TombstoneAddressWriter
+
+	tombCopiesWriter TombstoneCopiesWriter
+
+	tombReuseWriter TombstoneReuseWriter
+
+	forceTombstone bool
 }
 
 // SetCommonParameters sets common parameters of the operation.
@@ -33,3 +52,22 @@ func (p *Prm) WithAddress(addr oid.Address) {
 func (p *Prm) WithTombstoneAddressTarget(w TombstoneAddressWriter) {
 	p.tombAddrWriter = w
 }
+
+// WithTombstoneCopiesTarget sets destination of the number of nodes
+// the tombstone has been saved on.
+func (p *Prm) WithTombstoneCopiesTarget(w TombstoneCopiesWriter) {
+	p.tombCopiesWriter = w
+}
+
+// WithTombstoneReuseTarget sets destination of the flag telling whether
+// the object had already been removed and its existing tombstone has
+// been returned.
+func (p *Prm) WithTombstoneReuseTarget(w TombstoneReuseWriter) {
+	p.tombReuseWriter = w
+}
+
+// WithForceTombstone sets flag to create a new tombstone even if
+// the object is known to be already removed.
+func (p *Prm) WithForceTombstone(v bool) {
+	p.forceTombstone = v
+}
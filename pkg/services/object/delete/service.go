@@ -1,7 +1,9 @@
 package deletesvc
 
 import (
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
@@ -52,12 +54,24 @@ type cfg struct {
 	}
 
 	placer interface {
-		put(*execCtx) (*oid.ID, error)
+		// must return the number of nodes the object has been saved on
+		put(*execCtx) (*oid.ID, uint32, error)
+	}
+
+	graveyard interface {
+		// must return (nil, nil) if the object is not known to be removed
+		tombstone(*execCtx) (*oid.Address, error)
 	}
 
 	netInfo NetworkInfo
 
 	keyStorage *util.KeyStorage
+
+	cnrSrc container.Source
+
+	// minimum number of tombstone copies, zero means
+	// the number of replicas in the container placement policy
+	tombstoneCopies uint32
 }
 
 func defaultCfg() *cfg {
@@ -109,6 +123,14 @@ func WithPutService(p *putsvc.Service) Option {
 	}
 }
 
+// WithLocalStorageEngine returns option to set local storage
+// instance to look for the tombstones of the already removed objects.
+func WithLocalStorageEngine(e *engine.StorageEngine) Option {
+	return func(c *cfg) {
+		c.graveyard = (*storageEngineWrapper)(e)
+	}
+}
+
 // WithNetworkInfo returns option to set network information source.
 func WithNetworkInfo(netInfo NetworkInfo) Option {
 	return func(c *cfg) {
@@ -122,3 +144,21 @@ func WithKeyStorage(ks *util.KeyStorage) Option {
 		c.keyStorage = ks
 	}
 }
+
+// WithContainerSource returns option to set container source
+// to read container placement policy from.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = src
+	}
+}
+
+// WithTombstoneCopies returns option to set minimum number of container
+// nodes the tombstone must be saved on for the removal to be successful.
+// Zero value (default) means the number of replicas in the container
+// placement policy.
+func WithTombstoneCopies(n uint32) Option {
+	return func(c *cfg) {
+		c.tombstoneCopies = n
+	}
+}
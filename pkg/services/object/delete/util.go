@@ -3,6 +3,7 @@ package deletesvc
 import (
 	"errors"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
@@ -16,11 +17,13 @@ type searchSvcWrapper searchsvc.Service
 
 type putSvcWrapper putsvc.Service
 
+type storageEngineWrapper engine.StorageEngine
+
 type simpleIDWriter struct {
 	ids []oid.ID
 }
 
-func (w *headSvcWrapper) headAddress(exec *execCtx, addr oid.Address) (*object.Object, error) {
+func (w *headSvcWrapper) headAddress(exec *execCtx, addr oid.Address, splitMember bool) (*object.Object, error) {
 	wr := getsvc.NewSimpleObjectWriter()
 
 	p := getsvc.HeadPrm{}
@@ -29,6 +32,10 @@ func (w *headSvcWrapper) headAddress(exec *execCtx, addr oid.Address) (*object.O
 	p.WithRawFlag(true)
 	p.WithAddress(addr)
 
+	if splitMember {
+		p.SetSplitMember()
+	}
+
 	err := (*getsvc.Service)(w).Head(exec.context(), p)
 	if err != nil {
 		return nil, err
@@ -38,7 +45,7 @@ func (w *headSvcWrapper) headAddress(exec *execCtx, addr oid.Address) (*object.O
 }
 
 func (w *headSvcWrapper) splitInfo(exec *execCtx) (*object.SplitInfo, error) {
-	_, err := w.headAddress(exec, exec.address())
+	_, err := w.headAddress(exec, exec.address(), false)
 
 	var errSplitInfo *object.SplitInfoError
 
@@ -57,7 +64,7 @@ func (w *headSvcWrapper) children(exec *execCtx) ([]oid.ID, error) {
 
 	a := exec.newAddress(link)
 
-	linking, err := w.headAddress(exec, a)
+	linking, err := w.headAddress(exec, a, true)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +75,7 @@ func (w *headSvcWrapper) children(exec *execCtx) ([]oid.ID, error) {
 func (w *headSvcWrapper) previous(exec *execCtx, id oid.ID) (*oid.ID, error) {
 	a := exec.newAddress(id)
 
-	h, err := w.headAddress(exec, a)
+	h, err := w.headAddress(exec, a, true)
 	if err != nil {
 		return nil, err
 	}
@@ -107,10 +114,10 @@ func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 	return nil
 }
 
-func (w *putSvcWrapper) put(exec *execCtx) (*oid.ID, error) {
+func (w *putSvcWrapper) put(exec *execCtx) (*oid.ID, uint32, error) {
 	streamer, err := (*putsvc.Service)(w).Put(exec.context())
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	payload := exec.tombstoneObj.Payload()
@@ -121,20 +128,35 @@ func (w *putSvcWrapper) put(exec *execCtx) (*oid.ID, error) {
 
 	err = streamer.Init(initPrm)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	err = streamer.SendChunk(new(putsvc.PutChunkPrm).WithChunk(payload))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	r, err := streamer.Close()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	id := r.ObjectID()
 
-	return &id, nil
+	return &id, r.PlacedCopies(), nil
+}
+
+func (w *storageEngineWrapper) tombstone(exec *execCtx) (*oid.Address, error) {
+	sts, err := (*engine.StorageEngine)(w).ObjectStatus(exec.address())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sts {
+		if tomb := sts[i].Status.Metabase.Tombstone; tomb != nil {
+			return tomb, nil
+		}
+	}
+
+	return nil, nil
 }
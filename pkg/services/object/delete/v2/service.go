@@ -2,11 +2,27 @@ package deletesvc
 
 import (
 	"context"
+	"strconv"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	deletesvc "github.com/nspcc-dev/neofs-node/pkg/services/object/delete"
 )
 
+// XHeaderTombstoneCopies is a key of the response X-Header with the number
+// of container nodes the tombstone has been saved on.
+const XHeaderTombstoneCopies = "__NEOFS__TOMBSTONE_COPIES"
+
+// XHeaderTombstoneReused is a key of the response X-Header set to "true"
+// if the object had already been removed and its existing tombstone
+// has been returned.
+const XHeaderTombstoneReused = "__NEOFS__TOMBSTONE_REUSED"
+
+// XHeaderForceTombstone is a key of the request X-Header which, being set
+// to "true", makes the node create a new tombstone even if the object
+// is known to be already removed.
+const XHeaderForceTombstone = "__NEOFS__FORCE_TOMBSTONE"
+
 // Service implements Delete operation of Object service v2.
 type Service struct {
 	*cfg
@@ -39,16 +55,49 @@ func (s *Service) Delete(ctx context.Context, req *objectV2.DeleteRequest) (*obj
 	body := new(objectV2.DeleteResponseBody)
 	resp.SetBody(body)
 
+	var (
+		copies tombstoneCopiesWriter
+		reused tombstoneReuseWriter
+	)
+
 	p, err := s.toPrm(req, body)
 	if err != nil {
 		return nil, err
 	}
 
+	p.WithTombstoneCopiesTarget(&copies)
+	p.WithTombstoneReuseTarget(&reused)
+
 	err = s.svc.Delete(ctx, *p)
 	if err != nil {
 		return nil, err
 	}
 
+	var xHdrs []session.XHeader
+
+	if copies.set {
+		var xHdr session.XHeader
+		xHdr.SetKey(XHeaderTombstoneCopies)
+		xHdr.SetValue(strconv.FormatUint(uint64(copies.n), 10))
+
+		xHdrs = append(xHdrs, xHdr)
+	}
+
+	if reused.v {
+		var xHdr session.XHeader
+		xHdr.SetKey(XHeaderTombstoneReused)
+		xHdr.SetValue(strconv.FormatBool(true))
+
+		xHdrs = append(xHdrs, xHdr)
+	}
+
+	if len(xHdrs) > 0 {
+		meta := new(session.ResponseMetaHeader)
+		meta.SetXHeaders(xHdrs)
+
+		resp.SetMetaHeader(meta)
+	}
+
 	return resp, nil
 }
 
@@ -3,6 +3,7 @@ package deletesvc
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
@@ -39,6 +40,7 @@ func (s *Service) toPrm(req *objectV2.DeleteRequest, respBody *objectV2.DeleteRe
 	p.SetCommonParameters(commonPrm)
 
 	p.WithAddress(addr)
+	p.WithForceTombstone(forceTombstone(req))
 	p.WithTombstoneAddressTarget(&tombstoneBodyWriter{
 		body: respBody,
 	})
@@ -46,6 +48,35 @@ func (s *Service) toPrm(req *objectV2.DeleteRequest, respBody *objectV2.DeleteRe
 	return p, nil
 }
 
+type tombstoneCopiesWriter struct {
+	n   uint32
+	set bool
+}
+
+func (w *tombstoneCopiesWriter) SetTombstoneCopies(n uint32) {
+	w.n, w.set = n, true
+}
+
+type tombstoneReuseWriter struct {
+	v bool
+}
+
+func (w *tombstoneReuseWriter) SetTombstoneReused(v bool) {
+	w.v = v
+}
+
+func forceTombstone(req *objectV2.DeleteRequest) bool {
+	xHdrs := req.GetMetaHeader().GetXHeaders()
+	for i := range xHdrs {
+		if xHdrs[i].GetKey() == XHeaderForceTombstone {
+			v, _ := strconv.ParseBool(xHdrs[i].GetValue())
+			return v
+		}
+	}
+
+	return false
+}
+
 func (w *tombstoneBodyWriter) SetAddress(addr oid.Address) {
 	var addrV2 refs.Address
 	addr.WriteToV2(&addrV2)
@@ -1,6 +1,8 @@
 package getsvc
 
 import (
+	"fmt"
+
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -10,6 +12,7 @@ import (
 func (exec *execCtx) assemble() {
 	if !exec.canAssemble() {
 		exec.log.Debug("can not assemble the object")
+		exec.denyVirtual("object assembly is disabled")
 		return
 	}
 
@@ -27,6 +30,7 @@ func (exec *execCtx) assemble() {
 		childID, ok = splitInfo.LastPart()
 		if !ok {
 			exec.log.Debug("neither linking nor last part of split-chain is presented in split info")
+			exec.denyVirtual("no split-chain parts to assemble the object from")
 			return
 		}
 	}
@@ -64,6 +68,20 @@ func (exec *execCtx) assemble() {
 	}
 }
 
+// denyVirtual replaces SplitInfoError of the virtual object that can not be
+// assembled with ObjectNotFound error. Raw and HEAD requests are not affected
+// since SplitInfoError is the expected response to them.
+func (exec *execCtx) denyVirtual(reason string) {
+	if exec.isRaw() || exec.headOnly() {
+		return
+	}
+
+	var errNotFound apistatus.ObjectNotFound
+
+	exec.status = statusUndefined
+	exec.err = fmt.Errorf("%w: virtual object payload is not available: %s", errNotFound, reason)
+}
+
 func (exec *execCtx) initFromChild(obj oid.ID) (prev *oid.ID, children []oid.ID) {
 	log := exec.log.With(zap.Stringer("child ID", obj))
 
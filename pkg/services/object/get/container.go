@@ -66,6 +66,13 @@ func (exec *execCtx) processCurrentEpoch() bool {
 		}
 
 		for i := range addrs {
+			if exec.written {
+				// another node would write the response from the beginning
+				exec.log.Debug("response has been partially written, abort placement iteration")
+
+				return true
+			}
+
 			select {
 			case <-ctx.Done():
 				exec.log.Debug("interrupt placement iteration by context",
@@ -83,7 +90,15 @@ func (exec *execCtx) processCurrentEpoch() bool {
 
 			client.NodeInfoFromNetmapElement(&info, addrs[i])
 
-			if exec.processNode(ctx, info) {
+			// give every node its share of the request deadline until
+			// it responds, so the unavailable ones do not exhaust it
+			nodeCtx, responded, nodeCancel := traverser.NodeResponseContext(ctx)
+			exec.nodeResponded = responded
+			ok := exec.processNode(nodeCtx, info)
+			exec.nodeResponded = nil
+			nodeCancel()
+
+			if ok {
 				exec.log.Debug("completing the operation")
 				return true
 			}
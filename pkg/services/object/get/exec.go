@@ -40,7 +40,21 @@ type execCtx struct {
 
 	head bool
 
+	short bool
+
 	curProcEpoch uint64
+
+	hashForwarder RangeHashForwarder
+
+	hashes *[][]byte
+
+	payloadVerified bool
+
+	// nodeResponded stops the timeout of the remote node being processed
+	nodeResponded func()
+
+	// written is set once anything is written to the object writer
+	written bool
 }
 
 type execOption func(*execCtx)
@@ -59,15 +73,33 @@ func headOnly() execOption {
 	}
 }
 
+func shortHeaderOnly() execOption {
+	return func(c *execCtx) {
+		c.short = true
+	}
+}
+
 func withPayloadRange(r *objectSDK.Range) execOption {
 	return func(c *execCtx) {
 		c.prm.rng = r
 	}
 }
 
+// withRangeHashForwarder makes the execution context forward the range hash
+// request to the remote nodes instead of reading the object from them. The
+// received hashes are written to dst.
+func withRangeHashForwarder(f RangeHashForwarder, dst *[][]byte) execOption {
+	return func(c *execCtx) {
+		c.hashForwarder = f
+		c.hashes = dst
+	}
+}
+
 func (exec *execCtx) setLogger(l *logger.Logger) {
 	req := "GET"
-	if exec.headOnly() {
+	if exec.isRangeHashForwarding() {
+		req = "GET_RANGE_HASH"
+	} else if exec.headOnly() {
 		req = "HEAD"
 	} else if exec.ctxRange() != nil {
 		req = "GET_RANGE"
@@ -105,6 +137,10 @@ func (exec execCtx) isChild(obj *objectSDK.Object) bool {
 }
 
 func (exec execCtx) key() (*ecdsa.PrivateKey, error) {
+	if exec.prm.splitMember {
+		return exec.svc.keyStore.GetKey(nil)
+	}
+
 	var sessionInfo *util.SessionInfo
 
 	if tok := exec.prm.common.SessionToken(); tok != nil {
@@ -137,6 +173,10 @@ func (exec *execCtx) headOnly() bool {
 	return exec.head
 }
 
+func (exec *execCtx) shortHeaderOnly() bool {
+	return exec.short
+}
+
 func (exec *execCtx) netmapEpoch() uint64 {
 	return exec.prm.common.NetmapEpoch()
 }
@@ -195,6 +235,9 @@ func (exec *execCtx) getChild(id oid.ID, rng *objectSDK.Range, withHdr bool) (*o
 	p := exec.prm
 	p.common = p.common.WithLocalOnly(false)
 	p.objWriter = w
+	p.verified = nil
+	p.forwarder = nil
+	p.SetSplitMember()
 	p.SetRange(rng)
 
 	p.addr.SetContainer(exec.containerID())
@@ -218,6 +261,8 @@ func (exec *execCtx) getChild(id oid.ID, rng *objectSDK.Range, withHdr bool) (*o
 func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
 	p := exec.prm
 	p.common = p.common.WithLocalOnly(false)
+	p.forwarder = nil
+	p.SetSplitMember()
 	p.addr.SetContainer(exec.containerID())
 	p.addr.SetObject(id)
 
@@ -336,6 +381,10 @@ func (exec *execCtx) writeObjectPayload(obj *objectSDK.Object) bool {
 }
 
 func (exec *execCtx) writeCollectedObject() {
+	if exec.payloadVerified && exec.prm.verified != nil {
+		*exec.prm.verified = true
+	}
+
 	if ok := exec.writeCollectedHeader(); ok {
 		exec.writeObjectPayload(exec.collectedObject)
 	}
@@ -347,8 +396,35 @@ func (exec execCtx) isForwardingEnabled() bool {
 	return exec.prm.forwarder != nil
 }
 
+// isRangeHashForwarding returns true if the range hash request
+// is forwarded to the remote nodes.
+func (exec execCtx) isRangeHashForwarding() bool {
+	return exec.hashForwarder != nil
+}
+
+// forwardRangeHash sends the range hash request to the remote node
+// and saves the received hashes.
+func (exec *execCtx) forwardRangeHash(ctx context.Context, info clientcore.NodeInfo, c clientcore.MultiAddressClient) error {
+	hashes, err := exec.hashForwarder(ctx, info, c)
+	if err != nil {
+		return err
+	}
+
+	*exec.hashes = hashes
+
+	return nil
+}
+
 // disableForwarding removes request forwarding closure from common
 // parameters, so it won't be inherited in new execution contexts.
 func (exec *execCtx) disableForwarding() {
 	exec.prm.SetRequestForwarder(nil)
 }
+
+// responseReceived is called on the first response of the remote node being
+// processed, the node is no longer limited by the node timeout after it.
+func (exec *execCtx) responseReceived() {
+	if exec.nodeResponded != nil {
+		exec.nodeResponded()
+	}
+}
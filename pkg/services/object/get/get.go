@@ -22,7 +22,72 @@ func (s *Service) getRange(ctx context.Context, prm RangePrm, opts ...execOption
 	return s.get(ctx, prm.commonPrm, append(opts, withPayloadRange(prm.rng))...).err
 }
 
+// GetRangeHash calculates hashes of the object payload ranges.
+//
+// If the object is not stored locally and the range hash forwarder is set,
+// the request is forwarded to the nodes storing the object. The payload
+// ranges are fetched and hashed locally if the object is stored on the node
+// or if no remote node has returned the hashes.
 func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHashRes, error) {
+	if prm.hashForwarder != nil && !prm.common.LocalOnly() {
+		hashes, err := s.forwardRangeHash(ctx, prm)
+		if err != nil {
+			return nil, err
+		}
+
+		if hashes != nil {
+			return &RangeHashRes{
+				hashes: hashes,
+			}, nil
+		}
+	}
+
+	return s.getRangeHash(ctx, prm)
+}
+
+// forwardRangeHash looks for the object in the container and requests its
+// range hashes from the first node storing it. Returns nil hashes without
+// an error if the hashes should be calculated from the payload.
+func (s *Service) forwardRangeHash(ctx context.Context, prm RangeHashPrm) ([][]byte, error) {
+	var hashes [][]byte
+
+	p := prm.commonPrm
+	p.objWriter = &partWriter{
+		headWriter: discardHeaderWriter{},
+	}
+
+	res := s.get(ctx, p, headOnly(), withRangeHashForwarder(prm.hashForwarder, &hashes))
+
+	switch res.status {
+	case statusINHUMED, statusOutOfRange:
+		return nil, res.err
+	case statusOK:
+		// hashes are not set if the object is stored locally
+		if hashes != nil && len(hashes) != len(prm.rngs) {
+			s.log.Debug("wrong number of range hashes received from the remote node",
+				zap.Stringer("address", prm.addr),
+				zap.Int("expected", len(prm.rngs)),
+				zap.Int("received", len(hashes)),
+			)
+
+			return nil, nil
+		}
+
+		return hashes, nil
+	case statusVIRTUAL:
+		// object is assembled from the children fetched
+		// by the payload range requests
+	default:
+		s.log.Debug("range hash request was not served by the remote nodes, fetching payload",
+			zap.Stringer("address", prm.addr),
+			zap.Error(res.err),
+		)
+	}
+
+	return nil, nil
+}
+
+func (s *Service) getRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHashRes, error) {
 	hashes := make([][]byte, 0, len(prm.rngs))
 
 	for _, rng := range prm.rngs {
@@ -59,7 +124,12 @@ func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHas
 // Returns ErrNotFound if the header was not received for the call.
 // Returns SplitInfoError if object is virtual and raw flag is set.
 func (s *Service) Head(ctx context.Context, prm HeadPrm) error {
-	return s.get(ctx, prm.commonPrm, headOnly()).err
+	opts := []execOption{headOnly()}
+	if prm.short {
+		opts = append(opts, shortHeaderOnly())
+	}
+
+	return s.get(ctx, prm.commonPrm, opts...).err
 }
 
 func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) statusError {
@@ -72,6 +142,11 @@ func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) st
 		infoSplit: object.NewSplitInfo(),
 	}
 
+	exec.prm.objWriter = &responseWriter{
+		ObjectWriter: prm.objWriter,
+		exec:         exec,
+	}
+
 	for i := range opts {
 		opts[i](exec)
 	}
@@ -3,11 +3,15 @@ package getsvc
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strconv"
 	"testing"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -23,6 +27,8 @@ import (
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,6 +58,8 @@ type testClient struct {
 		obj *objectSDK.Object
 		err error
 	}
+
+	onRequest func(*execCtx)
 }
 
 type testEpochReceiver uint64
@@ -117,7 +125,19 @@ func newTestClient() *testClient {
 	}
 }
 
-func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Object, error) {
+func (c *testClient) getObject(ctx context.Context, exec *execCtx, info client.NodeInfo) (*objectSDK.Object, error) {
+	if exec.isRangeHashForwarding() {
+		return nil, exec.forwardRangeHash(ctx, info, nil)
+	}
+
+	if c.onRequest != nil {
+		c.onRequest(exec)
+	}
+
+	if exec.isForwardingEnabled() {
+		return exec.prm.forwarder(ctx, info, nil, exec.prm.objWriter)
+	}
+
 	v, ok := c.results[exec.address().EncodeToString()]
 	if !ok {
 		var errNotFound apistatus.ObjectNotFound
@@ -392,6 +412,50 @@ func TestGetLocalOnly(t *testing.T) {
 		require.True(t, errors.As(err, &errSplit))
 		require.Equal(t, splitInfo, errSplit.SplitInfo())
 	})
+
+	t.Run("VIRTUAL without assembly", func(t *testing.T) {
+		storage := newTestStorage()
+		svc := newSvc(storage)
+		svc.assembly = false
+
+		addr := oidtest.Address()
+
+		splitInfo := objectSDK.NewSplitInfo()
+		splitInfo.SetSplitID(objectSDK.NewSplitID())
+		splitInfo.SetLastPart(oidtest.ID())
+
+		storage.addVirtual(addr, splitInfo)
+
+		rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 0, 1)
+		rngPrm.WithAddress(addr)
+
+		err := svc.GetRange(ctx, rngPrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+		rngPrm = newRngPrm(true, NewSimpleObjectWriter(), 0, 1)
+		rngPrm.WithAddress(addr)
+
+		err = svc.GetRange(ctx, rngPrm)
+
+		errSplit := objectSDK.NewSplitInfoError(objectSDK.NewSplitInfo())
+		require.True(t, errors.As(err, &errSplit))
+		require.Equal(t, splitInfo, errSplit.SplitInfo())
+	})
+
+	t.Run("VIRTUAL without split-chain parts", func(t *testing.T) {
+		storage := newTestStorage()
+		svc := newSvc(storage)
+
+		addr := oidtest.Address()
+
+		storage.addVirtual(addr, objectSDK.NewSplitInfo())
+
+		rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 0, 1)
+		rngPrm.WithAddress(addr)
+
+		err := svc.GetRange(ctx, rngPrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
 }
 
 func testNodeMatrix(t testing.TB, dim []int) ([][]netmap.NodeInfo, [][]string) {
@@ -588,6 +652,50 @@ func TestGetRemoteSmall(t *testing.T) {
 		require.Equal(t, obj.CutPayload(), w.Object())
 	})
 
+	t.Run("partially forwarded", func(t *testing.T) {
+		addr := oidtest.Address()
+		addr.SetContainer(idCnr)
+
+		ns, as := testNodeMatrix(t, []int{2})
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString(): ns,
+			},
+		}
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testClient{
+				as[0][0]: newTestClient(),
+				as[0][1]: newTestClient(),
+			},
+		})
+
+		obj := generateObject(addr, nil, []byte("payload"))
+		errStream := errors.New("stream failure")
+
+		var calls int
+
+		w := NewSimpleObjectWriter()
+
+		p := newPrm(false, w)
+		p.WithAddress(addr)
+		p.SetRequestForwarder(func(_ context.Context, _ client.NodeInfo, _ client.MultiAddressClient, w ObjectWriter) (*objectSDK.Object, error) {
+			calls++
+
+			if err := w.WriteHeader(obj.CutPayload()); err != nil {
+				return nil, err
+			}
+
+			return nil, errStream
+		})
+
+		// the header has been sent, the next node would send it again
+		err := svc.Get(ctx, p)
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+
 	t.Run("INHUMED", func(t *testing.T) {
 		addr := oidtest.Address()
 		addr.SetContainer(idCnr)
@@ -904,6 +1012,118 @@ func TestGetRemoteSmall(t *testing.T) {
 			})
 		})
 
+		t.Run("object-bound session", func(t *testing.T) {
+			addr := oidtest.Address()
+			addr.SetContainer(idCnr)
+
+			srcObj := generateObject(addr, nil, nil)
+
+			ns, as := testNodeMatrix(t, []int{1})
+
+			splitInfo := objectSDK.NewSplitInfo()
+			splitInfo.SetLink(oidtest.ID())
+
+			children, childIDs, payload := generateChain(2, idCnr)
+			srcObj.SetPayload(payload)
+			srcObj.SetPayloadSize(uint64(len(payload)))
+			children[len(children)-1].SetParent(srcObj)
+
+			var linkAddr oid.Address
+			linkAddr.SetContainer(idCnr)
+			idLink, _ := splitInfo.Link()
+			linkAddr.SetObject(idLink)
+
+			linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+			linkingObj.SetParentID(addr.Object())
+			linkingObj.SetParent(srcObj)
+
+			var child1Addr, child2Addr oid.Address
+			child1Addr.SetContainer(idCnr)
+			child1Addr.SetObject(childIDs[0])
+			child2Addr.SetContainer(idCnr)
+			child2Addr.SetObject(childIDs[1])
+
+			nodeKey, err := keys.NewPrivateKey()
+			require.NoError(t, err)
+
+			userKey, err := keys.NewPrivateKey()
+			require.NoError(t, err)
+
+			// session key is unknown to the node, so the members of the
+			// split object can be requested with the node key only
+			tok := *sessiontest.Object()
+			tok.ForVerb(session.VerbObjectGet)
+			tok.BindContainer(idCnr)
+			tok.LimitByObject(addr.Object())
+			require.NoError(t, tok.Sign(userKey.PrivateKey))
+
+			var tokV2 sessionV2.Token
+			tok.WriteToV2(&tokV2)
+
+			var meta sessionV2.RequestMetaHeader
+			meta.SetSessionToken(&tokV2)
+			meta.SetTTL(2)
+
+			var req objectV2.GetRequest
+			req.SetMetaHeader(&meta)
+
+			commonPrm, err := util.CommonPrmFromV2(&req)
+			require.NoError(t, err)
+
+			c := newTestClient()
+			c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+			c.addResult(linkAddr, linkingObj, nil)
+			c.addResult(child1Addr, children[0], nil)
+			c.addResult(child2Addr, children[1], nil)
+
+			var requested []oid.Address
+
+			c.onRequest = func(exec *execCtx) {
+				if exec.address().Object().Equals(addr.Object()) {
+					return
+				}
+
+				require.False(t, exec.isForwardingEnabled())
+
+				key, err := exec.key()
+				require.NoError(t, err)
+				require.Equal(t, &nodeKey.PrivateKey, key)
+				require.Equal(t, &tok, exec.prm.common.SessionToken())
+
+				requested = append(requested, exec.address())
+			}
+
+			builder := &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString():       ns,
+					linkAddr.EncodeToString():   ns,
+					child1Addr.EncodeToString(): ns,
+					child2Addr.EncodeToString(): ns,
+				},
+			}
+
+			svc := newSvc(builder, &testClientCache{
+				clients: map[string]*testClient{
+					as[0][0]: c,
+				},
+			})
+			svc.keyStore = util.NewKeyStorage(&nodeKey.PrivateKey, nil, nil)
+
+			w := NewSimpleObjectWriter()
+
+			p := newPrm(false, w)
+			p.SetCommonParameters(commonPrm)
+			p.WithAddress(addr)
+			p.SetRequestForwarder(func(context.Context, client.NodeInfo, client.MultiAddressClient, ObjectWriter) (*objectSDK.Object, error) {
+				return nil, objectSDK.NewSplitInfoError(splitInfo)
+			})
+
+			err = svc.Get(ctx, p)
+			require.NoError(t, err)
+			require.Equal(t, srcObj, w.Object())
+			require.ElementsMatch(t, []oid.Address{linkAddr, child1Addr, child2Addr}, requested)
+		})
+
 		t.Run("right child", func(t *testing.T) {
 			t.Run("get right child failure", func(t *testing.T) {
 				addr := oidtest.Address()
@@ -1244,3 +1464,133 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload(), w.Object())
 }
+
+func TestGetRangeHashForwarding(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	addr := oidtest.Address()
+	addr.SetContainer(idCnr)
+
+	payloadSz := uint64(10)
+	payload := make([]byte, payloadSz)
+	_, _ = rand.Read(payload)
+
+	obj := generateObject(addr, nil, payload)
+
+	ns, as := testNodeMatrix(t, []int{2})
+
+	c1 := newTestClient()
+	c1.addResult(addr, obj, nil)
+
+	c2 := newTestClient()
+	c2.addResult(addr, obj, nil)
+
+	const curEpoch = 13
+
+	storage := newTestStorage()
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = storage
+	svc.assembly = true
+	svc.traverserGenerator = &testTraverserGenerator{
+		c: cnr,
+		b: map[uint64]placement.Builder{
+			curEpoch: &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString(): ns,
+				},
+			},
+		},
+	}
+	svc.clientCache = &testClientCache{
+		clients: map[string]*testClient{
+			as[0][0]: c1,
+			as[0][1]: c2,
+		},
+	}
+	svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+	rngs := make([]objectSDK.Range, 2)
+	rngs[0].SetOffset(0)
+	rngs[0].SetLength(payloadSz / 2)
+	rngs[1].SetOffset(payloadSz / 2)
+	rngs[1].SetLength(payloadSz / 2)
+
+	payloadHashes := make([][]byte, len(rngs))
+	for i := range rngs {
+		h := sha256.Sum256(payload[rngs[i].GetOffset() : rngs[i].GetOffset()+rngs[i].GetLength()])
+		payloadHashes[i] = h[:]
+	}
+
+	newPrm := func(f RangeHashForwarder) RangeHashPrm {
+		p := RangeHashPrm{}
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+		p.SetRangeList(rngs)
+		p.SetHashGenerator(sha256.New)
+		p.SetRangeHashForwarder(f)
+
+		return p
+	}
+
+	t.Run("forwarded", func(t *testing.T) {
+		remoteHashes := [][]byte{{1}, {2}}
+
+		var calls int
+
+		res, err := svc.GetRangeHash(ctx, newPrm(func(context.Context, client.NodeInfo, client.MultiAddressClient) ([][]byte, error) {
+			calls++
+			return remoteHashes, nil
+		}))
+		require.NoError(t, err)
+		require.Equal(t, remoteHashes, res.Hashes())
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		var calls int
+
+		res, err := svc.GetRangeHash(ctx, newPrm(func(context.Context, client.NodeInfo, client.MultiAddressClient) ([][]byte, error) {
+			calls++
+			return nil, errors.New("unsupported")
+		}))
+		require.NoError(t, err)
+		require.Equal(t, payloadHashes, res.Hashes())
+		require.Equal(t, len(ns[0]), calls)
+	})
+
+	t.Run("wrong number of hashes", func(t *testing.T) {
+		res, err := svc.GetRangeHash(ctx, newPrm(func(context.Context, client.NodeInfo, client.MultiAddressClient) ([][]byte, error) {
+			return [][]byte{{1}}, nil
+		}))
+		require.NoError(t, err)
+		require.Equal(t, payloadHashes, res.Hashes())
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		res, err := svc.GetRangeHash(ctx, newPrm(func(context.Context, client.NodeInfo, client.MultiAddressClient) ([][]byte, error) {
+			return nil, new(apistatus.ObjectAlreadyRemoved)
+		}))
+		require.ErrorAs(t, err, new(*apistatus.ObjectAlreadyRemoved))
+		require.Nil(t, res)
+	})
+
+	t.Run("local", func(t *testing.T) {
+		storage.addPhy(addr, obj)
+		t.Cleanup(func() { delete(storage.phy, addr.EncodeToString()) })
+
+		res, err := svc.GetRangeHash(ctx, newPrm(func(context.Context, client.NodeInfo, client.MultiAddressClient) ([][]byte, error) {
+			t.Fatal("request must not be forwarded if the object is stored locally")
+			return nil, nil
+		}))
+		require.NoError(t, err)
+		require.Equal(t, payloadHashes, res.Hashes())
+	})
+}
@@ -1,6 +1,7 @@
 package getsvc
 
 import (
+	"context"
 	"hash"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -30,13 +31,24 @@ type RangeHashPrm struct {
 	rngs []object.Range
 
 	salt []byte
+
+	hashForwarder RangeHashForwarder
 }
 
-type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient) (*object.Object, error)
+// RequestForwarder is a callback to send the original request to the remote
+// node. The received object parts are written to the given ObjectWriter, the
+// first write is considered as the node response.
+type RequestForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient, ObjectWriter) (*object.Object, error)
+
+// RangeHashForwarder is a callback to send the original range hash request
+// to the remote node. It returns the list of hashes calculated by the node.
+type RangeHashForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient) ([][]byte, error)
 
 // HeadPrm groups parameters of Head service call.
 type HeadPrm struct {
 	commonPrm
+
+	short bool
 }
 
 type commonPrm struct {
@@ -49,6 +61,10 @@ type commonPrm struct {
 	raw bool
 
 	forwarder RequestForwarder
+
+	verified *bool
+
+	splitMember bool
 }
 
 // ChunkWriter is an interface of target component
@@ -101,6 +117,14 @@ func (p *RangeHashPrm) SetSalt(salt []byte) {
 	p.salt = salt
 }
 
+// SetRangeHashForwarder sets callback for forwarding the range hash request
+// to the nodes storing the object. If it is set and the object is not
+// stored locally, the hashes are requested from the remote nodes, the payload
+// ranges are fetched and hashed locally only if no node has responded.
+func (p *RangeHashPrm) SetRangeHashForwarder(f RangeHashForwarder) {
+	p.hashForwarder = f
+}
+
 // SetCommonParameters sets common parameters of the operation.
 func (p *commonPrm) SetCommonParameters(common *util.CommonPrm) {
 	p.common = common
@@ -110,6 +134,22 @@ func (p *commonPrm) SetRequestForwarder(f RequestForwarder) {
 	p.forwarder = f
 }
 
+// SetPayloadVerificationTarget sets the flag to be set to true right before
+// the object is written if its payload has been checked against the payload
+// checksum (see WithPayloadVerification). The flag is never set for the
+// objects received from the remote nodes or assembled from the children.
+func (p *commonPrm) SetPayloadVerificationTarget(v *bool) {
+	p.verified = v
+}
+
+// SetSplitMember marks the object as a member (child, link) of the split
+// object the node serves the request for. Remote requests for such objects
+// are signed with the node key and carry the original session token, so the
+// container nodes accept them under the session bound to the parent object.
+func (p *commonPrm) SetSplitMember() {
+	p.splitMember = true
+}
+
 // WithAddress sets object address to be read.
 func (p *commonPrm) WithAddress(addr oid.Address) {
 	p.addr = addr
@@ -120,6 +160,12 @@ func (p *commonPrm) WithRawFlag(raw bool) {
 	p.raw = raw
 }
 
+// WithShortHeaderFlag sets flag of the short header reading. If it is set,
+// header writer may receive object header with only the short header fields.
+func (p *HeadPrm) WithShortHeaderFlag(short bool) {
+	p.short = short
+}
+
 // SetHeaderWriter sets target component to write the object header.
 func (p *HeadPrm) SetHeaderWriter(w HeaderWriter) {
 	p.objWriter = &partWriter{
@@ -18,7 +18,7 @@ func (exec *execCtx) processNode(ctx context.Context, info client.NodeInfo) bool
 		return true
 	}
 
-	obj, err := client.getObject(exec, info)
+	obj, err := client.getObject(ctx, exec, info)
 
 	var errSplitInfo *objectSDK.SplitInfoError
 	var errRemoved *apistatus.ObjectAlreadyRemoved
@@ -1,6 +1,8 @@
 package getsvc
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -22,12 +24,14 @@ type Service struct {
 type Option func(*cfg)
 
 type getClient interface {
-	getObject(*execCtx, client.NodeInfo) (*object.Object, error)
+	getObject(context.Context, *execCtx, client.NodeInfo) (*object.Object, error)
 }
 
 type cfg struct {
 	assembly bool
 
+	verifyPayload bool
+
 	log *logger.Logger
 
 	localStorage interface {
@@ -86,6 +90,17 @@ func WithoutAssembly() Option {
 	}
 }
 
+// WithPayloadVerification returns option to check the payload of each object
+// read from the local storage against the payload checksum from its header.
+// Payload ranges are cut from the full payload after the check. Objects with
+// corrupted payload are not returned, they are read from the other container
+// nodes if possible.
+func WithPayloadVerification(v bool) Option {
+	return func(c *cfg) {
+		c.verifyPayload = v
+	}
+}
+
 // WithLocalStorageEngine returns option to set local storage
 // instance.
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
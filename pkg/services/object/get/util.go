@@ -1,6 +1,7 @@
 package getsvc
 
 import (
+	"context"
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -41,6 +42,15 @@ type partWriter struct {
 	chunkWriter ChunkWriter
 }
 
+// responseWriter is an ObjectWriter of the request executor which tracks
+// whether anything has been written to the requesting party. The first
+// write is considered as the response of the remote node being processed.
+type responseWriter struct {
+	ObjectWriter
+
+	exec *execCtx
+}
+
 type hasherWrapper struct {
 	hash io.Writer
 }
@@ -49,6 +59,13 @@ type nmSrcWrapper struct {
 	nmSrc netmap.Source
 }
 
+// discardHeaderWriter is a HeaderWriter that ignores the written header.
+type discardHeaderWriter struct{}
+
+func (discardHeaderWriter) WriteHeader(*object.Object) error {
+	return nil
+}
+
 func NewSimpleObjectWriter() *SimpleObjectWriter {
 	return &SimpleObjectWriter{
 		obj: object.New(),
@@ -87,9 +104,13 @@ func (c *clientCacheWrapper) get(info coreclient.NodeInfo) (getClient, error) {
 	}, nil
 }
 
-func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*object.Object, error) {
+func (c *clientWrapper) getObject(ctx context.Context, exec *execCtx, info coreclient.NodeInfo) (*object.Object, error) {
+	if exec.isRangeHashForwarding() {
+		return nil, exec.forwardRangeHash(ctx, info, c.client)
+	}
+
 	if exec.isForwardingEnabled() {
-		return exec.prm.forwarder(info, c.client)
+		return exec.prm.forwarder(ctx, info, c.client, exec.prm.objWriter)
 	}
 
 	key, err := exec.key()
@@ -100,7 +121,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if exec.headOnly() {
 		var prm internalclient.HeadObjectPrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -126,7 +147,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if rng := exec.ctxRange(); rng != nil {
 		var prm internalclient.PayloadRangePrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -136,6 +157,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 		prm.SetBearerToken(exec.prm.common.BearerToken())
 		prm.SetXHeaders(exec.prm.common.XHeaders())
 		prm.SetRange(rng)
+		prm.SetResponseHandler(exec.responseReceived)
 
 		if exec.isRaw() {
 			prm.SetRawFlag()
@@ -151,7 +173,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 
 	var prm internalclient.GetObjectPrm
 
-	prm.SetContext(exec.context())
+	prm.SetContext(ctx)
 	prm.SetClient(c.client)
 	prm.SetTTL(exec.prm.common.TTL())
 	prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -160,6 +182,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	prm.SetSessionToken(exec.prm.common.SessionToken())
 	prm.SetBearerToken(exec.prm.common.BearerToken())
 	prm.SetXHeaders(exec.prm.common.XHeaders())
+	prm.SetResponseHandler(exec.responseReceived)
 
 	if exec.isRaw() {
 		prm.SetRawFlag()
@@ -183,8 +206,9 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var headPrm engine.HeadPrm
 		headPrm.WithAddress(exec.address())
 		headPrm.WithRaw(exec.isRaw())
+		headPrm.WithShortHeader(exec.shortHeaderOnly())
 
-		r, err := e.engine.Head(headPrm)
+		r, err := e.engine.Head(exec.context(), headPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -195,7 +219,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		getRange.WithAddress(exec.address())
 		getRange.WithPayloadRange(rng)
 
-		r, err := e.engine.GetRange(getRange)
+		r, err := e.engine.GetRange(exec.context(), getRange)
 		if err != nil {
 			return nil, err
 		}
@@ -204,8 +228,9 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	} else {
 		var getPrm engine.GetPrm
 		getPrm.WithAddress(exec.address())
+		getPrm.WithIdentity(engine.AccessIdentityFromTokens(exec.prm.common))
 
-		r, err := e.engine.Get(getPrm)
+		r, err := e.engine.Get(exec.context(), getPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -222,6 +247,20 @@ func (w *partWriter) WriteHeader(o *object.Object) error {
 	return w.headWriter.WriteHeader(o)
 }
 
+func (w *responseWriter) WriteHeader(o *object.Object) error {
+	w.exec.written = true
+	w.exec.responseReceived()
+
+	return w.ObjectWriter.WriteHeader(o)
+}
+
+func (w *responseWriter) WriteChunk(p []byte) error {
+	w.exec.written = true
+	w.exec.responseReceived()
+
+	return w.ObjectWriter.WriteChunk(p)
+}
+
 func payloadOnlyObject(payload []byte) *object.Object {
 	obj := object.New()
 	obj.SetPayload(payload)
@@ -78,7 +78,7 @@ func (s *Service) GetRange(req *objectV2.GetRangeRequest, stream objectSvc.GetOb
 
 // GetRangeHash calls internal service and returns v2 response.
 func (s *Service) GetRangeHash(ctx context.Context, req *objectV2.GetRangeHashRequest) (*objectV2.GetRangeHashResponse, error) {
-	p, err := s.toHashRangePrm(req)
+	p, err := s.toHashRangePrm(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -1,17 +1,31 @@
 package getsvc
 
 import (
+	"strconv"
+
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
+// XHeaderVerifiedChecksum is a key of the response X-Header set to "true"
+// if the node has checked the object payload against the payload checksum
+// from the object header before sending it.
+const XHeaderVerifiedChecksum = "__NEOFS__VERIFIED_CHECKSUM"
+
 type streamObjectWriter struct {
 	objectSvc.GetObjectStream
+
+	// set by the Get service before the object is written
+	verified bool
 }
 
 type streamObjectRangeWriter struct {
 	objectSvc.GetObjectRangeStream
+
+	// set by the Get service before the payload range is written
+	verified bool
 }
 
 func (s *streamObjectWriter) WriteHeader(obj *object.Object) error {
@@ -22,17 +36,17 @@ func (s *streamObjectWriter) WriteHeader(obj *object.Object) error {
 	p.SetHeader(objV2.GetHeader())
 	p.SetSignature(objV2.GetSignature())
 
-	return s.GetObjectStream.Send(newResponse(p))
+	return s.GetObjectStream.Send(newResponse(p, s.verified))
 }
 
 func (s *streamObjectWriter) WriteChunk(chunk []byte) error {
 	p := new(objectV2.GetObjectPartChunk)
 	p.SetChunk(chunk)
 
-	return s.GetObjectStream.Send(newResponse(p))
+	return s.GetObjectStream.Send(newResponse(p, s.verified))
 }
 
-func newResponse(p objectV2.GetObjectPart) *objectV2.GetResponse {
+func newResponse(p objectV2.GetObjectPart, verified bool) *objectV2.GetResponse {
 	r := new(objectV2.GetResponse)
 
 	body := new(objectV2.GetResponseBody)
@@ -40,14 +54,18 @@ func newResponse(p objectV2.GetObjectPart) *objectV2.GetResponse {
 
 	body.SetObjectPart(p)
 
+	if verified {
+		r.SetMetaHeader(verifiedChecksumMeta())
+	}
+
 	return r
 }
 
 func (s *streamObjectRangeWriter) WriteChunk(chunk []byte) error {
-	return s.GetObjectRangeStream.Send(newRangeResponse(chunk))
+	return s.GetObjectRangeStream.Send(newRangeResponse(chunk, s.verified))
 }
 
-func newRangeResponse(p []byte) *objectV2.GetRangeResponse {
+func newRangeResponse(p []byte, verified bool) *objectV2.GetRangeResponse {
 	r := new(objectV2.GetRangeResponse)
 
 	body := new(objectV2.GetRangeResponseBody)
@@ -58,5 +76,22 @@ func newRangeResponse(p []byte) *objectV2.GetRangeResponse {
 
 	body.SetRangePart(part)
 
+	if verified {
+		r.SetMetaHeader(verifiedChecksumMeta())
+	}
+
 	return r
 }
+
+// verifiedChecksumMeta returns the response meta header attesting
+// the verification of the payload checksum.
+func verifiedChecksumMeta() *session.ResponseMetaHeader {
+	var xHdr session.XHeader
+	xHdr.SetKey(XHeaderVerifiedChecksum)
+	xHdr.SetValue(strconv.FormatBool(true))
+
+	meta := new(session.ResponseMetaHeader)
+	meta.SetXHeaders([]session.XHeader{xHdr})
+
+	return meta
+}
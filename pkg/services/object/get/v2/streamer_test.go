@@ -0,0 +1,29 @@
+package getsvc
+
+import (
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifiedChecksumXHeader(t *testing.T) {
+	requireXHeader := func(t *testing.T, meta *session.ResponseMetaHeader, exp bool) {
+		if !exp {
+			require.Nil(t, meta)
+			return
+		}
+
+		require.NotNil(t, meta)
+		require.Len(t, meta.GetXHeaders(), 1)
+		require.Equal(t, XHeaderVerifiedChecksum, meta.GetXHeaders()[0].GetKey())
+		require.Equal(t, "true", meta.GetXHeaders()[0].GetValue())
+	}
+
+	for _, verified := range []bool{false, true} {
+		requireXHeader(t, newResponse(new(objectV2.GetObjectPartInit), verified).GetMetaHeader(), verified)
+		requireXHeader(t, newResponse(new(objectV2.GetObjectPartChunk), verified).GetMetaHeader(), verified)
+		requireXHeader(t, newRangeResponse([]byte{1, 2, 3}, verified).GetMetaHeader(), verified)
+	}
+}
@@ -54,7 +54,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 		return nil, err
 	}
 
-	streamWrapper := &streamObjectWriter{stream}
+	streamWrapper := &streamObjectWriter{GetObjectStream: stream}
 
 	p := new(getsvc.Prm)
 	p.SetCommonParameters(commonPrm)
@@ -62,11 +62,12 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 	p.WithAddress(addr)
 	p.WithRawFlag(body.GetRaw())
 	p.SetObjectWriter(streamWrapper)
+	p.SetPayloadVerificationTarget(&streamWrapper.verified)
 
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, w getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
 			key, err := s.keyStorage.GetKey(nil)
@@ -98,7 +99,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 			// open stream
 			var getStream *rpc.GetResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				getStream, err = rpc.GetObject(cli, req, rpcclient.WithContext(stream.Context()))
+				getStream, err = rpc.GetObject(cli, req, rpcclient.WithContext(ctx))
 				return err
 			})
 			if err != nil {
@@ -155,7 +156,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 					obj.SetSignature(v.GetSignature())
 					obj.SetHeader(v.GetHeader())
 
-					if err = streamWrapper.WriteHeader(object.NewFromV2(obj)); err != nil {
+					if err = w.WriteHeader(object.NewFromV2(obj)); err != nil {
 						return nil, fmt.Errorf("could not write object header in Get forwarder: %w", err)
 					}
 				case *objectV2.GetObjectPartChunk:
@@ -163,7 +164,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 						return nil, errWrongMessageSeq
 					}
 
-					if err = streamWrapper.WriteChunk(v.GetChunk()); err != nil {
+					if err = w.WriteChunk(v.GetChunk()); err != nil {
 						return nil, fmt.Errorf("could not write object chunk in Get forwarder: %w", err)
 					}
 				case *objectV2.SplitInfo:
@@ -204,11 +205,12 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 	p := new(getsvc.RangePrm)
 	p.SetCommonParameters(commonPrm)
 
-	streamWrapper := &streamObjectRangeWriter{stream}
+	streamWrapper := &streamObjectRangeWriter{GetObjectRangeStream: stream}
 
 	p.WithAddress(addr)
 	p.WithRawFlag(body.GetRaw())
 	p.SetChunkWriter(streamWrapper)
+	p.SetPayloadVerificationTarget(&streamWrapper.verified)
 	p.SetRange(object.NewRangeFromV2(body.GetRange()))
 
 	if !commonPrm.LocalOnly() {
@@ -219,7 +221,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 			return nil, err
 		}
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, w getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
 			// once compose and resign forwarding request
@@ -246,7 +248,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 			// open stream
 			var rangeStream *rpc.ObjectRangeResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				rangeStream, err = rpc.GetObjectRange(cli, req, rpcclient.WithContext(stream.Context()))
+				rangeStream, err = rpc.GetObjectRange(cli, req, rpcclient.WithContext(ctx))
 				return err
 			})
 			if err != nil {
@@ -284,7 +286,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 				case nil:
 					return nil, fmt.Errorf("unexpected range type %T", v)
 				case *objectV2.GetRangePartChunk:
-					if err = streamWrapper.WriteChunk(v.GetChunk()); err != nil {
+					if err = w.WriteChunk(v.GetChunk()); err != nil {
 						return nil, fmt.Errorf("could not write object chunk in GetRange forwarder: %w", err)
 					}
 				case *objectV2.SplitInfo:
@@ -301,7 +303,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 	return p, nil
 }
 
-func (s *Service) toHashRangePrm(req *objectV2.GetRangeHashRequest) (*getsvc.RangeHashPrm, error) {
+func (s *Service) toHashRangePrm(ctx context.Context, req *objectV2.GetRangeHashRequest) (*getsvc.RangeHashPrm, error) {
 	body := req.GetBody()
 
 	addrV2 := body.GetAddress()
@@ -349,6 +351,64 @@ func (s *Service) toHashRangePrm(req *objectV2.GetRangeHashRequest) (*getsvc.Ran
 		})
 	}
 
+	if !commonPrm.LocalOnly() {
+		var onceResign sync.Once
+
+		meta := req.GetMetaHeader()
+
+		key, err := s.keyStorage.GetKey(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		p.SetRangeHashForwarder(groupAddressRangeHashForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) ([][]byte, error) {
+			var err error
+
+			// once compose and resign forwarding request
+			onceResign.Do(func() {
+				// compose meta header of the local server
+				metaHdr := new(session.RequestMetaHeader)
+				metaHdr.SetTTL(meta.GetTTL() - 1)
+				// TODO: #1165 think how to set the other fields
+				metaHdr.SetOrigin(meta)
+				writeCurrentVersion(metaHdr)
+
+				req.SetMetaHeader(metaHdr)
+
+				err = signature.SignServiceMessage(key, req)
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			var resp *objectV2.GetRangeHashResponse
+			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
+				resp, err = rpc.HashObjectRange(cli, req, rpcclient.WithContext(ctx))
+				return err
+			})
+			if err != nil {
+				return nil, fmt.Errorf("sending the request failed: %w", err)
+			}
+
+			// verify response key
+			if err = internal.VerifyResponseKeyV2(pubkey, resp); err != nil {
+				return nil, err
+			}
+
+			// verify response structure
+			if err := signature.VerifyServiceMessage(resp); err != nil {
+				return nil, fmt.Errorf("could not verify %T: %w", resp, err)
+			}
+
+			if err = checkStatus(resp.GetMetaHeader().GetStatus()); err != nil {
+				return nil, err
+			}
+
+			return resp.GetBody().GetHashList(), nil
+		}))
+	}
+
 	return p, nil
 }
 
@@ -395,6 +455,7 @@ func (s *Service) toHeadPrm(ctx context.Context, req *objectV2.HeadRequest, resp
 
 	p.WithAddress(objAddr)
 	p.WithRawFlag(body.GetRaw())
+	p.WithShortHeaderFlag(body.GetMainOnly())
 	p.SetHeaderWriter(&headResponseWriter{
 		mainOnly: body.GetMainOnly(),
 		body:     resp.GetBody(),
@@ -403,7 +464,7 @@ func (s *Service) toHeadPrm(ctx context.Context, req *objectV2.HeadRequest, resp
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, w getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
 			key, err := s.keyStorage.GetKey(nil)
@@ -598,20 +659,23 @@ func toShortObjectHeader(hdr *object.Object) objectV2.GetHeaderPart {
 	return sh
 }
 
-func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) (*object.Object, error)) getsvc.RequestForwarder {
-	return func(info client.NodeInfo, c client.MultiAddressClient) (*object.Object, error) {
+func groupAddressRequestForwarder(f func(context.Context, network.Address, client.MultiAddressClient, []byte, getsvc.ObjectWriter) (*object.Object, error)) getsvc.RequestForwarder {
+	return func(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient, w getsvc.ObjectWriter) (*object.Object, error) {
 		var (
 			firstErr error
 			res      *object.Object
 
 			key = info.PublicKey()
+
+			tw = &writeTracker{ObjectWriter: w}
 		)
 
 		info.AddressGroup().IterateAddresses(func(addr network.Address) (stop bool) {
 			var err error
 
 			defer func() {
-				stop = err == nil
+				// the response can't be continued from another address
+				stop = err == nil || tw.written
 
 				if stop || firstErr == nil {
 					firstErr = err
@@ -620,7 +684,54 @@ func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressCli
 				// would be nice to log otherwise
 			}()
 
-			res, err = f(addr, c, key)
+			res, err = f(ctx, addr, c, key, tw)
+
+			return
+		})
+
+		return res, firstErr
+	}
+}
+
+// writeTracker is an ObjectWriter which remembers whether anything has
+// been written to it.
+type writeTracker struct {
+	getsvc.ObjectWriter
+
+	written bool
+}
+
+func (w *writeTracker) WriteHeader(o *object.Object) error {
+	w.written = true
+	return w.ObjectWriter.WriteHeader(o)
+}
+
+func (w *writeTracker) WriteChunk(p []byte) error {
+	w.written = true
+	return w.ObjectWriter.WriteChunk(p)
+}
+
+func groupAddressRangeHashForwarder(f func(context.Context, network.Address, client.MultiAddressClient, []byte) ([][]byte, error)) getsvc.RangeHashForwarder {
+	return func(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient) ([][]byte, error) {
+		var (
+			firstErr error
+			res      [][]byte
+
+			key = info.PublicKey()
+		)
+
+		info.AddressGroup().IterateAddresses(func(addr network.Address) (stop bool) {
+			var err error
+
+			defer func() {
+				stop = err == nil
+
+				if stop || firstErr == nil {
+					firstErr = err
+				}
+			}()
+
+			res, err = f(ctx, addr, c, key)
 
 			return
 		})
@@ -0,0 +1,75 @@
+package getsvc
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+var errPayloadChecksumMismatch = errors.New("payload checksum mismatch")
+
+// getLocal reads the object from the local storage. If the payload
+// verification is enabled (see WithPayloadVerification), the full
+// payload is read and checked against the payload checksum from the
+// object header, the requested payload range is cut after the check.
+func (exec *execCtx) getLocal() (*objectSDK.Object, error) {
+	if !exec.svc.verifyPayload || exec.headOnly() {
+		return exec.svc.localStorage.get(exec)
+	}
+
+	full := *exec
+	full.prm.rng = nil
+
+	obj, err := exec.svc.localStorage.get(&full)
+	if err != nil {
+		return nil, err
+	}
+
+	exec.payloadVerified, err = verifyPayloadChecksum(obj)
+	if err != nil {
+		exec.log.Warn("stored object is corrupted",
+			zap.Stringer("address", exec.address()),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	rng := exec.ctxRange()
+	if rng == nil {
+		return obj, nil
+	}
+
+	payload := obj.Payload()
+	from := rng.GetOffset()
+	to := from + rng.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return nil, apistatus.ObjectOutOfRange{}
+	}
+
+	return payloadOnlyObject(payload[from:to]), nil
+}
+
+// verifyPayloadChecksum checks the payload of the object against the
+// SHA256 payload checksum from its header. Returns false without an error
+// if the checksum is missing or has another type, so it can not be checked.
+func verifyPayloadChecksum(obj *objectSDK.Object) (bool, error) {
+	cs, ok := obj.PayloadChecksum()
+	if !ok || cs.Type() != checksum.SHA256 {
+		return false, nil
+	}
+
+	var actual checksum.Checksum
+	checksum.Calculate(&actual, checksum.SHA256, obj.Payload())
+
+	if !bytes.Equal(cs.Value(), actual.Value()) {
+		return false, errPayloadChecksumMismatch
+	}
+
+	return true, nil
+}
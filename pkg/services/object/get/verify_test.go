@@ -0,0 +1,295 @@
+package getsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func setPayloadChecksum(obj *objectSDK.Object) {
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, obj.Payload())
+
+	obj.SetPayloadChecksum(cs)
+}
+
+func TestGetPayloadVerification(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	newSvc := func(verify bool, storage *testStorage, b placement.Builder, c *testClientCache) *Service {
+		const curEpoch = 13
+
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = storage
+		svc.assembly = true
+		svc.verifyPayload = verify
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{
+				curEpoch: b,
+			},
+		}
+		svc.clientCache = c
+		svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+		return svc
+	}
+
+	get := func(svc *Service, addr oid.Address) (*objectSDK.Object, bool, error) {
+		var verified bool
+
+		w := NewSimpleObjectWriter()
+
+		var p Prm
+		p.SetObjectWriter(w)
+		p.SetPayloadVerificationTarget(&verified)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+
+		err := svc.Get(ctx, p)
+
+		return w.Object(), verified, err
+	}
+
+	getRange := func(svc *Service, addr oid.Address, off, ln uint64) ([]byte, bool, error) {
+		var verified bool
+
+		w := NewSimpleObjectWriter()
+
+		var p RangePrm
+		p.SetChunkWriter(w)
+		p.SetPayloadVerificationTarget(&verified)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+
+		rng := objectSDK.NewRange()
+		rng.SetOffset(off)
+		rng.SetLength(ln)
+		p.SetRange(rng)
+
+		err := svc.GetRange(ctx, p)
+
+		return w.Object().Payload(), verified, err
+	}
+
+	newObject := func() (oid.Address, *objectSDK.Object) {
+		addr := oidtest.Address()
+		addr.SetContainer(idCnr)
+
+		payload := make([]byte, 10)
+		_, _ = rand.Read(payload)
+
+		obj := generateObject(addr, nil, payload)
+		setPayloadChecksum(obj)
+
+		return addr, obj
+	}
+
+	ns, as := testNodeMatrix(t, []int{1})
+
+	// remote nodes return the same objects which are stored locally
+	newRemote := func(objs ...*objectSDK.Object) (*testPlacementBuilder, *testClientCache) {
+		b := &testPlacementBuilder{vectors: make(map[string][][]netmap.NodeInfo)}
+		c := newTestClient()
+
+		for _, obj := range objs {
+			addr := object.AddressOf(obj)
+
+			b.vectors[addr.EncodeToString()] = ns
+			c.addResult(addr, obj, nil)
+		}
+
+		return b, &testClientCache{clients: map[string]*testClient{as[0][0]: c}}
+	}
+
+	for _, verify := range []bool{false, true} {
+		name := "verification disabled"
+		if verify {
+			name = "verification enabled"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Run("local", func(t *testing.T) {
+				addr, obj := newObject()
+
+				storage := newTestStorage()
+				storage.addPhy(addr, obj)
+
+				b, c := newRemote()
+				svc := newSvc(verify, storage, b, c)
+
+				res, verified, err := get(svc, addr)
+				require.NoError(t, err)
+				require.Equal(t, obj, res)
+				require.Equal(t, verify, verified)
+
+				pld, verified, err := getRange(svc, addr, 2, 5)
+				require.NoError(t, err)
+				require.Equal(t, obj.Payload()[2:7], pld)
+				require.Equal(t, verify, verified)
+
+				if verify {
+					// range is cut by the service from the verified payload
+					_, verified, err = getRange(svc, addr, 5, 10)
+					require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+					require.False(t, verified)
+				}
+			})
+
+			t.Run("without checksum", func(t *testing.T) {
+				addr, obj := newObject()
+				obj.SetPayloadChecksum(checksum.Checksum{})
+
+				storage := newTestStorage()
+				storage.addPhy(addr, obj)
+
+				b, c := newRemote()
+				svc := newSvc(verify, storage, b, c)
+
+				res, verified, err := get(svc, addr)
+				require.NoError(t, err)
+				require.Equal(t, obj, res)
+				require.False(t, verified)
+
+				_, verified, err = getRange(svc, addr, 2, 5)
+				require.NoError(t, err)
+				require.False(t, verified)
+			})
+
+			t.Run("remote", func(t *testing.T) {
+				addr, obj := newObject()
+
+				b, c := newRemote(obj)
+				svc := newSvc(verify, newTestStorage(), b, c)
+
+				res, verified, err := get(svc, addr)
+				require.NoError(t, err)
+				require.Equal(t, obj, res)
+				require.False(t, verified)
+
+				pld, verified, err := getRange(svc, addr, 2, 5)
+				require.NoError(t, err)
+				require.Equal(t, obj.Payload()[2:7], pld)
+				require.False(t, verified)
+			})
+
+			t.Run("corrupted", func(t *testing.T) {
+				addr, obj := newObject()
+
+				corrupted := generateObject(addr, nil, append([]byte{^obj.Payload()[0]}, obj.Payload()[1:]...))
+				cs, _ := obj.PayloadChecksum()
+				corrupted.SetPayloadChecksum(cs)
+
+				storage := newTestStorage()
+				storage.addPhy(addr, corrupted)
+
+				// the only copy is corrupted
+				b, c := newRemote()
+				svc := newSvc(verify, storage, b, c)
+
+				res, verified, err := get(svc, addr)
+				if verify {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, corrupted, res)
+				}
+				require.False(t, verified)
+
+				// the valid copy is stored on the remote node
+				b, c = newRemote(obj)
+				svc = newSvc(verify, storage, b, c)
+
+				res, verified, err = get(svc, addr)
+				require.NoError(t, err)
+				require.False(t, verified)
+
+				if verify {
+					require.Equal(t, obj, res)
+				} else {
+					require.Equal(t, corrupted, res)
+				}
+
+				pld, verified, err := getRange(svc, addr, 0, 5)
+				require.NoError(t, err)
+				require.False(t, verified)
+
+				if verify {
+					require.Equal(t, obj.Payload()[:5], pld)
+				} else {
+					require.Equal(t, corrupted.Payload()[:5], pld)
+				}
+			})
+
+			t.Run("assembled", func(t *testing.T) {
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+
+				children, childIDs, payload := generateChain(2, idCnr)
+				for i := range children {
+					setPayloadChecksum(children[i])
+				}
+
+				parent := generateObject(addr, nil, payload)
+				setPayloadChecksum(parent)
+				children[len(children)-1].SetParent(parent)
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(parent)
+				setPayloadChecksum(linkingObj)
+
+				storage := newTestStorage()
+				storage.addVirtual(addr, splitInfo)
+				storage.addPhy(linkAddr, linkingObj)
+
+				for i := range children {
+					storage.addPhy(object.AddressOf(children[i]), children[i])
+				}
+
+				b, c := newRemote()
+				svc := newSvc(verify, storage, b, c)
+
+				res, verified, err := get(svc, addr)
+				require.NoError(t, err)
+				require.Equal(t, parent, res)
+				require.False(t, verified)
+
+				pld, verified, err := getRange(svc, addr, 5, 10)
+				require.NoError(t, err)
+				require.Equal(t, payload[5:15], pld)
+				require.False(t, verified)
+			})
+		})
+	}
+}
@@ -82,6 +82,22 @@ func (x *commonPrm) SetXHeaders(hs []string) {
 
 type readPrmCommon struct {
 	commonPrm
+
+	onResponse func()
+}
+
+// SetResponseHandler sets the function to be called on the first response
+// of the remote node, before the rest of the object is read.
+//
+// Optional parameter.
+func (x *readPrmCommon) SetResponseHandler(f func()) {
+	x.onResponse = f
+}
+
+func (x readPrmCommon) responseReceived() {
+	if x.onResponse != nil {
+		x.onResponse()
+	}
 }
 
 // SetNetmapEpoch sets the epoch number to be used to locate the object.
@@ -166,6 +182,8 @@ func GetObject(prm GetObjectPrm) (*GetObjectRes, error) {
 		return nil, fmt.Errorf("read object header: %w", err)
 	}
 
+	prm.responseReceived()
+
 	buf := make([]byte, obj.PayloadSize())
 
 	_, err = rdr.Read(buf)
@@ -331,7 +349,16 @@ func PayloadRange(prm PayloadRangePrm) (*PayloadRangeRes, error) {
 
 	data := make([]byte, prm.ln)
 
-	_, err = io.ReadFull(rdr, data)
+	// read the first chunk separately to catch the response
+	n, err := rdr.Read(data)
+	if err == nil && n < len(data) {
+		prm.responseReceived()
+
+		_, err = io.ReadFull(rdr, data[n:])
+	} else if errors.Is(err, io.EOF) && n == len(data) {
+		err = nil
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("read payload: %w", err)
 	}
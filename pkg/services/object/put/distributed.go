@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -21,6 +22,8 @@ type preparedObjectTarget interface {
 }
 
 type distributedTarget struct {
+	ctx context.Context
+
 	traversal traversal
 
 	remotePool, localPool util.WorkerPool
@@ -38,6 +41,13 @@ type distributedTarget struct {
 	fmt *object.FormatValidator
 
 	log *logger.Logger
+
+	// number of nodes the object has been successfully saved on
+	placed uint32
+
+	// called with the number of nodes the object has been saved on
+	// after the successful placement
+	reportPlaced func(uint32)
 }
 
 // parameters and state of container traversal.
@@ -85,6 +95,10 @@ func (x traversal) processed(n placement.Node) bool {
 }
 
 type nodeDesc struct {
+	// ctx limits the processing of the node by its
+	// share of the request deadline.
+	ctx context.Context
+
 	local bool
 
 	info placement.Node
@@ -124,7 +138,12 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
-	return t.iteratePlacement(t.sendObject)
+	ids, err := t.iteratePlacement(t.sendObject)
+	if err == nil && t.reportPlaced != nil {
+		t.reportPlaced(atomic.LoadUint32(&t.placed))
+	}
+
+	return ids, err
 }
 
 func (t *distributedTarget) sendObject(node nodeDesc) error {
@@ -183,10 +202,13 @@ loop:
 				workerPool = t.remotePool
 			}
 
+			nodeCtx, nodeCancel := traverser.NodeContext(t.ctx)
+
 			if err := workerPool.Submit(func() {
 				defer wg.Done()
 
-				err := f(nodeDesc{local: isLocal, info: addr})
+				err := f(nodeDesc{ctx: nodeCtx, local: isLocal, info: addr})
+				nodeCancel()
 
 				// mark the container node as processed in order to exclude it
 				// in subsequent container broadcast. Note that we don't
@@ -200,9 +222,11 @@ loop:
 					return
 				}
 
+				atomic.AddUint32(&t.placed, 1)
 				traverser.SubmitSuccess()
 			}); err != nil {
 				wg.Done()
+				nodeCancel()
 
 				svcutil.LogWorkerPoolError(t.log, "PUT", err)
 
@@ -6,8 +6,17 @@ import (
 
 type PutResponse struct {
 	id oid.ID
+
+	placed uint32
 }
 
 func (r *PutResponse) ObjectID() oid.ID {
 	return r.id
 }
+
+// PlacedCopies returns the number of container nodes the object
+// has been successfully saved on. If the object has been split,
+// the minimum over all the parts is returned.
+func (r *PutResponse) PlacedCopies() uint32 {
+	return r.placed
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	objutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"go.uber.org/zap"
@@ -54,6 +55,8 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	traverseOpts []placement.Option
+
 	log *logger.Logger
 }
 
@@ -147,6 +150,14 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithTraverseOptions returns option to apply the provided options to the
+// placement traversers of the objects.
+func WithTraverseOptions(opts ...placement.Option) Option {
+	return func(c *cfg) {
+		c.traverseOpts = append(c.traverseOpts, opts...)
+	}
+}
+
 func WithLogger(l *logger.Logger) Option {
 	return func(c *cfg) {
 		c.log = l
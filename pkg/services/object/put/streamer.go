@@ -22,9 +22,12 @@ type Streamer struct {
 
 	target transformer.ObjectTarget
 
-	relay func(client.NodeInfo, client.MultiAddressClient) error
+	relay func(context.Context, client.NodeInfo, client.MultiAddressClient) error
 
 	maxPayloadSz uint64 // network config
+
+	placed    uint32 // minimum number of saved copies of the written objects
+	placedSet bool
 }
 
 var errNotInit = errors.New("stream not initialized")
@@ -158,9 +161,12 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 	prm.cnr = cnrInfo.Value
 
 	// add common options
+	prm.traverseOpts = append(prm.traverseOpts, p.traverseOpts...)
 	prm.traverseOpts = append(prm.traverseOpts,
 		// set processing container
 		placement.ForContainer(prm.cnr),
+		// nodes of each placement batch are processed concurrently
+		placement.WithParallelBatches(),
 	)
 
 	if id, ok := prm.hdr.ID(); ok {
@@ -200,7 +206,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 				return fmt.Errorf("could not create SDK client %s: %w", info.AddressGroup(), err)
 			}
 
-			return p.relay(info, c)
+			return p.relay(node.ctx, info, c)
 		}
 	}
 
@@ -210,6 +216,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 	withBroadcast := !prm.common.LocalOnly() && (typ == object.TypeTombstone || typ == object.TypeLock)
 
 	return &distributedTarget{
+		ctx: p.ctx,
 		traversal: traversal{
 			opts: prm.traverseOpts,
 
@@ -225,7 +232,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 			}
 
 			rt := &remoteTarget{
-				ctx:               p.ctx,
+				ctx:               node.ctx,
 				keyStorage:        p.keyStorage,
 				commonPrm:         prm.common,
 				clientConstructor: p.clientConstructor,
@@ -239,10 +246,20 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		fmt:   p.fmtValidator,
 		log:   p.log,
 
+		reportPlaced: p.submitPlaced,
+
 		isLocalKey: p.netmapKeys.IsLocalKey,
 	}
 }
 
+// submitPlaced saves the number of nodes the object has been saved on.
+func (p *Streamer) submitPlaced(n uint32) {
+	if !p.placedSet || n < p.placed {
+		p.placed = n
+		p.placedSet = true
+	}
+}
+
 func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 	if p.target == nil {
 		return errNotInit
@@ -268,11 +285,13 @@ func (p *Streamer) Close() (*PutResponse, error) {
 	id := ids.ParentID()
 	if id != nil {
 		return &PutResponse{
-			id: *id,
+			id:     *id,
+			placed: p.placed,
 		}, nil
 	}
 
 	return &PutResponse{
-		id: ids.SelfID(),
+		id:     ids.SelfID(),
+		placed: p.placed,
 	}, nil
 }
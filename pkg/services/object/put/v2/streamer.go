@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -118,7 +119,7 @@ func (s *streamer) CloseAndRecv() (*object.PutResponse, error) {
 	return fromPutResponse(resp), nil
 }
 
-func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClient) error {
+func (s *streamer) relayRequest(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient) error {
 	// open stream
 	resp := new(object.PutResponse)
 
@@ -142,7 +143,7 @@ func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClien
 		var stream *rpc.PutRequestWriter
 
 		err = c.RawForAddress(addr, func(cli *rawclient.Client) error {
-			stream, err = rpc.PutObject(cli, resp)
+			stream, err = rpc.PutObject(cli, resp, rawclient.WithContext(ctx))
 			return err
 		})
 		if err != nil {
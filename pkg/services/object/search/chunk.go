@@ -0,0 +1,94 @@
+package searchsvc
+
+import (
+	"crypto/sha256"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/proto"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// IDListFlusher is an interface of IDListWriter which buffers
+// the identifiers and must be flushed after the operation is completed.
+type IDListFlusher interface {
+	IDListWriter
+
+	// Flush writes all the buffered identifiers.
+	Flush() error
+}
+
+// ChunkedIDWriter is an IDListWriter which groups the identifiers
+// into fixed-size chunks encoded size of which in the search response
+// body does not exceed the configured limit.
+//
+// Must be created using NewChunkedIDWriter.
+type ChunkedIDWriter struct {
+	writer IDListWriter
+
+	chunkLen int
+
+	buf []oid.ID
+}
+
+// searchRespBodyObjectIDsField is a number of the object ID list field
+// in the search response body message.
+const searchRespBodyObjectIDsField = 1
+
+// idListEntrySize is a size of the single object identifier encoded
+// in the search response body.
+var idListEntrySize = func() int {
+	var id refs.ObjectID
+	id.SetValue(make([]byte, sha256.Size))
+
+	return proto.NestedStructureSize(searchRespBodyObjectIDsField, &id)
+}()
+
+// NewChunkedIDWriter returns ChunkedIDWriter which passes the identifiers
+// to w in chunks of at most limit bytes in the search response body.
+// Chunk contains at least one identifier regardless of the limit.
+func NewChunkedIDWriter(w IDListWriter, limit int) *ChunkedIDWriter {
+	chunkLen := limit / idListEntrySize
+	if chunkLen < 1 {
+		chunkLen = 1
+	}
+
+	return &ChunkedIDWriter{
+		writer:   w,
+		chunkLen: chunkLen,
+	}
+}
+
+// WriteIDs buffers the identifiers and writes all the full chunks.
+func (w *ChunkedIDWriter) WriteIDs(ids []oid.ID) error {
+	for len(ids) > 0 {
+		n := w.chunkLen - len(w.buf)
+		if n > len(ids) {
+			n = len(ids)
+		}
+
+		w.buf = append(w.buf, ids[:n]...)
+		ids = ids[n:]
+
+		if len(w.buf) == w.chunkLen {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Flush writes the partial chunk if any.
+func (w *ChunkedIDWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	chunk := make([]oid.ID, len(w.buf))
+	copy(chunk, w.buf)
+
+	w.buf = w.buf[:0]
+
+	return w.writer.WriteIDs(chunk)
+}
@@ -0,0 +1,107 @@
+package searchsvc
+
+import (
+	"context"
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+type chunkRecorder struct {
+	chunks [][]oid.ID
+}
+
+func (r *chunkRecorder) WriteIDs(ids []oid.ID) error {
+	r.chunks = append(r.chunks, ids)
+	return nil
+}
+
+func (r *chunkRecorder) ids() []oid.ID {
+	var res []oid.ID
+	for i := range r.chunks {
+		res = append(res, r.chunks[i]...)
+	}
+	return res
+}
+
+func chunkBodySize(ids []oid.ID) int {
+	idsV2 := make([]refs.ObjectID, len(ids))
+	for i := range ids {
+		ids[i].WriteToV2(&idsV2[i])
+	}
+
+	var body objectV2.SearchResponseBody
+	body.SetIDList(idsV2)
+
+	return body.StableSize()
+}
+
+func TestChunkedIDWriter(t *testing.T) {
+	for _, limit := range []int{1, 100, 1000, 4096} {
+		r := new(chunkRecorder)
+		w := NewChunkedIDWriter(r, limit)
+
+		ids := generateIDs(300)
+		for i := 0; i < len(ids); i += 7 {
+			end := i + 7
+			if end > len(ids) {
+				end = len(ids)
+			}
+			require.NoError(t, w.WriteIDs(ids[i:end]))
+		}
+		require.NoError(t, w.Flush())
+
+		for i := range r.chunks {
+			if limit >= idListEntrySize {
+				require.LessOrEqual(t, chunkBodySize(r.chunks[i]), limit)
+			} else {
+				require.Len(t, r.chunks[i], 1)
+			}
+
+			if i < len(r.chunks)-1 {
+				require.Equal(t, len(r.chunks[0]), len(r.chunks[i]), "chunks must have fixed size")
+			}
+		}
+		require.Equal(t, ids, r.ids())
+
+		// nothing is left after the flush
+		require.NoError(t, w.Flush())
+		require.Equal(t, ids, r.ids())
+	}
+}
+
+func TestSearchChunked(t *testing.T) {
+	storage := newTestStorage()
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = storage
+
+	cnr := cidtest.ID()
+	ids := generateIDs(1000)
+	storage.addResult(cnr, append(ids, ids[:100]...), nil)
+
+	const limit = 1 << 10
+
+	r := new(chunkRecorder)
+
+	var p Prm
+	p.WithContainerID(cnr)
+	p.SetWriter(NewChunkedIDWriter(r, limit))
+	p.common = new(util.CommonPrm).WithLocalOnly(true)
+
+	require.NoError(t, svc.Search(context.Background(), p))
+	require.Greater(t, len(r.chunks), 1)
+
+	for i := range r.chunks {
+		require.LessOrEqual(t, chunkBodySize(r.chunks[i]), limit)
+	}
+
+	require.Equal(t, ids, r.ids())
+}
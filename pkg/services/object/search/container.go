@@ -2,6 +2,7 @@ package searchsvc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"go.uber.org/zap"
@@ -41,6 +42,13 @@ func (exec *execCtx) executeOnContainer() {
 		exec.curProcEpoch--
 	}
 
+	if exec.nodeErr != nil {
+		exec.status = statusUndefined
+		exec.err = fmt.Errorf("%w: %v", ErrPartialResult, exec.nodeErr)
+
+		return
+	}
+
 	exec.status = statusOK
 	exec.err = nil
 }
@@ -81,7 +89,11 @@ func (exec *execCtx) processCurrentEpoch() bool {
 
 			client.NodeInfoFromNetmapElement(&info, addrs[i])
 
-			exec.processNode(ctx, info)
+			// give every node its share of the request deadline,
+			// so the unavailable ones do not exhaust it
+			nodeCtx, nodeCancel := traverser.NodeContext(ctx)
+			exec.processNode(nodeCtx, info)
+			nodeCancel()
 		}
 	}
 
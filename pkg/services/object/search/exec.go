@@ -2,6 +2,7 @@ package searchsvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
@@ -29,6 +30,10 @@ type execCtx struct {
 	log *logger.Logger
 
 	curProcEpoch uint64
+
+	// nodeErr is the last error of the container node
+	// which could not be queried.
+	nodeErr error
 }
 
 const (
@@ -118,19 +123,40 @@ func (exec *execCtx) generateTraverser(cnr cid.ID) (*placement.Traverser, bool)
 	}
 }
 
-func (exec execCtx) remoteClient(info client.NodeInfo) (searchClient, bool) {
-	c, err := exec.svc.clientConstructor.get(info)
-	switch {
-	default:
-		exec.status = statusUndefined
-		exec.err = err
+func (exec *execCtx) remoteClient(ctx context.Context, info client.NodeInfo) (searchClient, bool) {
+	backoff := exec.svc.clientRetryBackoff
 
-		exec.log.Debug("could not construct remote node client")
-	case err == nil:
-		return c, true
-	}
+	for i := 0; ; i++ {
+		c, err := exec.svc.clientConstructor.get(info)
+		if err == nil {
+			return c, true
+		}
+
+		if i >= exec.svc.clientRetries {
+			exec.nodeErr = err
 
-	return nil, false
+			exec.log.Debug("could not construct remote node client",
+				zap.String("error", err.Error()),
+			)
+
+			return nil, false
+		}
+
+		exec.log.Debug("could not construct remote node client, retrying",
+			zap.Int("attempt", i+1),
+			zap.String("error", err.Error()),
+		)
+
+		select {
+		case <-ctx.Done():
+			exec.nodeErr = ctx.Err()
+
+			return nil, false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
 }
 
 func (exec *execCtx) writeIDList(ids []oid.ID) {
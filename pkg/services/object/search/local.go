@@ -1,6 +1,7 @@
 package searchsvc
 
 import (
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
@@ -18,5 +19,11 @@ func (exec *execCtx) executeLocal() {
 		return
 	}
 
+	if exec.prm.verifyPresence {
+		ids = exec.verifyPresence(ids, func(id oid.ID) error {
+			return exec.svc.localStorage.head(exec, id)
+		})
+	}
+
 	exec.writeIDList(ids)
 }
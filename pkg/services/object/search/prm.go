@@ -1,6 +1,8 @@
 package searchsvc
 
 import (
+	"context"
+
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -19,6 +21,8 @@ type Prm struct {
 	filters object.SearchFilters
 
 	forwarder RequestForwarder
+
+	verifyPresence bool
 }
 
 // IDListWriter is an interface of target component
@@ -29,7 +33,7 @@ type IDListWriter interface {
 
 // RequestForwarder is a callback for forwarding of the
 // original Search requests.
-type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient) ([]oid.ID, error)
+type RequestForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient) ([]oid.ID, error)
 
 // SetCommonParameters sets common parameters of the operation.
 func (p *Prm) SetCommonParameters(common *util.CommonPrm) {
@@ -56,3 +60,11 @@ func (p *Prm) WithContainerID(id cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// WithVerifyPresence makes Search request the header of each found object
+// from the node which has returned it and write only the objects which
+// are still present there. It costs an extra request per object, so it is
+// disabled by default.
+func (p *Prm) WithVerifyPresence() {
+	p.verifyPresence = true
+}
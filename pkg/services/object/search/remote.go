@@ -4,18 +4,19 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
 func (exec *execCtx) processNode(ctx context.Context, info client.NodeInfo) {
 	exec.log.Debug("processing node...")
 
-	client, ok := exec.remoteClient(info)
+	client, ok := exec.remoteClient(ctx, info)
 	if !ok {
 		return
 	}
 
-	ids, err := client.searchObjects(exec, info)
+	ids, err := client.searchObjects(ctx, exec, info)
 
 	if err != nil {
 		exec.log.Debug("local operation failed",
@@ -25,5 +26,11 @@ func (exec *execCtx) processNode(ctx context.Context, info client.NodeInfo) {
 		return
 	}
 
+	if exec.prm.verifyPresence {
+		ids = exec.verifyPresence(ids, func(id oid.ID) error {
+			return client.headObject(ctx, exec, info, id)
+		})
+	}
+
 	exec.writeIDList(ids)
 }
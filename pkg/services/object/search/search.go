@@ -2,10 +2,16 @@ package searchsvc
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 )
 
+// ErrPartialResult is returned by Search if some container nodes could not
+// be queried, so the result may be incomplete. Identifiers received from
+// the other nodes are written anyway.
+var ErrPartialResult = errors.New("some container nodes were not queried")
+
 // Search serves a request to select the objects.
 func (s *Service) Search(ctx context.Context, prm Prm) error {
 	exec := &execCtx{
@@ -20,6 +26,12 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
+	if f, ok := prm.writer.(IDListFlusher); ok {
+		if err := f.Flush(); err != nil && exec.statusError.err == nil {
+			return err
+		}
+	}
+
 	return exec.statusError.err
 }
 
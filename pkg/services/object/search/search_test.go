@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
@@ -15,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
@@ -30,6 +32,9 @@ type idsErr struct {
 
 type testStorage struct {
 	items map[string]idsErr
+
+	// absent contains objects which are not found on head.
+	absent map[oid.ID]struct{}
 }
 
 type testTraverserGenerator struct {
@@ -62,7 +67,8 @@ func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 
 func newTestStorage() *testStorage {
 	return &testStorage{
-		items: make(map[string]idsErr),
+		items:  make(map[string]idsErr),
+		absent: make(map[oid.ID]struct{}),
 	}
 }
 
@@ -102,6 +108,27 @@ func (c *testClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
 	return v, nil
 }
 
+// flakyClientCache fails to construct the client
+// the specified number of times for every node.
+type flakyClientCache struct {
+	testClientCache
+
+	failures map[string]int
+	calls    map[string]int
+}
+
+func (c *flakyClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
+	key := network.StringifyGroup(info.AddressGroup())
+
+	c.calls[key]++
+	if c.failures[key] > 0 {
+		c.failures[key]--
+		return nil, errors.New("dial failure")
+	}
+
+	return c.testClientCache.get(info)
+}
+
 func (s *testStorage) search(exec *execCtx) ([]oid.ID, error) {
 	v, ok := s.items[exec.containerID().EncodeToString()]
 	if !ok {
@@ -111,7 +138,7 @@ func (s *testStorage) search(exec *execCtx) ([]oid.ID, error) {
 	return v.ids, v.err
 }
 
-func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, error) {
+func (c *testStorage) searchObjects(_ context.Context, exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, error) {
 	v, ok := c.items[exec.containerID().EncodeToString()]
 	if !ok {
 		return nil, nil
@@ -120,6 +147,18 @@ func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid
 	return v.ids, v.err
 }
 
+func (s *testStorage) head(_ *execCtx, id oid.ID) error {
+	if _, ok := s.absent[id]; ok {
+		return apistatus.ObjectNotFound{}
+	}
+
+	return nil
+}
+
+func (c *testStorage) headObject(_ context.Context, exec *execCtx, _ clientcore.NodeInfo, id oid.ID) error {
+	return c.head(exec, id)
+}
+
 func (c *testStorage) addResult(addr cid.ID, ids []oid.ID, err error) {
 	c.items[addr.EncodeToString()] = idsErr{
 		ids: ids,
@@ -425,3 +464,206 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	assertContains(ids11, ids12, ids21, ids22)
 }
+
+func TestClientConstructRetries(t *testing.T) {
+	ctx := context.Background()
+
+	var rd netmap.ReplicaDescriptor
+	rd.SetNumberOfObjects(2)
+
+	var pp netmap.PlacementPolicy
+	pp.AddReplicas(rd)
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(pp)
+
+	var id cid.ID
+	container.CalculateID(&id, cnr)
+
+	var addr oid.Address
+	addr.SetContainer(id)
+
+	ns, as := testNodeMatrix(t, []int{2})
+
+	c1 := newTestStorage()
+	ids1 := generateIDs(10)
+	c1.addResult(id, ids1, nil)
+
+	c2 := newTestStorage()
+	ids2 := generateIDs(10)
+	c2.addResult(id, ids2, nil)
+
+	newSvc := func(retries int, failures map[string]int) (*Service, *flakyClientCache) {
+		const curEpoch = 13
+
+		c := &flakyClientCache{
+			testClientCache: testClientCache{
+				clients: map[string]*testStorage{
+					as[0][0]: c1,
+					as[0][1]: c2,
+				},
+			},
+			failures: failures,
+			calls:    make(map[string]int),
+		}
+
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{
+				curEpoch: &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString(): ns,
+					},
+				},
+			},
+		}
+		svc.clientConstructor = c
+		svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+		WithClientConstructRetries(retries, time.Millisecond)(svc.cfg)
+
+		return svc, c
+	}
+
+	newPrm := func(w IDListWriter) Prm {
+		p := Prm{}
+		p.WithContainerID(id)
+		p.SetWriter(w)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+
+		return p
+	}
+
+	t.Run("eventually queried", func(t *testing.T) {
+		svc, c := newSvc(2, map[string]int{as[0][0]: 2})
+
+		w := new(simpleIDWriter)
+
+		err := svc.Search(ctx, newPrm(w))
+		require.NoError(t, err)
+		require.ElementsMatch(t, append(ids1, ids2...), w.ids)
+		require.Equal(t, 3, c.calls[as[0][0]])
+		require.Equal(t, 1, c.calls[as[0][1]])
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		svc, c := newSvc(1, map[string]int{as[0][0]: 2})
+
+		w := new(simpleIDWriter)
+
+		err := svc.Search(ctx, newPrm(w))
+		require.ErrorIs(t, err, ErrPartialResult)
+		require.ElementsMatch(t, ids2, w.ids)
+		require.Equal(t, 2, c.calls[as[0][0]])
+	})
+
+	t.Run("negative retries", func(t *testing.T) {
+		svc, _ := newSvc(1, nil)
+
+		WithClientConstructRetries(-1, -time.Second)(svc.cfg)
+		require.Equal(t, 1, svc.clientRetries)
+		require.Equal(t, time.Millisecond, svc.clientRetryBackoff)
+	})
+}
+
+func TestVerifyPresence(t *testing.T) {
+	ctx := context.Background()
+
+	placementDim := []int{2}
+
+	rs := make([]netmap.ReplicaDescriptor, len(placementDim))
+	for i := range placementDim {
+		rs[i].SetNumberOfObjects(uint32(placementDim[i]))
+	}
+
+	var pp netmap.PlacementPolicy
+	pp.AddReplicas(rs...)
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(pp)
+
+	var id cid.ID
+	container.CalculateID(&id, cnr)
+
+	var addr oid.Address
+	addr.SetContainer(id)
+
+	ns, as := testNodeMatrix(t, placementDim)
+
+	const curEpoch = 13
+
+	local := newTestStorage()
+	localIDs := generateIDs(5)
+	local.addResult(id, localIDs, nil)
+	local.absent[localIDs[0]] = struct{}{}
+
+	c1 := newTestStorage()
+	ids1 := generateIDs(5)
+	c1.addResult(id, ids1, nil)
+	c1.absent[ids1[1]] = struct{}{}
+	c1.absent[ids1[3]] = struct{}{}
+
+	c2 := newTestStorage()
+	ids2 := generateIDs(5)
+	c2.addResult(id, ids2, nil)
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = local
+	svc.traverserGenerator = &testTraverserGenerator{
+		c: cnr,
+		b: map[uint64]placement.Builder{
+			curEpoch: &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString(): ns,
+				},
+			},
+		},
+	}
+	svc.clientConstructor = &testClientCache{
+		clients: map[string]*testStorage{
+			as[0][0]: c1,
+			as[0][1]: c2,
+		},
+	}
+	svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+	search := func(verify bool) []oid.ID {
+		w := new(simpleIDWriter)
+
+		var p Prm
+		p.WithContainerID(id)
+		p.SetWriter(w)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		if verify {
+			p.WithVerifyPresence()
+		}
+
+		require.NoError(t, svc.Search(ctx, p))
+
+		return w.ids
+	}
+
+	all := append(append(append([]oid.ID{}, localIDs...), ids1...), ids2...)
+
+	t.Run("disabled", func(t *testing.T) {
+		require.ElementsMatch(t, all, search(false))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		var expected []oid.ID
+		for _, id := range all {
+			_, absent1 := c1.absent[id]
+			_, absentLocal := local.absent[id]
+			if !absent1 && !absentLocal {
+				expected = append(expected, id)
+			}
+		}
+
+		require.Len(t, expected, len(all)-3)
+		require.ElementsMatch(t, expected, search(true))
+	})
+}
@@ -1,6 +1,9 @@
 package searchsvc
 
 import (
+	"context"
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -22,7 +25,9 @@ type Service struct {
 type Option func(*cfg)
 
 type searchClient interface {
-	searchObjects(*execCtx, client.NodeInfo) ([]oid.ID, error)
+	searchObjects(context.Context, *execCtx, client.NodeInfo) ([]oid.ID, error)
+	// headObject returns nil if the object is present on the node.
+	headObject(context.Context, *execCtx, client.NodeInfo, oid.ID) error
 }
 
 type ClientConstructor interface {
@@ -34,6 +39,8 @@ type cfg struct {
 
 	localStorage interface {
 		search(*execCtx) ([]oid.ID, error)
+		// head returns nil if the object is present in the storage.
+		head(*execCtx, oid.ID) error
 	}
 
 	clientConstructor interface {
@@ -49,12 +56,26 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	// clientRetries is the number of repeated attempts
+	// to construct remote node client.
+	clientRetries int
+	// clientRetryBackoff is the delay before the first repeated
+	// attempt, it is doubled for every next one.
+	clientRetryBackoff time.Duration
 }
 
+const (
+	defaultClientRetries      = 2
+	defaultClientRetryBackoff = 100 * time.Millisecond
+)
+
 func defaultCfg() *cfg {
 	return &cfg{
-		log:               zap.L(),
-		clientConstructor: new(clientConstructorWrapper),
+		log:                zap.L(),
+		clientConstructor:  new(clientConstructorWrapper),
+		clientRetries:      defaultClientRetries,
+		clientRetryBackoff: defaultClientRetryBackoff,
 	}
 }
 
@@ -97,6 +118,22 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithClientConstructRetries returns option to set the number of repeated
+// attempts to construct remote node client and the delay before the first one.
+// The delay is doubled for every next attempt. Zero number disables retries.
+//
+// Ignores negative values.
+func WithClientConstructRetries(n int, backoff time.Duration) Option {
+	return func(c *cfg) {
+		if n >= 0 {
+			c.clientRetries = n
+		}
+		if backoff >= 0 {
+			c.clientRetryBackoff = backoff
+		}
+	}
+}
+
 // WithTraverserGenerator returns option to set generator of
 // placement traverser to get the objects from containers.
 func WithTraverserGenerator(t *util.TraverserGenerator) Option {
@@ -1,6 +1,7 @@
 package searchsvc
 
 import (
+	"context"
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -84,9 +85,9 @@ func (c *clientConstructorWrapper) get(info client.NodeInfo) (searchClient, erro
 	}, nil
 }
 
-func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oid.ID, error) {
+func (c *clientWrapper) searchObjects(ctx context.Context, exec *execCtx, info client.NodeInfo) ([]oid.ID, error) {
 	if exec.prm.forwarder != nil {
-		return exec.prm.forwarder(info, c.client)
+		return exec.prm.forwarder(ctx, info, c.client)
 	}
 
 	var sessionInfo *util.SessionInfo
@@ -105,7 +106,7 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 
 	var prm internalclient.SearchObjectsPrm
 
-	prm.SetContext(exec.context())
+	prm.SetContext(ctx)
 	prm.SetClient(c.client)
 	prm.SetPrivateKey(key)
 	prm.SetSessionToken(exec.prm.common.SessionToken())
@@ -124,6 +125,33 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	return res.IDList(), nil
 }
 
+// headObject requests the object header from the node on behalf of the
+// local node: the tokens of the original request are issued for SEARCH
+// and are not attached.
+func (c *clientWrapper) headObject(ctx context.Context, exec *execCtx, info client.NodeInfo, id oid.ID) error {
+	key, err := exec.svc.keyStore.GetKey(nil)
+	if err != nil {
+		return err
+	}
+
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(id)
+
+	var prm internalclient.HeadObjectPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c.client)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(1) // the object must be present on the node itself
+	prm.SetXHeaders(exec.prm.common.XHeaders())
+	prm.SetNetmapEpoch(exec.curProcEpoch)
+	prm.SetAddress(addr)
+
+	_, err = internalclient.HeadObject(prm)
+	return err
+}
+
 func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	if e.state != nil && e.state.IsMaintenance() {
 		var st apistatus.NodeUnderMaintenance
@@ -133,8 +161,9 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithIdentity(engine.AccessIdentityFromTokens(exec.prm.common))
 
-	r, err := e.storage.Select(selectPrm)
+	r, err := e.storage.Select(exec.context(), selectPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +171,18 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	return idsFromAddresses(r.AddressList()), nil
 }
 
+func (e *storageEngineWrapper) head(exec *execCtx, id oid.ID) error {
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(id)
+
+	var headPrm engine.HeadPrm
+	headPrm.WithAddress(addr)
+
+	_, err := e.storage.Head(exec.context(), headPrm)
+	return err
+}
+
 func idsFromAddresses(addrs []oid.Address) []oid.ID {
 	ids := make([]oid.ID, len(addrs))
 
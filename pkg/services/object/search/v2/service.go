@@ -19,6 +19,10 @@ type cfg struct {
 	svc *searchsvc.Service
 
 	keyStorage *objutil.KeyStorage
+
+	respBodyLimit int
+
+	verifyPresence bool
 }
 
 // NewService constructs Service instance from provided options.
@@ -58,3 +62,20 @@ func WithKeyStorage(ks *objutil.KeyStorage) Option {
 		c.keyStorage = ks
 	}
 }
+
+// WithResponseBodyLimit returns option to limit the size of the object
+// identifiers list in a single response message. Zero or negative value
+// disables the limit, and identifiers are sent as soon as they are received.
+func WithResponseBodyLimit(limit int) Option {
+	return func(c *cfg) {
+		c.respBodyLimit = limit
+	}
+}
+
+// WithPresenceVerification returns option to verify the presence of the
+// objects found on the remote nodes (see searchsvc.Prm.WithVerifyPresence).
+func WithPresenceVerification(v bool) Option {
+	return func(c *cfg) {
+		c.verifyPresence = v
+	}
+}
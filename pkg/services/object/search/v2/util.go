@@ -1,6 +1,7 @@
 package searchsvc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -47,9 +48,19 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	p := new(searchsvc.Prm)
 	p.SetCommonParameters(commonPrm)
 
-	p.SetWriter(&streamWriter{
+	var w searchsvc.IDListWriter = &streamWriter{
 		stream: stream,
-	})
+	}
+
+	if s.respBodyLimit > 0 {
+		w = searchsvc.NewChunkedIDWriter(w, s.respBodyLimit)
+	}
+
+	p.SetWriter(w)
+
+	if s.verifyPresence {
+		p.WithVerifyPresence()
+	}
 
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
@@ -59,7 +70,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 			return nil, err
 		}
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) ([]oid.ID, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) ([]oid.ID, error) {
 			var err error
 
 			// once compose and resign forwarding request
@@ -81,7 +92,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 
 			var searchStream *rpc.SearchResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				searchStream, err = rpc.SearchObjects(cli, req, rpcclient.WithContext(stream.Context()))
+				searchStream, err = rpc.SearchObjects(cli, req, rpcclient.WithContext(ctx))
 				return err
 			})
 			if err != nil {
@@ -139,8 +150,8 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	return p, nil
 }
 
-func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
-	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
+func groupAddressRequestForwarder(f func(context.Context, network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
+	return func(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
 		var (
 			firstErr error
 			res      []oid.ID
@@ -161,7 +172,7 @@ func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressCli
 				// would be nice to log otherwise
 			}()
 
-			res, err = f(addr, c, key)
+			res, err = f(ctx, addr, c, key)
 
 			return
 		})
@@ -0,0 +1,60 @@
+package searchsvc
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// presenceVerificationWorkers is the maximum number of objects
+// which presence is verified concurrently.
+const presenceVerificationWorkers = 8
+
+// verifyPresence returns identifiers of the objects for which head returns
+// no error keeping their order. Virtual objects are considered present.
+func (exec *execCtx) verifyPresence(ids []oid.ID, head func(oid.ID) error) []oid.ID {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, presenceVerificationWorkers)
+		present = make([]bool, len(ids))
+	)
+
+	for i := range ids {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := head(ids[i])
+
+			var errSplitInfo *object.SplitInfoError
+			if err == nil || errors.As(err, &errSplitInfo) {
+				present[i] = true
+				return
+			}
+
+			exec.log.Debug("object presence is not verified, skip",
+				zap.Stringer("object", ids[i]),
+				zap.String("error", err.Error()),
+			)
+		}(i)
+	}
+
+	wg.Wait()
+
+	res := make([]oid.ID, 0, len(ids))
+	for i := range ids {
+		if present[i] {
+			res = append(res, ids[i])
+		}
+	}
+
+	return res
+}
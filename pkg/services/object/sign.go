@@ -62,10 +62,10 @@ func (s *SignService) Get(req *object.GetRequest, stream GetObjectStream) error
 			return new(object.GetResponse)
 		},
 		func(respWriter util.ResponseMessageWriter) error {
-			return s.svc.Get(req, &getStreamSigner{
+			return toStatusError(s.svc.Get(req, &getStreamSigner{
 				ServerStream: stream,
 				respWriter:   respWriter,
-			})
+			}))
 		},
 	)
 }
@@ -92,10 +92,11 @@ func (s *SignService) Put(ctx context.Context) (PutObjectStream, error) {
 	return &putStreamSigner{
 		stream: s.sigSvc.CreateRequestStreamer(
 			func(req interface{}) error {
-				return stream.Send(req.(*object.PutRequest))
+				return toStatusError(stream.Send(req.(*object.PutRequest)))
 			},
 			func() (util.ResponseMessage, error) {
-				return stream.CloseAndRecv()
+				resp, err := stream.CloseAndRecv()
+				return resp, toStatusError(err)
 			},
 			func() util.ResponseMessage {
 				return new(object.PutResponse)
@@ -107,7 +108,8 @@ func (s *SignService) Put(ctx context.Context) (PutObjectStream, error) {
 func (s *SignService) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
 	resp, err := s.sigSvc.HandleUnaryRequest(ctx, req,
 		func(ctx context.Context, req interface{}) (util.ResponseMessage, error) {
-			return s.svc.Head(ctx, req.(*object.HeadRequest))
+			resp, err := s.svc.Head(ctx, req.(*object.HeadRequest))
+			return resp, toStatusError(err)
 		},
 		func() util.ResponseMessage {
 			return new(object.HeadResponse)
@@ -150,7 +152,7 @@ func (s *SignService) Search(req *object.SearchRequest, stream SearchStream) err
 				return stream.Send(new(object.SearchResponse))
 			}
 
-			return err
+			return toStatusError(err)
 		},
 	)
 }
@@ -158,7 +160,8 @@ func (s *SignService) Search(req *object.SearchRequest, stream SearchStream) err
 func (s *SignService) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
 	resp, err := s.sigSvc.HandleUnaryRequest(ctx, req,
 		func(ctx context.Context, req interface{}) (util.ResponseMessage, error) {
-			return s.svc.Delete(ctx, req.(*object.DeleteRequest))
+			resp, err := s.svc.Delete(ctx, req.(*object.DeleteRequest))
+			return resp, toStatusError(err)
 		},
 		func() util.ResponseMessage {
 			return new(object.DeleteResponse)
@@ -184,10 +187,10 @@ func (s *SignService) GetRange(req *object.GetRangeRequest, stream GetObjectRang
 			return new(object.GetRangeResponse)
 		},
 		func(respWriter util.ResponseMessageWriter) error {
-			return s.svc.GetRange(req, &getRangeStreamSigner{
+			return toStatusError(s.svc.GetRange(req, &getRangeStreamSigner{
 				ServerStream: stream,
 				respWriter:   respWriter,
-			})
+			}))
 		},
 	)
 }
@@ -195,7 +198,8 @@ func (s *SignService) GetRange(req *object.GetRangeRequest, stream GetObjectRang
 func (s *SignService) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
 	resp, err := s.sigSvc.HandleUnaryRequest(ctx, req,
 		func(ctx context.Context, req interface{}) (util.ResponseMessage, error) {
-			return s.svc.GetRangeHash(ctx, req.(*object.GetRangeHashRequest))
+			resp, err := s.svc.GetRangeHash(ctx, req.(*object.GetRangeHashRequest))
+			return resp, toStatusError(err)
 		},
 		func() util.ResponseMessage {
 			return new(object.GetRangeHashResponse)
@@ -0,0 +1,41 @@
+package object
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// toStatusError converts the error returned by the object service to the
+// NeoFS API status error, so that the clients receive the proper status
+// instead of the generic internal one:
+//   - if the error chain contains a status error (e.g. apistatus.ObjectOutOfRange
+//     from the shards or apistatus.ContainerNotFound from the placement
+//     builder), it is returned as is regardless of the wrapping;
+//   - storage space exhaustion errors (full write-cache, no space on the
+//     device) are returned as apistatus.ServerInternal with the message
+//     describing the cause since there is no dedicated status for them.
+//
+// Other errors are returned unchanged.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var st apistatus.StatusV2
+	if errors.As(err, &st) {
+		if stErr, ok := st.(error); ok {
+			return stErr
+		}
+	}
+
+	if shard.IsErrNoSpace(err) {
+		var errInternal apistatus.ServerInternal
+		errInternal.SetMessage("not enough space to store the object: " + err.Error())
+
+		return errInternal
+	}
+
+	return err
+}
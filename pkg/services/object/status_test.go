@@ -0,0 +1,100 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToStatusError(t *testing.T) {
+	globalize := func(code status.Code, globalizer func(*status.Code)) status.Code {
+		globalizer(&code)
+		return code
+	}
+
+	var (
+		codeInternal    = globalize(status.Internal, status.GlobalizeCommonFail)
+		codeOutOfRange  = globalize(objectV2.StatusOutOfRange, objectV2.GlobalizeFail)
+		codeNotFound    = globalize(objectV2.StatusNotFound, objectV2.GlobalizeFail)
+		codeCnrNotFound = globalize(container.StatusNotFound, container.GlobalizeFail)
+		codeLocked      = globalize(objectV2.StatusLocked, objectV2.GlobalizeFail)
+	)
+
+	otherErr := errors.New("some error")
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code status.Code
+	}{
+		{
+			name: "out of range from shard",
+			err:  fmt.Errorf("could not get object range: %w", apistatus.ObjectOutOfRange{}),
+			code: codeOutOfRange,
+		},
+		{
+			name: "out of range from remote node",
+			err:  fmt.Errorf("remote call failed: %w", new(apistatus.ObjectOutOfRange)),
+			code: codeOutOfRange,
+		},
+		{
+			name: "container not found during placement build",
+			err: fmt.Errorf("could not generate traverser: %w",
+				fmt.Errorf("could not get container: %w", apistatus.ContainerNotFound{})),
+			code: codeCnrNotFound,
+		},
+		{
+			name: "object not found",
+			err:  fmt.Errorf("%w: %v", apistatus.ObjectNotFound{}, otherErr),
+			code: codeNotFound,
+		},
+		{
+			name: "tombstone expires before lock",
+			err:  fmt.Errorf("could not inhume object: %w", engine.ErrTombstoneExpiresBeforeLock),
+			code: codeLocked,
+		},
+		{
+			name: "full write-cache",
+			err:  fmt.Errorf("could not put object: %w", writecache.ErrOutOfSpace),
+			code: codeInternal,
+		},
+		{
+			name: "no space on device",
+			err:  fmt.Errorf("could not put object: %w", common.ErrNoSpace),
+			code: codeInternal,
+		},
+		{
+			name: "no place in blobstor",
+			err:  blobstor.ErrNoPlaceFound,
+			code: codeInternal,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := toStatusError(tc.err)
+
+			st, ok := err.(apistatus.StatusV2)
+			require.True(t, ok, "%T is not a status", err)
+			require.Equal(t, tc.code, st.ToStatusV2().Code())
+		})
+	}
+
+	t.Run("space exhaustion message", func(t *testing.T) {
+		err := toStatusError(fmt.Errorf("could not put object: %w", writecache.ErrOutOfSpace))
+		require.Contains(t, err.(apistatus.ServerInternal).Message(), writecache.ErrOutOfSpace.Error())
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		require.NoError(t, toStatusError(nil))
+		require.Equal(t, otherErr, toStatusError(otherErr))
+	})
+}
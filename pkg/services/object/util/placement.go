@@ -116,7 +116,7 @@ func (g *TraverserGenerator) WithTraverseOptions(opts ...placement.Option) *Trav
 		netMapSrc:  g.netMapSrc,
 		cnrSrc:     g.cnrSrc,
 		netmapKeys: g.netmapKeys,
-		customOpts: opts,
+		customOpts: append(g.customOpts[:len(g.customOpts):len(g.customOpts)], opts...),
 	}
 }
 
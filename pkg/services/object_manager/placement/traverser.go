@@ -1,9 +1,11 @@
 package placement
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
@@ -34,6 +36,18 @@ type Traverser struct {
 	vectors [][]netmap.NodeInfo
 
 	rem []int
+
+	// pending is the number of nodes returned by the last
+	// Next call which have not been given a timeout yet.
+	pending int
+
+	// parallel is set if the nodes returned by one Next
+	// call are processed concurrently.
+	parallel bool
+
+	minNodeTimeout, maxNodeTimeout time.Duration
+
+	now func() time.Time
 }
 
 type cfg struct {
@@ -49,8 +63,18 @@ type cfg struct {
 	policy    netmap.PlacementPolicy
 
 	builder Builder
+
+	parallel bool
+
+	minNodeTimeout, maxNodeTimeout time.Duration
+
+	now func() time.Time
 }
 
+// DefaultMinNodeTimeout is the default lower limit of the timeout
+// given to a single node by Traverser.NodeTimeout.
+const DefaultMinNodeTimeout = time.Second
+
 const invalidOptsMsg = "invalid traverser options"
 
 var errNilBuilder = errors.New("placement builder is nil")
@@ -59,7 +83,9 @@ var errNilPolicy = errors.New("placement policy is nil")
 
 func defaultCfg() *cfg {
 	return &cfg{
-		trackCopies: true,
+		trackCopies:    true,
+		minNodeTimeout: DefaultMinNodeTimeout,
+		now:            time.Now,
 	}
 }
 
@@ -102,9 +128,13 @@ func NewTraverser(opts ...Option) (*Traverser, error) {
 	}
 
 	return &Traverser{
-		mtx:     new(sync.RWMutex),
-		rem:     rem,
-		vectors: ns,
+		mtx:            new(sync.RWMutex),
+		rem:            rem,
+		vectors:        ns,
+		parallel:       cfg.parallel,
+		minNodeTimeout: cfg.minNodeTimeout,
+		maxNodeTimeout: cfg.maxNodeTimeout,
+		now:            cfg.now,
 	}, nil
 }
 
@@ -184,10 +214,111 @@ func (t *Traverser) Next() []Node {
 	}
 
 	t.vectors[0] = t.vectors[0][count:]
+	t.pending = count
 
 	return nodes
 }
 
+// NodeTimeout returns the time a single node can be given to process
+// the operation so that all the nodes left to be traversed have their
+// chance before the deadline. The remaining time is divided between the
+// nodes of the last Next call that have not been given the timeout yet
+// and the nodes not returned by Next so far. If the nodes of each Next
+// call are processed concurrently (see WithParallelBatches), the time is
+// divided between the current and the remaining Next calls instead. The
+// result is limited by the configured floor and ceiling, see
+// WithNodeTimeoutLimits.
+//
+// Each call is considered as an attempt to process the next node.
+func (t *Traverser) NodeTimeout(deadline time.Time) time.Duration {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	var attempts int
+	if t.parallel {
+		attempts = 1 + t.remainingBatches()
+	} else {
+		attempts = t.pending
+		if attempts > 0 {
+			t.pending--
+		} else {
+			attempts = 1
+		}
+
+		for i := range t.vectors {
+			attempts += len(t.vectors[i])
+		}
+	}
+
+	timeout := deadline.Sub(t.now()) / time.Duration(attempts)
+
+	if t.maxNodeTimeout > 0 && timeout > t.maxNodeTimeout {
+		timeout = t.maxNodeTimeout
+	}
+
+	if timeout < t.minNodeTimeout {
+		timeout = t.minNodeTimeout
+	}
+
+	return timeout
+}
+
+// remainingBatches returns the maximum number of the Next calls returning
+// the nodes left to be traversed.
+func (t *Traverser) remainingBatches() int {
+	var n int
+
+	for i := range t.vectors {
+		if len(t.vectors[i]) == 0 {
+			continue
+		}
+
+		if t.rem[i] <= 0 {
+			n++
+		} else {
+			n += (len(t.vectors[i]) + t.rem[i] - 1) / t.rem[i]
+		}
+	}
+
+	return n
+}
+
+// NodeContext returns the context for processing the next node. If ctx
+// has a deadline, the returned context is limited by NodeTimeout.
+//
+// The returned cancel function must be called after the node is processed.
+func (t *Traverser) NodeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, t.NodeTimeout(deadline))
+}
+
+// NodeResponseContext is similar to NodeContext but limits the node by
+// NodeTimeout until it responds only: the returned stop function must be
+// called on the first response of the node, after that the node is limited
+// by ctx. It is intended for the streams which are written to the requesting
+// party as they are received.
+//
+// The returned cancel function must be called after the node is processed.
+func (t *Traverser) NodeResponseContext(ctx context.Context) (context.Context, func(), context.CancelFunc) {
+	nodeCtx, cancel := context.WithCancel(ctx)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nodeCtx, func() {}, cancel
+	}
+
+	timer := time.AfterFunc(t.NodeTimeout(deadline), cancel)
+
+	return nodeCtx, func() { timer.Stop() }, func() {
+		timer.Stop()
+		cancel()
+	}
+}
+
 func (t *Traverser) skipEmptyVectors() {
 	for i := 0; i < len(t.vectors); i++ { // don't use range, slice changes in body
 		if len(t.vectors[i]) == 0 && t.rem[i] <= 0 || t.rem[0] == 0 {
@@ -265,3 +396,35 @@ func WithoutSuccessTracking() Option {
 		c.trackCopies = false
 	}
 }
+
+// WithParallelBatches sets that the nodes returned by one Next call are
+// processed concurrently, so Traverser.NodeTimeout shares the deadline
+// between the Next calls rather than between the nodes.
+func WithParallelBatches() Option {
+	return func(c *cfg) {
+		c.parallel = true
+	}
+}
+
+// WithNodeTimeoutLimits sets the floor and the ceiling of the timeout
+// returned by Traverser.NodeTimeout. Zero ceiling means no upper limit.
+//
+// By default, the floor is DefaultMinNodeTimeout and there is no ceiling.
+func WithNodeTimeoutLimits(min, max time.Duration) Option {
+	return func(c *cfg) {
+		c.minNodeTimeout = min
+		c.maxNodeTimeout = max
+	}
+}
+
+// WithClock sets the function returning the current time which
+// is used to calculate node timeouts.
+//
+// By default, time.Now is used.
+func WithClock(now func() time.Time) Option {
+	return func(c *cfg) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
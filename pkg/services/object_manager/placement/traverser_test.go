@@ -1,8 +1,10 @@
 package placement
 
 import (
+	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
@@ -208,3 +210,158 @@ func TestTraverserObjectScenarios(t *testing.T) {
 		require.True(t, tr.Success())
 	})
 }
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func TestTraverserNodeTimeout(t *testing.T) {
+	t.Run("dead nodes", func(t *testing.T) {
+		nodes, cnr := testPlacement(t, []int{1, 1, 1}, []int{1, 1, 1})
+		nodesCopy := copyVectors(nodes)
+
+		// nodes of the first two vectors do not respond
+		const deadNum = 2
+
+		clock := &testClock{now: time.Unix(1000, 0)}
+		deadline := clock.now.Add(9 * time.Second)
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: nodes}),
+			WithoutSuccessTracking(),
+			WithNodeTimeoutLimits(100*time.Millisecond, 0),
+			WithClock(clock.Now),
+		)
+		require.NoError(t, err)
+
+		var attempted int
+
+		for {
+			addrs := tr.Next()
+			if len(addrs) == 0 {
+				break
+			}
+
+			for i := range addrs {
+				timeout := tr.NodeTimeout(deadline)
+				require.Equal(t, 3*time.Second, timeout)
+
+				require.True(t, clock.now.Before(deadline))
+				assertSameAddress(t, nodesCopy[attempted][0], addrs[i].Addresses())
+
+				if attempted < deadNum {
+					// dead node consumes the whole given time
+					clock.now = clock.now.Add(timeout)
+				}
+
+				attempted++
+			}
+		}
+
+		require.Equal(t, 3, attempted)
+	})
+
+	t.Run("parallel batches", func(t *testing.T) {
+		nodes, cnr := testPlacement(t, []int{5, 2}, []int{2, 2})
+
+		clock := &testClock{now: time.Unix(1000, 0)}
+		deadline := clock.now.Add(12 * time.Second)
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: nodes}),
+			WithParallelBatches(),
+			WithNodeTimeoutLimits(100*time.Millisecond, 0),
+			WithClock(clock.Now),
+		)
+		require.NoError(t, err)
+
+		// 3 batches of the first vector and 1 of the second one
+		addrs := tr.Next()
+		require.Len(t, addrs, 2)
+
+		for range addrs {
+			require.Equal(t, 3*time.Second, tr.NodeTimeout(deadline))
+		}
+
+		// nodes of the batch do not respond
+		clock.now = clock.now.Add(3 * time.Second)
+
+		require.Len(t, tr.Next(), 2)
+		require.Equal(t, 3*time.Second, tr.NodeTimeout(deadline))
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		nodes, cnr := testPlacement(t, []int{10}, []int{1})
+
+		clock := &testClock{now: time.Unix(1000, 0)}
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: nodes}),
+			WithNodeTimeoutLimits(time.Second, 5*time.Second),
+			WithClock(clock.Now),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, tr.Next(), 1)
+		require.Equal(t, time.Second, tr.NodeTimeout(clock.now.Add(5*time.Second)))
+		require.Equal(t, 5*time.Second, tr.NodeTimeout(clock.now.Add(time.Minute)))
+		require.Equal(t, time.Second, tr.NodeTimeout(clock.now.Add(-time.Second)))
+	})
+
+	t.Run("no deadline", func(t *testing.T) {
+		nodes, cnr := testPlacement(t, []int{1}, []int{1})
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: nodes}),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := tr.NodeContext(context.Background())
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("until response", func(t *testing.T) {
+		nodes, cnr := testPlacement(t, []int{2}, []int{1})
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: nodes}),
+			WithNodeTimeoutLimits(10*time.Millisecond, 10*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		require.Len(t, tr.Next(), 1)
+
+		nodeCtx, _, nodeCancel := tr.NodeResponseContext(ctx)
+		defer nodeCancel()
+
+		select {
+		case <-nodeCtx.Done():
+		case <-time.After(time.Second):
+			require.FailNow(t, "node timeout is not applied")
+		}
+
+		nodeCtx, responded, nodeCancel := tr.NodeResponseContext(ctx)
+		responded()
+
+		time.Sleep(50 * time.Millisecond)
+		require.NoError(t, nodeCtx.Err())
+
+		nodeCancel()
+		require.Error(t, nodeCtx.Err())
+	})
+}
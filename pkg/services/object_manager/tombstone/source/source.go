@@ -82,3 +82,25 @@ func (s Source) Tombstone(ctx context.Context, a oid.Address, _ uint64) (*object
 
 	return hr.o, nil
 }
+
+// IsRemoved checks if the object is removed in the NeoFS network, i.e.
+// the local storage or the container nodes respond that the object has
+// already been removed.
+func (s Source) IsRemoved(ctx context.Context, a oid.Address) (bool, error) {
+	var headPrm getsvc.HeadPrm
+	headPrm.WithAddress(a)
+	headPrm.SetHeaderWriter(new(headerWriter))
+	headPrm.SetCommonParameters(&util.CommonPrm{}) // default values are ok for that operation
+
+	err := s.s.Head(ctx, headPrm)
+	switch {
+	case errors.As(err, new(apistatus.ObjectAlreadyRemoved)):
+		return true, nil
+	case err == nil,
+		errors.As(err, new(apistatus.ObjectNotFound)),
+		errors.As(err, new(*objectSDK.SplitInfoError)):
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not get object header from the source: %w", err)
+	}
+}
@@ -19,7 +19,21 @@ func (n nodeCache) SubmitSuccessfulReplication(id uint64) {
 	n[id] = true
 }
 
+// replicationResult counts the replicas created by the replication task.
+type replicationResult struct {
+	nodeCache
+
+	replicas uint32
+}
+
+func (r *replicationResult) SubmitSuccessfulReplication(id uint64) {
+	r.nodeCache.SubmitSuccessfulReplication(id)
+	r.replicas++
+}
+
 func (p *Policer) processObject(ctx context.Context, addr oid.Address) {
+	p.objectInspected()
+
 	idCnr := addr.Container()
 
 	cnr, err := p.cnrSrc.Get(idCnr)
@@ -79,6 +93,10 @@ func (p *Policer) processObject(ctx context.Context, addr oid.Address) {
 		p.processNodes(c, addr, nn[i], policy.ReplicaNumberByIndex(i), checkedNodes)
 	}
 
+	if c.underReplicated {
+		p.underReplicationDetected()
+	}
+
 	if !c.needLocalCopy {
 		p.log.Info("redundant local object copy detected",
 			zap.Stringer("object", addr),
@@ -92,6 +110,8 @@ type processPlacementContext struct {
 	context.Context
 
 	needLocalCopy bool
+
+	underReplicated bool
 }
 
 func (p *Policer) processNodes(ctx *processPlacementContext, addr oid.Address,
@@ -154,11 +174,17 @@ func (p *Policer) processNodes(ctx *processPlacementContext, addr oid.Address,
 			zap.Uint32("shortage", shortage),
 		)
 
+		ctx.underReplicated = true
+
 		var task replicator.Task
 		task.SetObjectAddress(addr)
 		task.SetNodes(nodes)
 		task.SetCopiesNumber(shortage)
 
-		p.replicator.HandleTask(ctx, task, checkedNodes)
+		res := &replicationResult{nodeCache: checkedNodes}
+
+		p.taskQueued()
+		p.replicator.HandleTask(ctx, task, res)
+		p.taskFinished(res.replicas >= shortage)
 	}
 }
@@ -56,6 +56,8 @@ type Policer struct {
 	cache *lru.Cache
 
 	objsInWork *objectsInWork
+
+	stats stats
 }
 
 // Option is an option for Policer constructor.
@@ -88,6 +90,8 @@ type cfg struct {
 
 	loader nodeLoader
 
+	metrics MetricRegister
+
 	maxCapacity int
 
 	batchSize, cacheSize uint32
@@ -216,3 +220,10 @@ func WithNodeLoader(l nodeLoader) Option {
 		c.loader = l
 	}
 }
+
+// WithMetrics returns option to set the storage of Policer work metrics.
+func WithMetrics(m MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
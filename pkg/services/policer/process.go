@@ -36,6 +36,7 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 		addrs, cursor, err = p.jobQueue.Select(cursor, p.batchSize)
 		if err != nil {
 			if errors.Is(err, engine.ErrEndOfListing) {
+				p.passCompleted()
 				time.Sleep(time.Second) // finished whole cycle, sleep a bit
 				continue
 			}
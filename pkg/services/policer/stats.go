@@ -0,0 +1,150 @@
+package policer
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// MetricRegister is an interface of the storage of Policer work metrics.
+type MetricRegister interface {
+	IncInspectedObjects()
+	IncUnderReplicatedObjects()
+	IncReplicationTasks()
+	IncReplicationTaskResults(success bool)
+	SetLastPassTime(t time.Time)
+}
+
+// Stats groups the counters of the Policer work. All the counters
+// grow monotonically since the Policer start.
+type Stats struct {
+	// InspectedObjects is a number of the objects checked
+	// for compliance with the storage policy.
+	InspectedObjects uint64
+
+	// UnderReplicatedObjects is a number of the inspected objects
+	// lacking copies on the container nodes.
+	UnderReplicatedObjects uint64
+
+	// QueuedTasks is a number of the replication tasks passed to the replicator.
+	QueuedTasks uint64
+
+	// CompletedTasks is a number of the replication tasks
+	// which created all the missing copies.
+	CompletedTasks uint64
+
+	// FailedTasks is a number of the replication tasks
+	// which did not create all the missing copies.
+	FailedTasks uint64
+
+	// Passes is a number of the completed passes over the local objects.
+	Passes uint64
+
+	// LastPassTime is the time the last pass was completed at.
+	// Zero if no pass has been completed yet.
+	LastPassTime time.Time
+}
+
+type stats struct {
+	inspected       atomic.Uint64
+	underReplicated atomic.Uint64
+	queued          atomic.Uint64
+	completed       atomic.Uint64
+	failed          atomic.Uint64
+	passes          atomic.Uint64
+
+	// Unix nanoseconds, zero if there were no passes
+	lastPass atomic.Int64
+
+	// counters at the start of the current pass,
+	// accessed by the policy worker only
+	passStart Stats
+}
+
+// Stats returns the counters of the Policer work.
+func (p *Policer) Stats() Stats {
+	res := Stats{
+		InspectedObjects:       p.stats.inspected.Load(),
+		UnderReplicatedObjects: p.stats.underReplicated.Load(),
+		QueuedTasks:            p.stats.queued.Load(),
+		CompletedTasks:         p.stats.completed.Load(),
+		FailedTasks:            p.stats.failed.Load(),
+		Passes:                 p.stats.passes.Load(),
+	}
+
+	if t := p.stats.lastPass.Load(); t != 0 {
+		res.LastPassTime = time.Unix(0, t)
+	}
+
+	return res
+}
+
+func (p *Policer) objectInspected() {
+	p.stats.inspected.Inc()
+
+	if p.metrics != nil {
+		p.metrics.IncInspectedObjects()
+	}
+}
+
+func (p *Policer) underReplicationDetected() {
+	p.stats.underReplicated.Inc()
+
+	if p.metrics != nil {
+		p.metrics.IncUnderReplicatedObjects()
+	}
+}
+
+func (p *Policer) taskQueued() {
+	p.stats.queued.Inc()
+
+	if p.metrics != nil {
+		p.metrics.IncReplicationTasks()
+	}
+}
+
+func (p *Policer) taskFinished(success bool) {
+	if success {
+		p.stats.completed.Inc()
+	} else {
+		p.stats.failed.Inc()
+	}
+
+	if p.metrics != nil {
+		p.metrics.IncReplicationTaskResults(success)
+	}
+}
+
+// passCompleted registers the end of the pass over the local
+// objects and logs the summary of the pass.
+func (p *Policer) passCompleted() {
+	now := time.Now()
+
+	p.stats.passes.Inc()
+	p.stats.lastPass.Store(now.UnixNano())
+
+	if p.metrics != nil {
+		p.metrics.SetLastPassTime(now)
+	}
+
+	cur := p.Stats()
+	prev := p.stats.passStart
+	p.stats.passStart = cur
+
+	log := p.log.Debug
+	if cur.InspectedObjects != prev.InspectedObjects {
+		log = p.log.Info
+	}
+
+	// tasks are executed asynchronously, so task counters
+	// may include the objects of the previous pass
+	log("policer pass completed",
+		zap.Uint64("pass", cur.Passes),
+		zap.Uint64("inspected", cur.InspectedObjects-prev.InspectedObjects),
+		zap.Uint64("under-replicated", cur.UnderReplicatedObjects-prev.UnderReplicatedObjects),
+		zap.Uint64("queued tasks", cur.QueuedTasks-prev.QueuedTasks),
+		zap.Uint64("completed tasks", cur.CompletedTasks-prev.CompletedTasks),
+		zap.Uint64("failed tasks", cur.FailedTasks-prev.FailedTasks),
+	)
+}
@@ -0,0 +1,232 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// maxErrorClassLength is the maximum length of the error message prefix
+// used to classify the error.
+const maxErrorClassLength = 64
+
+// maxSuppressedSummaries is the number of the latest summaries
+// kept by Suppressor.
+const maxSuppressedSummaries = 32
+
+// maxSuppressedClasses is the maximum number of the message classes tracked
+// by Suppressor. Messages of the new classes are written without suppression
+// when the limit is reached and there are no stale classes to evict.
+const maxSuppressedClasses = 1024
+
+// ErrorClass returns the class of the error which can be used to deduplicate
+// log messages: the type of the innermost wrapped error and the error message
+// prefix up to the first colon.
+func ErrorClass(err error) string {
+	inner := err
+	for u := errors.Unwrap(inner); u != nil; u = errors.Unwrap(inner) {
+		inner = u
+	}
+
+	msg := err.Error()
+	if i := strings.IndexByte(msg, ':'); i >= 0 {
+		msg = msg[:i]
+	}
+	if len(msg) > maxErrorClassLength {
+		msg = msg[:maxErrorClassLength]
+	}
+
+	return fmt.Sprintf("%T: %s", inner, msg)
+}
+
+// Suppressor deduplicates repeated log messages. The first message
+// of a class is written immediately, the following ones are counted
+// and reported as a single summary line once per interval.
+//
+// Suppressor is safe for concurrent use.
+type Suppressor struct {
+	log      *Logger
+	interval time.Duration
+
+	mtx       sync.Mutex
+	classes   map[string]*suppressedClass
+	summaries []SuppressedSummary
+}
+
+// SuppressedSummary describes the messages of a single class suppressed
+// during an interval.
+type SuppressedSummary struct {
+	// Class is the class of the messages.
+	Class string
+	// Message is the first message of the class in the interval.
+	Message string
+	// Count is the number of the suppressed messages.
+	Count uint64
+	// Time is the time the summary has been written.
+	Time time.Time
+}
+
+type suppressedClass struct {
+	// lvl is the level of the first occurrence.
+	lvl zapcore.Level
+	// msg is the message of the first occurrence.
+	msg string
+	// start is the beginning of the current interval.
+	start time.Time
+	// count is the number of suppressed messages in the current interval.
+	count uint64
+	// timer reports suppressed messages at the end of the interval.
+	timer *time.Timer
+}
+
+// NewSuppressor returns new Suppressor writing to l. If interval is
+// not positive, every message is written.
+func NewSuppressor(l *Logger, interval time.Duration) *Suppressor {
+	return &Suppressor{
+		log:      l,
+		interval: interval,
+		classes:  make(map[string]*suppressedClass),
+	}
+}
+
+// Warn writes the message with warning level if it is the first message
+// of the class in the current interval, otherwise only counts it.
+func (s *Suppressor) Warn(class, msg string, fields ...zap.Field) {
+	s.write(zapcore.WarnLevel, class, msg, fields)
+}
+
+// Error writes the message with error level if it is the first message
+// of the class in the current interval, otherwise only counts it.
+func (s *Suppressor) Error(class, msg string, fields ...zap.Field) {
+	s.write(zapcore.ErrorLevel, class, msg, fields)
+}
+
+func (s *Suppressor) write(lvl zapcore.Level, class, msg string, fields []zap.Field) {
+	if s.interval <= 0 {
+		s.check(lvl, msg, fields)
+		return
+	}
+
+	now := time.Now()
+
+	s.mtx.Lock()
+	c, ok := s.classes[class]
+	if !ok && len(s.classes) >= maxSuppressedClasses {
+		s.evictStale(now)
+		if len(s.classes) >= maxSuppressedClasses {
+			s.mtx.Unlock()
+
+			s.check(lvl, msg, fields)
+			return
+		}
+	}
+
+	if !ok || c.count == 0 && now.Sub(c.start) >= s.interval {
+		s.classes[class] = &suppressedClass{
+			lvl:   lvl,
+			msg:   msg,
+			start: now,
+		}
+		s.mtx.Unlock()
+
+		s.check(lvl, msg, fields)
+		return
+	}
+
+	c.count++
+	if c.timer == nil {
+		c.timer = time.AfterFunc(c.start.Add(s.interval).Sub(now), func() {
+			s.report(class)
+		})
+	}
+	s.mtx.Unlock()
+}
+
+// evictStale removes the classes without the messages in the last interval.
+// Must be called under the lock.
+func (s *Suppressor) evictStale(now time.Time) {
+	for class, c := range s.classes {
+		if c.count == 0 && now.Sub(c.start) >= s.interval {
+			delete(s.classes, class)
+		}
+	}
+}
+
+// report writes the summary of the suppressed messages of the class
+// and starts a new interval.
+func (s *Suppressor) report(class string) {
+	s.mtx.Lock()
+	c, ok := s.classes[class]
+	if !ok || c.count == 0 {
+		s.mtx.Unlock()
+		return
+	}
+
+	n := c.count
+	c.count = 0
+	c.start = time.Now()
+	c.timer = nil
+
+	if len(s.summaries) == maxSuppressedSummaries {
+		s.summaries = append(s.summaries[:0], s.summaries[1:]...)
+	}
+	s.summaries = append(s.summaries, SuppressedSummary{
+		Class:   class,
+		Message: c.msg,
+		Count:   n,
+		Time:    c.start,
+	})
+	s.mtx.Unlock()
+
+	s.check(c.lvl, fmt.Sprintf("%s: repeated %d times in last %s", c.msg, n, s.interval), []zap.Field{
+		zap.String("class", class),
+		zap.Uint64("count", n),
+	})
+}
+
+func (s *Suppressor) check(lvl zapcore.Level, msg string, fields []zap.Field) {
+	if ce := s.log.Check(lvl, msg); ce != nil {
+		ce.Write(fields...)
+	}
+}
+
+// Summaries returns at most n latest summaries of the suppressed messages
+// starting from the newest one. Non-positive n means all the retained
+// summaries.
+func (s *Suppressor) Summaries(n int) []SuppressedSummary {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if n <= 0 || n > len(s.summaries) {
+		n = len(s.summaries)
+	}
+
+	res := make([]SuppressedSummary, n)
+	for i := range res {
+		res[i] = s.summaries[len(s.summaries)-1-i]
+	}
+
+	return res
+}
+
+// Flush writes summaries of all suppressed messages and stops the timers.
+func (s *Suppressor) Flush() {
+	s.mtx.Lock()
+	classes := make([]string, 0, len(s.classes))
+	for class, c := range s.classes {
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+		classes = append(classes, class)
+	}
+	s.mtx.Unlock()
+
+	for i := range classes {
+		s.report(classes[i])
+	}
+}
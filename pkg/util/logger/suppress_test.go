@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestErrorClass(t *testing.T) {
+	errBase := errors.New("object not found")
+
+	require.Equal(t,
+		ErrorClass(fmt.Errorf("could not get object: %w", errBase)),
+		ErrorClass(fmt.Errorf("could not get object: %w", fmt.Errorf("another cause: %w", errBase))))
+	require.NotEqual(t,
+		ErrorClass(fmt.Errorf("could not get object: %w", errBase)),
+		ErrorClass(fmt.Errorf("could not put object: %w", errBase)))
+}
+
+func TestSuppressor(t *testing.T) {
+	const interval = 100 * time.Millisecond
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	s := NewSuppressor(zap.New(core), interval)
+
+	for i := 0; i < 10; i++ {
+		s.Error("a", "error a")
+	}
+	s.Warn("b", "error b")
+
+	require.Equal(t, 2, logs.Len())
+
+	require.Eventually(t, func() bool { return logs.Len() == 3 }, time.Second, interval/10)
+
+	entry := logs.All()[2]
+	require.Equal(t, zapcore.ErrorLevel, entry.Level)
+	require.Equal(t, uint64(9), entry.ContextMap()["count"])
+
+	// A new interval starts after the summary, so the messages are still counted.
+	s.Error("a", "error a")
+	require.Equal(t, 3, logs.Len())
+
+	s.Flush()
+	require.Equal(t, 4, logs.Len())
+	require.Equal(t, uint64(1), logs.All()[3].ContextMap()["count"])
+
+	summaries := s.Summaries(0)
+	require.Len(t, summaries, 2)
+	require.Equal(t, "a", summaries[0].Class)
+	require.Equal(t, "error a", summaries[0].Message)
+	require.EqualValues(t, 1, summaries[0].Count)
+	require.EqualValues(t, 9, summaries[1].Count)
+	require.Equal(t, summaries[:1], s.Summaries(1))
+
+	t.Run("stale classes", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := NewSuppressor(zap.New(core), interval)
+
+		for i := 0; i < maxSuppressedClasses; i++ {
+			s.Warn(fmt.Sprint(i), "error")
+		}
+		require.Len(t, s.classes, maxSuppressedClasses)
+
+		// the class over the limit is not tracked
+		s.Warn("new", "error")
+		s.Warn("new", "error")
+		require.Equal(t, maxSuppressedClasses+2, logs.Len())
+		require.Len(t, s.classes, maxSuppressedClasses)
+
+		// the classes without messages in the last interval are evicted
+		time.Sleep(interval)
+
+		s.Warn("new", "error")
+		s.Warn("new", "error")
+		require.Equal(t, maxSuppressedClasses+3, logs.Len())
+		require.Len(t, s.classes, 1)
+
+		s.Flush()
+	})
+
+	t.Run("no interval", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := NewSuppressor(zap.New(core), 0)
+
+		for i := 0; i < 10; i++ {
+			s.Warn("a", "error a")
+		}
+		require.Equal(t, 10, logs.Len())
+	})
+}